@@ -21,12 +21,18 @@ import (
 
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/cmd"
+	"github.com/tektoncd/cli/pkg/i18n"
 	"github.com/tektoncd/cli/pkg/plugins"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 )
 
 func main() {
+	// an unsupported $LANG falls back to English, it must not prevent tkn
+	// from running, and --language overrides it when the flags are parsed
+	_ = i18n.SetLanguage(i18n.FromEnv())
+
 	tp := &cli.TektonParams{}
+	tp.SetInCluster(cli.InClusterFromEnv())
 	tkn := cmd.Root(tp)
 
 	args := os.Args[1:]
@@ -48,6 +54,6 @@ func main() {
 
 CoreTkn:
 	if err := tkn.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(cli.ExitCode(err))
 	}
 }
@@ -0,0 +1,143 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+var (
+	// the message of the API server when a validating webhook denies a
+	// request, followed by the message of the webhook
+	webhookDenied = regexp.MustCompile(`(?s)^admission webhook "([^"]+)" denied the request:?(.*)$`)
+	// the path of the field Kyverno reports a violation at
+	failedAtPath = regexp.MustCompile(`failed at path (\S+)`)
+	// the field paths Knative, and so Tekton, ends its validation errors with
+	trailingPaths = regexp.MustCompile(`^(.*?): ((?:spec|metadata)[\w.\[\]"/-]*(?:, (?:spec|metadata)[\w.\[\]"/-]*)*)$`)
+	// a rule of a Kyverno policy and its message
+	kyvernoRule = regexp.MustCompile(`^([\w.-]+): '(.*)'$`)
+)
+
+// Violation is a reason an admission webhook rejected an object
+type Violation struct {
+	// Policy is the policy, or the policy and its rule, violated when the
+	// webhook tells it
+	Policy  string
+	Message string
+	// Field is the path of the offending field when the webhook tells it
+	Field string
+}
+
+// RejectionError is returned when a validating admission webhook, e.g. the
+// one of a policy engine, rejected the creation of an object
+type RejectionError struct {
+	Kind       string
+	Webhook    string
+	Violations []Violation
+	// Err is the error of the API server
+	Err error
+}
+
+func (e *RejectionError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s rejected by admission webhook %q", e.Kind, e.Webhook)
+	for _, v := range e.Violations {
+		b.WriteString("\n- ")
+		if v.Policy != "" {
+			fmt.Fprintf(&b, "[%s] ", v.Policy)
+		}
+		b.WriteString(v.Message)
+		if v.Field != "" {
+			fmt.Fprintf(&b, " (field %s)", v.Field)
+		}
+	}
+	return b.String()
+}
+
+func (e *RejectionError) Unwrap() error {
+	return e.Err
+}
+
+// DescribeRejection returns a RejectionError listing the violations when
+// err is the rejection of the creation of an object of kind by a
+// validating admission webhook, and err otherwise
+func DescribeRejection(kind string, err error) error {
+	var status apierrors.APIStatus
+	if !errors.As(err, &status) {
+		return err
+	}
+	s := status.Status()
+	m := webhookDenied.FindStringSubmatch(s.Message)
+	if m == nil {
+		return err
+	}
+
+	rejection := &RejectionError{Kind: kind, Webhook: m[1], Err: err}
+	if s.Details != nil {
+		for _, c := range s.Details.Causes {
+			if c.Message != "" {
+				rejection.Violations = append(rejection.Violations, Violation{Message: c.Message, Field: c.Field})
+			}
+		}
+	}
+	if len(rejection.Violations) == 0 {
+		rejection.Violations = parseViolations(m[2])
+	}
+	return rejection
+}
+
+// parseViolations reads the violations from the message of a webhook, a
+// violation per line, the lines ending with a colon naming the policy of
+// the lines indented below them as Kyverno does
+func parseViolations(message string) []Violation {
+	var violations []Violation
+	policy := ""
+	for _, line := range strings.Split(message, "\n") {
+		indented := strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "resource ") && strings.Contains(line, " was blocked "):
+			// the preamble of Kyverno
+			continue
+		case strings.HasSuffix(line, ":") && !indented:
+			policy = strings.TrimSuffix(line, ":")
+			continue
+		}
+
+		v := Violation{Message: line}
+		if !indented {
+			policy = ""
+		}
+		v.Policy = policy
+		if m := kyvernoRule.FindStringSubmatch(line); m != nil && policy != "" {
+			v.Policy = policy + "/" + m[1]
+			v.Message = m[2]
+		}
+		if m := failedAtPath.FindStringSubmatch(v.Message); m != nil {
+			v.Field = m[1]
+		} else if m := trailingPaths.FindStringSubmatch(v.Message); m != nil {
+			v.Message, v.Field = m[1], m[2]
+		}
+		violations = append(violations, v)
+	}
+	return violations
+}
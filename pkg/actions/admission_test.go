@@ -0,0 +1,100 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func denied(message string, causes ...metav1.StatusCause) error {
+	status := metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusBadRequest,
+		Reason:  metav1.StatusReasonBadRequest,
+		Message: message,
+	}
+	if len(causes) > 0 {
+		status.Details = &metav1.StatusDetails{Causes: causes}
+	}
+	return &apierrors.StatusError{ErrStatus: status}
+}
+
+func TestDescribeRejection(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "kyverno",
+			err: denied(`admission webhook "validate.kyverno.svc-fail" denied the request: 
+
+resource PipelineRun/ns/build-1 was blocked due to the following policies 
+
+require-team-label:
+  check-team: 'validation error: label team is required. rule check-team failed at path /metadata/labels/team/'
+`),
+			want: `PipelineRun rejected by admission webhook "validate.kyverno.svc-fail"
+- [require-team-label/check-team] validation error: label team is required. rule check-team failed at path /metadata/labels/team/ (field /metadata/labels/team/)`,
+		},
+		{
+			name: "tekton",
+			err: denied(`admission webhook "validation.webhook.pipeline.tekton.dev" denied the request: validation failed: invalid value: 0s: spec.timeout
+missing field(s): spec.taskRef, spec.taskSpec`),
+			want: `PipelineRun rejected by admission webhook "validation.webhook.pipeline.tekton.dev"
+- validation failed: invalid value: 0s (field spec.timeout)
+- missing field(s) (field spec.taskRef, spec.taskSpec)`,
+		},
+		{
+			name: "gatekeeper",
+			err:  denied(`admission webhook "validation.gatekeeper.sh" denied the request: [allowed-repos] container <step-build> has an invalid image repo <docker.io/build>`),
+			want: `PipelineRun rejected by admission webhook "validation.gatekeeper.sh"
+- [allowed-repos] container <step-build> has an invalid image repo <docker.io/build>`,
+		},
+		{
+			name: "causes",
+			err: denied(`admission webhook "policy.example.com" denied the request: 2 violations`,
+				metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid, Message: "must be signed", Field: "spec.pipelineRef"},
+				metav1.StatusCause{Type: metav1.CauseTypeFieldValueRequired, Message: "is required", Field: "metadata.labels.team"},
+			),
+			want: `PipelineRun rejected by admission webhook "policy.example.com"
+- must be signed (field spec.pipelineRef)
+- is required (field metadata.labels.team)`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := DescribeRejection("PipelineRun", tc.err)
+			assert.Error(t, err, tc.want)
+			assert.Assert(t, errors.Is(err, tc.err))
+			assert.Assert(t, apierrors.IsBadRequest(err))
+		})
+	}
+}
+
+func TestDescribeRejection_other(t *testing.T) {
+	for _, err := range []error{
+		errors.New("connection refused"),
+		apierrors.NewAlreadyExists(taskrunResource.GroupResource(), "run"),
+	} {
+		assert.Equal(t, DescribeRejection("TaskRun", err), err)
+	}
+}
@@ -16,9 +16,15 @@ package actions
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/names"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 )
@@ -37,3 +43,43 @@ func Delete(gr schema.GroupVersionResource, dynamic dynamic.Interface, discovery
 
 	return nil
 }
+
+// DeleteV1 deletes the object using the dynamic client of the clients, with
+// the DryRun of op set the API server only checks that it could be deleted.
+func DeleteV1(gr schema.GroupVersionResource, c *cli.Clients, objname, ns string, op metav1.DeleteOptions) error {
+	return Delete(gr, c.Dynamic, c.Tekton.Discovery(), objname, ns, op)
+}
+
+// deletionPollInterval is how often WaitForDeletion checks whether the
+// objects are gone
+const deletionPollInterval = time.Second
+
+// WaitForDeletion blocks until the objects are gone from the cluster, i.e.
+// until their finalizers have run, or until timeout expires.
+func WaitForDeletion(gr schema.GroupVersionResource, dynamic dynamic.Interface, discovery discovery.DiscoveryInterface, objnames []string, ns string, timeout time.Duration) error {
+	gvr, err := GetGroupVersionResource(gr, discovery)
+	if err != nil {
+		return err
+	}
+
+	remaining := objnames
+	err = wait.PollUntilContextTimeout(context.Background(), deletionPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		var left []string
+		for _, name := range remaining {
+			_, err := dynamic.Resource(*gvr).Namespace(ns).Get(ctx, name, metav1.GetOptions{})
+			switch {
+			case apierrors.IsNotFound(err):
+			case err != nil:
+				return false, err
+			default:
+				left = append(left, name)
+			}
+		}
+		remaining = left
+		return len(remaining) == 0, nil
+	})
+	if wait.Interrupted(err) {
+		return fmt.Errorf("timed out after %s waiting for the deletion of %s %s", timeout, gvr.Resource, names.QuotedList(remaining))
+	}
+	return err
+}
@@ -17,6 +17,7 @@ package actions
 import (
 	"sync"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/restmapper"
@@ -24,6 +25,7 @@ import (
 
 var (
 	doOnce      sync.Once
+	mu          sync.RWMutex
 	apiGroupRes []*restmapper.APIGroupResources
 )
 
@@ -37,7 +39,9 @@ func GetGroupVersionResource(gr schema.GroupVersionResource, discovery discovery
 		return nil, err
 	}
 
+	mu.RLock()
 	rm := restmapper.NewDiscoveryRESTMapper(apiGroupRes)
+	mu.RUnlock()
 	gvr, err := rm.ResourceFor(gr)
 	if err != nil {
 		return nil, err
@@ -46,12 +50,48 @@ func GetGroupVersionResource(gr schema.GroupVersionResource, discovery discovery
 	return &gvr, nil
 }
 
+// ResolveGroupVersionResource is GetGroupVersionResource with the resources
+// discovered on each call rather than those of the first cluster, for the
+// clusters of several contexts to be used in one process
+func ResolveGroupVersionResource(gr schema.GroupVersionResource, discovery discovery.DiscoveryInterface) (*schema.GroupVersionResource, error) {
+	res, err := restmapper.GetAPIGroupResources(discovery)
+	if err != nil {
+		return nil, err
+	}
+
+	gvr, err := restmapper.NewDiscoveryRESTMapper(res).ResourceFor(gr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gvr, nil
+}
+
+// GetRESTMapping returns the resource and the scope of a kind, as served by
+// the API server
+func GetRESTMapping(gvk schema.GroupVersionKind, discovery discovery.DiscoveryInterface) (*meta.RESTMapping, error) {
+	var err error
+	doOnce.Do(func() {
+		err = InitializeAPIGroupRes(discovery)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mu.RLock()
+	rm := restmapper.NewDiscoveryRESTMapper(apiGroupRes)
+	mu.RUnlock()
+	return rm.RESTMapping(gvk.GroupKind(), gvk.Version)
+}
+
 // InitializeAPIGroupRes initializes and populates the discovery client.
 func InitializeAPIGroupRes(discovery discovery.DiscoveryInterface) error {
-	var err error
-	apiGroupRes, err = restmapper.GetAPIGroupResources(discovery)
+	res, err := restmapper.GetAPIGroupResources(discovery)
 	if err != nil {
 		return err
 	}
+	mu.Lock()
+	apiGroupRes = res
+	mu.Unlock()
 	return nil
 }
@@ -51,6 +51,22 @@ func ListV1(gr schema.GroupVersionResource, c *cli.Clients, opts metav1.ListOpti
 	return runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObj.UnstructuredContent(), obj)
 }
 
+// ListV1Cluster is ListV1 resolving the resource with the discovery of the
+// clients, for the clients of several clusters to be used concurrently
+func ListV1Cluster(gr schema.GroupVersionResource, c *cli.Clients, opts metav1.ListOptions, ns string, obj interface{}) error {
+	gvr, err := ResolveGroupVersionResource(gr, c.Tekton.Discovery())
+	if err != nil {
+		return err
+	}
+
+	unstructuredObj, err := c.Dynamic.Resource(*gvr).Namespace(ns).List(context.Background(), opts)
+	if err != nil {
+		return err
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObj.UnstructuredContent(), obj)
+}
+
 // list takes a partial resource and fetches a list of that resource's objects in the cluster using the dynamic client.
 func list(gr schema.GroupVersionResource, dynamic dynamic.Interface, discovery discovery.DiscoveryInterface, ns string, op metav1.ListOptions) (*unstructured.UnstructuredList, error) {
 	gvr, err := GetGroupVersionResource(gr, discovery)
@@ -16,23 +16,87 @@ package actions
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 
 	"github.com/tektoncd/cli/pkg/cli"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// FieldManager is the manager of the fields tkn applies when the options of
+// Apply do not set one
+const FieldManager = "tkn"
+
+// JSONPatchOperation is an operation of a JSON patch, see RFC 6902
+type JSONPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// DryRun returns the DryRun of the options of a patch, an apply or a
+// deletion, asking the API server to only return what the result would be
+// when dryRun is set
+func DryRun(dryRun bool) []string {
+	if !dryRun {
+		return nil
+	}
+	return []string{metav1.DryRunAll}
+}
+
 // Patch takes a partial resource, an object name in the cluster, and patch data to be applied to that object, and patches the object using the dynamic client.
 func Patch(gr schema.GroupVersionResource, clients *cli.Clients, objName string, data []byte, opt metav1.PatchOptions, ns string, obj interface{}) error {
+	return patch(gr, clients, objName, types.JSONPatchType, data, opt, ns, obj)
+}
+
+// JSONPatch patches the object with the operations of a JSON patch and
+// converts the patched object into obj.
+func JSONPatch(gr schema.GroupVersionResource, clients *cli.Clients, objName string, ops []JSONPatchOperation, opt metav1.PatchOptions, ns string, obj interface{}) error {
+	data, err := json.Marshal(ops)
+	if err != nil {
+		return err
+	}
+	return patch(gr, clients, objName, types.JSONPatchType, data, opt, ns, obj)
+}
+
+// MergePatch patches the object with a JSON merge patch, mergePatch being
+// marshalled to JSON, and converts the patched object into obj.
+func MergePatch(gr schema.GroupVersionResource, clients *cli.Clients, objName string, mergePatch interface{}, opt metav1.PatchOptions, ns string, obj interface{}) error {
+	data, err := json.Marshal(mergePatch)
+	if err != nil {
+		return err
+	}
+	return patch(gr, clients, objName, types.MergePatchType, data, opt, ns, obj)
+}
+
+// Apply applies the object with server-side apply, creating it when it does
+// not exist, and converts the object as the API server stored it into obj.
+func Apply(gr schema.GroupVersionResource, clients *cli.Clients, object *unstructured.Unstructured, opt metav1.ApplyOptions, ns string, obj interface{}) error {
+	gvr, err := GetGroupVersionResource(gr, clients.Tekton.Discovery())
+	if err != nil {
+		return err
+	}
+	if opt.FieldManager == "" {
+		opt.FieldManager = FieldManager
+	}
+	unstructuredObj, err := clients.Dynamic.Resource(*gvr).Namespace(ns).Apply(context.Background(), object.GetName(), object, opt)
+	if err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObj.UnstructuredContent(), obj)
+}
+
+func patch(gr schema.GroupVersionResource, clients *cli.Clients, objName string, pt types.PatchType, data []byte, opt metav1.PatchOptions, ns string, obj interface{}) error {
 	gvr, err := GetGroupVersionResource(gr, clients.Tekton.Discovery())
 	if err != nil {
 		return err
 	}
-	unstructuredObj, err := clients.Dynamic.Resource(*gvr).Namespace(ns).Patch(context.Background(), objName, types.JSONPatchType, data, opt)
+	unstructuredObj, err := clients.Dynamic.Resource(*gvr).Namespace(ns).Patch(context.Background(), objName, pt, data, opt)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to patch object from %s namespace \n", ns)
 		return err
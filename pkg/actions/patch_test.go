@@ -0,0 +1,144 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	fakepipeline "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/fake"
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	k8stest "k8s.io/client-go/testing"
+)
+
+var taskrunResource = schema.GroupVersionResource{Group: "tekton.dev", Resource: "taskruns"}
+
+func unstructuredTaskRun(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "tekton.dev/v1",
+		"kind":       "TaskRun",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": "ns",
+			"labels":    map[string]interface{}{"app": "build"},
+		},
+		"spec": map[string]interface{}{
+			"taskRef": map[string]interface{}{"name": "build"},
+		},
+	}}
+}
+
+// testClients returns clients whose dynamic client holds the objects and
+// whose discovery knows the TaskRuns of tekton.dev/v1
+func testClients(t *testing.T, objects ...runtime.Object) (*cli.Clients, *fake.FakeDynamicClient) {
+	t.Helper()
+	tekton := fakepipeline.NewSimpleClientset()
+	tekton.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "tekton.dev/v1",
+		APIResources: []metav1.APIResource{{Name: "taskruns", Group: "tekton.dev", Version: "v1", Kind: "TaskRun", Namespaced: true}},
+	}}
+	dynamic := fake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}: "TaskRunList"},
+		objects...,
+	)
+	return &cli.Clients{Tekton: tekton, Dynamic: dynamic}, dynamic
+}
+
+func TestJSONPatch(t *testing.T) {
+	c, _ := testClients(t, unstructuredTaskRun("run"))
+
+	var tr *v1.TaskRun
+	err := JSONPatch(taskrunResource, c, "run", []JSONPatchOperation{
+		{Op: "add", Path: "/spec/status", Value: v1.TaskRunSpecStatusCancelled},
+		{Op: "remove", Path: "/metadata/labels/app"},
+	}, metav1.PatchOptions{}, "ns", &tr)
+	assert.NilError(t, err)
+	assert.Equal(t, string(tr.Spec.Status), v1.TaskRunSpecStatusCancelled)
+	assert.Equal(t, len(tr.Labels), 0)
+}
+
+func TestMergePatch(t *testing.T) {
+	c, _ := testClients(t, unstructuredTaskRun("run"))
+
+	var tr *v1.TaskRun
+	err := MergePatch(taskrunResource, c, "run", map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{"owner": "ci"},
+			"labels":      map[string]interface{}{"app": nil},
+		},
+	}, metav1.PatchOptions{}, "ns", &tr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, tr.Annotations, map[string]string{"owner": "ci"})
+	assert.Equal(t, len(tr.Labels), 0)
+	assert.Equal(t, tr.Spec.TaskRef.Name, "build")
+}
+
+func TestPatch_notFound(t *testing.T) {
+	c, _ := testClients(t)
+
+	var tr *v1.TaskRun
+	err := MergePatch(taskrunResource, c, "missing", map[string]string{}, metav1.PatchOptions{}, "ns", &tr)
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
+
+func TestApply(t *testing.T) {
+	c, dynamic := testClients(t)
+
+	// the object tracker of the fake client cannot apply unstructured
+	// objects, the reactor returns the applied configuration
+	var patchType types.PatchType
+	dynamic.PrependReactor("patch", "taskruns", func(action k8stest.Action) (bool, runtime.Object, error) {
+		patch := action.(k8stest.PatchAction)
+		patchType = patch.GetPatchType()
+		obj := &unstructured.Unstructured{}
+		return true, obj, obj.UnmarshalJSON(patch.GetPatch())
+	})
+
+	applied := unstructuredTaskRun("run")
+	applied.SetAnnotations(map[string]string{"owner": "ci"})
+	var tr *v1.TaskRun
+	err := Apply(taskrunResource, c, applied, metav1.ApplyOptions{}, "ns", &tr)
+	assert.NilError(t, err)
+	assert.Equal(t, patchType, types.ApplyPatchType)
+	assert.Equal(t, tr.Name, "run")
+	assert.DeepEqual(t, tr.Annotations, map[string]string{"owner": "ci"})
+}
+
+func TestDeleteV1(t *testing.T) {
+	c, dynamic := testClients(t, unstructuredTaskRun("run"))
+
+	err := DeleteV1(taskrunResource, c, "run", "ns", metav1.DeleteOptions{})
+	assert.NilError(t, err)
+	_, err = dynamic.Resource(schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}).Namespace("ns").Get(context.Background(), "run", metav1.GetOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+
+	err = DeleteV1(taskrunResource, c, "run", "ns", metav1.DeleteOptions{})
+	assert.Assert(t, apierrors.IsNotFound(err))
+}
+
+func TestDryRun(t *testing.T) {
+	assert.Assert(t, DryRun(false) == nil)
+	assert.DeepEqual(t, DryRun(true), []string{metav1.DryRunAll})
+}
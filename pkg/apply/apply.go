@@ -0,0 +1,298 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apply applies manifests of Tekton resources with server-side
+// apply and diffs them against the resources of the cluster
+package apply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+)
+
+// FieldManager is the manager of the fields set by tkn
+const FieldManager = "tkn"
+
+// groups are the API groups of the resources which can be applied
+var groups = map[string]bool{
+	"tekton.dev":          true,
+	"triggers.tekton.dev": true,
+}
+
+// rank orders the kinds so the resources are applied after the ones they
+// refer to, e.g. Tasks before the Pipelines and Pipelines before the runs
+var rank = map[string]int{
+	"StepAction":            0,
+	"Task":                  0,
+	"ClusterTask":           0,
+	"Interceptor":           0,
+	"ClusterInterceptor":    0,
+	"VerificationPolicy":    0,
+	"Pipeline":              1,
+	"TriggerBinding":        1,
+	"ClusterTriggerBinding": 1,
+	"TriggerTemplate":       1,
+	"Trigger":               2,
+	"EventListener":         3,
+}
+
+// runRank is the rank of the kinds missing from rank, i.e. the runs
+const runRank = 4
+
+// Object is a resource read from a manifest
+type Object struct {
+	*unstructured.Unstructured
+	// Source is the file the resource was read from
+	Source string
+}
+
+// Ref is the kind and name of the object, e.g. Pipeline/build
+func (o Object) Ref() string {
+	return o.GetKind() + "/" + o.GetName()
+}
+
+// Load reads the resources of the manifests of paths, a directory is read
+// file by file and its subdirectories too when recursive is set, - reads
+// the manifests from in
+func Load(paths []string, recursive bool, in io.Reader) ([]Object, error) {
+	var objs []Object
+	for _, path := range paths {
+		if path == "-" {
+			o, err := decode("stdin", in)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, o...)
+			continue
+		}
+
+		files, err := manifests(path, recursive)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			o, err := loadFile(f)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, o...)
+		}
+	}
+	if len(objs) == 0 {
+		return nil, errors.New("no resources found in the manifests")
+	}
+	return objs, nil
+}
+
+// manifests returns the path if it is a file, else the YAML and JSON files
+// of the directory
+func manifests(path string, recursive bool) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != path && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch filepath.Ext(p) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, p)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func loadFile(path string) ([]Object, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return decode(path, f)
+}
+
+// decode reads the documents of a manifest, the empty ones are skipped
+func decode(source string, r io.Reader) ([]Object, error) {
+	var objs []Object
+	d := yaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		content := map[string]interface{}{}
+		if err := d.Decode(&content); err != nil {
+			if err == io.EOF {
+				return objs, nil
+			}
+			return nil, fmt.Errorf("failed to parse %s: %w", source, err)
+		}
+		if len(content) == 0 {
+			continue
+		}
+
+		o := Object{Unstructured: &unstructured.Unstructured{Object: content}, Source: source}
+		gvk := o.GroupVersionKind()
+		if !groups[gvk.Group] {
+			return nil, fmt.Errorf("%s: %s %s is not a Tekton resource", source, o.GetAPIVersion(), o.GetKind())
+		}
+		if o.GetName() == "" {
+			return nil, fmt.Errorf("%s: %s has no name, resources with a generateName cannot be applied", source, o.GetKind())
+		}
+		objs = append(objs, o)
+	}
+}
+
+// Sort orders the objects so that the ones referred to are applied first,
+// the order of the manifests is kept otherwise
+func Sort(objs []Object) {
+	sort.SliceStable(objs, func(i, j int) bool {
+		return kindRank(objs[i].GetKind()) < kindRank(objs[j].GetKind())
+	})
+}
+
+func kindRank(kind string) int {
+	if r, ok := rank[kind]; ok {
+		return r
+	}
+	return runRank
+}
+
+// Options are the options of an apply
+type Options struct {
+	// Namespace is the namespace of the objects which do not set one
+	Namespace string
+	// DryRun only asks the API server what the result would be
+	DryRun bool
+	// Force takes the ownership of the fields other managers set
+	Force bool
+}
+
+// Apply applies the object with server-side apply and returns the object
+// as the API server stored it, or would store it on a dry run
+func Apply(c *cli.Clients, obj Object, opts Options) (*unstructured.Unstructured, error) {
+	ao := metav1.ApplyOptions{FieldManager: FieldManager, Force: opts.Force}
+	if opts.DryRun {
+		ao.DryRun = []string{metav1.DryRunAll}
+	}
+	r, err := resource(c, obj, opts.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return r.Apply(context.Background(), obj.GetName(), obj.Unstructured, ao)
+}
+
+// Live returns the object as it is in the cluster, nil if it does not exist
+func Live(c *cli.Clients, obj Object, ns string) (*unstructured.Unstructured, error) {
+	r, err := resource(c, obj, ns)
+	if err != nil {
+		return nil, err
+	}
+	live, err := r.Get(context.Background(), obj.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return live, err
+}
+
+// resource returns the client of the resource of the object, as mapped by
+// the discovery of the API server
+func resource(c *cli.Clients, obj Object, ns string) (dynamic.ResourceInterface, error) {
+	mapping, err := actions.GetRESTMapping(obj.GroupVersionKind(), c.Tekton.Discovery())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the resource of %s: %w", obj.Ref(), err)
+	}
+	r := c.Dynamic.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		return r, nil
+	}
+	if obj.GetNamespace() != "" {
+		ns = obj.GetNamespace()
+	}
+	return r.Namespace(ns), nil
+}
+
+// Plan is what applying an object changes, as told by a dry run
+type Plan struct {
+	Object Object
+	// Live is the object in the cluster, nil when it does not exist
+	Live    *unstructured.Unstructured
+	Changes []Change
+}
+
+// Status is created, configured or unchanged
+func (p Plan) Status() string {
+	switch {
+	case p.Live == nil:
+		return "created"
+	case len(p.Changes) > 0:
+		return "configured"
+	default:
+		return "unchanged"
+	}
+}
+
+// NewPlans dry runs the apply of each object and diffs the result with the
+// object in the cluster
+func NewPlans(c *cli.Clients, objs []Object, opts Options) ([]Plan, error) {
+	opts.DryRun = true
+	plans := make([]Plan, 0, len(objs))
+	for _, obj := range objs {
+		live, err := Live(c, obj, opts.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s: %v", obj.Ref(), err)
+		}
+		applied, err := Apply(c, obj, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply %s from %s: %v", obj.Ref(), obj.Source, err)
+		}
+		plan := Plan{Object: obj, Live: live}
+		if live != nil {
+			plan.Changes = Diff(live, applied)
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// PrintPlans prints the status of each object followed by its changes
+func PrintPlans(w io.Writer, plans []Plan, suffix string) {
+	for _, p := range plans {
+		fmt.Fprintf(w, "%s %s%s\n", p.Object.Ref(), p.Status(), suffix)
+		PrintChanges(w, p.Changes)
+	}
+}
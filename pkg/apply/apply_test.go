@@ -0,0 +1,170 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestLoad(t *testing.T) {
+	testParams := []struct {
+		name     string
+		manifest string
+		want     []string
+		wantErr  string
+	}{
+		{
+			name: "documents",
+			manifest: `---
+apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: build
+---
+---
+apiVersion: triggers.tekton.dev/v1beta1
+kind: TriggerTemplate
+metadata:
+  name: on-push
+`,
+			want: []string{"Pipeline/build", "TriggerTemplate/on-push"},
+		},
+		{
+			name:     "not a Tekton resource",
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n",
+			wantErr:  "stdin: v1 ConfigMap is not a Tekton resource",
+		},
+		{
+			name:     "generateName",
+			manifest: "apiVersion: tekton.dev/v1\nkind: PipelineRun\nmetadata:\n  generateName: build-\n",
+			wantErr:  "stdin: PipelineRun has no name, resources with a generateName cannot be applied",
+		},
+		{
+			name:     "empty",
+			manifest: "---\n",
+			wantErr:  "no resources found in the manifests",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			objs, err := Load([]string{"-"}, false, strings.NewReader(tp.manifest))
+			if tp.wantErr != "" {
+				assert.Error(t, err, tp.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tp.want, refs(objs))
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	objs := []Object{
+		object("tekton.dev/v1", "PipelineRun", "build-run"),
+		object("triggers.tekton.dev/v1beta1", "EventListener", "listener"),
+		object("tekton.dev/v1", "Pipeline", "build"),
+		object("tekton.dev/v1", "Task", "compile"),
+		object("tekton.dev/v1", "Pipeline", "release"),
+		object("tekton.dev/v1beta1", "ClusterTask", "git-clone"),
+	}
+	Sort(objs)
+	assert.DeepEqual(t, []string{
+		"Task/compile", "ClusterTask/git-clone", "Pipeline/build", "Pipeline/release", "EventListener/listener", "PipelineRun/build-run",
+	}, refs(objs))
+}
+
+func TestLive(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+	cs.Pipeline.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "tekton.dev/v1beta1",
+		APIResources: []metav1.APIResource{
+			{Name: "tasks", Kind: "Task", Namespaced: true},
+			{Name: "clustertasks", Kind: "ClusterTask"},
+		},
+	}}
+	task := object("tekton.dev/v1beta1", "Task", "compile")
+	task.SetNamespace("ns")
+	clusterTask := object("tekton.dev/v1beta1", "ClusterTask", "git-clone")
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(task.Unstructured, clusterTask.Unstructured)
+	assert.NilError(t, err)
+	p := &test.Params{Tekton: cs.Pipeline, Dynamic: dc}
+	c, err := p.Clients()
+	assert.NilError(t, err)
+
+	// the resources and their scope come from the discovery of the cluster
+	live, err := Live(c, object("tekton.dev/v1beta1", "Task", "compile"), "ns")
+	assert.NilError(t, err)
+	assert.Equal(t, live.GetName(), "compile")
+	live, err = Live(c, clusterTask, "ns")
+	assert.NilError(t, err)
+	assert.Equal(t, live.GetName(), "git-clone")
+	live, err = Live(c, object("tekton.dev/v1beta1", "Task", "lint"), "ns")
+	assert.NilError(t, err)
+	assert.Assert(t, live == nil)
+
+	_, err = Live(c, object("tekton.dev/v1beta1", "StepAction", "setup"), "ns")
+	assert.ErrorContains(t, err, "failed to find the resource of StepAction/setup: ")
+}
+
+func TestDiff_lists(t *testing.T) {
+	live := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"workspaces": []interface{}{"source"},
+			"steps": []interface{}{
+				map[string]interface{}{"name": "build", "image": "golang:1.21"},
+			},
+		},
+	}
+	applied := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"workspaces": []interface{}{"source", "cache"},
+			"steps": []interface{}{
+				map[string]interface{}{"name": "build", "image": "golang:1.22"},
+			},
+		},
+	}
+
+	changes := Diff(&unstructured.Unstructured{Object: live}, &unstructured.Unstructured{Object: applied})
+	assert.DeepEqual(t, []Change{
+		{Op: Changed, Path: "spec.steps[build].image", Old: "golang:1.21", New: "golang:1.22"},
+		{Op: Added, Path: "spec.workspaces[1]", New: "cache"},
+	}, changes)
+}
+
+func object(apiVersion, kind, name string) Object {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	return Object{Unstructured: obj}
+}
+
+func refs(objs []Object) []string {
+	refs := make([]string, 0, len(objs))
+	for _, o := range objs {
+		refs = append(refs, o.Ref())
+	}
+	return refs
+}
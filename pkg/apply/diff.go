@@ -0,0 +1,189 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/tektoncd/cli/pkg/export"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Operations of a Change
+const (
+	Added   = "+"
+	Removed = "-"
+	Changed = "~"
+)
+
+// Change is a field which differs between two versions of a resource
+type Change struct {
+	Op   string
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+// Diff returns the changes of the fields from live to applied, the fields
+// set by the API server such as the status or the managedFields are
+// ignored. A nil live is a resource which does not exist yet.
+func Diff(live, applied *unstructured.Unstructured) []Change {
+	var changes []Change
+	diff(&changes, "", content(live), content(applied))
+	return changes
+}
+
+func content(obj *unstructured.Unstructured) map[string]interface{} {
+	if obj == nil {
+		return map[string]interface{}{}
+	}
+	obj = obj.DeepCopy()
+	export.RemoveServerFields(obj)
+	return obj.Object
+}
+
+func diff(changes *[]Change, path string, old, new interface{}) {
+	switch o := old.(type) {
+	case map[string]interface{}:
+		if n, ok := new.(map[string]interface{}); ok {
+			diffMaps(changes, path, o, n)
+			return
+		}
+	case []interface{}:
+		if n, ok := new.([]interface{}); ok {
+			diffLists(changes, path, o, n)
+			return
+		}
+	}
+	if !reflect.DeepEqual(old, new) {
+		*changes = append(*changes, Change{Op: Changed, Path: path, Old: old, New: new})
+	}
+}
+
+func diffMaps(changes *[]Change, path string, old, new map[string]interface{}) {
+	keys := make([]string, 0, len(old)+len(new))
+	for k := range old {
+		keys = append(keys, k)
+	}
+	for k := range new {
+		if _, ok := old[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		p := k
+		if path != "" {
+			p = path + "." + k
+		}
+		o, inOld := old[k]
+		n, inNew := new[k]
+		switch {
+		case !inOld:
+			*changes = append(*changes, Change{Op: Added, Path: p, New: n})
+		case !inNew:
+			*changes = append(*changes, Change{Op: Removed, Path: p, Old: o})
+		default:
+			diff(changes, p, o, n)
+		}
+	}
+}
+
+// diffLists matches the items of lists of named items, such as the tasks
+// of a Pipeline or the params, by name and the items of the other lists by
+// index
+func diffLists(changes *[]Change, path string, old, new []interface{}) {
+	oldNames, oldNamed := names(old)
+	newNames, newNamed := names(new)
+	if !oldNamed || !newNamed {
+		for i := 0; i < len(old) || i < len(new); i++ {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(old):
+				*changes = append(*changes, Change{Op: Added, Path: p, New: new[i]})
+			case i >= len(new):
+				*changes = append(*changes, Change{Op: Removed, Path: p, Old: old[i]})
+			default:
+				diff(changes, p, old[i], new[i])
+			}
+		}
+		return
+	}
+
+	oldByName := make(map[string]interface{}, len(old))
+	for i, name := range oldNames {
+		oldByName[name] = old[i]
+	}
+	newByName := make(map[string]bool, len(new))
+	for i, name := range newNames {
+		newByName[name] = true
+		p := fmt.Sprintf("%s[%s]", path, name)
+		if o, ok := oldByName[name]; ok {
+			diff(changes, p, o, new[i])
+			continue
+		}
+		*changes = append(*changes, Change{Op: Added, Path: p, New: new[i]})
+	}
+	for i, name := range oldNames {
+		if !newByName[name] {
+			*changes = append(*changes, Change{Op: Removed, Path: fmt.Sprintf("%s[%s]", path, name), Old: old[i]})
+		}
+	}
+}
+
+// names returns the names of the items of the list, false when one of them
+// has no name
+func names(list []interface{}) ([]string, bool) {
+	names := make([]string, 0, len(list))
+	for _, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := m["name"].(string)
+		if !ok || name == "" {
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, true
+}
+
+// PrintChanges prints a change per line
+func PrintChanges(w io.Writer, changes []Change) {
+	for _, c := range changes {
+		switch c.Op {
+		case Added:
+			fmt.Fprintf(w, "  + %s: %s\n", c.Path, value(c.New))
+		case Removed:
+			fmt.Fprintf(w, "  - %s: %s\n", c.Path, value(c.Old))
+		default:
+			fmt.Fprintf(w, "  ~ %s: %s -> %s\n", c.Path, value(c.Old), value(c.New))
+		}
+	}
+}
+
+func value(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -0,0 +1,240 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit keeps a local log of the commands which changed resources,
+// enabled with the audit.enabled setting of the config profile
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/state"
+)
+
+// Actions recorded in the audit log
+const (
+	ActionStart  = "start"
+	ActionDelete = "delete"
+	ActionCancel = "cancel"
+	ActionApply  = "apply"
+)
+
+const (
+	logKey = "audit/log"
+	// maxEntries is the number of entries kept, the oldest ones are
+	// dropped first
+	maxEntries = 10000
+)
+
+var (
+	// openStore returns the store the audit log is kept in
+	openStore = state.Open
+	// warnings is where failures to record an entry are reported, they
+	// must not fail a command which already changed the cluster
+	warnings io.Writer = os.Stderr
+)
+
+// Entry is a change made by a command
+type Entry struct {
+	Time      time.Time `json:"time"`
+	User      string    `json:"user,omitempty"`
+	Cluster   string    `json:"cluster,omitempty"`
+	Namespace string    `json:"namespace,omitempty"`
+	Action    string    `json:"action"`
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	Command   string    `json:"command,omitempty"`
+}
+
+// clusterParams is implemented by the Params which know the API server
+// their clients talk to
+type clusterParams interface {
+	Cluster() string
+}
+
+// Record adds an entry per name to the audit log when it is enabled
+func Record(p cli.Params, action, kind string, names ...string) {
+	if len(names) == 0 {
+		return
+	}
+	profile, err := p.Profile()
+	if err != nil || !profile.Audit.Enabled {
+		return
+	}
+
+	entry := Entry{
+		Time:      p.Time().Now(),
+		Namespace: p.Namespace(),
+		Action:    action,
+		Kind:      kind,
+		Command:   command(os.Args),
+	}
+	if u, err := user.Current(); err == nil {
+		entry.User = u.Username
+	}
+	if cp, ok := p.(clusterParams); ok {
+		entry.Cluster = cp.Cluster()
+	}
+
+	entries := make([]Entry, 0, len(names))
+	for _, name := range names {
+		e := entry
+		e.Name = name
+		entries = append(entries, e)
+	}
+	if err := appendEntries(entries); err != nil {
+		fmt.Fprintf(warnings, "Warning: failed to record %s of %s in the audit log: %v\n", action, kind, err)
+	}
+}
+
+// redacted replaces the values of the flags which may hold secrets
+const redacted = "***"
+
+// secretFlag tells whether the value of the flag named name may be a secret,
+// params are passed to the runs as is and keys or tokens are credentials
+func secretFlag(name string) bool {
+	if name == "p" || name == "param" {
+		return true
+	}
+	for _, suffix := range []string{"key", "token", "password", "secret"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// command returns the command line of args with the values of the flags
+// which may hold secrets redacted
+func command(args []string) string {
+	out := make([]string, 0, len(args))
+	redactNext := false
+	for _, arg := range args {
+		switch {
+		case redactNext:
+			out = append(out, redacted)
+			redactNext = false
+		case arg == "--":
+			out = append(out, arg)
+		case strings.HasPrefix(arg, "--"):
+			name, _, hasValue := strings.Cut(arg[2:], "=")
+			if !secretFlag(name) {
+				out = append(out, arg)
+			} else if hasValue {
+				out = append(out, "--"+name+"="+redacted)
+			} else {
+				out = append(out, arg)
+				redactNext = true
+			}
+		case strings.HasPrefix(arg, "-") && strings.Contains(arg, "p"):
+			// shorthands are clustered, e.g. -Lp name=value, and the value
+			// may be attached, e.g. -pname=value. Which of them take a value
+			// is not known here, so everything after the first p is
+			// redacted, at worst hiding a value of another shorthand.
+			i := strings.Index(arg, "p") + 1
+			if i == len(arg) {
+				out = append(out, arg)
+				redactNext = true
+			} else {
+				out = append(out, arg[:i]+redacted)
+			}
+		default:
+			out = append(out, arg)
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+func appendEntries(entries []Entry) error {
+	store, err := openStore()
+	if err != nil {
+		return err
+	}
+	return store.Update(logKey, func(old []byte) ([]byte, error) {
+		var buf bytes.Buffer
+		buf.Write(old)
+		enc := json.NewEncoder(&buf)
+		for _, e := range entries {
+			if err := enc.Encode(e); err != nil {
+				return nil, err
+			}
+		}
+		return truncate(buf.Bytes()), nil
+	})
+}
+
+// truncate drops the oldest lines beyond maxEntries
+func truncate(log []byte) []byte {
+	lines := bytes.Count(log, []byte("\n"))
+	for ; lines > maxEntries; lines-- {
+		log = log[bytes.IndexByte(log, '\n')+1:]
+	}
+	return log
+}
+
+// Filter selects entries of the audit log, empty fields match any entry
+type Filter struct {
+	Action    string
+	Kind      string
+	Namespace string
+	Since     time.Time
+}
+
+func (f Filter) matches(e Entry) bool {
+	return (f.Action == "" || strings.EqualFold(f.Action, e.Action)) &&
+		(f.Kind == "" || strings.EqualFold(f.Kind, e.Kind)) &&
+		(f.Namespace == "" || f.Namespace == e.Namespace) &&
+		!e.Time.Before(f.Since)
+}
+
+// List returns the entries of the audit log matching the filter, oldest
+// first
+func List(f Filter) ([]Entry, error) {
+	store, err := openStore()
+	if err != nil {
+		return nil, err
+	}
+	b, err := store.Get(logKey)
+	if errors.Is(err, state.ErrNotFound) {
+		return []Entry{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []Entry{}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		e := Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			// skip entries written by a future version or truncated
+			continue
+		}
+		if f.matches(e) {
+			entries = append(entries, e)
+		}
+	}
+	return entries, scanner.Err()
+}
@@ -0,0 +1,138 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/state"
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/assert"
+)
+
+func setup(t *testing.T) *state.MemoryStore {
+	t.Helper()
+	store := state.NewMemoryStore()
+	openStore = func() (state.Store, error) { return store, nil }
+	t.Cleanup(func() { openStore = state.Open })
+	return store
+}
+
+func params(enabled bool) *test.Params {
+	return &test.Params{
+		Clock:      test.FakeClock(),
+		TknProfile: config.Profile{Audit: config.Audit{Enabled: enabled}},
+	}
+}
+
+func TestRecord(t *testing.T) {
+	setup(t)
+	clock := test.FakeClock()
+	p := params(true)
+	p.Clock = clock
+	p.SetNamespace("ns")
+
+	Record(p, ActionStart, "PipelineRun", "pr-1")
+	clock.Advance(time.Hour)
+	Record(p, ActionDelete, "TaskRun", "tr-1", "tr-2")
+	Record(p, ActionCancel, "TaskRun")
+
+	entries, err := List(Filter{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(entries), 3)
+	assert.Equal(t, entries[0].Action, ActionStart)
+	assert.Equal(t, entries[0].Kind, "PipelineRun")
+	assert.Equal(t, entries[0].Name, "pr-1")
+	assert.Equal(t, entries[0].Namespace, "ns")
+	assert.Equal(t, entries[2].Name, "tr-2")
+
+	entries, err = List(Filter{Kind: "taskrun"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(entries), 2)
+
+	entries, err = List(Filter{Action: ActionStart, Since: clock.Now().Add(-time.Minute)})
+	assert.NilError(t, err)
+	assert.Equal(t, len(entries), 0)
+}
+
+func TestRecord_disabled(t *testing.T) {
+	store := setup(t)
+
+	Record(params(false), ActionStart, "PipelineRun", "pr-1")
+
+	_, err := store.Get(logKey)
+	assert.ErrorIs(t, err, state.ErrNotFound)
+}
+
+func TestRecord_skipsInvalidEntries(t *testing.T) {
+	store := setup(t)
+	assert.NilError(t, store.Put(logKey, []byte("{not json\n")))
+
+	Record(params(true), ActionCancel, "PipelineRun", "pr-1")
+
+	entries, err := List(Filter{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(entries), 1)
+	assert.Equal(t, entries[0].Name, "pr-1")
+}
+
+func TestTruncate(t *testing.T) {
+	var log bytes.Buffer
+	for i := 0; i < maxEntries+2; i++ {
+		log.WriteString("{}\n")
+	}
+	log.WriteString("{\"name\":\"last\"}\n")
+
+	got := truncate(log.Bytes())
+	assert.Equal(t, bytes.Count(got, []byte("\n")), maxEntries)
+	assert.Assert(t, bytes.HasSuffix(got, []byte("{\"name\":\"last\"}\n")))
+}
+
+func TestCommand(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "no secret",
+			args: []string{"tkn", "pipelinerun", "delete", "pr-1", "-n", "ci", "--force"},
+			want: "tkn pipelinerun delete pr-1 -n ci --force",
+		},
+		{
+			name: "params",
+			args: []string{"tkn", "pipeline", "start", "build", "-p", "token=abc", "--param", "pass=def", "--param=user=me", "-puser=me", "-n", "ci"},
+			want: "tkn pipeline start build -p *** --param *** --param=*** -p*** -n ci",
+		},
+		{
+			name: "clustered shorthands",
+			args: []string{"tkn", "pipeline", "start", "build", "-Lp", "key=secret", "-Lpkey=secret", "-pkey=secret", "-n", "ci"},
+			want: "tkn pipeline start build -Lp *** -Lp*** -p*** -n ci",
+		},
+		{
+			name: "keys and tokens",
+			args: []string{"tkn", "bundle", "push", "ref", "--sign-key", "cosign.key", "--token=abc", "--docker-secret", "regcred", "-f", "task.yaml"},
+			want: "tkn bundle push ref --sign-key *** --token=*** --docker-secret *** -f task.yaml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, command(tt.args), tt.want)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/google/go-containerregistry/pkg/authn"
 	remoteimg "github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/spf13/pflag"
+	"github.com/tektoncd/cli/pkg/config"
 )
 
 // RemoteOptions is a set of flags that are used configure the connection options to a registry.
@@ -18,8 +19,8 @@ type RemoteOptions struct {
 }
 
 // ToOptions outputs a list of `remoteimg.Option`s that can be passed into various fetch/write calls to a remote
-// registry.
-func (r *RemoteOptions) ToOptions() []remoteimg.Option {
+// registry. The tokens kept in the keychain are looked up with the credentials helper of profile.
+func (r *RemoteOptions) ToOptions(profile config.Profile) []remoteimg.Option {
 	var opts []remoteimg.Option
 
 	// Set the auth chain based on the flags.
@@ -35,7 +36,7 @@ func (r *RemoteOptions) ToOptions() []remoteimg.Option {
 
 	// Use local keychain if no auth is provided. It's not allowed to use both.
 	if len(opts) == 0 {
-		keychains := authn.NewMultiKeychain(authn.DefaultKeychain, PodmanKeyChain)
+		keychains := authn.NewMultiKeychain(TokenKeyChain(profile), authn.DefaultKeychain, PodmanKeyChain)
 		opts = []remoteimg.Option{remoteimg.WithAuthFromKeychain(keychains)}
 	}
 
@@ -12,6 +12,8 @@ import (
 	"github.com/docker/cli/cli/config/types"
 	"github.com/docker/docker/pkg/homedir"
 	"github.com/google/go-containerregistry/pkg/authn"
+	tknconfig "github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/keyring"
 )
 
 type podmanKeychain struct {
@@ -58,6 +60,24 @@ func (pk *podmanKeychain) Resolve(target authn.Resource) (authn.Authenticator, e
 	}), nil
 }
 
+type tokenKeychain struct {
+	profile tknconfig.Profile
+}
+
+// TokenKeyChain resolves the bearer tokens of registries kept in the keychain
+// of profile with tkn auth token set registry/HOST
+func TokenKeyChain(profile tknconfig.Profile) authn.Keychain {
+	return &tokenKeychain{profile: profile}
+}
+
+func (k *tokenKeychain) Resolve(target authn.Resource) (authn.Authenticator, error) {
+	token := keyring.Lookup(k.profile, keyring.Registry(target.RegistryStr()))
+	if token == "" {
+		return authn.Anonymous, nil
+	}
+	return &authn.Bearer{Token: token}, nil
+}
+
 func getPathToPodmanAuth() string {
 	var (
 		defaultPerUIDPathFormat = filepath.FromSlash("/run/containers/%d/auth.json")
@@ -11,6 +11,8 @@ import (
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/keyring"
 	"gotest.tools/assert"
 )
 
@@ -146,3 +148,47 @@ func TestPodmanKeychain(t *testing.T) {
 	}
 
 }
+
+type tokenKeyring map[string]string
+
+func (k tokenKeyring) Set(service, token string) error {
+	k[service] = token
+	return nil
+}
+
+func (k tokenKeyring) Get(service string) (string, error) {
+	token, ok := k[service]
+	if !ok {
+		return "", keyring.ErrNotFound
+	}
+	return token, nil
+}
+
+func (k tokenKeyring) Delete(service string) error {
+	delete(k, service)
+	return nil
+}
+
+func TestTokenKeyChain(t *testing.T) {
+	open := keyring.Open
+	defer func() { keyring.Open = open }()
+	keyring.Open = func(config.Profile) (keyring.Keyring, error) {
+		return tokenKeyring{"registry/test.io": "s3cr3t"}, nil
+	}
+
+	keychain := TokenKeyChain(config.Profile{})
+	auth, err := keychain.Resolve(testRegistry)
+	if err != nil {
+		t.Fatalf("Resolve() = %v", err)
+	}
+	assert.DeepEqual(t, auth, &authn.Bearer{Token: "s3cr3t"})
+
+	other, _ := name.NewRegistry("other.io", name.WeakValidation)
+	auth, err = keychain.Resolve(other)
+	if err != nil {
+		t.Fatalf("Resolve() = %v", err)
+	}
+	if auth != authn.Anonymous {
+		t.Errorf("expected Anonymous, got %+v", auth)
+	}
+}
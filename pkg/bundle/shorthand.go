@@ -0,0 +1,155 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/docker/docker/pkg/homedir"
+)
+
+const (
+	ecrScheme = "ecr://"
+	gcrScheme = "gcr://"
+	acrScheme = "acr://"
+)
+
+// Lookups of the cloud identities, replaced in tests
+var (
+	awsIdentity = defaultAWSIdentity
+	gcpProject  = defaultGCPProject
+	azureACR    = defaultAzureACR
+)
+
+// ExpandReference expands the ecr://, gcr:// and acr:// shorthands into a
+// full image reference using the credentials configured for the cloud CLIs:
+//
+//	ecr://repo/task:1.0 -> ACCOUNT.dkr.ecr.REGION.amazonaws.com/repo/task:1.0
+//	gcr://repo/task:1.0 -> gcr.io/PROJECT/repo/task:1.0
+//	acr://repo/task:1.0 -> REGISTRY.azurecr.io/repo/task:1.0
+//
+// Any other reference is returned unchanged.
+func ExpandReference(ctx context.Context, ref string) (string, error) {
+	switch {
+	case strings.HasPrefix(ref, ecrScheme):
+		account, region, err := awsIdentity(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to expand %s: %w", ref, err)
+		}
+		return fmt.Sprintf("%s.dkr.ecr.%s.amazonaws.com/%s", account, region, strings.TrimPrefix(ref, ecrScheme)), nil
+	case strings.HasPrefix(ref, gcrScheme):
+		project, err := gcpProject()
+		if err != nil {
+			return "", fmt.Errorf("failed to expand %s: %w", ref, err)
+		}
+		return fmt.Sprintf("gcr.io/%s/%s", project, strings.TrimPrefix(ref, gcrScheme)), nil
+	case strings.HasPrefix(ref, acrScheme):
+		registry, err := azureACR()
+		if err != nil {
+			return "", fmt.Errorf("failed to expand %s: %w", ref, err)
+		}
+		return fmt.Sprintf("%s.azurecr.io/%s", registry, strings.TrimPrefix(ref, acrScheme)), nil
+	}
+	return ref, nil
+}
+
+// defaultAWSIdentity returns the account and region of the default AWS
+// credentials, the account is looked up with STS
+func defaultAWSIdentity(ctx context.Context) (string, string, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	if cfg.Region == "" {
+		return "", "", fmt.Errorf("no AWS region configured, set AWS_REGION or the region of the AWS profile")
+	}
+	identity, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get the AWS account: %w", err)
+	}
+	return aws.ToString(identity.Account), cfg.Region, nil
+}
+
+// defaultGCPProject returns the project of the active gcloud configuration
+func defaultGCPProject() (string, error) {
+	for _, env := range []string{"CLOUDSDK_CORE_PROJECT", "GOOGLE_CLOUD_PROJECT"} {
+		if project := os.Getenv(env); project != "" {
+			return project, nil
+		}
+	}
+
+	dir := os.Getenv("CLOUDSDK_CONFIG")
+	if dir == "" {
+		dir = filepath.Join(homedir.Get(), ".config", "gcloud")
+	}
+	active := "default"
+	if b, err := os.ReadFile(filepath.Join(dir, "active_config")); err == nil {
+		active = strings.TrimSpace(string(b))
+	}
+	project, err := iniValue(filepath.Join(dir, "configurations", "config_"+active), "core", "project")
+	if err != nil || project == "" {
+		return "", fmt.Errorf("no GCP project configured, set CLOUDSDK_CORE_PROJECT or run gcloud config set project")
+	}
+	return project, nil
+}
+
+// defaultAzureACR returns the default registry of the az CLI
+func defaultAzureACR() (string, error) {
+	if registry := os.Getenv("AZURE_DEFAULTS_ACR"); registry != "" {
+		return registry, nil
+	}
+
+	dir := os.Getenv("AZURE_CONFIG_DIR")
+	if dir == "" {
+		dir = filepath.Join(homedir.Get(), ".azure")
+	}
+	registry, err := iniValue(filepath.Join(dir, "config"), "defaults", "acr")
+	if err != nil || registry == "" {
+		return "", fmt.Errorf("no default Azure Container Registry configured, set AZURE_DEFAULTS_ACR or run az configure --defaults acr=NAME")
+	}
+	return registry, nil
+}
+
+// iniValue reads the value of key in section of the ini file at path
+func iniValue(path, section, key string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	current := ""
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			current = strings.TrimSpace(line[1 : len(line)-1])
+		case current == section:
+			if k, v, ok := strings.Cut(line, "="); ok && strings.TrimSpace(k) == key {
+				return strings.TrimSpace(v), nil
+			}
+		}
+	}
+	return "", scanner.Err()
+}
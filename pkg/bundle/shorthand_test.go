@@ -0,0 +1,84 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestExpandReference(t *testing.T) {
+	awsIdentity = func(context.Context) (string, string, error) { return "123456789012", "eu-west-1", nil }
+	gcpProject = func() (string, error) { return "my-project", nil }
+	azureACR = func() (string, error) { return "myregistry", nil }
+	t.Cleanup(func() {
+		awsIdentity, gcpProject, azureACR = defaultAWSIdentity, defaultGCPProject, defaultAzureACR
+	})
+
+	tests := map[string]string{
+		"ecr://repo/task:1.0":        "123456789012.dkr.ecr.eu-west-1.amazonaws.com/repo/task:1.0",
+		"gcr://repo/task:1.0":        "gcr.io/my-project/repo/task:1.0",
+		"acr://repo/task:1.0":        "myregistry.azurecr.io/repo/task:1.0",
+		"docker.io/myorg/task:1.0":   "docker.io/myorg/task:1.0",
+		"localhost:5000/task@sha256": "localhost:5000/task@sha256",
+	}
+	for ref, want := range tests {
+		got, err := ExpandReference(context.Background(), ref)
+		assert.NilError(t, err)
+		assert.Equal(t, got, want)
+	}
+}
+
+func TestExpandReference_noCredentials(t *testing.T) {
+	awsIdentity = func(context.Context) (string, string, error) { return "", "", errors.New("no credentials") }
+	t.Cleanup(func() { awsIdentity = defaultAWSIdentity })
+
+	_, err := ExpandReference(context.Background(), "ecr://repo/task:1.0")
+	assert.Error(t, err, "failed to expand ecr://repo/task:1.0: no credentials")
+}
+
+func TestDefaultGCPProject_gcloudConfig(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("CLOUDSDK_CORE_PROJECT", "")
+	t.Setenv("GOOGLE_CLOUD_PROJECT", "")
+	t.Setenv("CLOUDSDK_CONFIG", dir)
+	assert.NilError(t, os.MkdirAll(filepath.Join(dir, "configurations"), 0o755))
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "active_config"), []byte("work\n"), 0o600))
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "configurations", "config_work"),
+		[]byte("[core]\naccount = me@example.com\nproject = work-project\n"), 0o600))
+
+	project, err := defaultGCPProject()
+	assert.NilError(t, err)
+	assert.Equal(t, project, "work-project")
+}
+
+func TestDefaultAzureACR_azConfig(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("AZURE_DEFAULTS_ACR", "")
+	t.Setenv("AZURE_CONFIG_DIR", dir)
+
+	_, err := defaultAzureACR()
+	assert.ErrorContains(t, err, "no default Azure Container Registry configured")
+
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "config"), []byte("[defaults]\nacr = myregistry\n"), 0o600))
+	registry, err := defaultAzureACR()
+	assert.NilError(t, err)
+	assert.Equal(t, registry, "myregistry")
+}
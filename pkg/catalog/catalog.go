@@ -0,0 +1,345 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package catalog generates and validates the metadata the Tekton catalog
+// and Artifact Hub expect from the resources contributed to them
+package catalog
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+const (
+	VersionLabel          = "app.kubernetes.io/version"
+	MinVersionAnnotation  = "tekton.dev/pipelines.minVersion"
+	CategoriesAnnotation  = "tekton.dev/categories"
+	TagsAnnotation        = "tekton.dev/tags"
+	DisplayNameAnnotation = "tekton.dev/displayName"
+	PlatformsAnnotation   = "tekton.dev/platforms"
+
+	// PackageFile is the metadata file read by Artifact Hub in the
+	// directory of each version of a resource
+	PackageFile = "artifacthub-pkg.yml"
+	ReadmeFile  = "README.md"
+)
+
+// Categories are the categories accepted by the hub
+var Categories = []string{
+	"Automation", "Build Tools", "CLI", "Cloud", "Code Quality", "Continuous Integration",
+	"Deployment", "Developers", "Git", "Image Build", "Integration & Delivery", "Kubernetes",
+	"Messaging", "Monitoring", "Networking", "Openshift", "Publishing", "Security", "Storage",
+	"Testing",
+}
+
+// kinds are the kinds of the resources published on the hub
+var kinds = []string{"Task", "Pipeline", "StepAction"}
+
+var versionRe = regexp.MustCompile(`^\d+\.\d+(\.\d+)?$`)
+
+// Resource is the Task, Pipeline or StepAction of the directory of a version
+// of a catalog entry, kept as a YAML node so that its comments and layout
+// survive the changes of its metadata
+type Resource struct {
+	Dir  string
+	File string
+	Kind string
+	Name string
+	doc  *yaml.Node
+}
+
+// Metadata is the metadata of a resource, the empty fields are left as they
+// are when set on a resource
+type Metadata struct {
+	Version             string
+	DisplayName         string
+	MinPipelinesVersion string
+	Categories          []string
+	Tags                []string
+	Platforms           []string
+}
+
+// Load reads the resource of dir, the YAML file named after the resource
+func Load(dir string) (*Resource, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	found := []*Resource{}
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var doc yaml.Node
+		if err := yaml.Unmarshal(b, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+		r := &Resource{Dir: dir, File: file, doc: &doc}
+		r.Kind = r.scalar("kind")
+		r.Name = r.scalar("metadata", "name")
+		if slices.Contains(kinds, r.Kind) && r.Name == strings.TrimSuffix(filepath.Base(file), ".yaml") {
+			found = append(found, r)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no Task, Pipeline or StepAction named after its file found in %s", dir)
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf("several resources found in %s, a directory holds a single resource", dir)
+	}
+}
+
+// Version is the version of the resource, from its version label
+func (r *Resource) Version() string {
+	return r.scalar("metadata", "labels", VersionLabel)
+}
+
+// Annotation returns the value of an annotation of the resource
+func (r *Resource) Annotation(key string) string {
+	return r.scalar("metadata", "annotations", key)
+}
+
+// Description is the description of the spec of the resource
+func (r *Resource) Description() string {
+	return r.scalar("spec", "description")
+}
+
+// Set sets the metadata of the resource
+func (r *Resource) Set(m Metadata) {
+	if m.Version != "" {
+		r.set(m.Version, "metadata", "labels", VersionLabel)
+	}
+	annotations := map[string]string{
+		DisplayNameAnnotation: m.DisplayName,
+		MinVersionAnnotation:  m.MinPipelinesVersion,
+		CategoriesAnnotation:  strings.Join(m.Categories, ", "),
+		TagsAnnotation:        strings.Join(m.Tags, ","),
+		PlatformsAnnotation:   strings.Join(m.Platforms, ","),
+	}
+	for _, key := range []string{MinVersionAnnotation, CategoriesAnnotation, TagsAnnotation, DisplayNameAnnotation, PlatformsAnnotation} {
+		if annotations[key] != "" {
+			r.set(annotations[key], "metadata", "annotations", key)
+		}
+	}
+}
+
+// Save writes the resource back to its file
+func (r *Resource) Save() error {
+	b := &bytes.Buffer{}
+	enc := yaml.NewEncoder(b)
+	enc.SetIndent(2)
+	if err := enc.Encode(r.doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(r.File, b.Bytes(), 0o644) // nolint: gosec
+}
+
+// Validate returns the problems of the metadata of the resource which
+// would make the hub reject it
+func (r *Resource) Validate() []string {
+	problems := []string{}
+	switch version := r.Version(); {
+	case version == "":
+		problems = append(problems, fmt.Sprintf("the %s label is missing", VersionLabel))
+	case !versionRe.MatchString(version):
+		problems = append(problems, fmt.Sprintf("the %s label %q is not a version like 0.1", VersionLabel, version))
+	case versionRe.MatchString(filepath.Base(r.Dir)) && filepath.Base(r.Dir) != version:
+		problems = append(problems, fmt.Sprintf("the %s label %q does not match the directory %s", VersionLabel, version, filepath.Base(r.Dir)))
+	}
+
+	switch v := r.Annotation(MinVersionAnnotation); {
+	case v == "":
+		problems = append(problems, fmt.Sprintf("the %s annotation is missing", MinVersionAnnotation))
+	case !versionRe.MatchString(v):
+		problems = append(problems, fmt.Sprintf("the %s annotation %q is not a version like 0.50.0", MinVersionAnnotation, v))
+	}
+
+	if categories := r.Annotation(CategoriesAnnotation); categories == "" {
+		problems = append(problems, fmt.Sprintf("the %s annotation is missing", CategoriesAnnotation))
+	} else {
+		for _, c := range split(categories) {
+			if !slices.Contains(Categories, c) {
+				problems = append(problems, fmt.Sprintf("unknown category %q, use one of %s", c, strings.Join(Categories, ", ")))
+			}
+		}
+	}
+	for _, key := range []string{TagsAnnotation, DisplayNameAnnotation} {
+		if r.Annotation(key) == "" {
+			problems = append(problems, fmt.Sprintf("the %s annotation is missing", key))
+		}
+	}
+
+	if strings.TrimSpace(r.Description()) == "" {
+		problems = append(problems, "the description of the spec is missing")
+	}
+	if _, err := os.Stat(filepath.Join(r.Dir, ReadmeFile)); errors.Is(err, os.ErrNotExist) {
+		problems = append(problems, fmt.Sprintf("%s is missing", ReadmeFile))
+	}
+	return problems
+}
+
+// Package is the content of the Artifact Hub metadata file
+type Package struct {
+	Version     string            `json:"version"`
+	Name        string            `json:"name"`
+	DisplayName string            `json:"displayName"`
+	CreatedAt   string            `json:"createdAt"`
+	Description string            `json:"description"`
+	License     string            `json:"license,omitempty"`
+	Keywords    []string          `json:"keywords,omitempty"`
+	Platforms   []string          `json:"platforms,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Provider    *Provider         `json:"provider,omitempty"`
+}
+
+// Provider is who publishes the package
+type Provider struct {
+	Name string `json:"name"`
+}
+
+// Package returns the Artifact Hub metadata of the resource. The creation
+// time of an existing metadata file of the same version is kept, so that
+// generating the file again does not change it
+func (r *Resource) Package(license, provider string, now time.Time) (*Package, error) {
+	version := r.Version()
+	if strings.Count(version, ".") == 1 {
+		version += ".0"
+	}
+	description, _, _ := strings.Cut(strings.TrimSpace(r.Description()), "\n")
+
+	pkg := &Package{
+		Version:     version,
+		Name:        r.Name,
+		DisplayName: r.Annotation(DisplayNameAnnotation),
+		CreatedAt:   now.UTC().Format(time.RFC3339),
+		Description: description,
+		License:     license,
+		Keywords:    split(r.Annotation(TagsAnnotation)),
+		Platforms:   split(r.Annotation(PlatformsAnnotation)),
+		Annotations: map[string]string{},
+	}
+	for _, key := range []string{MinVersionAnnotation, CategoriesAnnotation, TagsAnnotation, DisplayNameAnnotation, PlatformsAnnotation} {
+		if v := r.Annotation(key); v != "" {
+			pkg.Annotations[key] = v
+		}
+	}
+	if provider != "" {
+		pkg.Provider = &Provider{Name: provider}
+	}
+
+	b, err := os.ReadFile(filepath.Join(r.Dir, PackageFile))
+	if err == nil {
+		existing := &Package{}
+		if err := k8syaml.Unmarshal(b, existing); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", PackageFile, err)
+		}
+		if existing.Version == pkg.Version && existing.CreatedAt != "" {
+			pkg.CreatedAt = existing.CreatedAt
+		}
+	}
+	return pkg, nil
+}
+
+// WritePackage writes the Artifact Hub metadata file of the resource
+func (r *Resource) WritePackage(pkg *Package) error {
+	b, err := k8syaml.Marshal(pkg)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(r.Dir, PackageFile), b, 0o644) // nolint: gosec
+}
+
+// scalar returns the value of the scalar at the path of mapping keys
+func (r *Resource) scalar(path ...string) string {
+	node := r.doc.Content[0]
+	for _, key := range path {
+		node = lookup(node, key)
+		if node == nil {
+			return ""
+		}
+	}
+	if node.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return node.Value
+}
+
+// set sets the scalar at the path of mapping keys, creating the missing
+// mappings
+func (r *Resource) set(value string, path ...string) {
+	node := r.doc.Content[0]
+	for i, key := range path {
+		if node.Kind != yaml.MappingNode {
+			// e.g. labels left empty
+			node.Kind, node.Tag, node.Value, node.Content = yaml.MappingNode, "!!map", "", nil
+		}
+		next := lookup(node, key)
+		if next == nil {
+			next = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			if i == len(path)-1 {
+				next = &yaml.Node{Kind: yaml.ScalarNode}
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, next)
+		}
+		node = next
+	}
+	node.Kind, node.Tag, node.Style, node.Value = yaml.ScalarNode, "!!str", 0, value
+	if versionRe.MatchString(value) {
+		// keep versions like 0.1 strings
+		node.Style = yaml.DoubleQuotedStyle
+	}
+}
+
+func lookup(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func split(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,181 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+const gitClone = `# Clones a git repository
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: git-clone
+  labels:
+    app.kubernetes.io/version: "0.9"
+  annotations:
+    tekton.dev/pipelines.minVersion: "0.50.0"
+    tekton.dev/categories: Git
+    tekton.dev/tags: git
+    tekton.dev/displayName: "git clone"
+    tekton.dev/platforms: "linux/amd64,linux/arm64"
+spec:
+  description: >-
+    Clones a git repository.
+
+    The repository is cloned in the output workspace.
+  steps:
+    - name: clone
+      image: alpine/git
+      script: git clone $(params.url) # the url param
+`
+
+func writeDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), "git-clone", "0.9")
+	assert.NilError(t, os.MkdirAll(dir, 0o755))
+	for name, content := range files {
+		assert.NilError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
+func TestLoad(t *testing.T) {
+	dir := writeDir(t, map[string]string{"git-clone.yaml": gitClone, "samples.yaml": "kind: TaskRun\nmetadata:\n  name: samples\n"})
+	r, err := Load(dir)
+	assert.NilError(t, err)
+	assert.Equal(t, r.Kind, "Task")
+	assert.Equal(t, r.Name, "git-clone")
+	assert.Equal(t, r.Version(), "0.9")
+	assert.Equal(t, r.Annotation(CategoriesAnnotation), "Git")
+	assert.Equal(t, r.CatalogDir(), filepath.Join("task", "git-clone", "0.9"))
+
+	_, err = Load(writeDir(t, map[string]string{"clone.yaml": gitClone}))
+	assert.ErrorContains(t, err, "no Task, Pipeline or StepAction named after its file found in")
+}
+
+func TestResource_Set(t *testing.T) {
+	dir := writeDir(t, map[string]string{"git-clone.yaml": "# Clones a git repository\nkind: Task\nmetadata:\n  name: git-clone\n  labels:\nspec:\n  steps: [] # no steps yet\n"})
+	r, err := Load(dir)
+	assert.NilError(t, err)
+
+	r.Set(Metadata{Version: "0.1", Categories: []string{"Git", "CLI"}, Tags: []string{"git", "scm"}, DisplayName: "git clone"})
+	assert.NilError(t, r.Save())
+
+	b, err := os.ReadFile(filepath.Join(dir, "git-clone.yaml"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(b), `# Clones a git repository
+kind: Task
+metadata:
+  name: git-clone
+  labels:
+    app.kubernetes.io/version: "0.1"
+  annotations:
+    tekton.dev/categories: Git, CLI
+    tekton.dev/tags: git,scm
+    tekton.dev/displayName: git clone
+spec:
+  steps: [] # no steps yet
+`)
+}
+
+func TestResource_Validate(t *testing.T) {
+	r, err := Load(writeDir(t, map[string]string{"git-clone.yaml": gitClone, ReadmeFile: "# git-clone\n"}))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r.Validate(), []string{})
+
+	r, err = Load(writeDir(t, map[string]string{"git-clone.yaml": "kind: Task\nmetadata:\n  name: git-clone\n  labels:\n    app.kubernetes.io/version: \"1.0\"\n  annotations:\n    tekton.dev/pipelines.minVersion: latest\n    tekton.dev/categories: Git, VCS\n"}))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, r.Validate(), []string{
+		`the app.kubernetes.io/version label "1.0" does not match the directory 0.9`,
+		`the tekton.dev/pipelines.minVersion annotation "latest" is not a version like 0.50.0`,
+		`unknown category "VCS", use one of Automation, Build Tools, CLI, Cloud, Code Quality, Continuous Integration, Deployment, Developers, Git, Image Build, Integration & Delivery, Kubernetes, Messaging, Monitoring, Networking, Openshift, Publishing, Security, Storage, Testing`,
+		"the tekton.dev/tags annotation is missing",
+		"the tekton.dev/displayName annotation is missing",
+		"the description of the spec is missing",
+		"README.md is missing",
+	})
+}
+
+func TestResource_Package(t *testing.T) {
+	dir := writeDir(t, map[string]string{"git-clone.yaml": gitClone})
+	r, err := Load(dir)
+	assert.NilError(t, err)
+
+	created := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	pkg, err := r.Package("Apache-2.0", "tekton", created)
+	assert.NilError(t, err)
+	assert.NilError(t, r.WritePackage(pkg))
+
+	b, err := os.ReadFile(filepath.Join(dir, PackageFile))
+	assert.NilError(t, err)
+	assert.Equal(t, string(b), `annotations:
+  tekton.dev/categories: Git
+  tekton.dev/displayName: git clone
+  tekton.dev/pipelines.minVersion: 0.50.0
+  tekton.dev/platforms: linux/amd64,linux/arm64
+  tekton.dev/tags: git
+createdAt: "2026-10-15T12:00:00Z"
+description: Clones a git repository.
+displayName: git clone
+keywords:
+- git
+license: Apache-2.0
+name: git-clone
+platforms:
+- linux/amd64
+- linux/arm64
+provider:
+  name: tekton
+version: 0.9.0
+`)
+
+	// the creation time of the version is kept
+	pkg, err = r.Package("Apache-2.0", "tekton", created.Add(time.Hour))
+	assert.NilError(t, err)
+	assert.Equal(t, pkg.CreatedAt, "2026-10-15T12:00:00Z")
+}
+
+func TestResource_Contribute(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	catalog := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q", "-b", "main"},
+		{"config", "user.email", "tkn@example.com"},
+		{"config", "user.name", "tkn"},
+		{"commit", "-q", "--allow-empty", "-m", "init"},
+	} {
+		_, err := git(catalog, args...)
+		assert.NilError(t, err)
+	}
+
+	r, err := Load(writeDir(t, map[string]string{"git-clone.yaml": gitClone, ReadmeFile: "# git-clone\n"}))
+	assert.NilError(t, err)
+	branch, err := r.Contribute(catalog)
+	assert.NilError(t, err)
+	assert.Equal(t, branch, "task-git-clone-0.9")
+
+	out, err := git(catalog, "show", "--name-only", "--format=%s", "HEAD")
+	assert.NilError(t, err)
+	assert.Equal(t, out, "Add version 0.9 of Task git-clone\n\ntask/git-clone/0.9/README.md\ntask/git-clone/0.9/git-clone.yaml\n")
+}
@@ -0,0 +1,117 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// CatalogDir returns where the resource goes in a checkout of the catalog,
+// e.g. task/git-clone/0.9
+func (r *Resource) CatalogDir() string {
+	return filepath.Join(strings.ToLower(r.Kind), r.Name, r.Version())
+}
+
+// Contribute copies the directory of the resource to its place in a checkout
+// of the catalog and commits it on a new branch, whose name is returned
+func (r *Resource) Contribute(catalog string) (string, error) {
+	dest := filepath.Join(catalog, r.CatalogDir())
+	if err := copyDir(r.Dir, dest); err != nil {
+		return "", fmt.Errorf("failed to copy %s to the catalog: %w", r.Dir, err)
+	}
+
+	branch := fmt.Sprintf("%s-%s-%s", strings.ToLower(r.Kind), r.Name, r.Version())
+	message := fmt.Sprintf("Add version %s of %s %s", r.Version(), r.Kind, r.Name)
+	for _, args := range [][]string{
+		{"checkout", "-b", branch},
+		{"add", r.CatalogDir()},
+		{"commit", "-m", message},
+	} {
+		if _, err := git(catalog, args...); err != nil {
+			return "", err
+		}
+	}
+	return branch, nil
+}
+
+// OpenPullRequest pushes the branch to the remote of the checkout of the
+// catalog and opens the pull request with the GitHub CLI, which authenticates
+// with token when it is not empty, the URL of the pull request is returned
+func OpenPullRequest(catalog, remote, branch, token string) (string, error) {
+	if _, err := git(catalog, "push", "-u", remote, branch); err != nil {
+		return "", err
+	}
+	cmd := exec.Command("gh", "pr", "create", "--fill", "--head", branch)
+	cmd.Dir = catalog
+	if token != "" {
+		cmd.Env = append(os.Environ(), "GH_TOKEN="+token)
+	}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("failed to open the pull request with gh: %v: %s", err, strings.TrimSpace(string(out)))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func git(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	out := &bytes.Buffer{}
+	cmd.Stdout, cmd.Stderr = out, out
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s failed: %v: %s", strings.Join(args, " "), err, strings.TrimSpace(out.String()))
+	}
+	return out.String(), nil
+}
+
+func copyDir(src, dest string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dest, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		out, err := os.Create(target)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(out, in); err != nil {
+			out.Close()
+			return err
+		}
+		return out.Close()
+	})
+}
@@ -55,7 +55,7 @@ func GetTaskRunBackends(cs *cli.Clients, namespace string, tr *v1.TaskRun) (map[
 	// Get the storage backend.
 	backends, err := initializeBackends(cs, namespace)
 	if err != nil {
-		return nil, config.StorageOpts{}, fmt.Errorf("failed to retrieve the backend storage: %v", err)
+		return nil, config.StorageOpts{}, fmt.Errorf("failed to retrieve the backend storage: %w", err)
 	}
 
 	// Initialize the storage options.
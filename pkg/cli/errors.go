@@ -0,0 +1,120 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// The exit codes of tkn, for automation to tell failures apart without
+// parsing the messages. Other failures exit with 1, the codes are never
+// renumbered once released.
+const (
+	// ExitCodeRunDeleted is used when the run being followed is deleted
+	// before its logs could be streamed completely
+	ExitCodeRunDeleted = 3
+	// ExitCodeNotFound is used when a resource does not exist
+	ExitCodeNotFound = 4
+	// ExitCodeRunFailed is used when the runs a command waited for
+	// failed, e.g. with --exit-with-pipelinerun-error or the test cases
+	// of tkn task test
+	ExitCodeRunFailed = 5
+	// ExitCodeTimeout is used when a request to the cluster or a wait
+	// timed out
+	ExitCodeTimeout = 6
+	// ExitCodeForbidden is used when the cluster refused a request, the
+	// user not being authenticated or not allowed by RBAC
+	ExitCodeForbidden = 7
+	// ExitCodeTransport is used when the cluster could not be reached,
+	// e.g. the connection was refused or its certificate is not trusted
+	ExitCodeTransport = 8
+	// ExitCodeActivityTimeout is used when logs stopped being followed
+	// because nothing happened within the activity timeout
+	ExitCodeActivityTimeout = 9
+	// ExitCodeLeak is used with --fail-on-leak when the logs likely leak
+	// secrets
+	ExitCodeLeak = 10
+)
+
+// ExitError is returned by commands which need tkn to terminate with
+// a specific exit code rather than the generic 1
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// causeError is an error whose message does not show its cause, the cause
+// still giving the exit code
+type causeError struct {
+	msg   string
+	cause error
+}
+
+func (e *causeError) Error() string {
+	return e.msg
+}
+
+func (e *causeError) Unwrap() error {
+	return e.cause
+}
+
+// WithCause returns an error with the formatted message whose exit code is
+// the one of cause, for the messages which do not show their cause
+func WithCause(cause error, format string, a ...any) error {
+	return &causeError{msg: fmt.Sprintf(format, a...), cause: cause}
+}
+
+// ExitCode returns the exit code tkn should terminate with for err, the one
+// of an ExitError or the one of the failure of the requests to the cluster
+// it wraps
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+
+	var netErr net.Error
+	var urlErr *url.Error
+	switch {
+	case apierrors.IsNotFound(err):
+		return ExitCodeNotFound
+	case apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+		return ExitCodeForbidden
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err), errors.Is(err, context.DeadlineExceeded):
+		return ExitCodeTimeout
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return ExitCodeTimeout
+	case netErr != nil, errors.As(err, &urlErr):
+		return ExitCodeTransport
+	}
+	return 1
+}
@@ -0,0 +1,63 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestExitCode(t *testing.T) {
+	gr := schema.GroupResource{Group: "tekton.dev", Resource: "taskruns"}
+	refused := &url.Error{Op: "Get", URL: "https://cluster", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+
+	testCases := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "no error", err: nil, want: 0},
+		{name: "generic", err: errors.New("boom"), want: 1},
+		{name: "exit error", err: &ExitError{Code: ExitCodeLeak, Err: errors.New("leak")}, want: ExitCodeLeak},
+		{name: "not found", err: apierrors.NewNotFound(gr, "run"), want: ExitCodeNotFound},
+		{name: "wrapped not found", err: fmt.Errorf("failed to get: %w", apierrors.NewNotFound(gr, "run")), want: ExitCodeNotFound},
+		{name: "not found with cause", err: WithCause(apierrors.NewNotFound(gr, "run"), "failed to find taskrun %q", "run"), want: ExitCodeNotFound},
+		{name: "forbidden", err: apierrors.NewForbidden(gr, "run", errors.New("rbac")), want: ExitCodeForbidden},
+		{name: "unauthorized", err: apierrors.NewUnauthorized("token expired"), want: ExitCodeForbidden},
+		{name: "server timeout", err: apierrors.NewTimeoutError("slow", 1), want: ExitCodeTimeout},
+		{name: "deadline", err: fmt.Errorf("waiting: %w", context.DeadlineExceeded), want: ExitCodeTimeout},
+		{name: "transport", err: refused, want: ExitCodeTransport},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, ExitCode(tc.err), tc.want)
+		})
+	}
+}
+
+func TestWithCause(t *testing.T) {
+	cause := errors.New("cause")
+	err := WithCause(cause, "failed to find taskrun %q", "run")
+	assert.Error(t, err, `failed to find taskrun "run"`)
+	assert.Assert(t, errors.Is(err, cause))
+}
@@ -19,6 +19,7 @@ import (
 	"net/http"
 
 	"github.com/jonboulle/clockwork"
+	"github.com/tektoncd/cli/pkg/config"
 	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
 	versionedTriggers "github.com/tektoncd/triggers/pkg/client/clientset/versioned"
 	"k8s.io/client-go/dynamic"
@@ -48,6 +49,9 @@ type Params interface {
 	// SetKubeContext extends the specificity of the above SetKubeConfigPath
 	// by using a context other than the default context in the given kubeconfig
 	SetKubeContext(string)
+	// SetImpersonation configures the user, uid and groups the clients
+	// act as, the same way as kubectl --as, --as-uid and --as-group do
+	SetImpersonation(rest.ImpersonationConfig)
 	Clients(...*rest.Config) (*Clients, error)
 	KubeClient() (k8s.Interface, error)
 
@@ -60,4 +64,16 @@ type Params interface {
 	SetNoColour(bool)
 
 	Time() clockwork.Clock
+
+	// Profile returns the active profile of the tkn config
+	Profile() (config.Profile, error)
+}
+
+// ConfigParams is implemented by the Params which know the configuration
+// their clients were created with
+type ConfigParams interface {
+	Params
+	// RESTConfig returns the configuration the clients were created with,
+	// it is nil until the clients are created
+	RESTConfig() *rest.Config
 }
@@ -15,9 +15,18 @@
 package cli
 
 import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
 	"github.com/fatih/color"
 	"github.com/jonboulle/clockwork"
 	"github.com/pkg/errors"
+	tknconfig "github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/pods/stream"
 	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
 	versionedTriggers "github.com/tektoncd/triggers/pkg/client/clientset/versioned"
 	"k8s.io/client-go/dynamic"
@@ -26,15 +35,36 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 )
 
+const inClusterNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// InClusterEnv is the environment variable which, set to true, makes tkn use
+// the service account of its pod even when a kubeconfig exists
+const InClusterEnv = "TKN_IN_CLUSTER"
+
+// profileWarnings is where a configuration file which cannot be read is
+// reported
+var profileWarnings io.Writer = os.Stderr
+
+var errNoConfig = errors.New("no kubeconfig found and tkn is not running inside a cluster, use --kubeconfig or set $KUBECONFIG")
+
 type TektonParams struct {
 	clients        *Clients
 	kubeConfigPath string
 	kubeContext    string
 	namespace      string
+	inCluster      bool
+	impersonate    rest.ImpersonationConfig
+	// host is the address of the API server the clients talk to
+	host string
+	// restConfig is the configuration the clients were created with
+	restConfig *rest.Config
+	// profile is the active profile of the tkn config, read once
+	profileOnce sync.Once
+	profile     tknconfig.Profile
 }
 
-// ensure that TektonParams complies with cli.Params interface
-var _ Params = (*TektonParams)(nil)
+// ensure that TektonParams complies with cli.ConfigParams interface
+var _ ConfigParams = (*TektonParams)(nil)
 
 func (p *TektonParams) SetKubeConfigPath(path string) {
 	p.kubeConfigPath = path
@@ -44,6 +74,30 @@ func (p *TektonParams) SetKubeContext(context string) {
 	p.kubeContext = context
 }
 
+func (p *TektonParams) SetImpersonation(impersonate rest.ImpersonationConfig) {
+	p.impersonate = impersonate
+}
+
+// SetInCluster forces the clients to be initialised from the service account
+// mounted in the pod, ignoring any kubeconfig. This is meant for embedding tkn
+// in controllers or jobs running inside the cluster.
+func (p *TektonParams) SetInCluster(inCluster bool) {
+	p.inCluster = inCluster
+}
+
+// InClusterFromEnv tells whether $TKN_IN_CLUSTER asks for the service account
+// of the pod to be used
+func InClusterFromEnv() bool {
+	inCluster, _ := strconv.ParseBool(os.Getenv(InClusterEnv))
+	return inCluster
+}
+
+// runningInCluster tells whether tkn runs in a pod of a cluster, the way
+// client-go tells it
+func runningInCluster() bool {
+	return os.Getenv("KUBERNETES_SERVICE_HOST") != "" && os.Getenv("KUBERNETES_SERVICE_PORT") != ""
+}
+
 func (p *TektonParams) tektonClient(config *rest.Config) (versioned.Interface, error) {
 	cs, err := versioned.NewForConfig(config)
 	if err != nil {
@@ -138,11 +192,16 @@ func (p *TektonParams) Clients(cfg ...*rest.Config) (*Clients, error) {
 		Triggers: triggers,
 		Dynamic:  dynamic,
 	}
+	p.restConfig = config
 
 	return p.clients, nil
 }
 
 func (p *TektonParams) config() (*rest.Config, error) {
+	if p.inCluster {
+		return p.inClusterConfig()
+	}
+
 	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
 	if p.kubeConfigPath != "" {
 		loadingRules.ExplicitPath = p.kubeConfigPath
@@ -151,24 +210,122 @@ func (p *TektonParams) config() (*rest.Config, error) {
 	if p.kubeContext != "" {
 		configOverrides.CurrentContext = p.kubeContext
 	}
+	configOverrides.AuthInfo.Impersonate = p.impersonate.UserName
+	configOverrides.AuthInfo.ImpersonateUID = p.impersonate.UID
+	configOverrides.AuthInfo.ImpersonateGroups = p.impersonate.Groups
 	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
 	if p.namespace == "" {
 		namespace, _, err := kubeConfig.Namespace()
 		if err != nil {
+			if clientcmd.IsEmptyConfig(err) {
+				return p.noConfig()
+			}
 			return nil, errors.Wrap(err, "Couldn't get kubeConfiguration namespace")
 		}
 		p.namespace = namespace
 	}
 	config, err := kubeConfig.ClientConfig()
 	if err != nil {
+		if clientcmd.IsEmptyConfig(err) {
+			return p.noConfig()
+		}
 		return nil, errors.Wrap(err, "Parsing kubeconfig failed")
 	}
 
+	if err := p.setClientDefaults(config); err != nil {
+		return nil, err
+	}
+	p.host = config.Host
+	return config, nil
+}
+
+// noConfig returns the configuration of the service account of the pod when
+// no kubeconfig exists and tkn runs inside a cluster
+func (p *TektonParams) noConfig() (*rest.Config, error) {
+	if !runningInCluster() {
+		return nil, errNoConfig
+	}
+	return p.inClusterConfig()
+}
+
+func (p *TektonParams) inClusterConfig() (*rest.Config, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "Couldn't get in-cluster configuration")
+	}
+	config.Impersonate = p.impersonate
+
+	if p.namespace == "" {
+		ns, err := os.ReadFile(inClusterNamespaceFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "Couldn't get in-cluster namespace")
+		}
+		p.namespace = strings.TrimSpace(string(ns))
+	}
+
+	if err := p.setClientDefaults(config); err != nil {
+		return nil, err
+	}
+	p.host = config.Host
+	return config, nil
+}
+
+func (p *TektonParams) setClientDefaults(config *rest.Config) error {
 	// set values as done in kubectl
 	config.QPS = 50.0
 	config.Burst = 300
 
-	return config, nil
+	// tune the keepalive of the connections, which long log follows rely on
+	profile, err := p.Profile()
+	if err != nil {
+		return err
+	}
+	opts, err := stream.ProfileOptions(profile.Stream)
+	if err != nil {
+		return err
+	}
+	config.Wrap(opts.WrapTransport())
+	return nil
+}
+
+// ForContext returns Params talking to another context of the same
+// kubeconfig with the same identity, in namespace or in the namespace of the
+// context when it is empty
+func (p *TektonParams) ForContext(kubeContext, namespace string) Params {
+	return &TektonParams{
+		kubeConfigPath: p.kubeConfigPath,
+		kubeContext:    kubeContext,
+		namespace:      namespace,
+		impersonate:    p.impersonate,
+	}
+}
+
+// Cluster returns the address of the API server the clients talk to, it is
+// empty until the clients are created
+func (p *TektonParams) Cluster() string {
+	return p.host
+}
+
+// RESTConfig returns the configuration the clients were created with, it
+// is nil until the clients are created
+func (p *TektonParams) RESTConfig() *rest.Config {
+	return p.restConfig
+}
+
+// Profile returns the active profile of the tkn config, the configuration
+// file is read the first time only. A configuration file which cannot be
+// read is reported and the commands run with an empty profile, rather than
+// all of them failing until it is fixed.
+func (p *TektonParams) Profile() (tknconfig.Profile, error) {
+	p.profileOnce.Do(func() {
+		profile, err := tknconfig.ActiveProfile()
+		if err != nil {
+			fmt.Fprintf(profileWarnings, "Warning: %v, the tkn profile is ignored\n", err)
+			profile = tknconfig.Profile{}
+		}
+		p.profile = profile
+	})
+	return p.profile, nil
 }
 
 func (p *TektonParams) SetNoColour(b bool) {
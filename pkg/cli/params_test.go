@@ -0,0 +1,105 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	tknconfig "github.com/tektoncd/cli/pkg/config"
+)
+
+func TestTektonParams_NoKubeConfig(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("KUBECONFIG", "")
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+
+	p := &TektonParams{}
+	_, err := p.Clients()
+	if err == nil {
+		t.Fatal("expected an error when neither kubeconfig nor in-cluster config is available")
+	}
+	if !strings.Contains(err.Error(), "no kubeconfig found") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestTektonParams_InCluster(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "")
+
+	p := &TektonParams{}
+	p.SetInCluster(true)
+	_, err := p.Clients()
+	if err == nil {
+		t.Fatal("expected an error when not running inside a cluster")
+	}
+	if !strings.Contains(err.Error(), "in-cluster configuration") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestTektonParams_NoKubeConfigInCluster(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("KUBECONFIG", "")
+	t.Setenv("KUBERNETES_SERVICE_HOST", "10.0.0.1")
+	t.Setenv("KUBERNETES_SERVICE_PORT", "443")
+
+	// the service account of the pod is used, whose token is missing here
+	p := &TektonParams{}
+	_, err := p.Clients()
+	if err == nil {
+		t.Fatal("expected an error without the token of the service account")
+	}
+	if !strings.Contains(err.Error(), "in-cluster configuration") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestInClusterFromEnv(t *testing.T) {
+	for value, want := range map[string]bool{"": false, "true": true, "1": true, "false": false, "yes": false} {
+		t.Setenv(InClusterEnv, value)
+		if got := InClusterFromEnv(); got != want {
+			t.Errorf("InClusterFromEnv() with %q = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func TestTektonParams_ProfileInvalidConfig(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(config, []byte("profiles: ["), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TKN_CONFIG", config)
+	warnings := &bytes.Buffer{}
+	profileWarnings = warnings
+	t.Cleanup(func() { profileWarnings = os.Stderr })
+
+	p := &TektonParams{}
+	profile, err := p.Profile()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(profile, tknconfig.Profile{}) {
+		t.Errorf("expected an empty profile, got %+v", profile)
+	}
+	if !strings.Contains(warnings.String(), "Warning: failed to parse config file") {
+		t.Errorf("expected a warning, got %q", warnings.String())
+	}
+}
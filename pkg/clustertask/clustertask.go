@@ -32,7 +32,7 @@ var clustertaskGroupResource = schema.GroupVersionResource{Group: "tekton.dev",
 func GetAllClusterTaskNames(gr schema.GroupVersionResource, c *cli.Clients) ([]string, error) {
 	var clustertasks *v1beta1.ClusterTaskList
 	if err := actions.ListV1(gr, c, metav1.ListOptions{}, "", &clustertasks); err != nil {
-		return nil, fmt.Errorf("failed to list clusterTasks: %v", err)
+		return nil, fmt.Errorf("failed to list clusterTasks: %w", err)
 	}
 
 	ret := []string{}
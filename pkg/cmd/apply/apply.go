@@ -0,0 +1,131 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	applypkg "github.com/tektoncd/cli/pkg/apply"
+	"github.com/tektoncd/cli/pkg/audit"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cli/prerun"
+	"github.com/tektoncd/cli/pkg/flags"
+)
+
+const (
+	dryRunNone   = "none"
+	dryRunServer = "server"
+)
+
+type applyOptions struct {
+	Filenames      []string
+	Recursive      bool
+	DryRun         string
+	ForceConflicts bool
+}
+
+// Command returns the apply command
+func Command(p cli.Params) *cobra.Command {
+	opts := &applyOptions{}
+	eg := `Apply the Tasks and Pipelines of the directory 'tekton' and its subdirectories:
+
+    tkn apply -f tekton -R
+
+Show what applying a Pipeline would change without changing it:
+
+    tkn apply -f pipeline.yaml --dry-run=server
+`
+
+	c := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply Tekton resources with server-side apply",
+		Long: `Applies manifests of Tekton resources with server-side apply, the fields
+are owned by the field manager "tkn".
+
+The resources are applied after the ones they refer to, e.g. Tasks before the
+Pipelines and Pipelines before the runs. The changes each resource goes
+through, as told by a server dry run, are printed before applying them.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:              cobra.NoArgs,
+		Example:           eg,
+		PersistentPreRunE: prerun.PersistentPreRunE(p),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.run(p, cmd.InOrStdin(), cmd.OutOrStdout())
+		},
+	}
+
+	flags.AddTektonOptions(c)
+	c.Flags().StringSliceVarP(&opts.Filenames, "filename", "f", []string{}, "file or directory of the manifests to apply, - to read them from stdin, can be repeated")
+	c.Flags().BoolVarP(&opts.Recursive, "recursive", "R", false, "read the manifests of the subdirectories of the directories too")
+	c.Flags().StringVar(&opts.DryRun, "dry-run", dryRunNone, "none or server, server only prints the changes the API server would make")
+	c.Flags().BoolVar(&opts.ForceConflicts, "force-conflicts", false, "take the ownership of the fields set by other field managers")
+	return c
+}
+
+func (opts *applyOptions) run(p cli.Params, in io.Reader, out io.Writer) error {
+	if len(opts.Filenames) == 0 {
+		return errors.New("manifests must be provided with --filename")
+	}
+	if opts.DryRun != dryRunNone && opts.DryRun != dryRunServer {
+		return fmt.Errorf("invalid value %q for --dry-run, use %s or %s", opts.DryRun, dryRunNone, dryRunServer)
+	}
+
+	objs, err := applypkg.Load(opts.Filenames, opts.Recursive, in)
+	if err != nil {
+		return err
+	}
+	applypkg.Sort(objs)
+
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+	ao := applypkg.Options{Namespace: p.Namespace(), Force: opts.ForceConflicts}
+	plans, err := applypkg.NewPlans(cs, objs, ao)
+	if err != nil {
+		return err
+	}
+
+	if opts.DryRun == dryRunServer {
+		applypkg.PrintPlans(out, plans, " (server dry run)")
+		return nil
+	}
+	applypkg.PrintPlans(out, plans, "")
+
+	applied := map[string][]string{}
+	var kinds []string
+	for _, plan := range plans {
+		if plan.Status() == "unchanged" {
+			continue
+		}
+		if _, err := applypkg.Apply(cs, plan.Object, ao); err != nil {
+			return fmt.Errorf("failed to apply %s from %s: %v", plan.Object.Ref(), plan.Object.Source, err)
+		}
+		kind := plan.Object.GetKind()
+		if _, ok := applied[kind]; !ok {
+			kinds = append(kinds, kind)
+		}
+		applied[kind] = append(applied[kind], plan.Object.GetName())
+	}
+	for _, kind := range kinds {
+		audit.Record(p, audit.ActionApply, kind, applied[kind]...)
+	}
+	return nil
+}
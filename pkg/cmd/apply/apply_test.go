@@ -0,0 +1,150 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stest "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+)
+
+const compileTask = `apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: compile
+  namespace: ns
+  resourceVersion: "12"
+  uid: 7e1b6a3c
+spec:
+  params:
+    - name: revision
+  steps:
+    - name: build
+      image: golang:1.22
+      script: go build ./...
+`
+
+const buildPipeline = `apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: build
+  namespace: ns
+spec:
+  tasks:
+    - name: compile
+      taskRef:
+        name: compile
+    - name: lint
+      taskRef:
+        name: golint
+    - name: test
+      taskRef:
+        name: test
+`
+
+func unstructuredObject(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(manifest), &obj.Object); err != nil {
+		t.Fatal(err)
+	}
+	return obj
+}
+
+func TestApply(t *testing.T) {
+	testParams := []struct {
+		name    string
+		command []string
+		applied []string
+		wantErr string
+	}{
+		{
+			name:    "directory",
+			command: []string{"apply", "-f", "testdata/manifests", "-n", "ns"},
+			// dry runs, then the changed resources
+			applied: []string{"compile", "lint", "build", "lint", "build"},
+		},
+		{
+			name:    "recursive dry run",
+			command: []string{"apply", "-f", "testdata/manifests", "-R", "--dry-run=server", "-n", "ns"},
+			applied: []string{"compile", "lint", "build", "build-run"},
+		},
+		{
+			name:    "no manifests",
+			command: []string{"apply", "-n", "ns"},
+			wantErr: "manifests must be provided with --filename",
+		},
+		{
+			name:    "invalid dry run",
+			command: []string{"apply", "-f", "testdata/manifests", "--dry-run=client"},
+			wantErr: `invalid value "client" for --dry-run, use none or server`,
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			var applied []string
+			// the fake client does not implement server-side apply, the
+			// applied object is returned as is
+			tdc := testDynamic.Options{
+				PrependReactors: []testDynamic.PrependOpt{{
+					Verb:     "patch",
+					Resource: "*",
+					Action: func(action k8stest.Action) (bool, runtime.Object, error) {
+						patch := action.(k8stest.PatchAction)
+						applied = append(applied, patch.GetName())
+						obj := &unstructured.Unstructured{}
+						err := obj.UnmarshalJSON(patch.GetPatch())
+						obj.SetNamespace(patch.GetNamespace())
+						return true, obj, err
+					},
+				}},
+			}
+			dc, err := tdc.Client(unstructuredObject(t, compileTask), unstructuredObject(t, buildPipeline))
+			if err != nil {
+				t.Fatalf("unable to create dynamic client: %v", err)
+			}
+			cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+			cs.Pipeline.Resources = []*metav1.APIResourceList{{
+				GroupVersion: "tekton.dev/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "tasks", Kind: "Task", Namespaced: true},
+					{Name: "pipelines", Kind: "Pipeline", Namespaced: true},
+					{Name: "pipelineruns", Kind: "PipelineRun", Namespaced: true},
+				},
+			}}
+			p := &test.Params{Tekton: cs.Pipeline, Dynamic: dc}
+
+			out, err := test.ExecuteCommand(Command(p), tp.command[1:]...)
+			if tp.wantErr != "" {
+				assert.Error(t, err, tp.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tp.applied, applied)
+			golden.Assert(t, out, strings.ReplaceAll(t.Name(), "/", "-")+".golden")
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/keyring"
+)
+
+const servicesHelp = `The service is one of:
+
+  results        the bearer token of the Tekton Results API
+  hub            the GitHub token tkn hub publish opens pull requests with
+  registry/HOST  the bearer token of the image registry HOST, e.g. registry/ghcr.io`
+
+func tokenCommand(p cli.Params) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Manage the tokens stored in the keychain of the operating system",
+		Long: `Store the tokens tkn authenticates with in the keychain of the operating system
+rather than in plaintext files or environment variables.
+
+Tokens are kept through the docker credential helper of the keychain, e.g.
+docker-credential-osxkeychain on macOS, docker-credential-wincred on Windows and
+docker-credential-secretservice on Linux, which must be installed. Another helper
+can be set with the credentialsHelper setting of the tkn profile.
+
+` + servicesHelp,
+	}
+
+	cmd.AddCommand(
+		tokenSetCommand(p),
+		tokenGetCommand(p),
+		tokenDeleteCommand(p),
+	)
+	return cmd
+}
+
+func tokenSetCommand(p cli.Params) *cobra.Command {
+	var fromStdin bool
+	askOpts := func(opt *survey.AskOptions) error {
+		opt.Stdio = terminal.Stdio{
+			In:  os.Stdin,
+			Out: os.Stdout,
+			Err: os.Stderr,
+		}
+		return nil
+	}
+	eg := `Store the token of the Results API, prompting for it:
+
+    tkn auth token set results
+
+Store the token of the ghcr.io registry read from stdin:
+
+    echo $TOKEN | tkn auth token set registry/ghcr.io --stdin
+`
+
+	c := &cobra.Command{
+		Use:          "set SERVICE",
+		Short:        "Store the token of a service in the keychain",
+		Long:         servicesHelp,
+		Example:      eg,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			service := args[0]
+			if err := keyring.ValidateService(service); err != nil {
+				return err
+			}
+
+			var token string
+			if fromStdin {
+				b, err := io.ReadAll(cmd.InOrStdin())
+				if err != nil {
+					return err
+				}
+				token = strings.TrimSpace(string(b))
+			} else {
+				prompt := &survey.Password{Message: fmt.Sprintf("Token for %s:", service)}
+				if err := survey.AskOne(prompt, &token, askOpts); err != nil {
+					return err
+				}
+			}
+			if token == "" {
+				return errors.New("the token must not be empty")
+			}
+
+			profile, err := p.Profile()
+			if err != nil {
+				return err
+			}
+			kr, err := keyring.Open(profile)
+			if err != nil {
+				return err
+			}
+			if err := kr.Set(service, token); err != nil {
+				return fmt.Errorf("failed to store the token for %s: %w", service, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Token for %s stored in the keychain\n", service)
+			return nil
+		},
+	}
+	c.Flags().BoolVar(&fromStdin, "stdin", false, "read the token from stdin rather than prompting for it")
+	return c
+}
+
+func tokenGetCommand(p cli.Params) *cobra.Command {
+	return &cobra.Command{
+		Use:          "get SERVICE",
+		Short:        "Print the token of a service stored in the keychain",
+		Long:         servicesHelp,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			service := args[0]
+			if err := keyring.ValidateService(service); err != nil {
+				return err
+			}
+			profile, err := p.Profile()
+			if err != nil {
+				return err
+			}
+			kr, err := keyring.Open(profile)
+			if err != nil {
+				return err
+			}
+			token, err := kr.Get(service)
+			if err != nil {
+				return tokenError("read", service, err)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), token)
+			return nil
+		},
+	}
+}
+
+func tokenDeleteCommand(p cli.Params) *cobra.Command {
+	return &cobra.Command{
+		Use:          "delete SERVICE",
+		Aliases:      []string{"rm"},
+		Short:        "Delete the token of a service from the keychain",
+		Long:         servicesHelp,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			service := args[0]
+			if err := keyring.ValidateService(service); err != nil {
+				return err
+			}
+			profile, err := p.Profile()
+			if err != nil {
+				return err
+			}
+			kr, err := keyring.Open(profile)
+			if err != nil {
+				return err
+			}
+			if err := kr.Delete(service); err != nil {
+				return tokenError("delete", service, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Token for %s deleted from the keychain\n", service)
+			return nil
+		},
+	}
+}
+
+func tokenError(action, service string, err error) error {
+	if errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("no token stored for %s", service)
+	}
+	return fmt.Errorf("failed to %s the token for %s: %w", action, service, err)
+}
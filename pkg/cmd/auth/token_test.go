@@ -0,0 +1,105 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/keyring"
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+type memoryKeyring map[string]string
+
+func (k memoryKeyring) Set(service, token string) error {
+	k[service] = token
+	return nil
+}
+
+func (k memoryKeyring) Get(service string) (string, error) {
+	token, ok := k[service]
+	if !ok {
+		return "", keyring.ErrNotFound
+	}
+	return token, nil
+}
+
+func (k memoryKeyring) Delete(service string) error {
+	if _, ok := k[service]; !ok {
+		return keyring.ErrNotFound
+	}
+	delete(k, service)
+	return nil
+}
+
+func useKeyring(t *testing.T, kr keyring.Keyring) {
+	open := keyring.Open
+	keyring.Open = func(config.Profile) (keyring.Keyring, error) {
+		return kr, nil
+	}
+	t.Cleanup(func() { keyring.Open = open })
+}
+
+func TestToken(t *testing.T) {
+	kr := memoryKeyring{}
+	useKeyring(t, kr)
+
+	c := Command(&test.Params{})
+	c.SetIn(strings.NewReader("s3cr3t\n"))
+	out, err := test.ExecuteCommand(c, "token", "set", "registry/ghcr.io", "--stdin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, "Token for registry/ghcr.io stored in the keychain\n", out)
+	test.AssertOutput(t, "s3cr3t", kr["registry/ghcr.io"])
+
+	out, err = test.ExecuteCommand(Command(&test.Params{}), "token", "get", "registry/ghcr.io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, "s3cr3t\n", out)
+
+	out, err = test.ExecuteCommand(Command(&test.Params{}), "token", "delete", "registry/ghcr.io")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, "Token for registry/ghcr.io deleted from the keychain\n", out)
+
+	_, err = test.ExecuteCommand(Command(&test.Params{}), "token", "get", "registry/ghcr.io")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	test.AssertOutput(t, "no token stored for registry/ghcr.io", err.Error())
+}
+
+func TestToken_invalid(t *testing.T) {
+	useKeyring(t, memoryKeyring{})
+
+	_, err := test.ExecuteCommand(Command(&test.Params{}), "token", "get", "github")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	test.AssertOutput(t, `invalid service "github", use results, hub or registry/HOST`, err.Error())
+
+	c := Command(&test.Params{})
+	c.SetIn(strings.NewReader("\n"))
+	_, err = test.ExecuteCommand(c, "token", "set", "results", "--stdin")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	test.AssertOutput(t, "the token must not be empty", err.Error())
+}
@@ -14,6 +14,7 @@
 package bundle
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -65,6 +66,12 @@ Authentication:
 	2. Additionally, you can supply a Bearer Token via --remote-bearer
 	3. Additionally, you can use Basic auth via --remote-username and --remote-password
 
+Shorthand references:
+	References starting with ecr://, gcr:// or acr:// are expanded using the credentials of the cloud CLIs:
+	ecr://repo/bundle:1.0 becomes ACCOUNT.dkr.ecr.REGION.amazonaws.com/repo/bundle:1.0 for the default AWS credentials,
+	gcr://repo/bundle:1.0 becomes gcr.io/PROJECT/repo/bundle:1.0 for the active gcloud project and
+	acr://repo/bundle:1.0 becomes REGISTRY.azurecr.io/repo/bundle:1.0 for the default registry of the az CLI.
+
 Caching:
     By default, bundles will be cached in ~/.tekton/bundles. If you would like to use a different location, set
 "--cache-dir" and if you would like to skip the cache altogether, set "--no-cache".
@@ -84,7 +91,11 @@ Caching:
 				return errInvalidRef
 			}
 
-			ref, err := name.ParseReference(args[0], name.StrictValidation, name.Insecure)
+			expanded, err := bundle.ExpandReference(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+			ref, err := name.ParseReference(expanded, name.StrictValidation, name.Insecure)
 			if err != nil {
 				return err
 			}
@@ -132,7 +143,11 @@ Caching:
 
 // Run performs the principal logic of reading and parsing the input, creating the bundle, and publishing it.
 func (l *listOptions) Run(args []string, formatter bundle.ObjectVisitor) error {
-	img, err := bundle.Read(l.ref, &l.cacheOptions, l.remoteOptions.ToOptions()...)
+	profile, err := l.cliparams.Profile()
+	if err != nil {
+		return err
+	}
+	img, err := bundle.Read(l.ref, &l.cacheOptions, l.remoteOptions.ToOptions(profile)...)
 	if err != nil {
 		return err
 	}
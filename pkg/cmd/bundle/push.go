@@ -14,6 +14,7 @@
 package bundle
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -48,8 +49,8 @@ type pushOptions struct {
 	ctime              time.Time
 }
 
-func pushCommand(_ cli.Params) *cobra.Command {
-	opts := &pushOptions{}
+func pushCommand(p cli.Params) *cobra.Command {
+	opts := &pushOptions{cliparams: p}
 
 	longHelp := `Publish a new Tekton Bundle to a registry by passing in a set of Tekton objects via files, arguments or standard in:
 
@@ -63,6 +64,12 @@ Authentication:
 	2. Additionally, you can supply a Bearer Token via --remote-bearer
 	3. Additionally, you can use Basic auth via --remote-username and --remote-password
 
+Shorthand references:
+	References starting with ecr://, gcr:// or acr:// are expanded using the credentials of the cloud CLIs:
+	ecr://repo/bundle:1.0 becomes ACCOUNT.dkr.ecr.REGION.amazonaws.com/repo/bundle:1.0 for the default AWS credentials,
+	gcr://repo/bundle:1.0 becomes gcr.io/PROJECT/repo/bundle:1.0 for the active gcloud project and
+	acr://repo/bundle:1.0 becomes REGISTRY.azurecr.io/repo/bundle:1.0 for the default registry of the az CLI.
+
 Input:
 	Valid input in any form is valid Tekton YAML or JSON with a fully-specified "apiVersion" and "kind". To pass multiple objects in a single input, use "---" separators in YAML or a top-level "[]" in JSON.
 
@@ -84,11 +91,12 @@ Created time:
 				return errInvalidRef
 			}
 
-			if _, err := name.ParseReference(args[0], name.StrictValidation, name.Insecure); err != nil {
+			ref, err := bundle.ExpandReference(context.Background(), args[0])
+			if err != nil {
 				return err
 			}
-
-			return nil
+			opts.ref, err = name.ParseReference(ref, name.StrictValidation, name.Insecure)
+			return err
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.stream = &cli.Stream{
@@ -112,7 +120,10 @@ Created time:
 // Reads the positional arguments and the `-f` flag to fill in the `bunldeContents` parameter with all of the raw Tekton
 // contents.
 func (p *pushOptions) parseArgsAndFlags(args []string) (err error) {
-	p.ref, _ = name.ParseReference(args[0], name.StrictValidation, name.Insecure)
+	// The reference is already expanded and parsed when running the command.
+	if p.ref == nil {
+		p.ref, _ = name.ParseReference(args[0], name.StrictValidation, name.Insecure)
+	}
 
 	// If there are file paths specified, then read them and include their contents.
 	for _, path := range p.bundleContentPaths {
@@ -162,7 +173,11 @@ func (p *pushOptions) Run(args []string) error {
 		return err
 	}
 
-	outputDigest, err := bundle.Write(img, p.ref, p.remoteOptions.ToOptions()...)
+	profile, err := p.cliparams.Profile()
+	if err != nil {
+		return err
+	}
+	outputDigest, err := bundle.Write(img, p.ref, p.remoteOptions.ToOptions(profile)...)
 	if err != nil {
 		return err
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/tektoncd/cli/pkg/bundle"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/test"
 	tkremote "github.com/tektoncd/pipeline/pkg/remote/oci"
 	"sigs.k8s.io/yaml"
 )
@@ -151,7 +152,7 @@ func TestPushCommand(t *testing.T) {
 			}
 
 			opts := pushOptions{
-				cliparams: nil,
+				cliparams: &test.Params{},
 				stream: &cli.Stream{
 					In:  bytes.NewBuffer([]byte(tc.stdin)),
 					Out: &bytes.Buffer{},
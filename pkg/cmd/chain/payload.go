@@ -50,7 +50,7 @@ func payloadCommand(p cli.Params) *cobra.Command {
 
 			chainsNamespace, err := cmd.Flags().GetString("chains-namespace")
 			if err != nil {
-				return fmt.Errorf("error: output option not set properly: %v", err)
+				return fmt.Errorf("error: output option not set properly: %w", err)
 			}
 
 			// Get the Tekton clients.
@@ -62,7 +62,7 @@ func payloadCommand(p cli.Params) *cobra.Command {
 			// Retrieve the taskrun.
 			var taskrun *v1.TaskRun
 			if err = actions.GetV1(taskrunGroupResource, cs, taskName, p.Namespace(), metav1.GetOptions{}, &taskrun); err != nil {
-				return fmt.Errorf("failed to get TaskRun %s: %v", taskName, err)
+				return fmt.Errorf("failed to get TaskRun %s: %w", taskName, err)
 			}
 
 			return printPayloads(cs, chainsNamespace, taskrun, skipVerify)
@@ -77,7 +77,7 @@ func printPayloads(cs *cli.Clients, namespace string, tr *v1.TaskRun, skipVerify
 	// Get the storage backend.
 	backends, opts, err := chain.GetTaskRunBackends(cs, namespace, tr)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve the backend storage: %v", err)
+		return fmt.Errorf("failed to retrieve the backend storage: %w", err)
 	}
 	for _, backend := range backends {
 		// Some limitations occur when the backend is OCI.
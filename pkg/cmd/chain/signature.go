@@ -41,7 +41,7 @@ func signatureCommand(p cli.Params) *cobra.Command {
 
 			chainsNamespace, err := cmd.Flags().GetString("chains-namespace")
 			if err != nil {
-				return fmt.Errorf("error: output option not set properly: %v", err)
+				return fmt.Errorf("error: output option not set properly: %w", err)
 			}
 
 			// Get the Tekton clients.
@@ -52,7 +52,7 @@ func signatureCommand(p cli.Params) *cobra.Command {
 
 			var taskrun *v1.TaskRun
 			if err = actions.GetV1(taskrunGroupResource, cs, taskName, p.Namespace(), metav1.GetOptions{}, &taskrun); err != nil {
-				return fmt.Errorf("failed to get TaskRun %s: %v", taskName, err)
+				return fmt.Errorf("failed to get TaskRun %s: %w", taskName, err)
 			}
 
 			return printSignatures(cs, chainsNamespace, taskrun)
@@ -66,7 +66,7 @@ func printSignatures(cs *cli.Clients, namespace string, tr *v1.TaskRun) error {
 	// Get the storage backend.
 	backends, opts, err := chain.GetTaskRunBackends(cs, namespace, tr)
 	if err != nil {
-		return fmt.Errorf("failed to retrieve the backend storage: %v", err)
+		return fmt.Errorf("failed to retrieve the backend storage: %w", err)
 	}
 
 	for _, backend := range backends {
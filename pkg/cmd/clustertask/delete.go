@@ -134,6 +134,8 @@ func deleteClusterTasks(opts *options.DeleteOptions, s *cli.Stream, p cli.Params
 
 	}
 
+	d.Audit(p)
+
 	if !opts.DeleteAll {
 		d.PrintSuccesses(s)
 	} else if opts.DeleteAll {
@@ -130,7 +130,7 @@ or
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
 			}
 
 			cs, err := p.Clients()
@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +37,7 @@ const (
 
 type listOptions struct {
 	NoHeaders bool
+	Script    flags.ScriptOutput
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -53,7 +55,10 @@ func listCommand(p cli.Params) *cobra.Command {
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
+			}
+			if err := opts.Script.Validate(output); err != nil {
+				return err
 			}
 
 			cs, err := p.Clients()
@@ -61,6 +66,19 @@ func listCommand(p cli.Params) *cobra.Command {
 				return err
 			}
 
+			if opts.Script.Enabled() {
+				var clustertasks *v1beta1.ClusterTaskList
+				if err := actions.ListV1(clustertaskGroupResource, cs, metav1.ListOptions{}, "", &clustertasks); err != nil {
+					return fmt.Errorf("failed to list ClusterTasks")
+				}
+				for _, ct := range clustertasks.Items {
+					if err := opts.Script.Print(cmd.OutOrStdout(), ct.Name, ct.Name, formatted.PorcelainTime(&ct.CreationTimestamp)); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
 			if output != "" {
 				ctGroupResource := schema.GroupVersionResource{Group: "tekton.dev", Resource: "clustertasks"}
 				p, err := f.ToPrinter()
@@ -78,6 +96,7 @@ func listCommand(p cli.Params) *cobra.Command {
 	}
 	f.AddFlags(c)
 	c.Flags().BoolVar(&opts.NoHeaders, "no-headers", opts.NoHeaders, "do not print column headers with output (default print column headers with output)")
+	opts.Script.AddFlags(c, "ClusterTask")
 	c.Deprecated = "ClusterTasks are deprecated, this command will be removed in future releases."
 	return c
 }
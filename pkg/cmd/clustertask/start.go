@@ -27,11 +27,13 @@ import (
 	"github.com/AlecAivazis/survey/v2/terminal"
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/audit"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/cmd/taskrun"
 	"github.com/tektoncd/cli/pkg/file"
 	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/i18n"
 	"github.com/tektoncd/cli/pkg/labels"
 	"github.com/tektoncd/cli/pkg/options"
 	"github.com/tektoncd/cli/pkg/params"
@@ -57,10 +59,12 @@ type startOptions struct {
 	ServiceAccountName    string
 	Last                  bool
 	Labels                []string
+	Annotations           []string
 	ShowLog               bool
 	TimeOut               string
 	DryRun                bool
 	Output                string
+	Script                flags.ScriptOutput
 	PrefixName            string
 	Workspaces            []string
 	UseParamDefaults      bool
@@ -164,6 +168,12 @@ For passing the workspaces via flags:
 			if format != "" && opt.ShowLog {
 				return errors.New("cannot use --output option with --showlog option")
 			}
+			if err := opt.Script.Validate(opt.Output); err != nil {
+				return err
+			}
+			if opt.Script.Enabled() && (opt.ShowLog || opt.DryRun) {
+				return errors.New("--quiet and --porcelain cannot be used with --showlog or --dry-run")
+			}
 			if err := flags.InitParams(p, cmd); err != nil {
 				return err
 			}
@@ -186,11 +196,14 @@ For passing the workspaces via flags:
 	c.Flags().BoolVarP(&opt.Last, "last", "L", false, "re-run the ClusterTask using last TaskRun values")
 	c.Flags().StringVarP(&opt.UseTaskRun, "use-taskrun", "", "", "specify a TaskRun name to use its values to re-run the TaskRun")
 	c.Flags().StringSliceVarP(&opt.Labels, "labels", "l", []string{}, "pass labels as label=value.")
+	c.Flags().StringSliceVarP(&opt.Annotations, "annotation", "", []string{}, "pass annotations of the TaskRun as annotation=value, Tekton propagates them with the labels to the pods of the run")
+	flags.AddAliases(c, map[string]string{"label": "labels"})
 	c.Flags().StringArrayVarP(&opt.Workspaces, "workspace", "w", []string{}, "pass one or more workspaces to map to the corresponding physical volumes")
 	c.Flags().BoolVarP(&opt.ShowLog, "showlog", "", false, "show logs right after starting the ClusterTask")
 	c.Flags().StringVar(&opt.TimeOut, "timeout", "", "timeout for TaskRun")
 	c.Flags().BoolVarP(&opt.DryRun, "dry-run", "", false, "preview TaskRun without running it")
 	c.Flags().StringVarP(&opt.Output, "output", "", "", "format of TaskRun (yaml or json)")
+	opt.Script.AddFlags(c, "TaskRun")
 	c.Flags().StringVarP(&opt.PrefixName, "prefix-name", "", "", "specify a prefix for the TaskRun name (must be lowercase alphanumeric characters)")
 	c.Flags().StringVar(&opt.PodTemplate, "pod-template", "", "local or remote file containing a PodTemplate definition")
 	c.Flags().BoolVar(&opt.UseParamDefaults, "use-param-defaults", false, "use default parameter values without prompting for input")
@@ -252,6 +265,12 @@ func startClusterTask(opt startOptions, args []string) error {
 		tr.Spec.Timeout = &metav1.Duration{Duration: timeoutDuration}
 	}
 
+	annotations, err := labels.MergeAnnotations(tr.ObjectMeta.Annotations, opt.Annotations)
+	if err != nil {
+		return err
+	}
+	tr.ObjectMeta.Annotations = annotations
+
 	labels, err := labels.MergeLabels(tr.ObjectMeta.Labels, opt.Labels)
 	if err != nil {
 		return err
@@ -303,8 +322,9 @@ func startClusterTask(opt startOptions, args []string) error {
 
 	trCreated, err := tractions.Create(cs, tr, metav1.CreateOptions{}, opt.cliparams.Namespace())
 	if err != nil {
-		return err
+		return actions.DescribeRejection("TaskRun", err)
 	}
+	audit.Record(opt.cliparams, audit.ActionStart, "TaskRun", trCreated.Name)
 
 	if opt.Output != "" {
 		gvr, err := actions.GetGroupVersionResource(taskrunGroupResource, cs.Tekton.Discovery())
@@ -324,19 +344,21 @@ func startClusterTask(opt startOptions, args []string) error {
 		return printTaskRun(opt.Output, opt.stream, trCreated)
 	}
 
-	fmt.Fprintf(opt.stream.Out, "TaskRun started: %s\n", trCreated.Name)
+	if opt.Script.Enabled() {
+		return opt.Script.Print(opt.stream.Out, trCreated.Name, trCreated.Namespace, trCreated.Name)
+	}
+
+	i18n.Fprintf(opt.stream.Out, "TaskRun started: %s\n", trCreated.Name)
 	if !opt.ShowLog {
-		inOrderString := "\nIn order to track the TaskRun progress run:\ntkn taskrun "
-		if opt.TektonOptions.Context != "" {
-			inOrderString += fmt.Sprintf("--context=%s ", opt.TektonOptions.Context)
-		}
+		inOrderString := i18n.T("\nIn order to track the TaskRun progress run:\n") + "tkn taskrun "
+		inOrderString += opt.TektonOptions.Args()
 		inOrderString += fmt.Sprintf("logs %s -f -n %s\n", trCreated.Name, trCreated.Namespace)
 
 		fmt.Fprint(opt.stream.Out, inOrderString)
 		return nil
 	}
 
-	fmt.Fprintf(opt.stream.Out, "Waiting for logs to be available...\n")
+	i18n.Fprintf(opt.stream.Out, "Waiting for logs to be available...\n")
 	runLogOpts := &options.LogOptions{
 		TaskrunName: trCreated.Name,
 		Stream:      opt.stream,
@@ -118,6 +118,8 @@ func deleteClusterTriggerBindings(s *cli.Stream, p cli.Params, ctbNames []string
 	}
 	d.Delete(ctbNames)
 
+	d.Audit(p)
+
 	if !deleteAll {
 		d.PrintSuccesses(s)
 	} else if deleteAll {
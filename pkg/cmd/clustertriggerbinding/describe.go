@@ -77,7 +77,7 @@ or
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
 			}
 
 			if len(args) == 0 {
@@ -121,7 +121,7 @@ func printClusterTriggerBindingDescription(s *cli.Stream, p cli.Params, ctbName
 
 	ctb, err := clustertriggerbinding.Get(cs, ctbName, metav1.GetOptions{})
 	if err != nil {
-		return fmt.Errorf("failed to get ClusterTriggerBinding %s: %v", ctbName, err)
+		return fmt.Errorf("failed to get ClusterTriggerBinding %s: %w", ctbName, err)
 	}
 
 	var data = struct {
@@ -137,7 +137,7 @@ func printClusterTriggerBindingDescription(s *cli.Stream, p cli.Params, ctbName
 	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
 	tparsed := template.Must(template.New("Describe ClusterTriggerbinding").Funcs(funcMap).Parse(describeTemplate))
 	if err = tparsed.Execute(w, data); err != nil {
-		return fmt.Errorf("failed to execute template: %v", err)
+		return fmt.Errorf("failed to execute template: %w", err)
 	}
 	return w.Flush()
 }
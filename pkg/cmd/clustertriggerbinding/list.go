@@ -22,6 +22,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/clustertriggerbinding"
+	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +35,7 @@ const (
 
 type listOptions struct {
 	NoHeaders bool
+	Script    flags.ScriptOutput
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -65,13 +67,24 @@ or
 
 			tbs, err := clustertriggerbinding.List(cs, metav1.ListOptions{})
 			if err != nil {
-				return fmt.Errorf("failed to list ClusterTriggerBindings: %v", err)
+				return fmt.Errorf("failed to list ClusterTriggerBindings: %w", err)
 			}
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
 				return errors.New("output option not set properly")
 			}
+			if err := opts.Script.Validate(output); err != nil {
+				return err
+			}
+			if opts.Script.Enabled() {
+				for _, ctb := range tbs.Items {
+					if err := opts.Script.Print(cmd.OutOrStdout(), ctb.Name, ctb.Name, formatted.PorcelainTime(&ctb.CreationTimestamp)); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
 
 			stream := &cli.Stream{
 				Out: cmd.OutOrStdout(),
@@ -96,7 +109,7 @@ or
 			}
 
 			if err = printFormatted(stream, tbs, p, opts.NoHeaders); err != nil {
-				return fmt.Errorf("failed to print ClusterTriggerBindings: %v", err)
+				return fmt.Errorf("failed to print ClusterTriggerBindings: %w", err)
 			}
 			return nil
 
@@ -105,6 +118,7 @@ or
 
 	f.AddFlags(c)
 	c.Flags().BoolVar(&opts.NoHeaders, "no-headers", opts.NoHeaders, "do not print column headers with output (default print column headers with output)")
+	opts.Script.AddFlags(c, "ClusterTriggerBinding")
 	return c
 }
 
@@ -17,6 +17,7 @@ package completion
 import (
 	"bytes"
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
 )
@@ -73,6 +74,22 @@ func genZshCompletion(cmd *cobra.Command) string {
 		cmd.Root().Use, cmd.Root().Use, output.String())
 }
 
+// Write writes the completion script of shell for the root command of cmd
+func Write(cmd *cobra.Command, shell string, w io.Writer) error {
+	switch shell {
+	case "bash":
+		return cmd.Root().GenBashCompletion(w)
+	case "zsh":
+		_, err := fmt.Fprint(w, genZshCompletion(cmd))
+		return err
+	case "fish":
+		return cmd.Root().GenFishCompletion(w, true)
+	case "powershell":
+		return cmd.Root().GenPowerShellCompletion(w)
+	}
+	return fmt.Errorf("unsupported shell %q", shell)
+}
+
 func Command() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:       "completion [SHELL]",
@@ -85,17 +102,7 @@ func Command() *cobra.Command {
 		},
 		Args: cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			switch args[0] {
-			case "bash":
-				_ = cmd.Root().GenBashCompletion(cmd.OutOrStdout())
-			case "zsh":
-				fmt.Fprint(cmd.OutOrStdout(), genZshCompletion(cmd))
-			case "fish":
-				_ = cmd.Root().GenFishCompletion(cmd.OutOrStdout(), true)
-			case "powershell":
-				_ = cmd.Root().GenPowerShellCompletion(cmd.OutOrStdout())
-			}
-
+			_ = Write(cmd, args[0], cmd.OutOrStdout())
 			return nil
 		},
 	}
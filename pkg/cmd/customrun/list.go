@@ -25,6 +25,7 @@ import (
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
 	crsort "github.com/tektoncd/cli/pkg/customrun/sort"
+	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -55,6 +56,7 @@ type ListOptions struct {
 	Reverse       bool
 	AllNamespaces bool
 	NoHeaders     bool
+	Script        flags.ScriptOutput
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -90,7 +92,21 @@ func listCommand(p cli.Params) *cobra.Command {
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
+			}
+			if err := opts.Script.Validate(output); err != nil {
+				return err
+			}
+			if opts.Script.Enabled() {
+				if crs == nil {
+					return nil
+				}
+				for _, cr := range crs.Items {
+					if err := opts.Script.Print(cmd.OutOrStdout(), cr.Name, cr.Namespace, cr.Name, customRefKind(cr), customRefName(cr), formatted.Phase(cr.Status.Conditions), formatted.PorcelainTime(cr.Status.StartTime), formatted.PorcelainTime(cr.Status.CompletionTime)); err != nil {
+						return err
+					}
+				}
+				return nil
 			}
 			if output == "name" && crs != nil {
 				w := cmd.OutOrStdout()
@@ -133,6 +149,7 @@ func listCommand(p cli.Params) *cobra.Command {
 	c.Flags().BoolVarP(&opts.Reverse, "reverse", "", opts.Reverse, "list CustomRuns in reverse order")
 	c.Flags().BoolVarP(&opts.AllNamespaces, "all-namespaces", "A", opts.AllNamespaces, "list CustomRuns from all namespaces")
 	c.Flags().BoolVarP(&opts.NoHeaders, "no-headers", "", opts.NoHeaders, "do not print column headers with output (default print column headers with output)")
+	opts.Script.AddFlags(c, "CustomRun")
 	return c
 }
 
@@ -230,3 +247,19 @@ func printFormatted(s *cli.Stream, crs *v1beta1.CustomRunList, c clockwork.Clock
 
 	return w.Flush()
 }
+
+// customRefKind and customRefName return the kind and name of the custom task
+// a CustomRun is for, empty for an embedded spec
+func customRefKind(cr v1beta1.CustomRun) string {
+	if cr.Spec.CustomRef == nil {
+		return ""
+	}
+	return string(cr.Spec.CustomRef.Kind)
+}
+
+func customRefName(cr v1beta1.CustomRun) string {
+	if cr.Spec.CustomRef == nil {
+		return ""
+	}
+	return cr.Spec.CustomRef.Name
+}
@@ -0,0 +1,150 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cli/prerun"
+	"github.com/tektoncd/cli/pkg/daemon"
+	"github.com/tektoncd/cli/pkg/flags"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type daemonOptions struct {
+	Namespaces    []string
+	AllNamespaces bool
+	Socket        string
+}
+
+// Command returns the daemon command
+func Command(p cli.Params) *cobra.Command {
+	opts := &daemonOptions{}
+	eg := `Cache the runs of the current namespace until interrupted:
+
+    tkn daemon
+
+Cache the runs of the namespaces 'ci' and 'release':
+
+    tkn daemon --namespaces ci,release
+`
+
+	c := &cobra.Command{
+		Use:   "daemon",
+		Short: "Cache the runs of a cluster to list them instantly",
+		Long: `Keep the PipelineRuns and the TaskRuns of some namespaces in informer caches
+and serve them over a unix socket until interrupted.
+
+While the daemon runs, the list and describe commands of pipelinerun and
+taskrun, and the completion of the names of the runs, read those runs from
+the caches instead of the API server when they talk to the same cluster as
+the same user and impersonation. What they cannot find in the caches is read
+from the API server as usual, and the other commands always talk to the API
+server.
+
+The caches are updated by watches, so a run created a moment ago may not be
+listed yet. The socket is in $XDG_RUNTIME_DIR/tkn, or in a tkn directory of
+the temporary directory, and can be set with $TKN_DAEMON_SOCKET.`,
+		Example: eg,
+		Annotations: map[string]string{
+			"commandType": "utility",
+		},
+		Args:              cobra.NoArgs,
+		SilenceUsage:      true,
+		PersistentPreRunE: prerun.PersistentPreRunE(p),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.AllNamespaces && len(opts.Namespaces) != 0 {
+				return fmt.Errorf("--all-namespaces cannot be used with --namespaces")
+			}
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return opts.run(ctx, cmd, p)
+		},
+	}
+
+	flags.AddTektonOptions(c)
+	c.Flags().StringSliceVar(&opts.Namespaces, "namespaces", nil, "namespaces whose runs are cached, the namespace of --namespace by default")
+	c.Flags().BoolVarP(&opts.AllNamespaces, "all-namespaces", "A", false, "cache the runs of all the namespaces")
+	c.Flags().StringVar(&opts.Socket, "socket", "", "path of the socket to serve on, the one the other commands look for by default")
+	return c
+}
+
+func (opts *daemonOptions) run(ctx context.Context, cmd *cobra.Command, p cli.Params) error {
+	out := cmd.OutOrStdout()
+
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+
+	gvrs := []schema.GroupVersionResource{}
+	for _, r := range daemon.Resources {
+		gvr, err := actions.GetGroupVersionResource(schema.GroupVersionResource{Group: pipeline.GroupName, Resource: r}, cs.Tekton.Discovery())
+		if err != nil {
+			return err
+		}
+		gvrs = append(gvrs, *gvr)
+	}
+
+	namespaces := opts.Namespaces
+	described := "namespaces " + strings.Join(namespaces, ", ")
+	switch {
+	case opts.AllNamespaces:
+		namespaces = []string{metav1.NamespaceAll}
+		described = "all the namespaces"
+	case len(namespaces) == 0:
+		namespaces = []string{p.Namespace()}
+		described = "namespace " + p.Namespace()
+	}
+
+	socket := opts.Socket
+	if cp, ok := p.(cli.ConfigParams); ok && socket == "" && cp.RESTConfig() != nil {
+		socket = daemon.SocketPath(cp.RESTConfig())
+	}
+	if socket == "" {
+		return fmt.Errorf("the address of the cluster is unknown, pass --socket")
+	}
+
+	s := daemon.NewServer(cs.Dynamic, namespaces, gvrs)
+	fmt.Fprintf(out, "Filling the caches of the runs of %s...\n", described)
+	if err := s.Start(ctx); err != nil {
+		// interrupted before the caches were filled
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+
+	l, err := daemon.Listen(socket)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Serving on %s\n", socket)
+	return s.Serve(ctx, l)
+}
@@ -0,0 +1,27 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/assert"
+)
+
+func TestDaemon_invalidFlags(t *testing.T) {
+	_, err := test.ExecuteCommand(Command(&test.Params{}), "--all-namespaces", "--namespaces", "ci")
+	assert.Error(t, err, "--all-namespaces cannot be used with --namespaces")
+}
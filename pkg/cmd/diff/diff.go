@@ -0,0 +1,85 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"errors"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/apply"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cli/prerun"
+	"github.com/tektoncd/cli/pkg/flags"
+)
+
+type diffOptions struct {
+	Filenames []string
+	Recursive bool
+}
+
+// Command returns the diff command
+func Command(p cli.Params) *cobra.Command {
+	opts := &diffOptions{}
+	eg := `Show the changes applying the manifests of the directory 'tekton' would make:
+
+    tkn diff -f tekton -R
+`
+
+	c := &cobra.Command{
+		Use:   "diff",
+		Short: "Diff Tekton resources against the cluster",
+		Long: `Prints the changes applying manifests of Tekton resources with tkn apply
+would make to the resources of the cluster, as told by a server dry run of the
+server-side apply. Nothing is changed.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:              cobra.NoArgs,
+		Example:           eg,
+		PersistentPreRunE: prerun.PersistentPreRunE(p),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.run(p, cmd.InOrStdin(), cmd.OutOrStdout())
+		},
+	}
+
+	flags.AddTektonOptions(c)
+	c.Flags().StringSliceVarP(&opts.Filenames, "filename", "f", []string{}, "file or directory of the manifests to diff, - to read them from stdin, can be repeated")
+	c.Flags().BoolVarP(&opts.Recursive, "recursive", "R", false, "read the manifests of the subdirectories of the directories too")
+	return c
+}
+
+func (opts *diffOptions) run(p cli.Params, in io.Reader, out io.Writer) error {
+	if len(opts.Filenames) == 0 {
+		return errors.New("manifests must be provided with --filename")
+	}
+
+	objs, err := apply.Load(opts.Filenames, opts.Recursive, in)
+	if err != nil {
+		return err
+	}
+	apply.Sort(objs)
+
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+	plans, err := apply.NewPlans(cs, objs, apply.Options{Namespace: p.Namespace()})
+	if err != nil {
+		return err
+	}
+	apply.PrintPlans(out, plans, "")
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stest "k8s.io/client-go/testing"
+)
+
+func TestDiff(t *testing.T) {
+	live := &unstructured.Unstructured{}
+	live.SetAPIVersion("tekton.dev/v1")
+	live.SetKind("Task")
+	live.SetName("lint")
+	live.SetNamespace("ns")
+	live.Object["spec"] = map[string]interface{}{
+		"steps": []interface{}{
+			map[string]interface{}{"name": "vet", "image": "golang:1.21", "script": "go vet ./..."},
+		},
+	}
+
+	var applied bool
+	// the fake client does not implement server-side apply, the applied
+	// object is returned as is
+	tdc := testDynamic.Options{
+		PrependReactors: []testDynamic.PrependOpt{{
+			Verb:     "patch",
+			Resource: "*",
+			Action: func(action k8stest.Action) (bool, runtime.Object, error) {
+				patch := action.(k8stest.PatchAction)
+				applied = true
+				obj := &unstructured.Unstructured{}
+				err := obj.UnmarshalJSON(patch.GetPatch())
+				obj.SetNamespace(patch.GetNamespace())
+				return true, obj, err
+			},
+		}},
+	}
+	dc, err := tdc.Client(live)
+	if err != nil {
+		t.Fatalf("unable to create dynamic client: %v", err)
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+	cs.Pipeline.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "tekton.dev/v1",
+		APIResources: []metav1.APIResource{{Name: "tasks", Kind: "Task", Namespaced: true}},
+	}}
+	p := &test.Params{Tekton: cs.Pipeline, Dynamic: dc}
+
+	out, err := test.ExecuteCommand(Command(p), "-f", "../apply/testdata/manifests/tasks.yaml", "-n", "ns")
+	assert.NilError(t, err)
+	assert.Assert(t, applied)
+	golden.Assert(t, out, "TestDiff.golden")
+
+}
@@ -118,6 +118,8 @@ func deleteEventListeners(s *cli.Stream, p cli.Params, elNames []string, deleteA
 	}
 	d.Delete(elNames)
 
+	d.Audit(p)
+
 	if !deleteAll {
 		d.PrintSuccesses(s)
 	} else if deleteAll {
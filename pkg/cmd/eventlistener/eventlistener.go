@@ -39,6 +39,7 @@ func Command(p cli.Params) *cobra.Command {
 	cmd.AddCommand(
 		deleteCommand(p),
 		describeCommand(p),
+		eventsCommand(p),
 		listCommand(p),
 		logCommand(p),
 	)
@@ -0,0 +1,161 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlistener
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/eventlistener"
+	"github.com/tektoncd/cli/pkg/formatted"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type eventsOptions struct {
+	Limit  int
+	Replay string
+	URL    string
+}
+
+func eventsCommand(p cli.Params) *cobra.Command {
+	opts := &eventsOptions{}
+	eg := `List the events recently received by EventListener 'foo' in namespace 'bar':
+
+    tkn eventlistener events foo -n bar
+
+Send event '3c8a0a44-5b9b-4d29-a0a1-2f8c5d1e7b6a' again to EventListener 'foo', through a port-forward:
+
+    kubectl port-forward svc/el-foo 8080 &
+    tkn eventlistener events foo --replay 3c8a0a44-5b9b-4d29-a0a1-2f8c5d1e7b6a --url http://localhost:8080
+`
+	c := &cobra.Command{
+		Use:   "events",
+		Short: "List the events received by an EventListener and replay them",
+		Long: `List the events received by an EventListener and replay them.
+
+The events are read from the logs of the pods of the EventListener, which only
+record them when the log level of the EventListener is debug, e.g. with
+loglevel.eventlistener set to debug in the config-logging-triggers ConfigMap.
+A replayed event is sent with the payload and headers it was received with, so
+its signature stays valid.`,
+		Example:      eg,
+		SilenceUsage: true,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: formatted.ParentCompletion,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Limit <= 0 {
+				return fmt.Errorf("limit was %d but must be a positive number", opts.Limit)
+			}
+			if opts.URL != "" && opts.Replay == "" {
+				return fmt.Errorf("--url can only be used with --replay")
+			}
+			s := &cli.Stream{
+				Out: cmd.OutOrStdout(),
+				Err: cmd.OutOrStderr(),
+			}
+			return events(args[0], p, s, opts)
+		},
+	}
+	c.Flags().IntVarP(&opts.Limit, "limit", "", 10, "number of most recent events to list")
+	c.Flags().StringVarP(&opts.Replay, "replay", "", "", "ID of the event to send again to the EventListener")
+	c.Flags().StringVarP(&opts.URL, "url", "", "", "URL the replayed event is sent to, by default the address of the EventListener, which is only reachable from the cluster")
+	return c
+}
+
+func events(elName string, p cli.Params, s *cli.Stream, opts *eventsOptions) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return fmt.Errorf("failed to create tekton client")
+	}
+
+	el, err := eventlistener.Get(cs, elName, metav1.GetOptions{}, p.Namespace())
+	if err != nil {
+		return err
+	}
+
+	elPods, err := cs.Kube.CoreV1().Pods(p.Namespace()).List(context.Background(), metav1.ListOptions{LabelSelector: "eventlistener=" + elName})
+	if err != nil {
+		return fmt.Errorf("failed to get pods for EventListener %s", elName)
+	}
+
+	var received []eventlistener.Event
+	for _, pod := range elPods.Items {
+		podLogs, err := cs.Kube.CoreV1().Pods(p.Namespace()).GetLogs(pod.Name, &corev1.PodLogOptions{}).Stream(context.Background())
+		if err != nil {
+			return err
+		}
+		podEvents, err := eventlistener.ParseEvents(podLogs)
+		podLogs.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read the events of EventListener %s from pod %s: %w", elName, pod.Name, err)
+		}
+		received = append(received, podEvents...)
+	}
+
+	if opts.Replay != "" {
+		for _, e := range received {
+			if e.ID != opts.Replay {
+				continue
+			}
+			url := opts.URL
+			if url == "" {
+				if el.Status.Address == nil || el.Status.Address.URL == nil {
+					return fmt.Errorf("EventListener %s has no address yet, pass one with --url", elName)
+				}
+				url = el.Status.Address.URL.String()
+			}
+			status, err := eventlistener.Replay(&cs.HTTPClient, url, e)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(s.Out, "Event %s replayed to %s: %s\n", e.ID, url, status)
+			return nil
+		}
+		return fmt.Errorf("event %s not found in the logs of EventListener %s", opts.Replay, elName)
+	}
+
+	if len(received) == 0 {
+		fmt.Fprintf(s.Out, "No events found in the logs of EventListener %s, its log level must be debug for them to be recorded\n", elName)
+		return nil
+	}
+
+	// the events of all the pods, the most recent last
+	sort.SliceStable(received, func(i, j int) bool {
+		return received[i].Received.Before(received[j].Received)
+	})
+	if len(received) > opts.Limit {
+		received = received[len(received)-opts.Limit:]
+	}
+
+	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(w, "ID\tRECEIVED\tPATH\tTYPE")
+	for _, e := range received {
+		at := metav1.NewTime(e.Received)
+		eventType := e.Type()
+		if eventType == "" {
+			eventType = "---"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.ID, formatted.Age(&at, p.Time()), e.Path, eventType)
+	}
+	return w.Flush()
+}
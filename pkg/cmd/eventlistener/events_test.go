@@ -0,0 +1,92 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlistener
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	triggertest "github.com/tektoncd/triggers/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEventListenerEvents(t *testing.T) {
+	el := &v1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "github-listener",
+			Namespace: "ns",
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "el-github-listener-7d9f",
+			Namespace: "ns",
+			Labels:    map[string]string{"eventlistener": "github-listener"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "no events logged",
+			args: []string{"events", "github-listener", "-n", "ns"},
+			want: "No events found in the logs of EventListener github-listener, its log level must be debug for them to be recorded\n",
+		},
+		{
+			name: "unknown event replayed",
+			args: []string{"events", "github-listener", "-n", "ns", "--replay", "event-1"},
+			want: "event event-1 not found in the logs of EventListener github-listener",
+		},
+		{
+			name: "url without replay",
+			args: []string{"events", "github-listener", "-n", "ns", "--url", "http://localhost:8080"},
+			want: "--url can only be used with --replay",
+		},
+		{
+			name: "invalid limit",
+			args: []string{"events", "github-listener", "-n", "ns", "--limit", "0"},
+			want: "limit was 0 but must be a positive number",
+		},
+	}
+
+	for _, td := range tests {
+		t.Run(td.name, func(t *testing.T) {
+			cs := test.SeedTestResources(t, triggertest.Resources{EventListeners: []*v1beta1.EventListener{el}, Pods: []*corev1.Pod{pod}})
+			cs.Triggers.Resources = cb.TriggersAPIResourceList("v1beta1", []string{"eventlistener"})
+			tdc := testDynamic.Options{}
+			dc, err := tdc.Client(cb.UnstructuredV1beta1EL(el, "v1beta1"))
+			if err != nil {
+				t.Fatalf("unable to create dynamic client: %v", err)
+			}
+			p := &test.Params{Tekton: cs.Pipeline, Clock: clockwork.NewFakeClockAt(time.Now()), Kube: cs.Kube, Triggers: cs.Triggers, Dynamic: dc}
+
+			got, err := test.ExecuteCommand(Command(p), td.args...)
+			if err != nil {
+				test.AssertOutput(t, td.want, err.Error())
+				return
+			}
+			test.AssertOutput(t, td.want, got)
+		})
+	}
+}
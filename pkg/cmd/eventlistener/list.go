@@ -22,6 +22,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/eventlistener"
+	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -36,6 +37,7 @@ const (
 type listOptions struct {
 	AllNamespaces bool
 	NoHeaders     bool
+	Script        flags.ScriptOutput
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -73,15 +75,26 @@ or
 			els, err := eventlistener.List(cs, metav1.ListOptions{}, namespace)
 			if err != nil {
 				if opts.AllNamespaces {
-					return fmt.Errorf("failed to list EventListeners from all namespaces: %v", err)
+					return fmt.Errorf("failed to list EventListeners from all namespaces: %w", err)
 				}
-				return fmt.Errorf("failed to list EventListeners from %s namespace: %v", namespace, err)
+				return fmt.Errorf("failed to list EventListeners from %s namespace: %w", namespace, err)
 			}
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
 				return errors.New(`output option not set properly \n`)
 			}
+			if err := opts.Script.Validate(output); err != nil {
+				return err
+			}
+			if opts.Script.Enabled() {
+				for _, el := range els.Items {
+					if err := opts.Script.Print(cmd.OutOrStdout(), el.Name, el.Namespace, el.Name, getURL(el)); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
 
 			stream := &cli.Stream{
 				Out: cmd.OutOrStdout(),
@@ -106,6 +119,7 @@ or
 	f.AddFlags(c)
 	c.Flags().BoolVarP(&opts.AllNamespaces, "all-namespaces", "A", opts.AllNamespaces, "list EventListeners from all namespaces")
 	c.Flags().BoolVar(&opts.NoHeaders, "no-headers", opts.NoHeaders, "do not print column headers with output (default print column headers with output)")
+	opts.Script.AddFlags(c, "EventListener")
 	return c
 }
 
@@ -0,0 +1,81 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	exportpkg "github.com/tektoncd/cli/pkg/export"
+)
+
+func namespaceCommand(p cli.Params) *cobra.Command {
+	var dir, target string
+	eg := `Export the Pipelines, Tasks and Triggers resources of the namespace 'team-a' to the directory 'team-a':
+
+    tkn export namespace team-a --output team-a/
+
+Apply them to the namespace 'team-b' of another cluster:
+
+    kubectl apply -k team-a/ -n team-b
+
+Export them for the namespace 'team-b', so that they are applied to it without -n:
+
+    tkn export namespace team-a --output team-b/ --target-namespace team-b
+`
+
+	c := &cobra.Command{
+		Use:     "namespace [NAMESPACE]",
+		Aliases: []string{"ns"},
+		Short:   "Export the Tekton resources of a namespace to a kustomize directory",
+		Long: `Export the Tasks, Pipelines, TriggerBindings, TriggerTemplates, Triggers and
+EventListeners of a namespace to a directory, with a directory per resource holding
+a file per object and a kustomization.yaml listing them. The kustomization.yaml at
+the top sets the namespace the resources are applied to only with --target-namespace,
+so that by default they are applied to the namespace given to kubectl.
+
+The fields set by the server, the status and the namespace of the objects are
+removed so that they can be kept in git and applied to any namespace. The resources
+of Tekton Triggers are skipped when it is not installed. Files of objects which
+were exported before are overwritten.`,
+		Args:         cobra.MaximumNArgs(1),
+		Example:      eg,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if dir == "" {
+				return fmt.Errorf("--output is required")
+			}
+			ns := p.Namespace()
+			if len(args) > 0 {
+				ns = args[0]
+			}
+
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+			exported, err := exportpkg.Namespace(cs, ns)
+			if err != nil {
+				return err
+			}
+			return exportpkg.WriteKustomize(dir, ns, target, exported, cmd.OutOrStdout())
+		},
+	}
+
+	c.Flags().StringVarP(&dir, "output", "o", "", "directory the resources are written to, created if needed")
+	c.Flags().StringVarP(&target, "target-namespace", "", "", "namespace set in the kustomization.yaml, by default none so that the resources are applied to the namespace given to kubectl")
+	return c
+}
@@ -0,0 +1,150 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"gotest.tools/v3/golden"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExportNamespace(t *testing.T) {
+	tasks := []*v1.Task{
+		{
+			TypeMeta: metav1.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "Task"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "build",
+				Namespace:       "team-a",
+				UID:             "a1b2",
+				ResourceVersion: "42",
+				Annotations:     map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"},
+			},
+			Spec: v1.TaskSpec{
+				Steps: []v1.Step{{Name: "build", Image: "golang:1.23", Script: "go build ./..."}},
+			},
+		},
+	}
+	pipelines := []*v1.Pipeline{
+		{
+			TypeMeta: metav1.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "Pipeline"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ci",
+				Namespace: "team-a",
+				Labels:    map[string]string{"app": "ci"},
+			},
+			Spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{{Name: "build", TaskRef: &v1.TaskRef{Name: "build"}}},
+			},
+		},
+	}
+	tb := &triggersv1beta1.TriggerBinding{
+		TypeMeta: metav1.TypeMeta{APIVersion: "triggers.tekton.dev/v1beta1", Kind: "TriggerBinding"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "push",
+			Namespace: "team-a",
+		},
+		Spec: triggersv1beta1.TriggerBindingSpec{
+			Params: []triggersv1beta1.Param{{Name: "revision", Value: "$(body.after)"}},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Tasks: tasks, Pipelines: pipelines})
+	cs.Pipeline.Resources = append(cb.APIResourceList("v1", []string{"task", "pipeline"}), cb.TriggersAPIResourceList("v1beta1", []string{"triggerbinding"})...)
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(
+		cb.UnstructuredT(tasks[0], "v1"),
+		cb.UnstructuredP(pipelines[0], "v1"),
+		cb.UnstructuredV1beta1TB(tb, "v1beta1"),
+	)
+	if err != nil {
+		t.Fatalf("unable to create dynamic client: %v", err)
+	}
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+	dir := filepath.Join(t.TempDir(), "team-a")
+	out, err := test.ExecuteCommand(Command(p), "namespace", "team-a", "-o", dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "tasks: 1 exported\npipelines: 1 exported\ntriggerbindings: 1 exported\n", out)
+
+	var files strings.Builder
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		fmt.Fprintf(&files, "# %s\n%s", rel, b)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.Assert(t, files.String(), fmt.Sprintf("%s.golden", t.Name()))
+
+	dir = filepath.Join(t.TempDir(), "team-b")
+	if _, err := test.ExecuteCommand(Command(p), "namespace", "team-a", "-o", dir, "--target-namespace", "team-b"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	b, err := os.ReadFile(filepath.Join(dir, "kustomization.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+namespace: team-b
+resources:
+- tasks
+- pipelines
+- triggerbindings
+`, string(b))
+}
+
+func TestExportNamespace_empty(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+	cs.Pipeline.Resources = cb.APIResourceList("v1", []string{"task", "pipeline"})
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client()
+	if err != nil {
+		t.Fatalf("unable to create dynamic client: %v", err)
+	}
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+	_, err = test.ExecuteCommand(Command(p), "namespace", "team-b", "-o", t.TempDir())
+	if err == nil || err.Error() != "no Tekton resources found in namespace team-b" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	_, err = test.ExecuteCommand(Command(p), "namespace", "team-b")
+	if err == nil || err.Error() != "--output is required" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/audit"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const historyTemplate = `{{- if eq (len .Entries) 0 -}}
+No changes found in the audit log
+{{ else -}}
+AGE	ACTION	KIND	NAME	NAMESPACE	CLUSTER	USER
+{{ range $_, $e := .Entries -}}
+{{ age $e.Time $.Time }}	{{ $e.Action }}	{{ $e.Kind }}	{{ $e.Name }}	{{ $e.Namespace }}	{{ $e.Cluster }}	{{ $e.User }}
+{{ end -}}
+{{- end -}}`
+
+type historyOptions struct {
+	Action    string
+	Kind      string
+	Namespace string
+	Since     time.Duration
+	Limit     int
+	Output    string
+}
+
+// Command returns the history command
+func Command(p cli.Params) *cobra.Command {
+	opts := &historyOptions{}
+	eg := `List the changes recorded in the audit log:
+
+    tkn history
+
+List the PipelineRuns deleted during the last day:
+
+    tkn history --action delete --kind PipelineRun --since 24h
+`
+
+	c := &cobra.Command{
+		Use:   "history",
+		Short: "Lists the changes made by tkn recorded in the audit log",
+		Long: `Lists the resources started, cancelled and deleted by tkn, oldest first.
+
+The changes are only recorded when the audit.enabled setting of the active
+tkn profile is set, in the local state directory of tkn.`,
+		Annotations: map[string]string{
+			"commandType": "utility",
+			"kubernetes":  "false",
+		},
+		Args:         cobra.NoArgs,
+		Example:      eg,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.run(cmd.OutOrStdout(), p.Time())
+		},
+	}
+
+	c.Flags().StringVar(&opts.Action, "action", "", "only list changes of this action: start, cancel, delete or apply")
+	c.Flags().StringVar(&opts.Kind, "kind", "", "only list changes of this kind of resource, e.g. PipelineRun")
+	c.Flags().StringVar(&opts.Namespace, "in-namespace", "", "only list changes made in this namespace")
+	c.Flags().DurationVar(&opts.Since, "since", 0, "only list changes made during this duration, e.g. 24h")
+	c.Flags().IntVar(&opts.Limit, "limit", 0, "only list this number of the most recent changes, 0 lists all of them")
+	c.Flags().StringVarP(&opts.Output, "output", "o", "", "output format, the only format supported is json")
+	return c
+}
+
+func (opts *historyOptions) run(out io.Writer, clock clockwork.Clock) error {
+	switch opts.Action {
+	case "", audit.ActionStart, audit.ActionCancel, audit.ActionDelete, audit.ActionApply:
+	default:
+		return fmt.Errorf("invalid action %q, expected %s, %s, %s or %s", opts.Action, audit.ActionStart, audit.ActionCancel, audit.ActionDelete, audit.ActionApply)
+	}
+	if opts.Output != "" && opts.Output != "json" {
+		return fmt.Errorf("invalid output format %q, only json is supported", opts.Output)
+	}
+	if opts.Limit < 0 {
+		return fmt.Errorf("limit was %d, but must be a positive number", opts.Limit)
+	}
+
+	f := audit.Filter{Action: opts.Action, Kind: opts.Kind, Namespace: opts.Namespace}
+	if opts.Since > 0 {
+		f.Since = clock.Now().Add(-opts.Since)
+	}
+	entries, err := audit.List(f)
+	if err != nil {
+		return fmt.Errorf("failed to read the audit log: %w", err)
+	}
+	if opts.Limit > 0 && len(entries) > opts.Limit {
+		entries = entries[len(entries)-opts.Limit:]
+	}
+
+	if opts.Output == "json" {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(entries)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	funcMap := template.FuncMap{
+		"age": func(t time.Time, c clockwork.Clock) string {
+			return formatted.Age(&metav1.Time{Time: t}, c)
+		},
+	}
+	t := template.Must(template.New("History").Funcs(funcMap).Parse(historyTemplate))
+	data := struct {
+		Entries []audit.Entry
+		Time    clockwork.Clock
+	}{entries, clock}
+	if err := t.Execute(w, data); err != nil {
+		return err
+	}
+	return w.Flush()
+}
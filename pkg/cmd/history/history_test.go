@@ -0,0 +1,103 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/state"
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/golden"
+)
+
+const auditLog = `{"time":"1984-04-01T10:00:00Z","user":"alice","cluster":"https://api.prod:6443","namespace":"ns","action":"start","kind":"PipelineRun","name":"build-xyz12"}
+{"time":"1984-04-03T10:00:00Z","user":"alice","cluster":"https://api.prod:6443","namespace":"ns","action":"cancel","kind":"PipelineRun","name":"build-xyz12"}
+{"time":"1984-04-03T22:00:00Z","user":"bob","cluster":"https://api.dev:6443","namespace":"dev","action":"delete","kind":"TaskRun","name":"lint-abc34"}
+{"time":"1984-04-03T22:00:00Z","user":"bob","cluster":"https://api.dev:6443","namespace":"dev","action":"delete","kind":"TaskRun","name":"lint-def56"}
+`
+
+func TestHistory(t *testing.T) {
+	testParams := []struct {
+		name    string
+		command []string
+		log     string
+		wantErr string
+	}{
+		{
+			name:    "all",
+			command: []string{"history"},
+			log:     auditLog,
+		},
+		{
+			name:    "filtered",
+			command: []string{"history", "--action", "delete", "--kind", "taskrun", "--since", "6h"},
+			log:     auditLog,
+		},
+		{
+			name:    "limit in namespace",
+			command: []string{"history", "--in-namespace", "ns", "--limit", "1"},
+			log:     auditLog,
+		},
+		{
+			name:    "json",
+			command: []string{"history", "--since", "48h", "--limit", "1", "-o", "json"},
+			log:     auditLog,
+		},
+		{
+			name:    "empty",
+			command: []string{"history"},
+		},
+		{
+			name:    "invalid action",
+			command: []string{"history", "--action", "create"},
+			wantErr: `invalid action "create", expected start, cancel, delete or apply`,
+		},
+		{
+			name:    "invalid output",
+			command: []string{"history", "-o", "yaml"},
+			wantErr: `invalid output format "yaml", only json is supported`,
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			t.Setenv("TKN_STATE_DIR", t.TempDir())
+			if tp.log != "" {
+				store, err := state.Open()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := store.Put("audit/log", []byte(tp.log)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			c := Command(&test.Params{Clock: test.FakeClock()})
+			got, err := test.ExecuteCommand(c, tp.command[1:]...)
+			if tp.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tp.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tp.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			golden.Assert(t, got, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+		})
+	}
+}
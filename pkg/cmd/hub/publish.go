@@ -0,0 +1,193 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hub adds the commands of tkn to the hub commands of the Tekton Hub
+// CLI
+package hub
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/bundle"
+	"github.com/tektoncd/cli/pkg/catalog"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/keyring"
+)
+
+type publishOptions struct {
+	metadata      catalog.Metadata
+	license       string
+	provider      string
+	check         bool
+	catalog       string
+	openPR        bool
+	remote        string
+	bundle        string
+	remoteOptions bundle.RemoteOptions
+}
+
+// PublishCommand prepares a resource for the hub
+func PublishCommand(p cli.Params) *cobra.Command {
+	opts := &publishOptions{}
+	eg := `Check that the Task of the directory task/git-clone/0.9 is ready for the hub:
+
+    tkn hub publish task/git-clone/0.9 --check
+
+Set the metadata of the Task and generate its Artifact Hub metadata:
+
+    tkn hub publish task/git-clone/0.9 --version 0.9 --categories Git --tags git --display-name "git clone" --min-pipelines-version 0.50.0
+
+Commit the Task to a checkout of the catalog and open the pull request with the GitHub CLI:
+
+    tkn hub publish task/git-clone/0.9 --catalog ~/src/catalog --open-pr
+`
+
+	c := &cobra.Command{
+		Use:   "publish DIR",
+		Short: "Prepares a Task, Pipeline or StepAction for publication on the hub",
+		Long: `Generate and validate the hub metadata of the Task, Pipeline or StepAction of a
+directory laid out as in the Tekton catalog, i.e. a directory per version holding the
+resource in a file named after it and its README.md.
+
+The metadata passed with the flags is set in the labels and annotations of the
+resource, then the metadata is validated and the ` + catalog.PackageFile + ` file read by
+Artifact Hub is generated. The directory can then be committed to a checkout of the
+catalog with --catalog, and the resource pushed as a Tekton Bundle with --bundle.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+			"kubernetes":  "false",
+		},
+		Args:         cobra.ExactArgs(1),
+		Example:      eg,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.openPR && opts.catalog == "" {
+				return fmt.Errorf("--open-pr requires --catalog")
+			}
+			s := &cli.Stream{Out: cmd.OutOrStdout(), Err: cmd.OutOrStderr()}
+			return publish(s, p, args[0], opts)
+		},
+	}
+	c.Flags().StringVar(&opts.metadata.Version, "version", "", "version of the resource, e.g. 0.1, set in its "+catalog.VersionLabel+" label")
+	c.Flags().StringVar(&opts.metadata.DisplayName, "display-name", "", "name of the resource displayed by the hub")
+	c.Flags().StringVar(&opts.metadata.MinPipelinesVersion, "min-pipelines-version", "", "minimum version of Tekton Pipelines the resource runs on")
+	c.Flags().StringSliceVar(&opts.metadata.Categories, "categories", nil, "categories of the resource, among "+strings.Join(catalog.Categories, ", "))
+	c.Flags().StringSliceVar(&opts.metadata.Tags, "tags", nil, "tags of the resource")
+	c.Flags().StringSliceVar(&opts.metadata.Platforms, "platforms", nil, "platforms the resource runs on, e.g. linux/amd64")
+	c.Flags().StringVar(&opts.license, "license", "Apache-2.0", "SPDX identifier of the license of the resource")
+	c.Flags().StringVar(&opts.provider, "provider", "", "name of the provider of the resource")
+	c.Flags().BoolVar(&opts.check, "check", false, "only validate the metadata, nothing is written")
+	c.Flags().StringVar(&opts.catalog, "catalog", "", "path of a checkout of the catalog to commit the resource to, on a new branch")
+	c.Flags().BoolVar(&opts.openPR, "open-pr", false, "push the branch of the catalog and open the pull request with the GitHub CLI (gh), authenticated with the token stored for hub with tkn auth token set")
+	c.Flags().StringVar(&opts.remote, "remote", "origin", "git remote of the checkout of the catalog the branch is pushed to")
+	c.Flags().StringVar(&opts.bundle, "bundle", "", "reference of the Tekton Bundle to push the resource to")
+	bundle.AddRemoteFlags(c.Flags(), &opts.remoteOptions)
+	return c
+}
+
+func publish(s *cli.Stream, p cli.Params, dir string, opts *publishOptions) error {
+	r, err := catalog.Load(dir)
+	if err != nil {
+		return err
+	}
+
+	m := opts.metadata
+	if !opts.check && (m.Version != "" || m.DisplayName != "" || m.MinPipelinesVersion != "" || len(m.Categories)+len(m.Tags)+len(m.Platforms) > 0) {
+		r.Set(m)
+		if err := r.Save(); err != nil {
+			return err
+		}
+		fmt.Fprintf(s.Out, "Updated the metadata of %s %s in %s\n", r.Kind, r.Name, r.File)
+	}
+
+	if problems := r.Validate(); len(problems) > 0 {
+		fmt.Fprintf(s.Err, "The hub metadata of %s %s is not valid:\n", r.Kind, r.Name)
+		for _, problem := range problems {
+			fmt.Fprintf(s.Err, "  - %s\n", problem)
+		}
+		return fmt.Errorf("%d problems found in the hub metadata of %s", len(problems), dir)
+	}
+	if opts.check {
+		fmt.Fprintf(s.Out, "%s %s %s is ready for the hub\n", r.Kind, r.Name, r.Version())
+		return nil
+	}
+
+	pkg, err := r.Package(opts.license, opts.provider, p.Time().Now())
+	if err != nil {
+		return err
+	}
+	if err := r.WritePackage(pkg); err != nil {
+		return err
+	}
+	fmt.Fprintf(s.Out, "Generated the Artifact Hub metadata of %s %s %s\n", r.Kind, r.Name, r.Version())
+
+	profile, err := p.Profile()
+	if err != nil {
+		return err
+	}
+	if opts.bundle != "" {
+		if err := pushBundle(s, r, opts, profile); err != nil {
+			return err
+		}
+	}
+
+	if opts.catalog != "" {
+		branch, err := r.Contribute(opts.catalog)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(s.Out, "Committed %s to branch %s of %s\n", r.CatalogDir(), branch, opts.catalog)
+		if opts.openPR {
+			url, err := catalog.OpenPullRequest(opts.catalog, opts.remote, branch, keyring.Lookup(profile, keyring.Hub))
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(s.Out, "Opened %s\n", url)
+		}
+	}
+	return nil
+}
+
+func pushBundle(s *cli.Stream, r *catalog.Resource, opts *publishOptions, profile config.Profile) error {
+	expanded, err := bundle.ExpandReference(context.Background(), opts.bundle)
+	if err != nil {
+		return err
+	}
+	ref, err := name.ParseReference(expanded, name.StrictValidation, name.Insecure)
+	if err != nil {
+		return fmt.Errorf("invalid reference %q of the bundle: %w", opts.bundle, err)
+	}
+
+	content, err := os.ReadFile(r.File)
+	if err != nil {
+		return err
+	}
+	img, err := bundle.BuildTektonBundle([]string{string(content)}, nil, nil, time.Unix(0, 0), s.Err)
+	if err != nil {
+		return err
+	}
+	digest, err := bundle.Write(img, ref, opts.remoteOptions.ToOptions(profile)...)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(s.Out, "Pushed Tekton Bundle to %s\n", digest)
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hub
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/tektoncd/cli/pkg/catalog"
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+)
+
+const task = `apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: hello
+spec:
+  description: Says hello.
+  steps:
+    - name: hello
+      image: alpine
+      script: echo hello
+`
+
+func taskDir(t *testing.T) string {
+	dir := filepath.Join(t.TempDir(), "hello", "0.1")
+	assert.NilError(t, os.MkdirAll(dir, 0o755))
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "hello.yaml"), []byte(task), 0o600))
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, catalog.ReadmeFile), []byte("# hello\n"), 0o600))
+	return dir
+}
+
+func TestPublish_check(t *testing.T) {
+	dir := taskDir(t)
+	out, err := test.ExecuteCommand(PublishCommand(&test.Params{}), dir, "--check")
+	assert.Error(t, err, fmt.Sprintf("5 problems found in the hub metadata of %s", dir))
+	golden.Assert(t, strings.ReplaceAll(out, dir, "DIR"), fmt.Sprintf("%s.golden", t.Name()))
+
+	// --check does not change the resource
+	_, err = test.ExecuteCommand(PublishCommand(&test.Params{}), dir, "--check", "--version", "0.1")
+	assert.ErrorContains(t, err, "5 problems found")
+}
+
+func TestPublish(t *testing.T) {
+	dir := taskDir(t)
+	p := &test.Params{Clock: test.FakeClock()}
+	out, err := test.ExecuteCommand(PublishCommand(p), dir,
+		"--version", "0.1", "--categories", "CLI", "--tags", "hello,greeting", "--display-name", "hello", "--min-pipelines-version", "0.50.0", "--provider", "tekton")
+	assert.NilError(t, err)
+	golden.Assert(t, strings.ReplaceAll(out, dir, "DIR"), fmt.Sprintf("%s.golden", t.Name()))
+
+	resource, err := os.ReadFile(filepath.Join(dir, "hello.yaml"))
+	assert.NilError(t, err)
+	golden.Assert(t, string(resource), fmt.Sprintf("%s-resource.golden", t.Name()))
+	pkg, err := os.ReadFile(filepath.Join(dir, catalog.PackageFile))
+	assert.NilError(t, err)
+	golden.Assert(t, string(pkg), fmt.Sprintf("%s-package.golden", t.Name()))
+
+	out, err = test.ExecuteCommand(PublishCommand(p), dir, "--check")
+	assert.NilError(t, err)
+	test.AssertOutput(t, "Task hello 0.1 is ready for the hub\n", out)
+}
+
+func TestPublish_bundle(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	assert.NilError(t, err)
+
+	dir := taskDir(t)
+	p := &test.Params{Clock: test.FakeClock()}
+	out, err := test.ExecuteCommand(PublishCommand(p), dir,
+		"--version", "0.1", "--categories", "CLI", "--tags", "hello", "--display-name", "hello", "--min-pipelines-version", "0.50.0",
+		"--bundle", u.Host+"/catalog/hello:0.1")
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out, "Pushed Tekton Bundle to "+u.Host+"/catalog/hello@sha256:"), out)
+}
+
+func TestPublish_openPRRequiresCatalog(t *testing.T) {
+	_, err := test.ExecuteCommand(PublishCommand(&test.Params{}), taskDir(t), "--open-pr")
+	assert.Error(t, err, "--open-pr requires --catalog")
+}
@@ -0,0 +1,168 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/interceptor"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+)
+
+const pingTemplate = `{{decorate "bold" "Interceptor"}}:	{{ .Interceptor.Name }} ({{ .Interceptor.Kind }})
+{{decorate "bold" "Address"}}:	{{ .Interceptor.Address }}
+{{- if .Interceptor.ClientConfig.Service }}
+{{decorate "bold" "Via"}}:	service proxy of the API server
+{{- end }}
+{{decorate "bold" "CA Bundle"}}:	{{ if .CABundle.Subjects }}{{ join .CABundle.Subjects ", " }}, valid until {{ .CABundle.NotAfter.Format "2006-01-02" }}{{ else }}---{{ end }}
+{{- range $w := .CABundle.Warnings }}
+ {{decorate "bullet" $w}}
+{{- end }}
+{{- if .Result }}
+{{decorate "bold" "HTTP Status"}}:	{{ .Result.StatusCode }} in {{ .Result.Duration }}
+{{- if .Result.Response }}
+{{decorate "bold" "Continue"}}:	{{ .Result.Response.Continue }}
+{{decorate "bold" "Status"}}:	{{ .Result.Response.Status.Code }}{{ if .Result.Response.Status.Message }}: {{ .Result.Response.Status.Message }}{{ end }}
+{{- if .Result.Response.Extensions }}
+{{decorate "bold" "Extensions"}}:	{{ toJSON .Result.Response.Extensions }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .Error }}
+{{decorate "bold" "Error"}}:	{{ .Error }}
+{{- end }}
+`
+
+type pingOptions struct {
+	Kind    string
+	Body    string
+	Headers []string
+	Params  []string
+}
+
+func pingCommand(p cli.Params) *cobra.Command {
+	opts := &pingOptions{}
+	eg := `Check that the cel ClusterInterceptor answers EventListener requests:
+
+    tkn interceptor ping cel
+
+Send a filter to the cel ClusterInterceptor:
+
+    tkn interceptor ping cel --param filter='"body.action == \"opened\""' --body '{"action":"opened"}'
+
+Check the namespaced Interceptor foo of namespace bar:
+
+    tkn interceptor ping foo --kind NamespacedInterceptor -n bar
+`
+
+	c := &cobra.Command{
+		Use:   "ping INTERCEPTOR",
+		Short: "Sends a sample request to an Interceptor and reports TLS and response issues",
+		Long: `Resolves the address of an Interceptor the way EventListeners do and sends it a
+sample InterceptorRequest, reporting problems with its caBundle, the HTTP
+status and the InterceptorResponse.
+
+Interceptors running as a Service of the cluster are reached through the
+service proxy of the API server, which does not verify their certificate, so
+only the caBundle itself is checked for them.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:         cobra.ExactArgs(1),
+		Example:      eg,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+
+			headers, err := keyValues("header", opts.Headers)
+			if err != nil {
+				return err
+			}
+			params, err := keyValues("param", opts.Params)
+			if err != nil {
+				return err
+			}
+
+			i, err := interceptor.Get(cs, v1beta1.InterceptorKind(opts.Kind), args[0], p.Namespace())
+			if err != nil {
+				return err
+			}
+
+			req := interceptor.SampleRequest(p.Namespace(), opts.Body, headers, params)
+			result, pingErr := i.Ping(context.Background(), cs, req)
+
+			data := struct {
+				Interceptor *interceptor.Interceptor
+				CABundle    *interceptor.CABundleReport
+				Result      *interceptor.PingResult
+				Error       error
+			}{i, i.CheckCABundle(p.Time().Now()), result, pingErr}
+			if err := printPing(cmd.OutOrStdout(), data); err != nil {
+				return err
+			}
+			if pingErr != nil {
+				return fmt.Errorf("interceptor %s failed the handshake", args[0])
+			}
+			return nil
+		},
+	}
+
+	c.Flags().StringVar(&opts.Kind, "kind", string(v1beta1.ClusterInterceptorKind), "kind of the interceptor, ClusterInterceptor or NamespacedInterceptor")
+	c.Flags().StringVar(&opts.Body, "body", "{}", "body of the sample event")
+	c.Flags().StringArrayVar(&opts.Headers, "header", []string{}, "header of the sample event, e.g. --header X-GitHub-Event=push")
+	c.Flags().StringArrayVar(&opts.Params, "param", []string{}, "interceptor param as name=value, the value is decoded as JSON when possible")
+	return c
+}
+
+func keyValues(flag string, values []string) (map[string]string, error) {
+	kv := map[string]string{}
+	for _, v := range values {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --%s %q, expected name=value", flag, v)
+		}
+		kv[key] = value
+	}
+	return kv, nil
+}
+
+func printPing(out io.Writer, data interface{}) error {
+	funcMap := template.FuncMap{
+		"decorate": formatted.DecorateAttr,
+		"join":     strings.Join,
+		"toJSON": func(v interface{}) string {
+			b, _ := json.Marshal(v)
+			return string(b)
+		},
+	}
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	t := template.Must(template.New("Ping").Funcs(funcMap).Parse(pingTemplate))
+	if err := t.Execute(w, data); err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -0,0 +1,151 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	triggertest "github.com/tektoncd/triggers/test"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+)
+
+func unstructuredInterceptor(t *testing.T, obj runtime.Object, kind string) *unstructured.Unstructured {
+	t.Helper()
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &unstructured.Unstructured{Object: content}
+	u.SetAPIVersion("triggers.tekton.dev/v1alpha1")
+	u.SetKind(kind)
+	return u
+}
+
+func pingParams(t *testing.T, objs ...runtime.Object) *test.Params {
+	t.Helper()
+	cs := test.SeedTestResources(t, triggertest.Resources{})
+	cs.Triggers.Resources = cb.TriggersAPIResourceList("v1alpha1", []string{"clusterinterceptor", "interceptor"})
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(objs...)
+	if err != nil {
+		t.Fatalf("unable to create dynamic client: %v", err)
+	}
+	clock := clockwork.NewFakeClockAt(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC))
+	return &test.Params{Triggers: cs.Triggers, Kube: cs.Kube, Dynamic: dc, Clock: clock}
+}
+
+func TestInterceptorPing_clusterInterceptor(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req v1beta1.InterceptorRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if req.InterceptorParams["filter"] != "body.action == 'opened'" || req.Body != `{"action":"opened"}` {
+			_, _ = io.WriteString(w, `{"continue":false,"status":{"code":3,"message":"unexpected request"}}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"continue":true,"extensions":{"checked":true}}`)
+	}))
+	defer srv.Close()
+
+	u, _ := apis.ParseURL(srv.URL)
+	ci := &v1alpha1.ClusterInterceptor{
+		ObjectMeta: metav1.ObjectMeta{Name: "cel"},
+		Spec: v1alpha1.ClusterInterceptorSpec{
+			ClientConfig: v1alpha1.ClientConfig{
+				URL:      u,
+				CaBundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}),
+			},
+		},
+	}
+	p := pingParams(t, unstructuredInterceptor(t, ci, "ClusterInterceptor"))
+
+	got, err := test.ExecuteCommand(Command(p), "ping", "cel", "--param", `filter="body.action == 'opened'"`, "--body", `{"action":"opened"}`)
+	assert.NilError(t, err)
+	for _, want := range []string{
+		"Interceptor:   cel (ClusterInterceptor)",
+		"Address:       " + srv.URL,
+		"CA Bundle:     O=Acme Co, valid until 2084-01-29",
+		"HTTP Status:   200 in",
+		"Continue:      true",
+		"Status:        OK",
+		`Extensions:    {"checked":true}`,
+	} {
+		assert.Assert(t, strings.Contains(got, want), "%q not found in:\n%s", want, got)
+	}
+}
+
+func TestInterceptorPing_untrustedCertificate(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `{"continue":true}`)
+	}))
+	defer srv.Close()
+
+	u, _ := apis.ParseURL(srv.URL)
+	ci := &v1alpha1.ClusterInterceptor{
+		ObjectMeta: metav1.ObjectMeta{Name: "cel"},
+		Spec:       v1alpha1.ClusterInterceptorSpec{ClientConfig: v1alpha1.ClientConfig{URL: u}},
+	}
+	p := pingParams(t, unstructuredInterceptor(t, ci, "ClusterInterceptor"))
+
+	got, err := test.ExecuteCommand(Command(p), "ping", "cel")
+	assert.Error(t, err, "interceptor cel failed the handshake")
+	assert.Assert(t, strings.Contains(got, "the address uses https but no caBundle is set"), got)
+	assert.Assert(t, strings.Contains(got, "TLS verification of "+srv.URL+" failed"), got)
+}
+
+func TestInterceptorPing_namespacedInterceptorError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(w, "boom\n")
+	}))
+	defer srv.Close()
+
+	u, _ := apis.ParseURL(srv.URL)
+	i := &v1alpha1.Interceptor{
+		ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "ns"},
+		Spec:       v1alpha1.InterceptorSpec{ClientConfig: v1alpha1.ClientConfig{URL: u}},
+	}
+	p := pingParams(t, unstructuredInterceptor(t, i, "Interceptor"))
+
+	got, err := test.ExecuteCommand(Command(p), "ping", "custom", "--kind", "NamespacedInterceptor", "-n", "ns")
+	assert.Error(t, err, "interceptor custom failed the handshake")
+	assert.Assert(t, strings.Contains(got, "HTTP Status:   500 in"), got)
+	assert.Assert(t, strings.Contains(got, "Error:         interceptor answered with HTTP status 500: boom"), got)
+}
+
+func TestInterceptorPing_unknownKind(t *testing.T) {
+	p := pingParams(t)
+	_, err := test.ExecuteCommand(Command(p), "ping", "cel", "--kind", "Foo")
+	assert.Error(t, err, `unknown interceptor kind "Foo", use ClusterInterceptor or NamespacedInterceptor`)
+}
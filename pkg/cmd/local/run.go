@@ -0,0 +1,296 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/debuglocal"
+	"github.com/tektoncd/cli/pkg/log"
+	"github.com/tektoncd/cli/pkg/task"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+type runOptions struct {
+	Filename   string
+	Params     []string
+	Workspaces []string
+	Runtime    string
+	Dir        string
+	Prefix     bool
+}
+
+func runCommand(_ cli.Params) *cobra.Command {
+	opts := &runOptions{}
+	eg := `Run the steps of the Task in task.yaml with docker:
+
+    tkn local run -f task.yaml
+
+Run a Task with podman, with a param and the current directory as its workspace 'source':
+
+    tkn local run -f task.yaml --runtime podman -p package=./cmd/... -w source=.
+`
+
+	c := &cobra.Command{
+		Use:   "run",
+		Short: "Runs the steps of a Task as local containers",
+		Long: `Runs the steps of a Task one after the other as local containers with docker
+or podman, for a fast iteration on a Task without a cluster.
+
+The params are substituted in the steps as the controller does, and are also
+passed to the steps as environment variables, e.g. git-url becomes $GIT_URL.
+The workspaces bound with --workspace are bind mounts of local directories, the
+other ones are empty directories. The values of the environment variables
+coming from Secrets and ConfigMaps are passed from the local environment.
+
+The steps referencing StepActions and the sidecars are not supported. The run
+stops at the first step which fails.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+			"kubernetes":  "false",
+		},
+		Args:         cobra.NoArgs,
+		Example:      eg,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.Filename == "" {
+				return errors.New("a Task must be provided with --filename")
+			}
+			if opts.Runtime != "docker" && opts.Runtime != "podman" {
+				return fmt.Errorf("invalid value %q for --runtime, use docker or podman", opts.Runtime)
+			}
+			s := &cli.Stream{Out: cmd.OutOrStdout(), Err: cmd.OutOrStderr()}
+			return opts.run(s)
+		},
+	}
+
+	c.Flags().StringVarP(&opts.Filename, "filename", "f", "", "file of the Task to run")
+	c.Flags().StringArrayVarP(&opts.Params, "param", "p", []string{}, "pass a param as name=value, the items of array params are separated by commas")
+	c.Flags().StringArrayVarP(&opts.Workspaces, "workspace", "w", []string{}, "bind a workspace to a local directory as name=path")
+	c.Flags().StringVarP(&opts.Runtime, "runtime", "", "docker", "container engine to run the steps with, docker or podman")
+	c.Flags().StringVarP(&opts.Dir, "dir", "", "", "directory to keep the scripts, workspaces and results of the steps in (default: a temporary directory removed after the run)")
+	c.Flags().BoolVarP(&opts.Prefix, "prefix", "", true, "prefix each log line with the step name")
+	return c
+}
+
+func (opts *runOptions) run(s *cli.Stream) error {
+	b, err := os.ReadFile(opts.Filename)
+	if err != nil {
+		return fmt.Errorf("failed to read Task %s: %w", opts.Filename, err)
+	}
+	t, err := parseTask(b)
+	if err != nil {
+		return fmt.Errorf("failed to parse Task %s: %w", opts.Filename, err)
+	}
+
+	tr, err := opts.taskRun(t)
+	if err != nil {
+		return err
+	}
+	hostPaths := map[string]string{}
+	for _, w := range opts.Workspaces {
+		name, dir, _ := strings.Cut(w, "=")
+		if hostPaths[name], err = filepath.Abs(dir); err != nil {
+			return err
+		}
+	}
+
+	dir := opts.Dir
+	if dir == "" {
+		if dir, err = os.MkdirTemp("", "tkn-local-"); err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+	}
+	if dir, err = filepath.Abs(dir); err != nil {
+		return err
+	}
+	for _, sub := range []string{"results", "scripts"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return err
+		}
+	}
+
+	logC := make(chan log.Log)
+	errC := make(chan error)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		log.NewWriter(log.LogTypeTask, opts.Prefix).Write(s, logC, errC)
+	}()
+
+	runErr := func() error {
+		for _, st := range tr.Spec.TaskSpec.Steps {
+			step, err := debuglocal.FromTaskRun(tr, st.Name)
+			if err != nil {
+				return err
+			}
+			if err := prepareStep(dir, step, tr, hostPaths); err != nil {
+				return err
+			}
+			if err := step.Run(opts.Runtime, dir, nil, t.Name, logC); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	close(logC)
+	close(errC)
+	<-done
+	return runErr
+}
+
+// taskRun returns the TaskRun running the Task with the params and
+// workspaces of the options, the steps without a name are named as the
+// controller does
+func (opts *runOptions) taskRun(t *v1.Task) (*v1.TaskRun, error) {
+	spec := t.Spec.DeepCopy()
+	for i := range spec.Steps {
+		if spec.Steps[i].Name == "" {
+			spec.Steps[i].Name = fmt.Sprintf("unnamed-%d", i)
+		}
+	}
+	tr := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   t.Name + "-local",
+			Labels: map[string]string{"tekton.dev/task": t.Name},
+		},
+		Spec: v1.TaskRunSpec{TaskSpec: spec},
+	}
+
+	specs := map[string]v1.ParamSpec{}
+	for _, p := range spec.Params {
+		specs[p.Name] = p
+	}
+	given := map[string]bool{}
+	for _, p := range opts.Params {
+		name, value, ok := strings.Cut(p, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid input format for param parameter: %s", p)
+		}
+		ps, ok := specs[name]
+		if !ok {
+			return nil, fmt.Errorf("param %q not present in the Task", name)
+		}
+		v := v1.ParamValue{Type: v1.ParamTypeString, StringVal: value}
+		if ps.Type == v1.ParamTypeArray {
+			v = v1.ParamValue{Type: v1.ParamTypeArray, ArrayVal: strings.Split(value, ",")}
+		}
+		tr.Spec.Params = append(tr.Spec.Params, v1.Param{Name: name, Value: v})
+		given[name] = true
+	}
+	for _, p := range spec.Params {
+		if !given[p.Name] && p.Default == nil {
+			return nil, fmt.Errorf("param %q of the Task has no default, pass it with --param", p.Name)
+		}
+	}
+
+	declared := map[string]bool{}
+	for _, w := range spec.Workspaces {
+		declared[w.Name] = true
+		tr.Spec.Workspaces = append(tr.Spec.Workspaces, v1.WorkspaceBinding{Name: w.Name, EmptyDir: &corev1.EmptyDirVolumeSource{}})
+	}
+	for _, w := range opts.Workspaces {
+		name, _, ok := strings.Cut(w, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid input format for workspace parameter: %s", w)
+		}
+		if !declared[name] {
+			return nil, fmt.Errorf("workspace %q not present in the Task", name)
+		}
+	}
+	return tr, nil
+}
+
+// prepareStep writes the script of the step, creates the directories of its
+// workspaces which are not bound to a local one and passes the string params
+// to it as environment variables
+func prepareStep(dir string, step *debuglocal.Step, tr *v1.TaskRun, hostPaths map[string]string) error {
+	if step.Script != "" {
+		// nolint: gosec
+		if err := os.WriteFile(filepath.Join(dir, "scripts", step.Name), []byte(step.Script), 0o755); err != nil {
+			return err
+		}
+	}
+	for i, ws := range step.Workspaces {
+		if hostPath, ok := hostPaths[ws.Name]; ok {
+			step.Workspaces[i].HostPath = hostPath
+			continue
+		}
+		if err := os.MkdirAll(filepath.Join(dir, "workspaces", ws.Name), 0o755); err != nil {
+			return err
+		}
+	}
+
+	defined := map[string]bool{}
+	for _, e := range step.Env {
+		defined[e.Name] = true
+	}
+	values := map[string]string{}
+	for _, p := range tr.Spec.TaskSpec.Params {
+		if p.Default != nil && p.Default.Type == v1.ParamTypeString {
+			values[p.Name] = p.Default.StringVal
+		}
+	}
+	for _, p := range tr.Spec.Params {
+		if p.Value.Type == v1.ParamTypeString {
+			values[p.Name] = p.Value.StringVal
+		}
+	}
+	for _, p := range tr.Spec.TaskSpec.Params {
+		v, ok := values[p.Name]
+		if name := task.EnvName(p.Name); ok && !defined[name] {
+			step.Env = append(step.Env, debuglocal.Env{Name: name, Value: v})
+		}
+	}
+	return nil
+}
+
+func parseTask(b []byte) (*v1.Task, error) {
+	m := map[string]interface{}{}
+	if err := yaml.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if m["kind"] != "Task" {
+		return nil, fmt.Errorf("expected a Task, got %v", m["kind"])
+	}
+
+	t := &v1.Task{}
+	if m["apiVersion"] == "tekton.dev/v1beta1" {
+		tv1beta1 := &v1beta1.Task{}
+		if err := yaml.UnmarshalStrict(b, tv1beta1); err != nil {
+			return nil, err
+		}
+		if err := tv1beta1.ConvertTo(context.Background(), t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+	if err := yaml.UnmarshalStrict(b, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
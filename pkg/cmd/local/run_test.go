@@ -0,0 +1,98 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/golden"
+)
+
+// fakeEngine puts a docker on the PATH printing its arguments, which fails
+// when running the image failing
+func fakeEngine(t *testing.T) {
+	bin := t.TempDir()
+	script := "#!/bin/sh\necho \"$@\"\ncase \"$*\" in *failing*) echo oops >&2; exit 3;; esac\n"
+	if err := os.WriteFile(filepath.Join(bin, "docker"), []byte(script), 0o755); err != nil { // nolint: gosec
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestLocalRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake container engine is a shell script")
+	}
+	fakeEngine(t)
+
+	failing := filepath.Join(t.TempDir(), "failing.yaml")
+	task := "apiVersion: tekton.dev/v1beta1\nkind: Task\nmetadata:\n  name: broken\nspec:\n  steps:\n    - image: failing\n      script: exit 3\n    - name: never\n      image: alpine\n"
+	if err := os.WriteFile(failing, []byte(task), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	testParams := []struct {
+		name    string
+		command []string
+		wantErr string
+	}{
+		{
+			name:    "params and workspaces",
+			command: []string{"run", "-f", "testdata/task.yaml", "-p", "package=./cmd/...", "-p", "flags=-v,-race", "-w", "source=/src"},
+		},
+		{
+			name:    "failing step",
+			command: []string{"run", "-f", failing},
+			wantErr: "step unnamed-0 failed with exit code 3",
+		},
+		{
+			name:    "unknown param",
+			command: []string{"run", "-f", "testdata/task.yaml", "-p", "go=1.23"},
+			wantErr: `param "go" not present in the Task`,
+		},
+		{
+			name:    "unknown workspace",
+			command: []string{"run", "-f", "testdata/task.yaml", "-w", "output=/out"},
+			wantErr: `workspace "output" not present in the Task`,
+		},
+		{
+			name:    "invalid runtime",
+			command: []string{"run", "-f", "testdata/task.yaml", "--runtime", "lxc"},
+			wantErr: `invalid value "lxc" for --runtime, use docker or podman`,
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			dir := t.TempDir()
+			got, err := test.ExecuteCommand(Command(&test.Params{}), append(tp.command, "--dir", dir)...)
+			if tp.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tp.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tp.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got = strings.ReplaceAll(got, dir, "DIR")
+			golden.Assert(t, got, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+		})
+	}
+}
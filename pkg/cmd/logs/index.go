@@ -0,0 +1,159 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cli/prerun"
+	"github.com/tektoncd/cli/pkg/flags"
+	"github.com/tektoncd/cli/pkg/log"
+	"github.com/tektoncd/cli/pkg/options"
+	"github.com/tektoncd/cli/pkg/pods/stream"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	pipelineRunGroupResource = schema.GroupVersionResource{Group: "tekton.dev", Resource: "pipelineruns"}
+	taskrunGroupResource     = schema.GroupVersionResource{Group: "tekton.dev", Resource: "taskruns"}
+)
+
+type indexOptions struct {
+	DB string
+	// streamer reads the logs of the pods, replaced in tests
+	streamer stream.NewStreamerFunc
+}
+
+func indexCommand(p cli.Params) *cobra.Command {
+	opts := &indexOptions{}
+	eg := `Index the logs of PipelineRun named 'microservice-1' in runs.db:
+
+    tkn logs index microservice-1 -n foo
+
+Index the logs of TaskRun named 'build-7xk2p' in a database of the nightly runs:
+
+    tkn logs index build-7xk2p --db nightly.db -n foo
+`
+
+	c := &cobra.Command{
+		Use:   "index",
+		Short: "Index the logs of a PipelineRun or a TaskRun in a SQLite database",
+		Long: `Adds the logs of the steps of a PipelineRun or a TaskRun, read from its pods,
+to a SQLite database with the status, exit code and times of the steps. A run
+indexed again replaces what was indexed of it before.
+
+The database has the tables runs, steps and logs, logs being a full text
+search table whose lines are searched with MATCH.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:              cobra.ExactArgs(1),
+		Example:           eg,
+		SilenceUsage:      true,
+		PersistentPreRunE: prerun.PersistentPreRunE(p),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s := &cli.Stream{Out: cmd.OutOrStdout(), Err: cmd.OutOrStderr()}
+			return opts.run(p, s, args[0])
+		},
+	}
+
+	flags.AddTektonOptions(c)
+	c.Flags().StringVarP(&opts.DB, "db", "", defaultDB, "SQLite database the logs are indexed in, created when it does not exist")
+	return c
+}
+
+func (opts *indexOptions) run(p cli.Params, s *cli.Stream, name string) error {
+	// fail before reading the logs rather than once they are read
+	if _, err := log.LookSQLite(); err != nil {
+		return err
+	}
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+	ns := p.Namespace()
+
+	var pr *v1.PipelineRun
+	var tr *v1.TaskRun
+	lo := &options.LogOptions{Params: p, Stream: s, Source: log.SourcePods, Streamer: opts.streamer}
+	logType := log.LogTypePipeline
+	err = actions.GetV1(pipelineRunGroupResource, cs, name, ns, metav1.GetOptions{}, &pr)
+	if errors.IsNotFound(err) {
+		logType = log.LogTypeTask
+		if err = actions.GetV1(taskrunGroupResource, cs, name, ns, metav1.GetOptions{}, &tr); errors.IsNotFound(err) {
+			return fmt.Errorf("no PipelineRun or TaskRun named %s in namespace %s", name, ns)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if logType == log.LogTypePipeline {
+		lo.PipelineRunName = name
+	} else {
+		lo.TaskrunName = name
+	}
+
+	lr, err := log.NewReader(logType, lo)
+	if err != nil {
+		return err
+	}
+	defer lr.Close()
+	logC, errC, err := lr.Read()
+	if err != nil {
+		return err
+	}
+
+	// the statements are run as they are written, without holding the logs
+	script, w := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		_, err := log.SQLite(opts.DB, script)
+		// the writes fail rather than block when sqlite3 stopped early
+		script.CloseWithError(fmt.Errorf("sqlite3 stopped reading"))
+		done <- err
+	}()
+
+	index := log.NewIndex(w, logType, ns, name)
+	index.Write(s, logC, errC)
+
+	var metadata *log.Metadata
+	if pr != nil {
+		if metadata, err = log.PipelineRunMetadata(cs, pr); err != nil {
+			w.Close()
+			<-done
+			return err
+		}
+	} else {
+		metadata = log.TaskRunMetadata(tr.Name, tr)
+	}
+	closeErr := index.Close(metadata)
+	w.Close()
+	if err := <-done; err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	fmt.Fprintf(s.Out, "Indexed %d lines of the logs of %s %s in %s\n", index.Lines(), metadata.Kind, name, opts.DB)
+	return nil
+}
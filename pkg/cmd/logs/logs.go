@@ -0,0 +1,45 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+)
+
+// defaultDB is the database of logs used when none is given
+const defaultDB = "runs.db"
+
+// Command returns the logs command
+func Command(p cli.Params) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "logs",
+		Short: "Index the logs of runs in a SQLite database and query them",
+		Long: `Indexes the logs of PipelineRuns and TaskRuns with the metadata of their steps
+in a local SQLite database, to search and analyze them offline, e.g. to find
+the steps failing most often or the runs whose logs hold an error.
+
+The database is read and written with the sqlite3 CLI, which must be installed.`,
+		Annotations: map[string]string{
+			"commandType": "utility",
+		},
+	}
+
+	c.AddCommand(
+		indexCommand(p),
+		queryCommand(),
+	)
+	return c
+}
@@ -0,0 +1,186 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/log"
+	"github.com/tektoncd/cli/pkg/pods/fake"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/golden"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// stubSQLite replaces the sqlite3 CLI, recording the statements it is given
+// and printing out
+func stubSQLite(t *testing.T, out string) *bytes.Buffer {
+	script := &bytes.Buffer{}
+	sqlite, look := log.SQLite, log.LookSQLite
+	t.Cleanup(func() { log.SQLite, log.LookSQLite = sqlite, look })
+	log.LookSQLite = func() (string, error) { return "sqlite3", nil }
+	log.SQLite = func(_ string, r io.Reader, _ ...string) ([]byte, error) {
+		if _, err := io.Copy(script, r); err != nil {
+			return nil, err
+		}
+		return []byte(out), nil
+	}
+	return script
+}
+
+func TestLogsIndex_taskrun(t *testing.T) {
+	ns := "ns"
+	start := test.FakeClock().Now()
+	exitCode := int32(1)
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "build-7xk2p", Namespace: ns},
+			Spec:       v1.TaskRunSpec{TaskRef: &v1.TaskRef{Name: "build"}},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionFalse, Reason: "Failed"}},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					PodName:        "build-7xk2p-pod",
+					StartTime:      &metav1.Time{Time: start},
+					CompletionTime: &metav1.Time{Time: start.Add(time.Minute)},
+					Steps: []v1.StepState{{
+						Name:      "compile",
+						Container: "step-compile",
+						ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+							ExitCode:   exitCode,
+							Reason:     "Error",
+							StartedAt:  metav1.Time{Time: start},
+							FinishedAt: metav1.Time{Time: start.Add(time.Minute)},
+						}},
+					}},
+				},
+			},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-7xk2p-pod", Namespace: ns},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "step-compile"}}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodFailed,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "step-compile", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error"}}},
+			},
+		},
+	}
+	logs := fake.Logs(fake.Task("build-7xk2p-pod", fake.Step("step-compile", "go build ./...", "main.go:3: undefined: it's")))
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{TaskRuns: trs, Pods: []*corev1.Pod{pod}})
+	cs.Pipeline.Resources = cb.APIResourceList("v1", []string{"taskrun", "pipelinerun"})
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(cb.UnstructuredTR(trs[0], "v1"))
+	if err != nil {
+		t.Fatalf("unable to create dynamic client: %v", err)
+	}
+	p := &test.Params{Kube: cs.Kube, Tekton: cs.Pipeline, Dynamic: dc}
+	p.SetNamespace(ns)
+
+	script := stubSQLite(t, "")
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	s := &cli.Stream{Out: out, Err: errOut}
+	opts := &indexOptions{DB: "runs.db", streamer: fake.Streamer(logs)}
+	if err := opts.run(p, s, "build-7xk2p"); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, "Indexed 2 lines of the logs of TaskRun build-7xk2p in runs.db\n", out.String())
+	test.AssertOutput(t, "task build has failed: \n", errOut.String())
+	golden.Assert(t, script.String(), fmt.Sprintf("%s.golden", t.Name()))
+
+	err = opts.run(p, s, "missing")
+	test.AssertOutput(t, "no PipelineRun or TaskRun named missing in namespace ns", err.Error())
+}
+
+func TestLogsQuery(t *testing.T) {
+	rows := "task,step,failures\nbuild,compile,3\ntest,\"unit\ttests\",1\n"
+	tests := []struct {
+		name       string
+		args       []string
+		sqlite     string
+		wantScript string
+		want       string
+	}{
+		{
+			name:       "query",
+			args:       []string{"SELECT task, step, failures FROM failures"},
+			sqlite:     rows,
+			wantScript: "SELECT task, step, failures FROM failures",
+			want:       "TASK    STEP         FAILURES\nbuild   compile      3\ntest    unit tests   1\n",
+		},
+		{
+			name:       "search",
+			args:       []string{"--search", "it's"},
+			sqlite:     "run,task,step,line_number,line\n",
+			wantScript: "SELECT run, task, step, line_number, line FROM logs\nWHERE logs MATCH 'it''s'\nORDER BY run, task, step, line_number;",
+			want:       "No rows found\n",
+		},
+		{
+			name:       "failures as csv",
+			args:       []string{"--failures", "-o", "csv"},
+			sqlite:     rows,
+			wantScript: failuresQuery,
+			want:       rows,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			script := stubSQLite(t, tt.sqlite)
+			c := Command(&test.Params{})
+			got, err := test.ExecuteCommand(c, append([]string{"query"}, tt.args...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertOutput(t, tt.want, got)
+			test.AssertOutput(t, tt.wantScript, script.String())
+		})
+	}
+}
+
+func TestLogsQuery_invalid(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{}, "a query must be given, either as an argument, with --search or with --failures"},
+		{[]string{"SELECT 1", "--failures"}, "a query must be given, either as an argument, with --search or with --failures"},
+		{[]string{"--failures", "-o", "json"}, "invalid output format \"json\", only csv is supported"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			c := Command(&test.Params{})
+			_, err := test.ExecuteCommand(c, append([]string{"query"}, tt.args...)...)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			test.AssertOutput(t, tt.want, err.Error())
+		})
+	}
+}
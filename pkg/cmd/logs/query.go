@@ -0,0 +1,157 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/log"
+)
+
+// searchQuery finds the lines matching a full text search, the search is
+// quoted by the caller
+const searchQuery = `SELECT run, task, step, line_number, line FROM logs
+WHERE logs MATCH %s
+ORDER BY run, task, step, line_number;`
+
+// failuresQuery counts the failures of the steps across the runs indexed,
+// the steps failing most often first
+const failuresQuery = `SELECT task, step, exit_code, count(*) AS failures, count(DISTINCT run) AS runs, max(completion_time) AS last_failure
+FROM steps
+WHERE exit_code <> 0
+GROUP BY task, step, exit_code
+ORDER BY failures DESC, task, step;`
+
+type queryOptions struct {
+	DB       string
+	Search   string
+	Failures bool
+	Output   string
+}
+
+func queryCommand() *cobra.Command {
+	opts := &queryOptions{}
+	eg := `List the runs of the logs indexed in runs.db:
+
+    tkn logs query 'SELECT name, kind, status, duration FROM runs'
+
+Find the lines of the logs mentioning a refused connection:
+
+    tkn logs query --search '"connection refused"'
+
+List the steps failing most often across the runs indexed in nightly.db:
+
+    tkn logs query --failures --db nightly.db
+`
+
+	c := &cobra.Command{
+		Use:   "query [SQL]",
+		Short: "Query a SQLite database of logs",
+		Long: `Runs a query of SQLite on a database of logs made by tkn logs index and prints
+the rows it returns as a table.
+
+The database has the tables runs, steps and logs. The lines of the logs are
+searched with the full text search of SQLite, e.g. WHERE logs MATCH 'timeout',
+--search does the same for all the runs. --failures lists the steps which
+failed, grouped by Task, step and exit code.`,
+		Args:         cobra.MaximumNArgs(1),
+		Example:      eg,
+		SilenceUsage: true,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			query, err := opts.query(args)
+			if err != nil {
+				return err
+			}
+			return opts.run(cmd.OutOrStdout(), query)
+		},
+	}
+
+	c.Flags().StringVarP(&opts.DB, "db", "", defaultDB, "SQLite database of the logs indexed with tkn logs index")
+	c.Flags().StringVarP(&opts.Search, "search", "", "", "list the lines of the logs matching this full text search of SQLite, e.g. 'timeout OR deadline'")
+	c.Flags().BoolVarP(&opts.Failures, "failures", "", false, "list the steps which failed, most often first")
+	c.Flags().StringVarP(&opts.Output, "output", "o", "", "output format, the only format supported is csv")
+	return c
+}
+
+// query returns the query given as argument or by a helper flag
+func (opts *queryOptions) query(args []string) (string, error) {
+	given := 0
+	if len(args) == 1 {
+		given++
+	}
+	if opts.Search != "" {
+		given++
+	}
+	if opts.Failures {
+		given++
+	}
+	if given != 1 {
+		return "", errors.New("a query must be given, either as an argument, with --search or with --failures")
+	}
+	if opts.Output != "" && opts.Output != "csv" {
+		return "", fmt.Errorf("invalid output format %q, only csv is supported", opts.Output)
+	}
+
+	switch {
+	case opts.Search != "":
+		return fmt.Sprintf(searchQuery, "'"+strings.ReplaceAll(opts.Search, "'", "''")+"'"), nil
+	case opts.Failures:
+		return failuresQuery, nil
+	}
+	return args[0], nil
+}
+
+func (opts *queryOptions) run(out io.Writer, query string) error {
+	b, err := log.SQLite(opts.DB, strings.NewReader(query), "-readonly", "-header", "-csv")
+	if err != nil {
+		return err
+	}
+	if opts.Output == "csv" {
+		_, err := out.Write(b)
+		return err
+	}
+
+	rows, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	if err != nil {
+		return fmt.Errorf("failed to read the rows returned by sqlite3: %w", err)
+	}
+	if len(rows) < 2 {
+		fmt.Fprintln(out, "No rows found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	for i, row := range rows {
+		for j := range row {
+			if i == 0 {
+				row[j] = strings.ToUpper(row[j])
+			}
+			// a line of the logs with tabs or newlines would break the table
+			row[j] = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ").Replace(row[j])
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,134 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/namespace"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+type initOptions struct {
+	ServiceAccount string
+	DockerSecrets  []string
+	Triggers       bool
+	Quota          string
+	DryRun         bool
+}
+
+func initCommand(p cli.Params) *cobra.Command {
+	opts := &initOptions{}
+	eg := `Create the namespace 'team-a' with the service account 'ci', which pulls and pushes
+images with the docker config.json of ~/.docker/config.json, and the quotas of a small tenant:
+
+    tkn namespace init team-a --service-account ci --docker-secret registry=$HOME/.docker/config.json --quota profile=small
+
+Raise the number of pods of the small profile and print the objects rather than creating them:
+
+    tkn namespace init team-a --quota profile=small,pods=50 --dry-run
+`
+
+	c := &cobra.Command{
+		Use:   "init NAMESPACE",
+		Short: "Bootstrap the namespace of a CI tenant",
+		Long: `Create a namespace ready to run Tekton Pipelines and Triggers in one shot:
+
+- the namespace
+- a docker config secret for each --docker-secret, linked to the service account
+  as image pull secret and as secret Tekton authenticates to registries with
+- the service account the PipelineRuns and EventListeners run as
+- the bindings of the service account to the ClusterRoles of Tekton Triggers
+  EventListeners need, unless --triggers=false
+- a ResourceQuota and a LimitRange giving defaults to the containers which do not
+  set their resources, with --quota
+
+The quota profiles are ` + strings.Join(namespace.ProfileNames(), ", ") + `, their hard limits can be overridden
+with RESOURCE=QUANTITY pairs. Objects which already exist are left unchanged, the
+secrets missing from an existing service account are linked to it. The objects are
+labelled app.kubernetes.io/managed-by=tkn.`,
+		Args:         cobra.ExactArgs(1),
+		Example:      eg,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(p, args[0], cmd.OutOrStdout())
+		},
+	}
+
+	c.Flags().StringVarP(&opts.ServiceAccount, "service-account", "s", "pipeline", "name of the service account the PipelineRuns and EventListeners of the namespace run as")
+	c.Flags().StringArrayVarP(&opts.DockerSecrets, "docker-secret", "", []string{}, "NAME=FILE, create the secret NAME from the docker config.json FILE and link it to the service account, can be repeated")
+	c.Flags().BoolVarP(&opts.Triggers, "triggers", "", true, "bind the service account to the roles Tekton Triggers EventListeners need")
+	c.Flags().StringVarP(&opts.Quota, "quota", "", "", "quota of the namespace, profile=NAME[,RESOURCE=QUANTITY...], e.g. profile=small,pods=50")
+	c.Flags().BoolVarP(&opts.DryRun, "dry-run", "", false, "print the objects rather than creating them")
+	return c
+}
+
+func (opts *initOptions) run(p cli.Params, name string, out io.Writer) error {
+	nsOpts := namespace.Options{
+		Name:           name,
+		ServiceAccount: opts.ServiceAccount,
+		DockerSecrets:  map[string][]byte{},
+		Triggers:       opts.Triggers,
+	}
+	for _, s := range opts.DockerSecrets {
+		secret, file, ok := strings.Cut(s, "=")
+		if !ok || secret == "" || file == "" {
+			return fmt.Errorf("invalid value %q for --docker-secret, expected NAME=FILE", s)
+		}
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read the docker config of secret %s: %w", secret, err)
+		}
+		nsOpts.DockerSecrets[secret] = b
+	}
+	if opts.Quota != "" {
+		quota, err := namespace.ParseQuota(opts.Quota)
+		if err != nil {
+			return err
+		}
+		nsOpts.Quota = quota
+	}
+
+	objs := namespace.Objects(nsOpts)
+	if opts.DryRun {
+		return printObjects(out, objs)
+	}
+
+	kube, err := p.KubeClient()
+	if err != nil {
+		return err
+	}
+	return namespace.Create(kube, objs, out)
+}
+
+func printObjects(out io.Writer, objs []runtime.Object) error {
+	for i, obj := range objs {
+		b, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			fmt.Fprintln(out, "---")
+		}
+		fmt.Fprint(out, string(b))
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/golden"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNamespaceInit_dry_run(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(config, []byte(`{"auths":{"ghcr.io":{"auth":"dXNlcjpwYXNz"}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := &test.Params{Kube: fake.NewSimpleClientset()}
+	out, err := test.ExecuteCommand(Command(p), "init", "team-a", "--service-account", "ci", "--docker-secret", "registry="+config, "--quota", "profile=small,pods=50", "--dry-run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden.Assert(t, out, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+}
+
+func TestNamespaceInit(t *testing.T) {
+	p := &test.Params{Kube: fake.NewSimpleClientset()}
+	out, err := test.ExecuteCommand(Command(p), "init", "team-a", "--triggers=false")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, "namespace/team-a created\nserviceaccount/pipeline created\n", out)
+}
+
+func TestNamespaceInit_invalid(t *testing.T) {
+	p := &test.Params{Kube: fake.NewSimpleClientset()}
+	for args, want := range map[string]string{
+		"--docker-secret registry":            `invalid value "registry" for --docker-secret, expected NAME=FILE`,
+		"--docker-secret registry=/not/there": "failed to read the docker config of secret registry: open /not/there: no such file or directory",
+		"--quota profile=huge":                "unknown quota profile \"huge\", use one of large, medium, small",
+	} {
+		_, err := test.ExecuteCommand(Command(p), append([]string{"init", "team-a"}, strings.Fields(args)...)...)
+		if err == nil {
+			t.Errorf("expected an error for %s", args)
+			continue
+		}
+		test.AssertOutput(t, want, err.Error())
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cli/prerun"
+	"github.com/tektoncd/cli/pkg/flags"
+)
+
+// Command returns the namespace command
+func Command(p cli.Params) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "namespace",
+		Aliases: []string{"ns"},
+		Short:   "Manage the namespaces of CI tenants",
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		PersistentPreRunE: prerun.PersistentPreRunE(p),
+	}
+
+	flags.AddTektonOptions(cmd)
+	_ = cmd.PersistentFlags().MarkHidden("namespace")
+	cmd.AddCommand(initCommand(p))
+	return cmd
+}
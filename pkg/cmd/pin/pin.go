@@ -0,0 +1,122 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/bundle"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/pin"
+)
+
+type pinOptions struct {
+	Filename      string
+	DryRun        bool
+	VerifyKey     string
+	remoteOptions bundle.RemoteOptions
+}
+
+// Command returns the pin command
+func Command(p cli.Params) *cobra.Command {
+	opts := &pinOptions{}
+	eg := `Pin the images of the steps of the Task in task.yaml to their digests:
+
+    tkn pin -f task.yaml
+
+Print the Pipeline with its images pinned, after verifying their signatures with a cosign public key:
+
+    tkn pin -f pipeline.yaml --verify-key cosign.pub --dry-run
+`
+
+	c := &cobra.Command{
+		Use:   "pin",
+		Short: "Pins the images of the steps of Tekton resources to their digests",
+		Long: `Resolves the tag of the image of each step, sidecar and step template of the
+Tekton resources of a file to its digest and rewrites the file with the images
+referenced by digest, e.g. golang:1.23 becomes golang:1.23@sha256:..., so that
+the runs of the resources are reproducible.
+
+The images already pinned or referencing variables are left as they are. The
+registries are authenticated against with the docker and podman credentials,
+the tokens of tkn auth or the --remote flags. With --verify-key, the signatures
+of the images are verified with a cosign public key and nothing is written when
+one of them is not signed with it.`,
+		Annotations: map[string]string{
+			"commandType": "utility",
+			"kubernetes":  "false",
+		},
+		Args:         cobra.NoArgs,
+		Example:      eg,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if opts.Filename == "" {
+				return errors.New("a file must be provided with --filename")
+			}
+			s := &cli.Stream{Out: cmd.OutOrStdout(), Err: cmd.OutOrStderr()}
+			return opts.run(s, p)
+		},
+	}
+
+	c.Flags().StringVarP(&opts.Filename, "filename", "f", "", "file of the Tekton resources to pin the images of")
+	c.Flags().BoolVar(&opts.DryRun, "dry-run", false, "print the resources with their images pinned instead of rewriting the file")
+	c.Flags().StringVar(&opts.VerifyKey, "verify-key", "", "cosign public key the images must be signed with")
+	bundle.AddRemoteFlags(c.Flags(), &opts.remoteOptions)
+	return c
+}
+
+func (opts *pinOptions) run(s *cli.Stream, p cli.Params) error {
+	b, err := os.ReadFile(opts.Filename)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.Filename, err)
+	}
+
+	profile, err := p.Profile()
+	if err != nil {
+		return err
+	}
+	remoteOpts := opts.remoteOptions.ToOptions(profile)
+	pinned, images, err := pin.Pin(b, pin.RemoteResolver(remoteOpts...))
+	if err != nil {
+		return err
+	}
+	if opts.VerifyKey != "" {
+		for _, img := range images {
+			if err := pin.Verify(context.Background(), img.Pinned, opts.VerifyKey, remoteOpts...); err != nil {
+				return err
+			}
+		}
+	}
+
+	if opts.DryRun {
+		_, err := s.Out.Write(pinned)
+		return err
+	}
+	if err := os.WriteFile(opts.Filename, pinned, 0o644); err != nil { // nolint: gosec
+		return err
+	}
+	for _, img := range images {
+		if img.Pinned == img.Ref {
+			fmt.Fprintf(s.Out, "%s left as it is\n", img.Ref)
+			continue
+		}
+		fmt.Fprintf(s.Out, "%s pinned to %s\n", img.Ref, img.Pinned)
+	}
+	return nil
+}
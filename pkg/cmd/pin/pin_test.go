@@ -0,0 +1,99 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pin
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/assert"
+)
+
+func TestPin(t *testing.T) {
+	s := httptest.NewServer(registry.New())
+	defer s.Close()
+	u, err := url.Parse(s.URL)
+	assert.NilError(t, err)
+
+	image := u.Host + "/tools/golang:1.23"
+	ref, err := name.ParseReference(image)
+	assert.NilError(t, err)
+	assert.NilError(t, remote.Write(ref, empty.Image))
+	digest, err := empty.Image.Digest()
+	assert.NilError(t, err)
+
+	task := fmt.Sprintf("apiVersion: tekton.dev/v1\nkind: Task\nmetadata:\n  name: build\nspec:\n  steps:\n    - name: test\n      image: %s\n", image)
+	pinned := fmt.Sprintf("apiVersion: tekton.dev/v1\nkind: Task\nmetadata:\n  name: build\nspec:\n  steps:\n    - name: test\n      image: %s@%s\n", image, digest)
+	write := func(t *testing.T) string {
+		file := filepath.Join(t.TempDir(), "task.yaml")
+		assert.NilError(t, os.WriteFile(file, []byte(task), 0o644))
+		return file
+	}
+
+	t.Run("rewrite", func(t *testing.T) {
+		file := write(t)
+		out, err := test.ExecuteCommand(Command(&test.Params{}), "-f", file)
+		assert.NilError(t, err)
+		assert.Equal(t, out, fmt.Sprintf("%s pinned to %s@%s\n", image, image, digest))
+		b, err := os.ReadFile(file)
+		assert.NilError(t, err)
+		assert.Equal(t, string(b), pinned)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		file := write(t)
+		out, err := test.ExecuteCommand(Command(&test.Params{}), "-f", file, "--dry-run")
+		assert.NilError(t, err)
+		assert.Equal(t, out, pinned)
+		b, err := os.ReadFile(file)
+		assert.NilError(t, err)
+		assert.Equal(t, string(b), task)
+	})
+
+	t.Run("unsigned image", func(t *testing.T) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NilError(t, err)
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		assert.NilError(t, err)
+		pub := filepath.Join(t.TempDir(), "cosign.pub")
+		assert.NilError(t, os.WriteFile(pub, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+		file := write(t)
+		_, err = test.ExecuteCommand(Command(&test.Params{}), "-f", file, "--verify-key", pub)
+		assert.ErrorContains(t, err, fmt.Sprintf("failed to verify the signature of image %s@%s", image, digest))
+		b, err := os.ReadFile(file)
+		assert.NilError(t, err)
+		assert.Equal(t, string(b), task)
+	})
+
+	t.Run("no file", func(t *testing.T) {
+		_, err := test.ExecuteCommand(Command(&test.Params{}))
+		assert.Error(t, err, "a file must be provided with --filename")
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	pipelinepkg "github.com/tektoncd/cli/pkg/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"knative.dev/pkg/apis"
+)
+
+type concurrencyOptions struct {
+	MaxConcurrent int
+}
+
+func concurrencyCommand(p cli.Params) *cobra.Command {
+	opts := &concurrencyOptions{}
+	eg := `Show how many PipelineRuns of Pipeline 'foo' are running in namespace 'bar':
+
+    tkn pipeline concurrency foo -n bar
+
+Show whether another PipelineRun of Pipeline 'foo' is within a soft limit of 3
+running PipelineRuns, as tkn pipeline start --max-concurrent 3 checks it:
+
+    tkn pipeline concurrency foo --max-concurrent 3 -n bar
+`
+
+	c := &cobra.Command{
+		Use:   "concurrency PIPELINE",
+		Short: "Shows how many runs of a Pipeline are running",
+		Long: `Show the PipelineRuns of a Pipeline which are running or pending, found with
+their tekton.dev/pipeline label.
+
+Tekton does not limit how many runs of a Pipeline run at once. As a stopgap on
+clusters without a queuing controller, tkn pipeline start --max-concurrent
+refuses to start a Pipeline which has that many PipelineRuns running or more.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: formatted.ParentCompletion,
+		Example:           eg,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.MaxConcurrent < 0 {
+				return fmt.Errorf("--max-concurrent must not be negative")
+			}
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+			name, ns := args[0], p.Namespace()
+			c, err := pipelinepkg.GetConcurrency(cs, name, ns)
+			if err != nil {
+				return fmt.Errorf("failed to list the PipelineRuns of Pipeline %s in namespace %s: %w", name, ns, err)
+			}
+			return printConcurrency(cmd.OutOrStdout(), name, ns, c, opts.MaxConcurrent, p.Time())
+		},
+	}
+	c.Flags().IntVar(&opts.MaxConcurrent, "max-concurrent", 0, "soft limit of running PipelineRuns to report whether another one would be started within")
+	return c
+}
+
+func printConcurrency(out io.Writer, name, ns string, c *pipelinepkg.Concurrency, max int, clock clockwork.Clock) error {
+	fmt.Fprintf(out, "Pipeline %s has %d PipelineRuns running and %d pending in namespace %s\n", name, len(c.Running), len(c.Pending), ns)
+	if max > 0 {
+		if len(c.Running) >= max {
+			fmt.Fprintf(out, "Another PipelineRun would be refused, the limit is %d\n", max)
+		} else {
+			fmt.Fprintf(out, "%d more PipelineRuns can start within the limit of %d\n", max-len(c.Running), max)
+		}
+	}
+	if len(c.Running) == 0 && len(c.Pending) == 0 {
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(w, "\nNAME\tSTATUS\tSTARTED")
+	for _, prs := range [][]v1.PipelineRun{c.Running, c.Pending} {
+		for i := range prs {
+			pr := &prs[i]
+			status := "Running"
+			if pr.IsPending() {
+				status = "Pending"
+			} else if cond := pr.Status.GetCondition(apis.ConditionSucceeded); cond != nil && cond.Reason != "" {
+				status = cond.Reason
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", pr.Name, status, formatted.Age(pr.Status.StartTime, clock))
+		}
+	}
+	return w.Flush()
+}
@@ -0,0 +1,112 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestPipelineConcurrency(t *testing.T) {
+	clock := test.FakeClock()
+	run := func(name, pipeline string, started time.Duration, status corev1.ConditionStatus, reason string) *v1.PipelineRun {
+		return &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "ns",
+				Labels:            map[string]string{"tekton.dev/pipeline": pipeline},
+				CreationTimestamp: metav1.Time{Time: clock.Now().Add(-started)},
+			},
+			Status: v1.PipelineRunStatus{
+				Status: duckv1.Status{Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: status, Reason: reason}}},
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					StartTime: &metav1.Time{Time: clock.Now().Add(-started)},
+				},
+			},
+		}
+	}
+	pending := run("build-4", "build", 0, corev1.ConditionUnknown, "")
+	pending.Spec.Status = v1.PipelineRunSpecStatusPending
+	pending.Status = v1.PipelineRunStatus{}
+	prs := []*v1.PipelineRun{
+		run("build-1", "build", time.Hour, corev1.ConditionTrue, "Succeeded"),
+		run("build-3", "build", 2*time.Minute, corev1.ConditionUnknown, "Running"),
+		run("build-2", "build", 10*time.Minute, corev1.ConditionUnknown, "Running"),
+		pending,
+		run("deploy-1", "deploy", time.Minute, corev1.ConditionUnknown, "Running"),
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		PipelineRuns: prs,
+		Namespaces:   []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}},
+	})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipeline", "pipelinerun"})
+	command := func() *test.Params {
+		var objs []runtime.Object
+		for _, pr := range prs {
+			objs = append(objs, cb.UnstructuredPR(pr, version))
+		}
+		tdc := testDynamic.Options{}
+		dc, err := tdc.Client(objs...)
+		assert.NilError(t, err)
+		return &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc, Clock: clock}
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "running", args: []string{"concurrency", "build", "-n", "ns"}},
+		{name: "within limit", args: []string{"concurrency", "build", "-n", "ns", "--max-concurrent", "3"}},
+		{name: "above limit", args: []string{"concurrency", "build", "-n", "ns", "--max-concurrent", "2"}},
+		{name: "none running", args: []string{"concurrency", "lint", "-n", "ns"}},
+		{
+			name:    "start refused",
+			args:    []string{"start", "--pipeline-ref", "hub://tekton/build@0.1", "-n", "ns", "--max-concurrent", "2"},
+			wantErr: "Pipeline build has 2 PipelineRuns running in namespace ns, the limit is 2",
+		},
+		{
+			name:    "negative limit",
+			args:    []string{"concurrency", "build", "-n", "ns", "--max-concurrent", "-1"},
+			wantErr: "--max-concurrent must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(Command(command()), tt.args...)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			golden.Assert(t, got, fmt.Sprintf("%s.golden", t.Name()))
+		})
+	}
+}
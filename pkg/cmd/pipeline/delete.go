@@ -24,6 +24,7 @@ import (
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/cli/pkg/options"
 	pipelinepkg "github.com/tektoncd/cli/pkg/pipeline"
+	"github.com/tektoncd/cli/pkg/references"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"go.uber.org/multierr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -88,6 +89,10 @@ or
 				return errs
 			}
 
+			if err := checkPipelineReferences(opts, s, p, availablePNames); err != nil {
+				return err
+			}
+
 			if err := opts.CheckOptions(s, availablePNames, p.Namespace()); err != nil {
 				return err
 			}
@@ -99,7 +104,7 @@ or
 		},
 	}
 	f.AddFlags(c)
-	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion (default: false)")
+	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion, also of the Pipelines referenced by TriggerTemplates or recent PipelineRuns (default: false)")
 	c.Flags().BoolVarP(&opts.DeleteRelated, "prs", "", false, "Whether to delete Pipeline(s) and related resources (PipelineRuns) (default: false)")
 	c.Flags().BoolVarP(&opts.DeleteAllNs, "all", "", false, "Delete all Pipelines in a namespace (default: false)")
 
@@ -132,6 +137,8 @@ func deletePipelines(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, p
 	default:
 		d.Delete(pNames)
 	}
+	d.Audit(p)
+
 	if !opts.DeleteAllNs {
 		d.PrintSuccesses(s)
 	} else if opts.DeleteAllNs {
@@ -159,3 +166,25 @@ func pipelineRunLister(cs *cli.Clients, ns string) func(string) ([]string, error
 		return names, nil
 	}
 }
+
+// checkPipelineReferences lists the resources referencing the Pipelines about to
+// be deleted, which are only deleted with --force when referenced
+func checkPipelineReferences(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, names []string) error {
+	// with --all every one of them goes, names given along are refused by
+	// CheckOptions
+	if opts.DeleteAllNs {
+		return nil
+	}
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+	// the runs deleted along are not references
+	refOpts := references.Options{
+		Since:    p.Time().Now().Add(-references.RecentRuns),
+		SkipRuns: opts.DeleteRelated,
+	}
+	return references.Check(s.Err, "Pipeline", names, opts.ForceDelete, func(name string) ([]references.Reference, error) {
+		return references.ToPipeline(cs, p.Namespace(), name, refOpts)
+	})
+}
@@ -175,7 +175,7 @@ func TestPipelineDelete_v1beta1(t *testing.T) {
 			input:       seeds[0].pipelineClient,
 			inputStream: nil,
 			wantError:   false,
-			want:        "Pipelines deleted: \"pipeline\"\n",
+			want:        "Pipeline \"pipeline\" is referenced by:\n  - PipelineRun pipeline-run-1\n  - PipelineRun pipeline-run-2\nPipelines deleted: \"pipeline\"\n",
 		},
 		{
 			name:        "With force delete flag",
@@ -184,25 +184,34 @@ func TestPipelineDelete_v1beta1(t *testing.T) {
 			input:       seeds[1].pipelineClient,
 			inputStream: nil,
 			wantError:   false,
-			want:        "Pipelines deleted: \"pipeline\"\n",
+			want:        "Pipeline \"pipeline\" is referenced by:\n  - PipelineRun pipeline-run-1\n  - PipelineRun pipeline-run-2\nPipelines deleted: \"pipeline\"\n",
 		},
 		{
-			name:        "Without force delete flag, reply no",
+			name:        "Without force delete flag, referenced by PipelineRuns",
 			command:     []string{"rm", "pipeline", "-n", "ns"},
 			dynamic:     seeds[2].dynamicClient,
 			input:       seeds[2].pipelineClient,
+			inputStream: strings.NewReader("y"),
+			wantError:   true,
+			want:        "refusing to delete the referenced Pipeline(s) \"pipeline\", use --force to delete them anyway",
+		},
+		{
+			name:        "Without force delete flag, reply no",
+			command:     []string{"rm", "pipeline2", "-n", "ns"},
+			dynamic:     seeds[2].dynamicClient,
+			input:       seeds[2].pipelineClient,
 			inputStream: strings.NewReader("n"),
 			wantError:   true,
-			want:        "canceled deleting Pipeline(s) \"pipeline\"",
+			want:        "canceled deleting Pipeline(s) \"pipeline2\"",
 		},
 		{
 			name:        "Without force delete flag, reply yes",
-			command:     []string{"rm", "pipeline", "-n", "ns"},
+			command:     []string{"rm", "pipeline2", "-n", "ns"},
 			dynamic:     seeds[2].dynamicClient,
 			input:       seeds[2].pipelineClient,
 			inputStream: strings.NewReader("y"),
 			wantError:   false,
-			want:        "Are you sure you want to delete Pipeline(s) \"pipeline\" (y/n): Pipelines deleted: \"pipeline\"\n",
+			want:        "Are you sure you want to delete Pipeline(s) \"pipeline2\" (y/n): Pipelines deleted: \"pipeline2\"\n",
 		},
 		{
 			name:        "Remove non existent resource",
@@ -292,7 +301,7 @@ func TestPipelineDelete_v1beta1(t *testing.T) {
 			input:       seeds[7].pipelineClient,
 			inputStream: nil,
 			wantError:   false,
-			want:        "Pipelines deleted: \"pipeline\", \"pipeline2\"\n",
+			want:        "Pipeline \"pipeline\" is referenced by:\n  - PipelineRun pipeline-run-1\n  - PipelineRun pipeline-run-2\nPipelines deleted: \"pipeline\", \"pipeline2\"\n",
 		},
 		{
 			name:        "Delete the Pipeline present and give error for non-existent Pipeline",
@@ -491,7 +500,7 @@ func TestPipelineDelete(t *testing.T) {
 			input:       seeds[0].pipelineClient,
 			inputStream: nil,
 			wantError:   false,
-			want:        "Pipelines deleted: \"pipeline\"\n",
+			want:        "Pipeline \"pipeline\" is referenced by:\n  - PipelineRun pipeline-run-1\n  - PipelineRun pipeline-run-2\nPipelines deleted: \"pipeline\"\n",
 		},
 		{
 			name:        "With force delete flag",
@@ -500,25 +509,34 @@ func TestPipelineDelete(t *testing.T) {
 			input:       seeds[1].pipelineClient,
 			inputStream: nil,
 			wantError:   false,
-			want:        "Pipelines deleted: \"pipeline\"\n",
+			want:        "Pipeline \"pipeline\" is referenced by:\n  - PipelineRun pipeline-run-1\n  - PipelineRun pipeline-run-2\nPipelines deleted: \"pipeline\"\n",
 		},
 		{
-			name:        "Without force delete flag, reply no",
+			name:        "Without force delete flag, referenced by PipelineRuns",
 			command:     []string{"rm", "pipeline", "-n", "ns"},
 			dynamic:     seeds[2].dynamicClient,
 			input:       seeds[2].pipelineClient,
+			inputStream: strings.NewReader("y"),
+			wantError:   true,
+			want:        "refusing to delete the referenced Pipeline(s) \"pipeline\", use --force to delete them anyway",
+		},
+		{
+			name:        "Without force delete flag, reply no",
+			command:     []string{"rm", "pipeline2", "-n", "ns"},
+			dynamic:     seeds[2].dynamicClient,
+			input:       seeds[2].pipelineClient,
 			inputStream: strings.NewReader("n"),
 			wantError:   true,
-			want:        "canceled deleting Pipeline(s) \"pipeline\"",
+			want:        "canceled deleting Pipeline(s) \"pipeline2\"",
 		},
 		{
 			name:        "Without force delete flag, reply yes",
-			command:     []string{"rm", "pipeline", "-n", "ns"},
+			command:     []string{"rm", "pipeline2", "-n", "ns"},
 			dynamic:     seeds[2].dynamicClient,
 			input:       seeds[2].pipelineClient,
 			inputStream: strings.NewReader("y"),
 			wantError:   false,
-			want:        "Are you sure you want to delete Pipeline(s) \"pipeline\" (y/n): Pipelines deleted: \"pipeline\"\n",
+			want:        "Are you sure you want to delete Pipeline(s) \"pipeline2\" (y/n): Pipelines deleted: \"pipeline2\"\n",
 		},
 		{
 			name:        "Remove non existent resource",
@@ -608,7 +626,7 @@ func TestPipelineDelete(t *testing.T) {
 			input:       seeds[7].pipelineClient,
 			inputStream: nil,
 			wantError:   false,
-			want:        "Pipelines deleted: \"pipeline\", \"pipeline2\"\n",
+			want:        "Pipeline \"pipeline\" is referenced by:\n  - PipelineRun pipeline-run-1\n  - PipelineRun pipeline-run-2\nPipelines deleted: \"pipeline\", \"pipeline2\"\n",
 		},
 		{
 			name:        "Delete the Pipeline present and give error for non-existent Pipeline",
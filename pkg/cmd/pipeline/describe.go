@@ -15,15 +15,22 @@
 package pipeline
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
+	"reflect"
 	"strings"
+	"syscall"
 	"text/tabwriter"
 	"text/template"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/apply"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/cli/pkg/options"
@@ -103,14 +110,31 @@ const DescribeTemplate = `{{decorate "bold" "Name"}}:	{{ .PipelineName }}
 {{- end }}
 `
 
+// driftOptions are the options of tkn pipeline describe --watch-drift
+type driftOptions struct {
+	Watch    bool
+	Against  string
+	Interval time.Duration
+}
+
 func describeCommand(p cli.Params) *cobra.Command {
 	f := cliopts.NewPrintFlags("describe")
 	opts := &options.DescribeOptions{Params: p}
+	drift := &driftOptions{}
+	eg := `Describe the Pipeline foo of namespace bar:
+
+    tkn pipeline describe foo -n bar
+
+Report every 5 minutes how the Pipeline foo drifts from its definition in git:
+
+    tkn pipeline describe foo --watch-drift --against git@github.com:org/repo//tekton/foo.yaml@main --drift-interval 5m
+`
 
 	c := &cobra.Command{
 		Use:     "describe",
 		Aliases: []string{"desc"},
 		Short:   "Describes a Pipeline in a namespace",
+		Example: eg,
 		Annotations: map[string]string{
 			"commandType": "main",
 		},
@@ -119,7 +143,13 @@ func describeCommand(p cli.Params) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
+			}
+			var source *pipelinepkg.GitRef
+			if drift.Watch || drift.Against != "" {
+				if source, err = drift.validate(output); err != nil {
+					return err
+				}
 			}
 
 			cs, err := p.Clients()
@@ -144,6 +174,16 @@ func describeCommand(p cli.Params) *cobra.Command {
 				opts.PipelineName = args[0]
 			}
 
+			if source != nil {
+				ctx := cmd.Context()
+				if ctx == nil {
+					ctx = context.Background()
+				}
+				ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+				defer stop()
+				return watchDrift(ctx, cmd.OutOrStdout(), cmd.ErrOrStderr(), cs, p.Namespace(), opts.PipelineName, *source, drift.Interval, p.Time())
+			}
+
 			if output != "" {
 				printer, err := f.ToPrinter()
 				if err != nil {
@@ -157,9 +197,78 @@ func describeCommand(p cli.Params) *cobra.Command {
 	}
 
 	f.AddFlags(c)
+	c.Flags().BoolVar(&drift.Watch, "watch-drift", false, "compare the Pipeline to its definition in git periodically and report how it drifts, until interrupted")
+	c.Flags().StringVar(&drift.Against, "against", "", "definition of the Pipeline in git to watch the drift from, as git@host:org/repo//path/to/pipeline.yaml@revision or git+https://host/org/repo//path/to/pipeline.yaml@revision")
+	c.Flags().DurationVar(&drift.Interval, "drift-interval", time.Minute, "time between two comparisons of --watch-drift")
 	return c
 }
 
+func (o *driftOptions) validate(output string) (*pipelinepkg.GitRef, error) {
+	if !o.Watch {
+		return nil, fmt.Errorf("--against can only be used with --watch-drift")
+	}
+	if o.Against == "" {
+		return nil, fmt.Errorf("--watch-drift requires the definition of the Pipeline in git with --against")
+	}
+	if output != "" {
+		return nil, fmt.Errorf("--watch-drift cannot be used with --output")
+	}
+	if o.Interval <= 0 {
+		return nil, fmt.Errorf("--drift-interval must be positive")
+	}
+	return pipelinepkg.ParseGitSource(o.Against)
+}
+
+// watchDrift compares the Pipeline to its definition in git every interval
+// until ctx is done, and reports the drift each time it changes. The first
+// comparison must succeed, the failures of the next ones are reported and
+// retried at the next interval.
+func watchDrift(ctx context.Context, out, errOut io.Writer, c *cli.Clients, ns, name string, source pipelinepkg.GitRef, interval time.Duration, clock clockwork.Clock) error {
+	fmt.Fprintf(out, "Watching the drift of Pipeline %s from %s every %s, interrupt to stop\n", name, source, interval)
+
+	var last []apply.Change
+	for n := 0; ctx.Err() == nil; n++ {
+		changes, err := pipelineDrift(c, ns, name, source)
+		stamp := clock.Now().Format(time.RFC3339)
+		switch {
+		case err != nil && n == 0:
+			return err
+		case err != nil:
+			fmt.Fprintf(errOut, "%s failed to compare the Pipeline to %s: %v\n", stamp, source, err)
+		case n > 0 && reflect.DeepEqual(changes, last):
+		case len(changes) == 0:
+			fmt.Fprintf(out, "%s in sync\n", stamp)
+			last = changes
+		default:
+			fmt.Fprintf(out, "%s drifted, the changes in the cluster are:\n", stamp)
+			apply.PrintChanges(out, changes)
+			last = changes
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-clock.After(interval):
+		}
+	}
+	return nil
+}
+
+func pipelineDrift(c *cli.Clients, ns, name string, source pipelinepkg.GitRef) ([]apply.Change, error) {
+	b, err := pipelinepkg.FetchGitFile(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", source, err)
+	}
+	definition, err := pipelinepkg.DecodePipeline(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	live, err := pipelinepkg.GetPipeline(pipelineGroupResource, c, name, ns)
+	if err != nil {
+		return nil, err
+	}
+	return pipelinepkg.Drift(definition, live)
+}
+
 func printPipelineDescription(out io.Writer, c *cli.Clients, ns string, pName string, time clockwork.Clock) error {
 	pipeline, err := pipelinepkg.GetPipeline(pipelineGroupResource, c, pName, ns)
 	if err != nil {
@@ -15,17 +15,22 @@
 package pipeline
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	pipelinepkg "github.com/tektoncd/cli/pkg/pipeline"
 	"github.com/tektoncd/cli/pkg/test"
 	cb "github.com/tektoncd/cli/pkg/test/builder"
 	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/assert"
 	"gotest.tools/v3/golden"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -2319,3 +2324,123 @@ func TestPipelineDescribe_with_OptionalWorkspaces(t *testing.T) {
 	}
 	golden.Assert(t, got, fmt.Sprintf("%s.golden", t.Name()))
 }
+
+func TestPipelineDescribe_watch_drift_invalid(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--watch-drift"}, "--watch-drift requires the definition of the Pipeline in git with --against"},
+		{[]string{"--against", "git@github.com:org/repo//build.yaml"}, "--against can only be used with --watch-drift"},
+		{[]string{"--watch-drift", "--against", "git@github.com:org/repo//build.yaml", "-o", "yaml"}, "--watch-drift cannot be used with --output"},
+		{[]string{"--watch-drift", "--against", "git@github.com:org/repo//build.yaml", "--drift-interval", "0s"}, "--drift-interval must be positive"},
+		{[]string{"--watch-drift", "--against", "github.com/org/repo"}, "invalid git source github.com/org/repo, it must be like git@host:org/repo//path/to/pipeline.yaml@revision"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			p := &test.Params{}
+			_, err := test.ExecuteCommand(Command(p), append([]string{"desc", "build"}, tt.args...)...)
+			assert.Error(t, err, tt.want)
+		})
+	}
+}
+
+func TestPipelineDescribe_watch_drift(t *testing.T) {
+	clock := test.FakeClock()
+	pipelines := []*v1.Pipeline{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "build",
+				Namespace:   "ns",
+				Annotations: map[string]string{corev1.LastAppliedConfigAnnotation: "{}"},
+			},
+			Spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{
+					{Name: "compile", TaskRef: &v1.TaskRef{Name: "golang-build"}, Params: v1.Params{{Name: "flags", Value: *v1.NewStructuredValues("-race")}}},
+				},
+			},
+		},
+	}
+	namespaces := []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}}
+
+	version := "v1"
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(cb.UnstructuredP(pipelines[0], version))
+	assert.NilError(t, err)
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Namespaces: namespaces, Pipelines: pipelines})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipeline"})
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube, Dynamic: dynamic}
+	c, err := p.Clients()
+	assert.NilError(t, err)
+
+	definition := `apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: build
+spec:
+  tasks:
+  - name: compile
+    taskRef:
+      name: golang-build
+    params:
+    - name: flags
+      value: %s
+`
+	// the definition is changed in git, then the fetch fails, then the
+	// cluster is updated
+	definitions := []string{fmt.Sprintf(definition, "-race"), fmt.Sprintf(definition, "-v"), "", fmt.Sprintf(definition, "-race")}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var fetched []pipelinepkg.GitRef
+	fetchGitFile := pipelinepkg.FetchGitFile
+	t.Cleanup(func() { pipelinepkg.FetchGitFile = fetchGitFile })
+	pipelinepkg.FetchGitFile = func(ref pipelinepkg.GitRef) ([]byte, error) {
+		fetched = append(fetched, ref)
+		n := len(fetched) - 1
+		if n >= len(definitions)-1 {
+			cancel()
+			n = len(definitions) - 1
+		}
+		if definitions[n] == "" {
+			return nil, errors.New("connection reset")
+		}
+		return []byte(definitions[n]), nil
+	}
+	go func() {
+		for clock.BlockUntilContext(ctx, 1) == nil {
+			clock.Advance(time.Minute)
+		}
+	}()
+
+	source, err := pipelinepkg.ParseGitSource("git@github.com:org/repo//tekton/build.yaml@main")
+	assert.NilError(t, err)
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	assert.NilError(t, watchDrift(ctx, out, errOut, c, "ns", "build", *source, time.Minute, clock))
+
+	assert.Equal(t, fetched[0], pipelinepkg.GitRef{URL: "git@github.com:org/repo", PathInRepo: "tekton/build.yaml", Revision: "main"})
+	test.AssertOutput(t, `Watching the drift of Pipeline build from git@github.com:org/repo//tekton/build.yaml@main every 1m0s, interrupt to stop
+1984-04-04T00:00:00Z in sync
+1984-04-04T00:01:00Z drifted, the changes in the cluster are:
+  ~ spec.tasks[compile].params[flags].value: "-v" -> "-race"
+1984-04-04T00:03:00Z in sync
+`, out.String())
+	test.AssertOutput(t, "1984-04-04T00:02:00Z failed to compare the Pipeline to git@github.com:org/repo//tekton/build.yaml@main: failed to fetch git@github.com:org/repo//tekton/build.yaml@main: connection reset\n", errOut.String())
+}
+
+func TestPipelineDescribe_watch_drift_not_found(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+	cs.Pipeline.Resources = cb.APIResourceList("v1", []string{"pipeline"})
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client()
+	assert.NilError(t, err)
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic}
+
+	fetchGitFile := pipelinepkg.FetchGitFile
+	t.Cleanup(func() { pipelinepkg.FetchGitFile = fetchGitFile })
+	pipelinepkg.FetchGitFile = func(pipelinepkg.GitRef) ([]byte, error) {
+		return nil, errors.New("fatal: couldn't find remote ref main")
+	}
+
+	_, err = test.ExecuteCommand(Command(p), "desc", "build", "-n", "ns", "--watch-drift", "--against", "git+https://github.com/org/repo//build.yaml@main")
+	assert.Error(t, err, "failed to fetch git+https://github.com/org/repo//build.yaml@main: fatal: couldn't find remote ref main")
+}
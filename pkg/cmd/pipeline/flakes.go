@@ -0,0 +1,138 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/flakes"
+	"github.com/tektoncd/cli/pkg/keyring"
+	"github.com/tektoncd/cli/pkg/results"
+)
+
+// resultsTokenEnv holds the bearer token sent to the Results API
+const resultsTokenEnv = "TKN_RESULTS_TOKEN"
+
+type flakesOptions struct {
+	Last        int
+	ResultsAddr string
+}
+
+func flakesCommand(p cli.Params) *cobra.Command {
+	opts := &flakesOptions{}
+	eg := `Find the steps of Pipeline 'foo' which failed intermittently in its last 50 runs:
+
+    tkn pipeline flakes foo --last 50 -n bar
+
+Include the runs archived in Tekton Results once they are pruned from the cluster:
+
+    tkn pipeline flakes foo --results-addr https://tekton-results.example.com
+`
+
+	c := &cobra.Command{
+		Use:   "flakes PIPELINE",
+		Short: "Finds the steps of a Pipeline which fail intermittently",
+		Long: `Scan the logs of the recent runs of a Pipeline for failing steps and tests,
+cluster the similar failures and report which steps fail intermittently.
+
+The runs are read from the cluster, and from Tekton Results when its address is
+passed with --results-addr or set in the results.addr setting of the tkn profile,
+so that the runs pruned from the cluster are analysed too. The bearer token of
+the Results API is read from $` + resultsTokenEnv + ` or from the keychain, where it is
+stored with tkn auth token set results.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:         cobra.ExactArgs(1),
+		Example:      eg,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Last <= 0 {
+				return fmt.Errorf("--last should be greater than 0")
+			}
+
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+			name, ns := args[0], p.Namespace()
+
+			onCluster, err := flakes.FromCluster(cs, name, ns, opts.Last)
+			if err != nil {
+				return err
+			}
+			sources := [][]flakes.Source{onCluster}
+
+			client, err := opts.resultsClient(p)
+			if err != nil {
+				return err
+			}
+			if client != nil && len(onCluster) < opts.Last {
+				seen := map[string]bool{}
+				for _, s := range onCluster {
+					seen[s.Name] = true
+				}
+				archived, err := flakes.FromResults(client, name, ns, opts.Last-len(onCluster), seen)
+				if err != nil {
+					return err
+				}
+				sources = append(sources, archived)
+			}
+
+			runs := flakes.Merge(opts.Last, sources...)
+			if len(runs) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No completed PipelineRuns found for Pipeline %s\n", name)
+				return nil
+			}
+			reports := flakes.Analyze(runs)
+			if len(reports) == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "No failures found in the last %d PipelineRuns of Pipeline %s\n", len(runs), name)
+				return nil
+			}
+			return flakes.Print(cmd.OutOrStdout(), reports)
+		},
+	}
+	c.Flags().IntVarP(&opts.Last, "last", "", 50, "number of the most recent PipelineRuns to analyse")
+	c.Flags().StringVarP(&opts.ResultsAddr, "results-addr", "", "", "address of the REST endpoint of the Results API to read the pruned PipelineRuns from (default: results.addr of the tkn profile)")
+	return c
+}
+
+// resultsClient returns a client for the Results API, or nil when its
+// address is not known
+func (o *flakesOptions) resultsClient(p cli.Params) (*results.Client, error) {
+	profile, err := p.Profile()
+	if err != nil {
+		return nil, err
+	}
+	addr := profile.Results.Addr
+	if o.ResultsAddr != "" {
+		addr = o.ResultsAddr
+	}
+	if addr == "" {
+		return nil, nil
+	}
+	token := os.Getenv(resultsTokenEnv)
+	if token == "" {
+		token = keyring.Lookup(profile, keyring.Results)
+	}
+	return results.NewClient(results.Options{
+		Addr:                  addr,
+		Token:                 token,
+		InsecureSkipTLSVerify: profile.Results.InsecureSkipTLSVerify,
+	})
+}
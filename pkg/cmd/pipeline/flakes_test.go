@@ -0,0 +1,203 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// flakyRun returns a completed PipelineRun of the Pipeline build and its
+// TaskRun, whose test step exits with testExit
+func flakyRun(name string, started time.Time, testExit int32) (*v1.PipelineRun, *v1.TaskRun) {
+	status := corev1.ConditionTrue
+	if testExit != 0 {
+		status = corev1.ConditionFalse
+	}
+	conditions := duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: status}}
+
+	tr := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + "-unit",
+			Namespace: "ns",
+			Labels:    map[string]string{"tekton.dev/pipeline": "build", "tekton.dev/pipelineTask": "unit"},
+		},
+		Status: v1.TaskRunStatus{
+			Status: duckv1.Status{Conditions: conditions},
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				PodName: name + "-unit-pod",
+				Steps: []v1.StepState{
+					{Name: "lint", Container: "step-lint", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}},
+					{Name: "test", Container: "step-test", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: testExit, Reason: "Error"}}},
+				},
+			},
+		},
+	}
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			Labels:    map[string]string{"tekton.dev/pipeline": "build"},
+		},
+		Status: v1.PipelineRunStatus{
+			Status: duckv1.Status{Conditions: conditions},
+			PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				StartTime: &metav1.Time{Time: started},
+				ChildReferences: []v1.ChildStatusReference{
+					{Name: tr.Name, PipelineTaskName: "unit", TypeMeta: runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"}},
+				},
+			},
+		},
+	}
+	return pr, tr
+}
+
+// archivedServer serves the runs archived in Results
+func archivedServer(t *testing.T, prs []*v1.PipelineRun, trs []*v1.TaskRun, logs map[string]string) *httptest.Server {
+	record := func(name string, obj interface{}) string {
+		b, err := json.Marshal(obj)
+		assert.NilError(t, err)
+		return fmt.Sprintf(`{"name":%q,"data":{"type":"tekton.dev/v1.PipelineRun","value":%q}}`, name, base64.StdEncoding.EncodeToString(b))
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/apis/results.tekton.dev/v1alpha2/parents/ns/results/-/records" {
+			_, _ = io.WriteString(w, `{"records":[`)
+			for i, pr := range prs {
+				if i > 0 {
+					_, _ = io.WriteString(w, ",")
+				}
+				_, _ = io.WriteString(w, record(fmt.Sprintf("ns/results/%s/records/%s", pr.Name, pr.Name), pr))
+			}
+			_, _ = io.WriteString(w, `]}`)
+			return
+		}
+		for i, pr := range prs {
+			switch r.URL.Path {
+			case fmt.Sprintf("/apis/results.tekton.dev/v1alpha2/parents/ns/results/%s/records", pr.Name):
+				fmt.Fprintf(w, `{"records":[%s]}`, record(fmt.Sprintf("ns/results/%s/records/%s", pr.Name, trs[i].Name), trs[i]))
+				return
+			case fmt.Sprintf("/apis/results.tekton.dev/v1alpha3/parents/ns/results/%s/logs/%s", pr.Name, trs[i].Name):
+				_, _ = io.WriteString(w, logs[pr.Name])
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestPipelineFlakes(t *testing.T) {
+	now := test.FakeClock().Now()
+
+	var prs []*v1.PipelineRun
+	var trs []*v1.TaskRun
+	for i, exit := range []int32{1, 0, 0, 1} {
+		pr, tr := flakyRun(fmt.Sprintf("build-%d", 4-i), now.Add(-time.Duration(i)*time.Hour), exit)
+		prs = append(prs, pr)
+		trs = append(trs, tr)
+	}
+	running, _ := flakyRun("build-5", now, 0)
+	running.Status.Conditions = duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown}}
+	other, _ := flakyRun("deploy-1", now, 1)
+	other.Labels["tekton.dev/pipeline"] = "deploy"
+
+	// build-1 and build-2 were pruned from the cluster
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		PipelineRuns: []*v1.PipelineRun{running, prs[0], prs[1], other},
+		TaskRuns:     trs[:2],
+		Namespaces:   []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}},
+	})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipeline", "pipelinerun", "taskrun"})
+
+	archived := []*v1.PipelineRun{prs[1], prs[2], prs[3]}
+	srv := archivedServer(t, archived, trs[1:], map[string]string{
+		"build-1": "[unit : lint] ok\n[unit : test] === RUN TestRetry\n[unit : test] --- FAIL: TestRetry (0.42s)\n",
+	})
+
+	command := func() *test.Params {
+		tdc := testDynamic.Options{}
+		dc, err := tdc.Client(
+			cb.UnstructuredPR(running, version),
+			cb.UnstructuredPR(prs[0], version),
+			cb.UnstructuredPR(prs[1], version),
+			cb.UnstructuredPR(other, version),
+			cb.UnstructuredTR(trs[0], version),
+			cb.UnstructuredTR(trs[1], version),
+		)
+		assert.NilError(t, err)
+		return &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+	}
+
+	tests := []struct {
+		name      string
+		args      []string
+		wantError string
+	}{
+		{
+			name: "cluster only",
+			args: []string{"flakes", "build", "-n", "ns"},
+		},
+		{
+			name: "with results",
+			args: []string{"flakes", "build", "-n", "ns", "--results-addr", srv.URL},
+		},
+		{
+			name: "last runs",
+			args: []string{"flakes", "build", "-n", "ns", "--results-addr", srv.URL, "--last", "3"},
+		},
+		{
+			name: "no runs",
+			args: []string{"flakes", "release", "-n", "ns"},
+		},
+		{
+			name:      "invalid last",
+			args:      []string{"flakes", "build", "-n", "ns", "--last", "0"},
+			wantError: "--last should be greater than 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(Command(command()), tt.args...)
+			if tt.wantError != "" {
+				assert.Error(t, err, tt.wantError)
+				return
+			}
+			assert.NilError(t, err)
+			golden.Assert(t, got, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+		})
+	}
+}
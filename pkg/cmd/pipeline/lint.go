@@ -0,0 +1,142 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	pipelinepkg "github.com/tektoncd/cli/pkg/pipeline"
+	"github.com/tektoncd/cli/pkg/sarif"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+const lintTemplate = `{{- if eq (len .Findings) 0 -}}
+No problems found in Pipeline {{ .Name }}
+{{ else -}}
+LEVEL	RULE	TASK	MESSAGE
+{{ range $_, $f := .Findings -}}
+{{ $f.Rule.Level }}	{{ $f.Rule.ID }}	{{ if $f.Task }}{{ $f.Task }}{{ else }}---{{ end }}	{{ $f.Message }}
+{{ end -}}
+{{- end -}}`
+
+type lintOptions struct {
+	Filename string
+	Output   string
+}
+
+func lintCommand(p cli.Params) *cobra.Command {
+	opts := &lintOptions{}
+	eg := `Lint the Pipeline foo of namespace bar:
+
+    tkn pipeline lint foo -n bar
+
+Lint a Pipeline file and write the findings in SARIF for GitHub code scanning:
+
+    tkn pipeline lint -f pipeline.yaml -o sarif > tkn.sarif
+`
+
+	c := &cobra.Command{
+		Use:   "lint [PIPELINE]",
+		Short: "Checks a Pipeline for common mistakes",
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:              cobra.MaximumNArgs(1),
+		Example:           eg,
+		SilenceUsage:      true,
+		ValidArgsFunction: formatted.ParentCompletion,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Output != "" && opts.Output != "sarif" {
+				return fmt.Errorf("invalid output format %q, only sarif is supported", opts.Output)
+			}
+			if (opts.Filename == "") == (len(args) == 0) {
+				return errors.New("either a Pipeline name or --filename must be provided")
+			}
+
+			pipeline, err := opts.pipeline(p, args)
+			if err != nil {
+				return err
+			}
+
+			findings := pipelinepkg.Lint(pipeline)
+			if opts.Output == "sarif" {
+				err = pipelinepkg.SARIF("tkn-pipeline-lint", pipelinepkg.LintRules, findings, "pipelines/"+pipeline.Name, opts.Filename).Write(cmd.OutOrStdout())
+			} else {
+				err = printFindings(cmd.OutOrStdout(), pipeline.Name, findings)
+			}
+			if err != nil {
+				return err
+			}
+
+			errs := 0
+			for _, f := range findings {
+				if f.Rule.Level == sarif.LevelError {
+					errs++
+				}
+			}
+			if errs != 0 {
+				return fmt.Errorf("found %d error(s) in Pipeline %s", errs, pipeline.Name)
+			}
+			return nil
+		},
+	}
+
+	c.Flags().StringVarP(&opts.Filename, "filename", "f", "", "local or remote file name containing a Pipeline definition to lint")
+	c.Flags().StringVarP(&opts.Output, "output", "o", "", "output format, the only format supported is sarif")
+	return c
+}
+
+func (opts *lintOptions) pipeline(p cli.Params, args []string) (*v1.Pipeline, error) {
+	if opts.Filename == "" {
+		cs, err := p.Clients()
+		if err != nil {
+			return nil, err
+		}
+		return pipelinepkg.GetPipeline(pipelineGroupResource, cs, args[0], p.Namespace())
+	}
+
+	// linting a file does not need a cluster
+	pipeline, err := parsePipeline(opts.Filename, http.Client{})
+	if err != nil {
+		return nil, err
+	}
+	pipelineV1 := &v1.Pipeline{}
+	if err := pipeline.ConvertTo(context.Background(), pipelineV1); err != nil {
+		return nil, err
+	}
+	return pipelineV1, nil
+}
+
+func printFindings(out io.Writer, name string, findings []pipelinepkg.Finding) error {
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	t := template.Must(template.New("Lint").Parse(lintTemplate))
+	data := struct {
+		Name     string
+		Findings []pipelinepkg.Finding
+	}{name, findings}
+	if err := t.Execute(w, data); err != nil {
+		return err
+	}
+	return w.Flush()
+}
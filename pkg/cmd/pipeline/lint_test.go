@@ -0,0 +1,97 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/golden"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPipelineLint_file(t *testing.T) {
+	testParams := []struct {
+		name    string
+		command []string
+	}{
+		{
+			name:    "text",
+			command: []string{"lint", "-f", "testdata/lint-pipeline.yaml"},
+		},
+		{
+			name:    "sarif",
+			command: []string{"lint", "-f", "testdata/lint-pipeline.yaml", "-o", "sarif"},
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			pipeline := Command(&test.Params{})
+			got, err := test.ExecuteCommand(pipeline, tp.command...)
+			if err == nil || err.Error() != "found 3 error(s) in Pipeline build" {
+				t.Errorf("unexpected error: %v", err)
+			}
+			golden.Assert(t, got, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+		})
+	}
+}
+
+func TestPipelineLint_cluster(t *testing.T) {
+	pipelines := []*v1.Pipeline{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "clean", Namespace: "ns"},
+			Spec: v1.PipelineSpec{
+				Params: v1.ParamSpecs{{Name: "revision"}},
+				Tasks: []v1.PipelineTask{
+					{
+						Name:    "clone",
+						TaskRef: &v1.TaskRef{Name: "git-clone"},
+						Params:  v1.Params{{Name: "revision", Value: *v1.NewStructuredValues("$(params.revision)")}},
+					},
+				},
+			},
+		},
+	}
+
+	version := "v1"
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(cb.UnstructuredP(pipelines[0], version))
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Namespaces: []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}},
+		Pipelines:  pipelines,
+	})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipeline"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic}
+
+	got, err := test.ExecuteCommand(Command(p), "lint", "clean", "-n", "ns")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "No problems found in Pipeline clean\n", got)
+
+	_, err = test.ExecuteCommand(Command(p), "lint", "-n", "ns")
+	test.AssertOutput(t, "either a Pipeline name or --filename must be provided", err.Error())
+}
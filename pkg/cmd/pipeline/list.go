@@ -22,6 +22,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/cli/pkg/pipeline"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
@@ -61,6 +62,7 @@ NAME	AGE	LAST RUN	STARTED	DURATION	STATUS
 type ListOptions struct {
 	AllNamespaces bool
 	NoHeaders     bool
+	Script        flags.ScriptOutput
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -83,7 +85,10 @@ func listCommand(p cli.Params) *cobra.Command {
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
+			}
+			if err := opts.Script.Validate(output); err != nil {
+				return err
 			}
 
 			ns := p.Namespace()
@@ -91,6 +96,19 @@ func listCommand(p cli.Params) *cobra.Command {
 				ns = ""
 			}
 
+			if opts.Script.Enabled() {
+				var pipelines *v1.PipelineList
+				if err := actions.ListV1(pipelineGroupResource, cs, metav1.ListOptions{}, ns, &pipelines); err != nil {
+					return fmt.Errorf("failed to list Pipelines from namespace %s: %w", ns, err)
+				}
+				for _, p := range pipelines.Items {
+					if err := opts.Script.Print(cmd.OutOrStdout(), p.Name, p.Namespace, p.Name, formatted.PorcelainTime(&p.CreationTimestamp)); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
 			if output != "" {
 				p, err := f.ToPrinter()
 				if err != nil {
@@ -108,6 +126,7 @@ func listCommand(p cli.Params) *cobra.Command {
 	f.AddFlags(c)
 	c.Flags().BoolVarP(&opts.AllNamespaces, "all-namespaces", "A", opts.AllNamespaces, "list Pipelines from all namespaces")
 	c.Flags().BoolVarP(&opts.NoHeaders, "no-headers", "", opts.NoHeaders, "do not print column headers with output (default print column headers with output)")
+	opts.Script.AddFlags(c, "Pipeline")
 
 	return c
 }
@@ -125,7 +144,7 @@ func printPipelineDetails(s *cli.Stream, p cli.Params, allnamespaces bool, nohea
 	}
 	ps, prs, err := listPipelineDetails(cs, ns)
 	if err != nil {
-		return fmt.Errorf("failed to list Pipelines from namespace %s: %v", ns, err)
+		return fmt.Errorf("failed to list Pipelines from namespace %s: %w", ns, err)
 	}
 
 	var data = struct {
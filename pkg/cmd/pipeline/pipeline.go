@@ -38,12 +38,15 @@ func Command(p cli.Params) *cobra.Command {
 
 	flags.AddTektonOptions(cmd)
 	cmd.AddCommand(
+		concurrencyCommand(p),
 		deleteCommand(p),
 		describeCommand(p),
+		lintCommand(p),
 		listCommand(p),
 		logCommand(p),
 		startCommand(p),
 		exportCommand(p),
+		flakesCommand(p),
 		signCommand(),
 		verifyCommand(),
 	)
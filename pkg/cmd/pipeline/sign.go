@@ -72,12 +72,12 @@ or using kms
 
 			crd := &v1beta1.Pipeline{}
 			if err := yaml.Unmarshal(b, &crd); err != nil {
-				return fmt.Errorf("error unmarshalling Pipeline: %v", err)
+				return fmt.Errorf("error unmarshalling Pipeline: %w", err)
 			}
 
 			// Sign the Pipeline and save to file
 			if err := trustedresources.Sign(crd, opts.keyfile, opts.kmsKey, opts.targetFile); err != nil {
-				return fmt.Errorf("error signing Pipeline: %v", err)
+				return fmt.Errorf("error signing Pipeline: %w", err)
 			}
 			fmt.Fprintf(s.Out, "Pipeline %s is signed successfully \n", args[0])
 			return nil
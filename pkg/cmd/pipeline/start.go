@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -28,17 +29,21 @@ import (
 	"github.com/AlecAivazis/survey/v2/terminal"
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/audit"
 	"github.com/tektoncd/cli/pkg/cli"
 	prcmd "github.com/tektoncd/cli/pkg/cmd/pipelinerun"
 	"github.com/tektoncd/cli/pkg/file"
 	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/i18n"
 	"github.com/tektoncd/cli/pkg/labels"
 	"github.com/tektoncd/cli/pkg/options"
 	"github.com/tektoncd/cli/pkg/params"
 	pipelinepkg "github.com/tektoncd/cli/pkg/pipeline"
 	"github.com/tektoncd/cli/pkg/pipelinerun"
 	"github.com/tektoncd/cli/pkg/pods"
+	"github.com/tektoncd/cli/pkg/policy"
+	"github.com/tektoncd/cli/pkg/resolverref"
 	"github.com/tektoncd/cli/pkg/workspaces"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
@@ -54,6 +59,10 @@ var (
 
 const (
 	invalidSvc = "invalid service account parameter: "
+	// showLogReadinessTimeout is how long --showlog waits for the
+	// PipelineRun to reference TaskRuns which can be read before following
+	// its logs
+	showLogReadinessTimeout = 30 * time.Second
 )
 
 type startOptions struct {
@@ -66,10 +75,13 @@ type startOptions struct {
 	Last                  bool
 	UsePipelineRun        string
 	Labels                []string
+	Annotations           []string
 	ShowLog               bool
+	NotifyTerminal        bool
 	DryRun                bool
 	ExitWithPrError       bool
 	Output                string
+	Script                flags.ScriptOutput
 	PrefixName            string
 	TimeOut               string
 	PipelineTimeOut       string
@@ -78,9 +90,25 @@ type startOptions struct {
 	Filename              string
 	Workspaces            []string
 	UseParamDefaults      bool
+	UseLastRunParams      bool
 	TektonOptions         flags.TektonOptions
 	PodTemplate           string
 	SkipOptionalWorkspace bool
+	ResolverParams        []string
+	Policies              []string
+	// gitRef is the git reference the Pipeline is resolved from, when started
+	// with git+https://repo//path/pipeline.yaml@revision
+	gitRef *pipelinepkg.GitRef
+	// PipelineRef is the bundle or hub reference the Pipeline is resolved
+	// from, e.g. hub://tekton/buildpacks@0.1
+	PipelineRef string
+	resolverRef *resolverref.Ref
+	// MaxConcurrent refuses to start the Pipeline when it has that many
+	// PipelineRuns running or more
+	MaxConcurrent int
+	// lastRunParams are the values of the params of the last successful
+	// PipelineRun, offered instead of the defaults of the Pipeline
+	lastRunParams map[string]string
 }
 
 func startCommand(p cli.Params) *cobra.Command {
@@ -106,6 +134,10 @@ func startCommand(p cli.Params) *cobra.Command {
 
     tkn pipeline start foo -s ServiceAccountName -n bar
 
+Start Pipeline foo with labels and annotations set on the run for cost attribution:
+
+    tkn pipeline start foo --label team=payments --annotation cost-center=cc-42 -n bar
+
 For params value, if you want to provide multiple values, provide them comma separated
 like cat,foo,bar
 
@@ -130,6 +162,19 @@ For passing the workspaces via flags:
   readOnly: true
   volumeAttributes:
     secretProviderClass: "vault-database"
+
+Start the Pipeline defined in a git repository at a revision, e.g. under review,
+without applying it to the cluster. The PipelineRun resolves it with the git
+resolver, --resolver-param passes it further params like a token. The params of
+the Pipeline are passed as strings with --param:
+
+    tkn pipeline start git+https://github.com/org/repo//tekton/pipeline.yaml@a1b2c3d -p image=foo -n bar
+
+Start a Pipeline out of the cluster, resolved from a Tekton Bundle with the
+bundles resolver or from a hub with the hub resolver:
+
+    tkn pipeline start --pipeline-ref bundle://gcr.io/org/pipelines:v1#build -p image=foo -n bar
+    tkn pipeline start --pipeline-ref hub://tekton/buildpacks@0.1 -p image=foo -n bar
 `,
 		SilenceUsage: true,
 
@@ -147,6 +192,9 @@ For passing the workspaces via flags:
 			if opt.UseParamDefaults && (opt.Last || opt.UsePipelineRun != "") {
 				return errors.New("cannot use --last or --use-pipelinerun options with --use-param-defaults option")
 			}
+			if opt.UseLastRunParams && (opt.Last || opt.UsePipelineRun != "") {
+				return errors.New("cannot use --last or --use-pipelinerun options with --use-param-defaults-from-last-run option")
+			}
 			format := strings.ToLower(opt.Output)
 			if format != "" && format != "json" && format != "yaml" && format != "name" {
 				return fmt.Errorf("output format specified is %s but must be yaml or json", opt.Output)
@@ -154,6 +202,21 @@ For passing the workspaces via flags:
 			if format != "" && opt.ShowLog {
 				return errors.New("cannot use --output option with --showlog option")
 			}
+			if err := opt.Script.Validate(opt.Output); err != nil {
+				return err
+			}
+			if opt.Script.Enabled() && (opt.ShowLog || opt.DryRun) {
+				return errors.New("--quiet and --porcelain cannot be used with --showlog or --dry-run")
+			}
+			if opt.NotifyTerminal && !opt.ShowLog {
+				return errors.New("--notify-terminal can only be used with --showlog")
+			}
+			if opt.MaxConcurrent < 0 {
+				return errors.New("--max-concurrent must not be negative")
+			}
+			if opt.TimeOut != "" && opt.PipelineTimeOut != "" {
+				return errors.New("cannot use --timeout option with --pipeline-timeout option")
+			}
 			opt.TektonOptions = flags.GetTektonOptions(cmd)
 			return nil
 		},
@@ -163,6 +226,56 @@ For passing the workspaces via flags:
 				Err: cmd.OutOrStderr(),
 			}
 
+			if len(args) != 0 && pipelinepkg.IsGitRef(args[0]) {
+				if opt.Filename != "" || opt.Last || opt.UsePipelineRun != "" || opt.UseLastRunParams {
+					return errors.New("cannot use --filename, --last, --use-pipelinerun or --use-param-defaults-from-last-run options with a git reference")
+				}
+				ref, err := pipelinepkg.ParseGitRef(args[0])
+				if err != nil {
+					return err
+				}
+				opt.gitRef = ref
+				// the definition is only fetched by the resolver, the params
+				// and workspaces are passed as flags rather than prompted and
+				// the params are passed as strings
+				pipeline := &v1beta1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: ref.Name()}}
+				values, err := params.ParseParams(opt.Params)
+				if err != nil {
+					return err
+				}
+				for name := range values {
+					pipeline.Spec.Params = append(pipeline.Spec.Params, v1beta1.ParamSpec{Name: name, Type: v1beta1.ParamTypeString})
+				}
+				return opt.run(pipeline)
+			}
+			if opt.PipelineRef != "" {
+				if len(args) != 0 || opt.Filename != "" || opt.Last || opt.UsePipelineRun != "" || opt.UseLastRunParams {
+					return errors.New("cannot use a Pipeline name, --filename, --last, --use-pipelinerun or --use-param-defaults-from-last-run options with --pipeline-ref")
+				}
+				ref, err := resolverref.Parse(opt.PipelineRef, "pipeline")
+				if err != nil {
+					return err
+				}
+				if err := ref.SetParams(opt.ResolverParams); err != nil {
+					return err
+				}
+				opt.resolverRef = ref
+				// as with a git reference, the definition is only fetched by
+				// the resolver
+				pipeline := &v1beta1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: ref.Name}}
+				values, err := params.ParseParams(opt.Params)
+				if err != nil {
+					return err
+				}
+				for name := range values {
+					pipeline.Spec.Params = append(pipeline.Spec.Params, v1beta1.ParamSpec{Name: name, Type: v1beta1.ParamTypeString})
+				}
+				return opt.run(pipeline)
+			}
+			if len(opt.ResolverParams) != 0 {
+				return errors.New("--resolver-param can only be used with a git reference or --pipeline-ref")
+			}
+
 			pipeline, err := NameArg(args, p, opt.Filename)
 			if err != nil {
 				return err
@@ -174,6 +287,7 @@ For passing the workspaces via flags:
 
 	c.Flags().BoolVarP(&opt.ShowLog, "showlog", "", false, "show logs right after starting the Pipeline")
 	c.Flags().StringArrayVarP(&opt.Params, "param", "p", []string{}, "pass the param as key=value for string type, or key=value1,value2,... for array type, or key=\"key1:value1, key2:value2\" for object type")
+	_ = c.RegisterFlagCompletionFunc("param", formatted.ParamCompletion("pipeline"))
 	c.Flags().BoolVarP(&opt.Last, "last", "L", false, "re-run the Pipeline using last PipelineRun values")
 	c.Flags().StringVarP(&opt.UsePipelineRun, "use-pipelinerun", "", "", "use this pipelinerun values to re-run the pipeline. ")
 	_ = c.RegisterFlagCompletionFunc("use-pipelinerun",
@@ -183,20 +297,29 @@ For passing the workspaces via flags:
 	)
 
 	c.Flags().StringSliceVarP(&opt.Labels, "labels", "l", []string{}, "pass labels as label=value.")
+	c.Flags().StringSliceVarP(&opt.Annotations, "annotation", "", []string{}, "pass annotations of the PipelineRun as annotation=value, Tekton propagates them with the labels to the pods of the run")
+	flags.AddAliases(c, map[string]string{"label": "labels"})
 	c.Flags().StringArrayVarP(&opt.Workspaces, "workspace", "w", []string{}, "pass one or more workspaces to map to the corresponding physical volumes")
 	c.Flags().BoolVarP(&opt.DryRun, "dry-run", "", false, "preview PipelineRun without running it")
 	c.Flags().StringVarP(&opt.Output, "output", "o", "", "format of PipelineRun (yaml, json or name)")
+	opt.Script.AddFlags(c, "PipelineRun")
 	c.Flags().StringVarP(&opt.PrefixName, "prefix-name", "", "", "specify a prefix for the PipelineRun name (must be lowercase alphanumeric characters)")
 	c.Flags().StringVarP(&opt.TimeOut, "timeout", "", "", "timeout for PipelineRun")
 	_ = c.Flags().MarkDeprecated("timeout", "please use --pipeline-timeout flag instead")
-	c.Flags().StringVarP(&opt.PipelineTimeOut, "pipeline-timeout", "", "", "timeout for PipelineRun")
-	c.Flags().StringVarP(&opt.TasksTimeOut, "tasks-timeout", "", "", "timeout for Pipeline TaskRuns")
-	c.Flags().StringVarP(&opt.FinallyTimeOut, "finally-timeout", "", "", "timeout for Finally TaskRuns")
+	c.Flags().StringVarP(&opt.PipelineTimeOut, "pipeline-timeout", "", "", "timeout for PipelineRun (default: timeouts.pipeline of the config profile)")
+	c.Flags().StringVarP(&opt.TasksTimeOut, "tasks-timeout", "", "", "timeout for Pipeline TaskRuns (default: timeouts.tasks of the config profile)")
+	c.Flags().StringVarP(&opt.FinallyTimeOut, "finally-timeout", "", "", "timeout for Finally TaskRuns (default: timeouts.finally of the config profile)")
 	c.Flags().StringVarP(&opt.Filename, "filename", "f", "", "local or remote file name containing a Pipeline definition to start a PipelineRun")
 	c.Flags().BoolVarP(&opt.UseParamDefaults, "use-param-defaults", "", false, "use default parameter values without prompting for input")
+	c.Flags().BoolVarP(&opt.UseLastRunParams, "use-param-defaults-from-last-run", "", false, "offer the param values of the last successful PipelineRun as defaults, with --use-param-defaults they are used without prompting")
 	c.Flags().StringVar(&opt.PodTemplate, "pod-template", "", "local or remote file containing a PodTemplate definition")
 	c.Flags().BoolVarP(&opt.SkipOptionalWorkspace, "skip-optional-workspace", "", false, "skips the prompt for optional workspaces")
-	c.Flags().BoolVarP(&opt.ExitWithPrError, "exit-with-pipelinerun-error", "E", false, "when using --showlog, exit with pipelinerun to the unix shell, 0 if success, 1 if error, 2 on unknown status")
+	c.Flags().StringVar(&opt.PipelineRef, "pipeline-ref", "", "start the Pipeline resolved from a Tekton Bundle as bundle://registry/repo:tag#name or from a hub as hub://catalog/name@version, without applying it to the cluster")
+	c.Flags().StringArrayVar(&opt.ResolverParams, "resolver-param", []string{}, "pass a param of the resolver as key=value when starting the Pipeline from a git reference or with --pipeline-ref, e.g. token=my-secret")
+	c.Flags().IntVar(&opt.MaxConcurrent, "max-concurrent", 0, "refuse to start the Pipeline when it has this many PipelineRuns running or more, a soft limit for clusters without a queuing controller (default: no limit)")
+	c.Flags().StringArrayVar(&opt.Policies, "policy", []string{}, "check the PipelineRun against this rego or CUE policy before starting it, in addition to policies.files of the config profile")
+	c.Flags().BoolVarP(&opt.ExitWithPrError, "exit-with-pipelinerun-error", "E", false, "when using --showlog, exit with pipelinerun to the unix shell, 0 if success, 5 if failed, 2 on unknown status")
+	c.Flags().BoolVarP(&opt.NotifyTerminal, "notify-terminal", "", false, "when using --showlog, show the state of the PipelineRun in the title of the terminal, and ring its bell with a desktop notification, where supported, once it is done")
 
 	c.Flags().StringVarP(&opt.ServiceAccountName, "serviceaccount", "s", "", "pass the serviceaccount name")
 	_ = c.RegisterFlagCompletionFunc("serviceaccount",
@@ -232,7 +355,33 @@ func (opt *startOptions) startPipeline(pipelineStart *v1beta1.Pipeline) error {
 		Namespace: opt.cliparams.Namespace(),
 	}
 	var pr *v1beta1.PipelineRun
-	if opt.Filename == "" {
+	if opt.gitRef != nil {
+		ref, err := opt.gitRef.PipelineRef(opt.ResolverParams)
+		if err != nil {
+			return err
+		}
+		pr = &v1beta1.PipelineRun{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "tekton.dev/v1beta1",
+				Kind:       "PipelineRun",
+			},
+			ObjectMeta: objMeta,
+			Spec: v1beta1.PipelineRunSpec{
+				PipelineRef: ref,
+			},
+		}
+	} else if opt.resolverRef != nil {
+		pr = &v1beta1.PipelineRun{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "tekton.dev/v1beta1",
+				Kind:       "PipelineRun",
+			},
+			ObjectMeta: objMeta,
+			Spec: v1beta1.PipelineRunSpec{
+				PipelineRef: &v1beta1.PipelineRef{ResolverRef: opt.resolverRef.ResolverRef()},
+			},
+		}
+	} else if opt.Filename == "" {
 		pr = &v1beta1.PipelineRun{
 			TypeMeta: metav1.TypeMeta{
 				APIVersion: "tekton.dev/v1beta1",
@@ -302,11 +451,15 @@ func (opt *startOptions) startPipeline(pipelineStart *v1beta1.Pipeline) error {
 		}
 	}
 
-	if opt.TasksTimeOut != "" || opt.PipelineTimeOut != "" || opt.FinallyTimeOut != "" {
-		if err := opt.getTimeouts(pr); err != nil {
-			return err
-		}
+	if err := opt.getTimeouts(pr); err != nil {
+		return err
+	}
+
+	annotations, err := labels.MergeAnnotations(pr.ObjectMeta.Annotations, opt.Annotations)
+	if err != nil {
+		return err
 	}
+	pr.ObjectMeta.Annotations = annotations
 
 	labels, err := labels.MergeLabels(pr.ObjectMeta.Labels, opt.Labels)
 	if err != nil {
@@ -343,6 +496,10 @@ func (opt *startOptions) startPipeline(pipelineStart *v1beta1.Pipeline) error {
 		pr.Spec.PodTemplate = &podTemplate
 	}
 
+	if err := opt.checkPolicies(pr); err != nil {
+		return err
+	}
+
 	if opt.DryRun {
 		format := strings.ToLower(opt.Output)
 		if format == "name" {
@@ -366,10 +523,15 @@ func (opt *startOptions) startPipeline(pipelineStart *v1beta1.Pipeline) error {
 		return printPipelineRun(opt.Output, opt.stream, pr)
 	}
 
+	if err := pipelinepkg.CheckConcurrency(cs, pipelineStart.ObjectMeta.Name, opt.cliparams.Namespace(), opt.MaxConcurrent); err != nil {
+		return err
+	}
+
 	prCreated, err := pipelinerun.Create(cs, pr, metav1.CreateOptions{}, opt.cliparams.Namespace())
 	if err != nil {
-		return err
+		return actions.DescribeRejection("PipelineRun", err)
 	}
+	audit.Record(opt.cliparams, audit.ActionStart, "PipelineRun", prCreated.Name)
 
 	if opt.Output != "" {
 		format := strings.ToLower(opt.Output)
@@ -394,19 +556,24 @@ func (opt *startOptions) startPipeline(pipelineStart *v1beta1.Pipeline) error {
 		return printPipelineRun(opt.Output, opt.stream, prCreated)
 	}
 
-	fmt.Fprintf(opt.stream.Out, "PipelineRun started: %s\n", prCreated.Name)
+	if opt.Script.Enabled() {
+		return opt.Script.Print(opt.stream.Out, prCreated.Name, prCreated.Namespace, prCreated.Name)
+	}
+
+	i18n.Fprintf(opt.stream.Out, "PipelineRun started: %s\n", prCreated.Name)
 	if !opt.ShowLog {
-		inOrderString := "\nIn order to track the PipelineRun progress run:\ntkn pipelinerun "
-		if opt.TektonOptions.Context != "" {
-			inOrderString += fmt.Sprintf("--context=%s ", opt.TektonOptions.Context)
-		}
+		inOrderString := i18n.T("\nIn order to track the PipelineRun progress run:\n") + "tkn pipelinerun "
+		inOrderString += opt.TektonOptions.Args()
 		inOrderString += fmt.Sprintf("logs %s -f -n %s\n", prCreated.Name, prCreated.Namespace)
 
 		fmt.Fprint(opt.stream.Out, inOrderString)
 		return nil
 	}
 
-	fmt.Fprintf(opt.stream.Out, "Waiting for logs to be available...\n")
+	i18n.Fprintf(opt.stream.Out, "Waiting for logs to be available...\n")
+	if err := pipelinerun.WaitForChildReferences(cs, prCreated.Name, prCreated.Namespace, showLogReadinessTimeout); err != nil {
+		return err
+	}
 	runLogOpts := &options.LogOptions{
 		PipelineName:    pipelineStart.ObjectMeta.Name,
 		PipelineRunName: prCreated.Name,
@@ -416,6 +583,7 @@ func (opt *startOptions) startPipeline(pipelineStart *v1beta1.Pipeline) error {
 		Params:          opt.cliparams,
 		AllSteps:        false,
 		ExitWithPrError: opt.ExitWithPrError,
+		NotifyTerminal:  opt.NotifyTerminal,
 	}
 	return prcmd.Run(runLogOpts)
 }
@@ -427,6 +595,11 @@ func (opt *startOptions) getInput(pipeline *v1beta1.Pipeline) error {
 		if err != nil {
 			return err
 		}
+		if opt.UseLastRunParams {
+			if err := opt.getLastRunParams(pipeline); err != nil {
+				return err
+			}
+		}
 		if err = opt.getInputParams(pipeline, skipParams, opt.UseParamDefaults); err != nil {
 			return err
 		}
@@ -441,37 +614,168 @@ func (opt *startOptions) getInput(pipeline *v1beta1.Pipeline) error {
 	return nil
 }
 
+// getTimeouts sets the timeouts of the PipelineRun, the values passed as flags
+// take precedence over the ones copied from a previous PipelineRun which in
+// turn take precedence over the defaults of the active config profile
 func (opt *startOptions) getTimeouts(pr *v1beta1.PipelineRun) error {
-	pr.Spec.Timeouts = &v1beta1.TimeoutFields{}
+	profile, err := opt.cliparams.Profile()
+	if err != nil {
+		return err
+	}
 
-	if opt.PipelineTimeOut != "" {
-		timeoutDuration, err := time.ParseDuration(opt.PipelineTimeOut)
+	if pr.Spec.Timeouts == nil {
+		pr.Spec.Timeouts = &v1beta1.TimeoutFields{}
+	}
+	timeouts := []struct {
+		flag, value, profileValue string
+		field                     **metav1.Duration
+	}{
+		{"pipeline-timeout", opt.PipelineTimeOut, profile.Timeouts.Pipeline, &pr.Spec.Timeouts.Pipeline},
+		{"tasks-timeout", opt.TasksTimeOut, profile.Timeouts.Tasks, &pr.Spec.Timeouts.Tasks},
+		{"finally-timeout", opt.FinallyTimeOut, profile.Timeouts.Finally, &pr.Spec.Timeouts.Finally},
+	}
+	for _, t := range timeouts {
+		value := t.value
+		if value == "" {
+			if *t.field != nil || t.profileValue == "" {
+				continue
+			}
+			value = t.profileValue
+		}
+		timeoutDuration, err := parseTimeout(t.flag, value)
 		if err != nil {
 			return err
 		}
-		pr.Spec.Timeouts.Pipeline = &metav1.Duration{Duration: timeoutDuration}
+		*t.field = &metav1.Duration{Duration: timeoutDuration}
 	}
 
-	if opt.TasksTimeOut != "" {
-		timeoutDuration, err := time.ParseDuration(opt.TasksTimeOut)
-		if err != nil {
-			return err
+	if pr.Spec.Timeouts.Pipeline == nil && pr.Spec.Timeouts.Tasks == nil && pr.Spec.Timeouts.Finally == nil {
+		pr.Spec.Timeouts = nil
+		return nil
+	}
+	return validateTimeouts(pr.Spec.Timeouts)
+}
+
+// checkPolicies checks the PipelineRun against the policies of the profile
+// and of --policy, the violations refuse to start it unless the profile only
+// warns about them
+func (opt *startOptions) checkPolicies(pr *v1beta1.PipelineRun) error {
+	profile, err := opt.cliparams.Profile()
+	if err != nil {
+		return err
+	}
+	files := append(append([]string{}, profile.Policies.Files...), opt.Policies...)
+	if len(files) == 0 {
+		return nil
+	}
+
+	var prv1 v1.PipelineRun
+	if err := pr.ConvertTo(context.Background(), &prv1); err != nil {
+		return err
+	}
+	prv1.Kind = "PipelineRun"
+	prv1.APIVersion = "tekton.dev/v1"
+	result, err := policy.Check(files, &prv1)
+	if err != nil {
+		return err
+	}
+
+	warnings := result.Warnings
+	if profile.Policies.WarnOnly {
+		warnings = append(warnings, result.Denials...)
+	}
+	for _, w := range warnings {
+		fmt.Fprintf(opt.stream.Err, "Warning: %s\n", w)
+	}
+	if len(result.Denials) == 0 || profile.Policies.WarnOnly {
+		return nil
+	}
+	return fmt.Errorf("PipelineRun refused by the policies:\n- %s", strings.Join(result.Denials, "\n- "))
+}
+
+func parseTimeout(flag, value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid value %q for --%s: timeout must not be negative", value, flag)
+	}
+	return d, nil
+}
+
+// validateTimeouts applies the same rules as the Tekton Pipelines webhook
+// so that invalid combinations are reported before creating the PipelineRun
+func validateTimeouts(t *v1beta1.TimeoutFields) error {
+	// a pipeline timeout of 0 means no timeout
+	if t.Pipeline == nil || t.Pipeline.Duration == 0 {
+		return nil
+	}
+
+	pipeline := t.Pipeline.Duration
+	var tasks, finally time.Duration
+	if t.Tasks != nil {
+		tasks = t.Tasks.Duration
+		if tasks > pipeline {
+			return fmt.Errorf("tasks timeout %s must not exceed the pipeline timeout %s", tasks, pipeline)
 		}
-		pr.Spec.Timeouts.Tasks = &metav1.Duration{Duration: timeoutDuration}
+	}
+	if t.Finally != nil {
+		finally = t.Finally.Duration
+		if finally > pipeline {
+			return fmt.Errorf("finally timeout %s must not exceed the pipeline timeout %s", finally, pipeline)
+		}
+	}
+	if tasks+finally > pipeline {
+		return fmt.Errorf("tasks timeout %s and finally timeout %s together must not exceed the pipeline timeout %s", tasks, finally, pipeline)
+	}
+	return nil
+}
+
+// getLastRunParams reads the values of the params of the last successful
+// PipelineRun of the Pipeline
+func (opt *startOptions) getLastRunParams(pipeline *v1beta1.Pipeline) error {
+	cs, err := opt.cliparams.Clients()
+	if err != nil {
+		return err
+	}
+	pr, err := pipelinepkg.LastSuccessfulRun(cs, pipeline.Name, opt.cliparams.Namespace())
+	if err != nil {
+		return err
 	}
 
-	if opt.FinallyTimeOut != "" {
-		timeoutDuration, err := time.ParseDuration(opt.FinallyTimeOut)
-		if err != nil {
-			return err
+	opt.lastRunParams = map[string]string{}
+	for _, p := range pr.Spec.Params {
+		switch p.Value.Type {
+		case v1.ParamTypeArray:
+			opt.lastRunParams[p.Name] = strings.Join(p.Value.ArrayVal, ",")
+		case v1.ParamTypeObject:
+			keys := make([]string, 0, len(p.Value.ObjectVal))
+			for k := range p.Value.ObjectVal {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			fields := make([]string, 0, len(keys))
+			for _, k := range keys {
+				fields = append(fields, k+":"+p.Value.ObjectVal[k])
+			}
+			opt.lastRunParams[p.Name] = strings.Join(fields, ",")
+		default:
+			opt.lastRunParams[p.Name] = p.Value.StringVal
 		}
-		pr.Spec.Timeouts.Finally = &metav1.Duration{Duration: timeoutDuration}
 	}
 	return nil
 }
 
 func (opt *startOptions) getInputParams(pipeline *v1beta1.Pipeline, skipParams map[string]string, useParamDefaults bool) error {
 	for _, param := range pipeline.Spec.Params {
+		lastValue, hasLastValue := opt.lastRunParams[param.Name]
+		if useParamDefaults && hasLastValue {
+			if _, toSkip := skipParams[param.Name]; !toSkip {
+				opt.Params = append(opt.Params, param.Name+"="+lastValue)
+			}
+			continue
+		}
 		if param.Default == nil && useParamDefaults || !useParamDefaults {
 			if _, toSkip := skipParams[param.Name]; toSkip {
 				continue
@@ -479,7 +783,10 @@ func (opt *startOptions) getInputParams(pipeline *v1beta1.Pipeline, skipParams m
 			var ans, ques, defaultValue string
 			ques = fmt.Sprintf("Value for param `%s` of type `%s`?", param.Name, param.Type)
 			input := &survey.Input{}
-			if param.Default != nil {
+			if hasLastValue {
+				ques += fmt.Sprintf(" (Last run value is `%s`)", lastValue)
+				input.Default = lastValue
+			} else if param.Default != nil {
 				if param.Type == "string" {
 					defaultValue = param.Default.StringVal
 				}
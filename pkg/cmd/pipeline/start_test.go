@@ -15,6 +15,7 @@
 package pipeline
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -45,6 +46,7 @@ import (
 	util "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	k8stest "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
@@ -517,6 +519,87 @@ func TestPipelineStart_ExecuteCommand_v1beta1(t *testing.T) {
 			wantError:  false,
 			goldenFile: true,
 		},
+		{
+			name: "Dry Run from a git reference",
+			command: []string{
+				"start", "git+https://github.com/org/repo//tekton/pipeline.yaml@a1b2c3d",
+				"-p=image=foo",
+				"--resolver-param=token=git-token",
+				"-n", "ns",
+				"--dry-run",
+			},
+			namespace:  "",
+			input:      c2,
+			wantError:  false,
+			goldenFile: true,
+		},
+		{
+			name: "Invalid git reference",
+			command: []string{
+				"start", "git+https://github.com/org/repo/tekton/pipeline.yaml",
+				"-n", "ns",
+				"--dry-run",
+			},
+			namespace: "",
+			input:     c2,
+			wantError: true,
+			want:      "invalid git reference git+https://github.com/org/repo/tekton/pipeline.yaml, it must be like git+https://host/org/repo//path/to/pipeline.yaml@revision",
+		},
+		{
+			name: "Resolver param without git reference",
+			command: []string{
+				"start", "test-pipeline",
+				"--resolver-param=token=git-token",
+				"-n", "ns",
+			},
+			namespace: "",
+			input:     c2,
+			wantError: true,
+			want:      "--resolver-param can only be used with a git reference or --pipeline-ref",
+		},
+		{
+			name: "Dry Run from a hub reference",
+			command: []string{
+				"start", "--pipeline-ref", "hub://tekton/buildpacks@0.1",
+				"-p=image=foo",
+				"-n", "ns",
+				"--dry-run",
+			},
+			namespace:  "",
+			input:      c2,
+			wantError:  false,
+			goldenFile: true,
+		},
+		{
+			name: "Invalid bundle reference",
+			command: []string{
+				"start", "--pipeline-ref", "bundle://gcr.io/org/pipelines:v1",
+				"-n", "ns",
+				"--dry-run",
+			},
+			namespace: "",
+			input:     c2,
+			wantError: true,
+			want:      "invalid bundle reference bundle://gcr.io/org/pipelines:v1, it must be like bundle://registry/repo:tag#name",
+		},
+		{
+			name: "Dry Run with --label and --annotation",
+			command: []string{
+				"start", "test-pipeline",
+				"-s=svc1",
+				"-p=pipeline-param=value1",
+				"-p=rev-param=value2",
+				"--label=team=payments",
+				"--annotation=cost-center=cc-42",
+				"--annotation=ticket=PAY-1",
+				"-n", "ns",
+				"--dry-run",
+			},
+			namespace:  "",
+			input:      c2,
+			wantError:  false,
+			goldenFile: true,
+		},
 		{
 			name: "Dry Run with --use-param-defaults and specified params",
 			command: []string{
@@ -1464,6 +1547,146 @@ func Test_start_pipeline_last_v1beta1(t *testing.T) {
 	test.AssertOutput(t, timeoutDuration, pr.Spec.Timeouts.Pipeline.Duration)
 }
 
+func Test_start_pipeline_use_param_defaults_from_last_run_v1beta1(t *testing.T) {
+	pipelineName := "test-pipeline"
+	ps := []*v1beta1.Pipeline{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pipelineName,
+				Namespace: "ns",
+			},
+			Spec: v1beta1.PipelineSpec{
+				Tasks: []v1beta1.PipelineTask{
+					{
+						Name: "unit-test-1",
+						TaskRef: &v1beta1.TaskRef{
+							Name: "unit-test-task",
+						},
+					},
+				},
+				Params: []v1beta1.ParamSpec{
+					{
+						Name: "rev-param",
+						Type: v1beta1.ParamTypeString,
+						Default: &v1beta1.ParamValue{
+							Type:      v1beta1.ParamTypeString,
+							StringVal: "revision",
+						},
+					},
+					{
+						Name: "array-param",
+						Type: v1beta1.ParamTypeArray,
+					},
+					{
+						Name: "image",
+						Type: v1beta1.ParamTypeString,
+					},
+				},
+			},
+		},
+	}
+
+	pipelineRun := func(name string, created time.Time, status corev1.ConditionStatus, rev string) *v1beta1.PipelineRun {
+		return &v1beta1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "ns",
+				Labels:            map[string]string{"tekton.dev/pipeline": pipelineName},
+				CreationTimestamp: metav1.Time{Time: created},
+			},
+			Spec: v1beta1.PipelineRunSpec{
+				PipelineRef: &v1beta1.PipelineRef{
+					Name: pipelineName,
+				},
+				Params: []v1beta1.Param{
+					{
+						Name:  "rev-param",
+						Value: *v1beta1.NewStructuredValues(rev),
+					},
+					{
+						Name:  "array-param",
+						Value: *v1beta1.NewStructuredValues("a", "b"),
+					},
+					{
+						Name:  "image",
+						Value: *v1beta1.NewStructuredValues("golang:1.22"),
+					},
+				},
+			},
+			Status: v1beta1.PipelineRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Type:   apis.ConditionSucceeded,
+							Status: status,
+						},
+					},
+				},
+			},
+		}
+	}
+	now := time.Now()
+	prs := []*v1beta1.PipelineRun{
+		pipelineRun("succeeded", now.Add(-2*time.Hour), corev1.ConditionTrue, "v1.0"),
+		pipelineRun("failed", now.Add(-time.Hour), corev1.ConditionFalse, "broken"),
+	}
+
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	seedData, _ := test.SeedV1beta1TestData(t, test.Data{
+		Namespaces: ns,
+	})
+	cs := pipelinetest.Clients{
+		Pipeline: seedData.Pipeline,
+		Kube:     seedData.Kube,
+	}
+	cs.Pipeline.Resources = cb.APIResourceList("v1beta1", []string{"pipeline", "pipelinerun"})
+	objs := []runtime.Object{ps[0], prs[0], prs[1]}
+	_, tdc := newV1beta1PipelineClient(objs...)
+	dc, err := tdc.Client(
+		cb.UnstructuredV1beta1P(ps[0], "v1beta1"),
+		cb.UnstructuredV1beta1PR(prs[0], "v1beta1"),
+		cb.UnstructuredV1beta1PR(prs[1], "v1beta1"),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+	pipeline := Command(p)
+	got, _ := test.ExecuteCommand(pipeline, "start", pipelineName,
+		"--use-param-defaults-from-last-run",
+		"--use-param-defaults",
+		"-p", "image=golang:1.23",
+		"--dry-run",
+		"-o", "json",
+		"-n", "ns",
+	)
+
+	pr := &v1beta1.PipelineRun{}
+	if err := json.Unmarshal([]byte(got), pr); err != nil {
+		t.Fatalf("failed to parse the PipelineRun %q: %v", got, err)
+	}
+	values := map[string]v1beta1.ParamValue{}
+	for _, param := range pr.Spec.Params {
+		values[param.Name] = param.Value
+	}
+	test.AssertOutput(t, map[string]v1beta1.ParamValue{
+		"rev-param":   *v1beta1.NewStructuredValues("v1.0"),
+		"array-param": *v1beta1.NewStructuredValues("a", "b"),
+		"image":       *v1beta1.NewStructuredValues("golang:1.23"),
+	}, values)
+
+	_, err = test.ExecuteCommand(Command(p), "start", pipelineName, "--use-param-defaults-from-last-run", "--last", "-n", "ns")
+	test.AssertOutput(t, "cannot use --last or --use-pipelinerun options with --use-param-defaults-from-last-run option", err.Error())
+}
+
 func Test_start_pipeline_last_override_timeout_deprecated_v1beta1(t *testing.T) {
 	pipelineName := "test-pipeline"
 	ps := []*v1beta1.Pipeline{
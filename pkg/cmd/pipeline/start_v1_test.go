@@ -16,6 +16,8 @@ package pipeline
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -26,6 +28,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/config"
 	"github.com/tektoncd/cli/pkg/pipeline"
 	"github.com/tektoncd/cli/pkg/test"
 	cb "github.com/tektoncd/cli/pkg/test/builder"
@@ -3126,7 +3129,8 @@ func Test_start_pipeline_with_skip_optional_workspace_flag(t *testing.T) {
 
 func Test_GetTimeouts(t *testing.T) {
 	opts := startOptions{
-		PipelineTimeOut: "1m",
+		cliparams:       &test.Params{},
+		PipelineTimeOut: "3m",
 		TasksTimeOut:    "2m",
 	}
 
@@ -3146,5 +3150,116 @@ func Test_GetTimeouts(t *testing.T) {
 	}
 
 	test.AssertOutput(t, "2m0s", prs[0].Spec.Timeouts.Tasks.Duration.String())
-	test.AssertOutput(t, "1m0s", prs[0].Spec.Timeouts.Pipeline.Duration.String())
+	test.AssertOutput(t, "3m0s", prs[0].Spec.Timeouts.Pipeline.Duration.String())
+}
+
+func Test_GetTimeouts_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     startOptions
+		expected string
+	}{
+		{
+			name:     "negative timeout",
+			opts:     startOptions{TasksTimeOut: "-1m"},
+			expected: `invalid value "-1m" for --tasks-timeout: timeout must not be negative`,
+		},
+		{
+			name:     "tasks timeout exceeds pipeline timeout",
+			opts:     startOptions{PipelineTimeOut: "1m", TasksTimeOut: "2m"},
+			expected: "tasks timeout 2m0s must not exceed the pipeline timeout 1m0s",
+		},
+		{
+			name:     "tasks and finally timeouts exceed pipeline timeout",
+			opts:     startOptions{PipelineTimeOut: "1h", TasksTimeOut: "40m", FinallyTimeOut: "30m"},
+			expected: "tasks timeout 40m0s and finally timeout 30m0s together must not exceed the pipeline timeout 1h0m0s",
+		},
+	}
+	for _, tp := range tests {
+		t.Run(tp.name, func(t *testing.T) {
+			tp.opts.cliparams = &test.Params{}
+			err := tp.opts.getTimeouts(&v1beta1.PipelineRun{})
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+			test.AssertOutput(t, tp.expected, err.Error())
+		})
+	}
+}
+
+func Test_GetTimeouts_ProfileDefaults(t *testing.T) {
+	p := &test.Params{TknProfile: config.Profile{Timeouts: config.Timeouts{Pipeline: "2h", Finally: "10m"}}}
+
+	opts := startOptions{cliparams: p, TasksTimeOut: "1h"}
+	pr := &v1beta1.PipelineRun{}
+	if err := opts.getTimeouts(pr); err != nil {
+		t.Fatalf("Expected nil, Got err: %v", err)
+	}
+
+	test.AssertOutput(t, "2h0m0s", pr.Spec.Timeouts.Pipeline.Duration.String())
+	test.AssertOutput(t, "1h0m0s", pr.Spec.Timeouts.Tasks.Duration.String())
+	test.AssertOutput(t, "10m0s", pr.Spec.Timeouts.Finally.Duration.String())
+}
+
+func Test_CheckPolicies(t *testing.T) {
+	bin := t.TempDir()
+	opa := `#!/bin/sh
+case "$(cat)" in
+*'"serviceAccountName":"admin"'*) echo '{"result":[{"expressions":[{"value":{"deny":["runs must not use the admin service account"]}}]}]}';;
+*) echo '{"result":[{"expressions":[{"value":{"warn":["no pod template"]}}]}]}';;
+esac
+`
+	if err := os.WriteFile(filepath.Join(bin, "opa"), []byte(opa), 0o755); err != nil { // nolint: gosec
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	tests := []struct {
+		name           string
+		policies       config.Policies
+		serviceAccount string
+		wantErr        string
+		wantWarnings   string
+	}{
+		{
+			name:           "denied",
+			policies:       config.Policies{Files: []string{"runs.rego"}},
+			serviceAccount: "admin",
+			wantErr:        "PipelineRun refused by the policies:\n- runs must not use the admin service account",
+		},
+		{
+			name:           "warned",
+			policies:       config.Policies{Files: []string{"runs.rego"}},
+			serviceAccount: "builder",
+			wantWarnings:   "Warning: no pod template\n",
+		},
+		{
+			name:           "denials only warned about",
+			policies:       config.Policies{Files: []string{"runs.rego"}, WarnOnly: true},
+			serviceAccount: "admin",
+			wantWarnings:   "Warning: runs must not use the admin service account\n",
+		},
+	}
+	for _, tp := range tests {
+		t.Run(tp.name, func(t *testing.T) {
+			stderr := &strings.Builder{}
+			opts := startOptions{
+				cliparams: &test.Params{TknProfile: config.Profile{Policies: tp.policies}},
+				stream:    &cli.Stream{Out: &strings.Builder{}, Err: stderr},
+			}
+			pr := &v1beta1.PipelineRun{Spec: v1beta1.PipelineRunSpec{ServiceAccountName: tp.serviceAccount}}
+			err := opts.checkPolicies(pr)
+			if tp.wantErr != "" {
+				if err == nil {
+					t.Fatal("Expected error, got nil")
+				}
+				test.AssertOutput(t, tp.wantErr, err.Error())
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected nil, Got err: %v", err)
+			}
+			test.AssertOutput(t, tp.wantWarnings, stderr.String())
+		})
+	}
 }
@@ -0,0 +1,122 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	pipelinepkg "github.com/tektoncd/cli/pkg/pipeline"
+	pipelinerunpkg "github.com/tektoncd/cli/pkg/pipelinerun"
+	"github.com/tektoncd/cli/pkg/sarif"
+)
+
+const analyzeTemplate = `{{- if eq (len .Findings) 0 -}}
+No problems found in PipelineRun {{ .Name }}
+{{ else -}}
+LEVEL	RULE	TASK	MESSAGE
+{{ range $_, $f := .Findings -}}
+{{ $f.Rule.Level }}	{{ $f.Rule.ID }}	{{ if $f.Task }}{{ $f.Task }}{{ else }}---{{ end }}	{{ $f.Message }}
+{{ end -}}
+{{- end -}}`
+
+type analyzeOptions struct {
+	Output string
+}
+
+func analyzeCommand(p cli.Params) *cobra.Command {
+	opts := &analyzeOptions{}
+	eg := `Analyze the PipelineRun foo of namespace bar:
+
+    tkn pipelinerun analyze foo -n bar
+
+Write the findings in SARIF for GitHub code scanning:
+
+    tkn pr analyze foo -o sarif > tkn.sarif
+`
+
+	c := &cobra.Command{
+		Use:   "analyze PIPELINERUN",
+		Short: "Checks a PipelineRun for failed, timed out, flaky and skipped tasks",
+		Long: `Checks a PipelineRun for its failed, timed out, retried and skipped tasks,
+and the Pipeline it ran for the mistakes found by tkn pipeline lint.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:              cobra.ExactArgs(1),
+		Example:           eg,
+		SilenceUsage:      true,
+		ValidArgsFunction: formatted.ParentCompletion,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Output != "" && opts.Output != "sarif" {
+				return fmt.Errorf("invalid output format %q, only sarif is supported", opts.Output)
+			}
+
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+			pr, err := pipelinerunpkg.GetPipelineRun(pipelineRunGroupResource, cs, args[0], p.Namespace())
+			if err != nil {
+				return cli.WithCause(err, "failed to find PipelineRun %s", args[0])
+			}
+
+			findings, err := pipelinerunpkg.Analyze(cs, pr)
+			if err != nil {
+				return fmt.Errorf("failed to analyze PipelineRun %s: %w", pr.Name, err)
+			}
+			if opts.Output == "sarif" {
+				err = pipelinepkg.SARIF("tkn-pipelinerun-analyze", pipelinerunpkg.AnalyzeRules, findings, "pipelineruns/"+pr.Name, "").Write(cmd.OutOrStdout())
+			} else {
+				err = printAnalysis(cmd.OutOrStdout(), pr.Name, findings)
+			}
+			if err != nil {
+				return err
+			}
+
+			errs := 0
+			for _, f := range findings {
+				if f.Rule.Level == sarif.LevelError {
+					errs++
+				}
+			}
+			if errs != 0 {
+				return fmt.Errorf("found %d error(s) in PipelineRun %s", errs, pr.Name)
+			}
+			return nil
+		},
+	}
+
+	c.Flags().StringVarP(&opts.Output, "output", "o", "", "output format, the only format supported is sarif")
+	return c
+}
+
+func printAnalysis(out io.Writer, name string, findings []pipelinepkg.Finding) error {
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	t := template.Must(template.New("Analyze").Parse(analyzeTemplate))
+	data := struct {
+		Name     string
+		Findings []pipelinepkg.Finding
+	}{name, findings}
+	if err := t.Execute(w, data); err != nil {
+		return err
+	}
+	return w.Flush()
+}
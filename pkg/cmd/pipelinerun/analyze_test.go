@@ -0,0 +1,159 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/golden"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestPipelineRunAnalyze(t *testing.T) {
+	ns := "ns"
+	taskRun := func(task string, status corev1.ConditionStatus, reason, message string, retries int) *v1.TaskRun {
+		tr := &v1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "build-1-" + task},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: status, Reason: reason, Message: message}},
+				},
+			},
+		}
+		for i := 0; i < retries; i++ {
+			tr.Status.RetriesStatus = append(tr.Status.RetriesStatus, v1.TaskRunStatus{})
+		}
+		return tr
+	}
+	trs := []*v1.TaskRun{
+		taskRun("compile", corev1.ConditionFalse, "Failed", `"step-build" exited with code 2`, 0),
+		taskRun("test", corev1.ConditionFalse, v1.TaskRunReasonTimedOut.String(), "TaskRun build-1-test failed to finish within 10m0s", 0),
+		taskRun("deploy", corev1.ConditionTrue, "Succeeded", "", 2),
+		taskRun("cleanup", corev1.ConditionFalse, v1.TaskRunReasonCancelled.String(), "TaskRun build-1-cleanup was cancelled", 0),
+	}
+
+	children := []v1.ChildStatusReference{}
+	for _, task := range []string{"compile", "test", "deploy", "cleanup"} {
+		children = append(children, v1.ChildStatusReference{
+			Name:             "build-1-" + task,
+			PipelineTaskName: task,
+			TypeMeta:         runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"},
+		})
+	}
+	prs := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "build-1"},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+			Status: v1.PipelineRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionFalse, Reason: "Failed"}},
+				},
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					ChildReferences: children,
+					PipelineSpec: &v1.PipelineSpec{
+						Params: v1.ParamSpecs{{Name: "revision"}},
+						Tasks: []v1.PipelineTask{
+							{Name: "compile", TaskRef: &v1.TaskRef{Name: "compile"}},
+							{Name: "test", TaskRef: &v1.TaskRef{Name: "test"}},
+							{Name: "deploy", TaskRef: &v1.TaskRef{Name: "deploy"}},
+							{Name: "cleanup", TaskRef: &v1.TaskRef{Name: "cleanup"}},
+							{Name: "notify", TaskRef: &v1.TaskRef{Name: "notify"}},
+						},
+					},
+					SkippedTasks: []v1.SkippedTask{{Name: "notify", Reason: v1.WhenExpressionsSkip}},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "build-2"},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+			Status: v1.PipelineRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue, Reason: "Succeeded"}},
+				},
+			},
+		},
+	}
+
+	version := "v1"
+	objs := []runtime.Object{cb.UnstructuredPR(prs[0], version), cb.UnstructuredPR(prs[1], version)}
+	for _, tr := range trs {
+		objs = append(objs, cb.UnstructuredTR(tr, version))
+	}
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(objs...)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Namespaces:   []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: ns}}},
+		PipelineRuns: prs,
+		TaskRuns:     trs,
+	})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun", "taskrun"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "text",
+			args:    []string{"analyze", "build-1", "-n", ns},
+			wantErr: "found 2 error(s) in PipelineRun build-1",
+		},
+		{
+			name:    "sarif",
+			args:    []string{"analyze", "build-1", "-n", ns, "-o", "sarif"},
+			wantErr: "found 2 error(s) in PipelineRun build-1",
+		},
+		{
+			name: "no problems",
+			args: []string{"analyze", "build-2", "-n", ns},
+		},
+		{
+			name:    "invalid output",
+			args:    []string{"analyze", "build-2", "-n", ns, "-o", "json"},
+			wantErr: `invalid output format "json", only sarif is supported`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(Command(p), tt.args...)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.wantErr != "" {
+				if err == nil {
+					t.Fatalf("expected error %q", tt.wantErr)
+				}
+				test.AssertOutput(t, tt.wantErr, err.Error())
+			}
+			golden.Assert(t, got, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+		})
+	}
+}
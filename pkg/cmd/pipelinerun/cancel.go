@@ -17,30 +17,39 @@ package pipelinerun
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/audit"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/i18n"
 	pipelinerunpkg "github.com/tektoncd/cli/pkg/pipelinerun"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
 )
 
 func cancelCommand(p cli.Params) *cobra.Command {
 	eg := `Cancel the PipelineRun named 'foo' from namespace 'bar':
 
     tkn pipelinerun cancel foo -n bar
+
+Stop scheduling the tasks of the PipelineRun named 'foo', let the running ones
+complete, run its finally tasks and wait until it has finished:
+
+    tkn pipelinerun cancel foo --grace stop --wait -n bar
 `
 
 	graceCancelDescription := `Gracefully cancel a PipelineRun
 To use this, you need to change the feature-flags configmap enable-api-fields to alpha instead of stable.
-Set to 'CancelledRunFinally' if you want to cancel the current running task and directly run the finally tasks.
-Set to 'StoppedRunFinally' if you want to cancel the remaining non-final task and directly run the finally tasks.
+Set to 'CancelledRunFinally' (or 'cancel') if you want to cancel the current running task and directly run the finally tasks.
+Set to 'StoppedRunFinally' (or 'stop') if you want to cancel the remaining non-final task and directly run the finally tasks.
 `
 
-	graceCancelStatus := ""
+	opts := &cancelOptions{}
 
 	c := &cobra.Command{
 		Use:     "cancel",
@@ -61,15 +70,42 @@ Set to 'StoppedRunFinally' if you want to cancel the remaining non-final task an
 				Err: cmd.OutOrStderr(),
 			}
 
-			return cancelPipelineRun(p, s, pr, graceCancelStatus)
+			return cancelPipelineRun(p, s, pr, opts)
 		},
 	}
 
-	c.Flags().StringVarP(&graceCancelStatus, "grace", "", "", graceCancelDescription)
+	c.Flags().StringVarP(&opts.Grace, "grace", "", "", graceCancelDescription)
+	c.Flags().BoolVar(&opts.Wait, "wait", false, "wait until the PipelineRun has finished, e.g. once its finally tasks are done")
+	c.Flags().DurationVar(&opts.Timeout, "timeout", 10*time.Minute, "how long --wait waits for the PipelineRun to finish")
 	return c
 }
 
-func cancelPipelineRun(p cli.Params, s *cli.Stream, prName string, graceCancelStatus string) error {
+type cancelOptions struct {
+	Grace   string
+	Wait    bool
+	Timeout time.Duration
+}
+
+// cancelStatus returns the spec.status cancelling the PipelineRun
+// according to the --grace mode
+func (o *cancelOptions) cancelStatus() (string, error) {
+	switch strings.ToLower(o.Grace) {
+	case "":
+		return v1.PipelineRunSpecStatusCancelled, nil
+	case "cancel", strings.ToLower(v1.PipelineRunSpecStatusCancelledRunFinally):
+		return v1.PipelineRunSpecStatusCancelledRunFinally, nil
+	case "stop", strings.ToLower(v1.PipelineRunSpecStatusStoppedRunFinally):
+		return v1.PipelineRunSpecStatusStoppedRunFinally, nil
+	}
+	return "", fmt.Errorf("invalid value %q for --grace, use %s (cancel) or %s (stop)", o.Grace, v1.PipelineRunSpecStatusCancelledRunFinally, v1.PipelineRunSpecStatusStoppedRunFinally)
+}
+
+func cancelPipelineRun(p cli.Params, s *cli.Stream, prName string, opts *cancelOptions) error {
+	cancelStatus, err := opts.cancelStatus()
+	if err != nil {
+		return err
+	}
+
 	cs, err := p.Clients()
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
@@ -78,7 +114,7 @@ func cancelPipelineRun(p cli.Params, s *cli.Stream, prName string, graceCancelSt
 	var pr *v1.PipelineRun
 	err = actions.GetV1(pipelineRunGroupResource, cs, prName, p.Namespace(), metav1.GetOptions{}, &pr)
 	if err != nil {
-		return fmt.Errorf("failed to find PipelineRun: %s", prName)
+		return cli.WithCause(err, "failed to find PipelineRun: %s", prName)
 	}
 
 	if len(pr.Status.Conditions) > 0 {
@@ -87,18 +123,20 @@ func cancelPipelineRun(p cli.Params, s *cli.Stream, prName string, graceCancelSt
 		}
 	}
 
-	cancelStatus := v1.PipelineRunSpecStatusCancelled
-	switch strings.ToLower(graceCancelStatus) {
-	case strings.ToLower(v1.PipelineRunSpecStatusCancelledRunFinally):
-		cancelStatus = v1.PipelineRunSpecStatusCancelledRunFinally
-	case strings.ToLower(v1.PipelineRunSpecStatusStoppedRunFinally):
-		cancelStatus = v1.PipelineRunSpecStatusStoppedRunFinally
+	if _, err = pipelinerunpkg.Cancel(cs, prName, metav1.PatchOptions{}, cancelStatus, p.Namespace()); err != nil {
+		return fmt.Errorf("failed to cancel PipelineRun: %s: %w", prName, err)
 	}
 
-	if _, err = pipelinerunpkg.Cancel(cs, prName, metav1.PatchOptions{}, cancelStatus, p.Namespace()); err != nil {
-		return fmt.Errorf("failed to cancel PipelineRun: %s: %v", prName, err)
+	audit.Record(p, audit.ActionCancel, "PipelineRun", pr.Name)
+	i18n.Fprintf(s.Out, "PipelineRun cancelled: %s\n", pr.Name)
+	if !opts.Wait {
+		return nil
 	}
 
-	fmt.Fprintf(s.Out, "PipelineRun cancelled: %s\n", pr.Name)
+	pr, err = pipelinerunpkg.WaitForCompletion(cs, prName, p.Namespace(), opts.Timeout)
+	if err != nil {
+		return err
+	}
+	i18n.Fprintf(s.Out, "PipelineRun %s finished: %s\n", pr.Name, pr.Status.GetCondition(apis.ConditionSucceeded).Reason)
 	return nil
 }
@@ -15,6 +15,7 @@
 package pipelinerun
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -28,6 +29,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	k8stest "k8s.io/client-go/testing"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -1377,3 +1381,100 @@ func Test_cancel_pipelinerun(t *testing.T) {
 	expected := "PipelineRun cancelled: " + prName + "\n"
 	tu.AssertOutput(t, expected, got)
 }
+
+func Test_cancel_pipelinerun_grace_wait(t *testing.T) {
+	prName := "test-pipeline-run-123"
+	running := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      prName,
+			Namespace: "ns",
+		},
+		Spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "pipelineName"},
+		},
+		Status: v1.PipelineRunStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{
+					{Status: corev1.ConditionUnknown, Type: apis.ConditionSucceeded, Reason: "Running"},
+				},
+			},
+		},
+	}
+	stopped := running.DeepCopy()
+	stopped.Spec.Status = v1.PipelineRunSpecStatusStoppedRunFinally
+	stopped.Status.Conditions = duckv1.Conditions{
+		{Status: corev1.ConditionFalse, Type: apis.ConditionSucceeded, Reason: v1.PipelineRunReasonCancelled.String()},
+	}
+
+	testParams := []struct {
+		name      string
+		command   []string
+		finish    bool
+		want      string
+		wantError string
+	}{
+		{
+			name:    "stop and wait",
+			command: []string{"cancel", prName, "-n", "ns", "--grace", "stop", "--wait"},
+			finish:  true,
+			want:    "PipelineRun cancelled: test-pipeline-run-123\nPipelineRun test-pipeline-run-123 finished: Cancelled\n",
+		},
+		{
+			name:      "timeout",
+			command:   []string{"cancel", prName, "-n", "ns", "--grace", "cancel", "--wait", "--timeout", "10ms"},
+			wantError: "timed out after 10ms waiting for PipelineRun test-pipeline-run-123 to finish",
+		},
+		{
+			name:      "invalid grace",
+			command:   []string{"cancel", prName, "-n", "ns", "--grace", "later"},
+			wantError: `invalid value "later" for --grace, use CancelledRunFinally (cancel) or StoppedRunFinally (stop)`,
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: []*v1.PipelineRun{running}})
+			cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun"})
+			watcher := watch.NewFakeWithChanSize(1, false)
+			tdc := testDynamic.Options{WatchResource: "pipelineruns", Watcher: watcher}
+			var dc dynamic.Interface
+			if tp.finish {
+				tdc.PrependReactors = []testDynamic.PrependOpt{{
+					Verb:     "patch",
+					Resource: "pipelineruns",
+					Action: func(_ k8stest.Action) (bool, runtime.Object, error) {
+						// the run finishes once it is cancelled and its
+						// finally tasks are done
+						go func() {
+							obj := cb.UnstructuredPR(stopped, version)
+							gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: version, Resource: "pipelineruns"}
+							if _, err := dc.Resource(gvr).Namespace("ns").Update(context.Background(), obj, metav1.UpdateOptions{}); err != nil {
+								t.Errorf("unable to update the PipelineRun: %v", err)
+							}
+							watcher.Modify(obj)
+						}()
+						return false, nil, nil
+					},
+				}}
+			}
+			dc, err := tdc.Client(cb.UnstructuredPR(running, version))
+			if err != nil {
+				t.Errorf("unable to create dynamic client: %v", err)
+			}
+			p := &tu.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+			got, err := tu.ExecuteCommand(Command(p), tp.command...)
+			if tp.wantError != "" {
+				if err == nil {
+					t.Fatal("error expected here")
+				}
+				tu.AssertOutput(t, tp.wantError, err.Error())
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tu.AssertOutput(t, tp.want, got)
+		})
+	}
+}
@@ -66,6 +66,14 @@ func deleteCommand(p cli.Params) *cobra.Command {
 or
 
     tkn pr rm foo bar -n quux
+
+Delete PipelineRun 'foo' but keep the resources it created, e.g. the PVCs of its volumeClaimTemplates:
+
+    tkn pr rm foo --cascade orphan -n quux
+
+Delete PipelineRun 'foo' and wait until its finalizers have run:
+
+    tkn pr rm foo --wait -n quux
 `
 
 	c := &cobra.Command{
@@ -99,6 +107,10 @@ or
 				return fmt.Errorf("keep-since option should not be lower than 0")
 			}
 
+			if _, err := opts.DeleteOptions(); err != nil {
+				return err
+			}
+
 			if (opts.Keep > 0 || opts.KeepSince > 0) && opts.ParentResourceName == "" {
 				opts.DeleteAllNs = true
 			}
@@ -129,6 +141,9 @@ or
 	c.Flags().IntVarP(&opts.KeepSince, "keep-since", "", 0, "When deleting all PipelineRuns keep the ones that has been completed since n minutes")
 	c.Flags().BoolVarP(&opts.IgnoreRunning, "ignore-running", "i", true, "ignore running PipelineRun")
 	c.Flags().BoolVarP(&opts.DeleteAllNs, "all", "", false, "Delete all PipelineRuns in a namespace (default: false)")
+	c.Flags().StringVar(&opts.Cascade, "cascade", options.CascadeBackground, "what happens to the TaskRuns, pods and PVCs of volumeClaimTemplates the PipelineRuns own: background deletes them after the PipelineRuns, foreground before them, orphan keeps them")
+	c.Flags().BoolVar(&opts.Wait, "wait", false, "wait until the deleted PipelineRuns are gone, i.e. their finalizers have run")
+	c.Flags().DurationVar(&opts.WaitTimeout, "wait-timeout", 5*time.Minute, "how long --wait waits for the PipelineRuns to be gone")
 	c.Flags().StringVarP(&opts.LabelSelector, "label", "", opts.LabelSelector, "A selector (label query) to filter on when running with --all, supports '=', '==', and '!='")
 	return c
 }
@@ -141,11 +156,15 @@ func deletePipelineRuns(s *cli.Stream, p cli.Params, prNames []string, opts *opt
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
 	}
+	delOpts, err := opts.DeleteOptions()
+	if err != nil {
+		return err
+	}
 	var d *deleter.Deleter
 	switch {
 	case opts.DeleteAllNs:
 		d = deleter.New("PipelineRun", func(pipelineRunName string) error {
-			return actions.Delete(prGroupResource, cs.Dynamic, cs.Tekton.Discovery(), pipelineRunName, p.Namespace(), metav1.DeleteOptions{})
+			return actions.DeleteV1(prGroupResource, cs, pipelineRunName, p.Namespace(), delOpts)
 		})
 		prtodelete, prtokeep, err := allPipelineRunNames(cs, opts.Keep, opts.KeepSince, opts.IgnoreRunning, opts.LabelSelector, p.Namespace())
 		if err != nil {
@@ -156,7 +175,7 @@ func deletePipelineRuns(s *cli.Stream, p cli.Params, prNames []string, opts *opt
 		d.Delete(prtodelete)
 	case opts.ParentResourceName == "":
 		d = deleter.New("PipelineRun", func(pipelineRunName string) error {
-			return actions.Delete(prGroupResource, cs.Dynamic, cs.Tekton.Discovery(), pipelineRunName, p.Namespace(), metav1.DeleteOptions{})
+			return actions.DeleteV1(prGroupResource, cs, pipelineRunName, p.Namespace(), delOpts)
 		})
 		d.Delete(prNames)
 	default:
@@ -178,7 +197,7 @@ func deletePipelineRuns(s *cli.Stream, p cli.Params, prNames []string, opts *opt
 
 		// Delete the PipelineRuns associated with a Pipeline
 		d.WithRelated("PipelineRun", pipelineRunLister(cs, opts.Keep, opts.KeepSince, p.Namespace(), opts.IgnoreRunning), func(pipelineRunName string) error {
-			return actions.Delete(prGroupResource, cs.Dynamic, cs.Tekton.Discovery(), pipelineRunName, p.Namespace(), metav1.DeleteOptions{})
+			return actions.DeleteV1(prGroupResource, cs, pipelineRunName, p.Namespace(), delOpts)
 		})
 
 		if len(prtodelete) == 0 && opts.Keep > 0 && opts.Keep == len(prtokeep) {
@@ -192,6 +211,13 @@ func deletePipelineRuns(s *cli.Stream, p cli.Params, prNames []string, opts *opt
 		d.DeleteRelated([]string{opts.ParentResourceName})
 	}
 
+	d.Audit(p)
+	if opts.Wait {
+		d.Wait("PipelineRun", func(names []string) error {
+			return actions.WaitForDeletion(prGroupResource, cs.Dynamic, cs.Tekton.Discovery(), names, p.Namespace(), opts.WaitTimeout)
+		})
+	}
+
 	if !opts.DeleteAllNs {
 		if d.Errors() == nil {
 			switch {
@@ -28,7 +28,9 @@ import (
 	pipelinetest "github.com/tektoncd/pipeline/test"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic"
+	k8stest "k8s.io/client-go/testing"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
@@ -1296,3 +1298,81 @@ func TestPipelineRunDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelineRunDelete_cascade(t *testing.T) {
+	version := "v1"
+	prdata := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "pipeline-run-1",
+			},
+		},
+	}
+
+	testParams := []struct {
+		name      string
+		command   []string
+		keep      bool
+		want      string
+		wantError string
+	}{
+		{
+			name:    "foreground and wait",
+			command: []string{"rm", "pipeline-run-1", "-n", "ns", "-f", "--cascade", "foreground", "--wait"},
+			want:    "PipelineRuns deleted: \"pipeline-run-1\"\n",
+		},
+		{
+			name:    "orphan",
+			command: []string{"rm", "pipeline-run-1", "-n", "ns", "-f", "--cascade", "orphan"},
+			want:    "PipelineRuns deleted: \"pipeline-run-1\"\n",
+		},
+		{
+			name:      "invalid cascade",
+			command:   []string{"rm", "pipeline-run-1", "-n", "ns", "-f", "--cascade", "all"},
+			wantError: `invalid value "all" for --cascade, use background, foreground or orphan`,
+		},
+		{
+			name:      "wait for finalizers",
+			command:   []string{"rm", "pipeline-run-1", "-n", "ns", "-f", "--wait", "--wait-timeout", "10ms"},
+			keep:      true,
+			wantError: `timed out after 10ms waiting for the deletion of pipelineruns "pipeline-run-1"`,
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prdata})
+			cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun"})
+
+			tdc := testDynamic.Options{
+				PrependReactors: []testDynamic.PrependOpt{{
+					Verb:     "delete",
+					Resource: "pipelineruns",
+					Action: func(_ k8stest.Action) (bool, runtime.Object, error) {
+						// a run kept by its finalizers is not removed
+						return tp.keep, nil, nil
+					},
+				}},
+			}
+			dc, err := tdc.Client(cb.UnstructuredPR(prdata[0], version))
+			if err != nil {
+				t.Errorf("unable to create dynamic client: %v", err)
+			}
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+			out, err := test.ExecuteCommand(Command(p), tp.command...)
+			if tp.wantError != "" {
+				if err == nil {
+					t.Fatal("error expected here")
+				}
+				test.AssertOutput(t, tp.wantError, err.Error())
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			test.AssertOutput(t, tp.want, out)
+		})
+	}
+}
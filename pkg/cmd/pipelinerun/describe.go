@@ -15,13 +15,18 @@
 package pipelinerun
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/daemon"
+	"github.com/tektoncd/cli/pkg/dashboard"
+	"github.com/tektoncd/cli/pkg/export"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/cli/pkg/options"
 	pipelinerunpkg "github.com/tektoncd/cli/pkg/pipelinerun"
@@ -43,6 +48,18 @@ func describeCommand(p cli.Params) *cobra.Command {
 or
 
     tkn pr desc foo -n bar
+
+Print the links to the PipelineRun 'foo' and to its TaskRuns in the Tekton Dashboard:
+
+    tkn pr desc foo --link
+
+Describe the PipelineRuns of namespace 'bar' created in the last 24 hours, along with their TaskRuns, as one JSON array:
+
+    tkn pr desc --all --since 24h -o json -n bar
+
+Print the PipelineRun 'foo' as YAML with only the names of its TaskRuns in its status:
+
+    tkn pr desc foo -o yaml --skip-status-taskruns
 `
 
 	c := &cobra.Command{
@@ -63,7 +80,33 @@ or
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
+			}
+			if output != "" && opts.Link {
+				return fmt.Errorf("--link cannot be used with --output")
+			}
+			if opts.History && (output != "" || opts.Link) {
+				return fmt.Errorf("--history cannot be used with --output or --link")
+			}
+			if opts.Scheduling && (output != "" || opts.Link) {
+				return fmt.Errorf("--scheduling cannot be used with --output or --link")
+			}
+			if output == "" && opts.Clean {
+				return fmt.Errorf("--clean can only be used with --output")
+			}
+			if output == "" && opts.SkipStatusTaskRuns {
+				return fmt.Errorf("--skip-status-taskruns can only be used with --output")
+			}
+			if opts.Since != 0 && !opts.All {
+				return fmt.Errorf("--since can only be used with --all")
+			}
+			if opts.All {
+				if output != "json" {
+					return fmt.Errorf("--all can only be used with --output json")
+				}
+				if len(args) != 0 || opts.Last {
+					return fmt.Errorf("--all cannot be used with a PipelineRun name or --last")
+				}
 			}
 
 			if !opts.Fzf {
@@ -72,11 +115,15 @@ or
 				}
 			}
 
-			cs, err := p.Clients()
+			cs, err := daemon.Clients(p)
 			if err != nil {
 				return err
 			}
 
+			if opts.All {
+				return describeAll(s, p, cs, opts)
+			}
+
 			if len(args) == 0 {
 				lOpts := metav1.ListOptions{}
 				if !opts.Last {
@@ -112,16 +159,63 @@ or
 				if err != nil {
 					return err
 				}
-				return actions.PrintObjectV1(pipelineRunGroupResource, opts.PipelineRunName, cmd.OutOrStdout(), cs, printer, p.Namespace())
+				obj, err := actions.GetUnstructured(pipelineRunGroupResource, cs, opts.PipelineRunName, p.Namespace(), metav1.GetOptions{})
+				if err != nil {
+					return err
+				}
+				// the object is printed as stored, unless asked to be cleaned up,
+				// managedFields are only kept with --show-managed-fields
+				if opts.Clean {
+					export.RemoveServerFields(obj)
+				}
+				if opts.SkipStatusTaskRuns {
+					export.CompactChildReferences(obj)
+				}
+				// the YAML of a PipelineRun with hundreds of TaskRuns is written
+				// field by field rather than built whole
+				if output == "yaml" {
+					if !f.JSONYamlPrintFlags.ShowManagedFields {
+						obj.SetManagedFields(nil)
+					}
+					return export.StreamYAML(cmd.OutOrStdout(), obj.Object)
+				}
+				return printer.PrintObj(obj, cmd.OutOrStdout())
+			}
+
+			if opts.Link {
+				links, err := dashboard.FromProfile(p)
+				if err != nil {
+					return err
+				}
+				return pipelinerunpkg.PrintPipelineRunLinks(s.Out, cs, opts.Params.Namespace(), opts.PipelineRunName, links)
 			}
 
-			return pipelinerunpkg.PrintPipelineRunDescription(s.Out, cs, opts.Params.Namespace(), opts.PipelineRunName, opts.Params.Time())
+			if err := pipelinerunpkg.PrintPipelineRunDescription(s.Out, cs, opts.Params.Namespace(), opts.PipelineRunName, opts.Params.Time()); err != nil {
+				return err
+			}
+			if opts.History {
+				if err := pipelinerunpkg.PrintPipelineRunConditionHistory(s.Out, cs, opts.Params.Namespace(), opts.PipelineRunName, opts.Params.Time()); err != nil {
+					return err
+				}
+			}
+			if opts.Scheduling {
+				return pipelinerunpkg.PrintPipelineRunScheduling(s.Out, cs, opts.Params.Namespace(), opts.PipelineRunName)
+			}
+			return nil
 		},
 	}
 
 	c.Flags().BoolVarP(&opts.Last, "last", "L", false, "show description for last PipelineRun")
 	c.Flags().IntVarP(&opts.Limit, "limit", "", defaultDescribeLimit, "lists number of PipelineRuns when selecting a PipelineRun to describe")
 	c.Flags().BoolVarP(&opts.Fzf, "fzf", "F", false, "use fzf to select a PipelineRun to describe")
+	c.Flags().BoolVar(&opts.Link, "link", false, "print the link to the PipelineRun and to its TaskRuns in the Tekton Dashboard set in the tkn profile instead of describing it")
+	c.Flags().BoolVar(&opts.Scheduling, "scheduling", false, "show the nodes the pods of the TaskRuns of the PipelineRun were scheduled on, how long it took, the node selector and tolerations used and whether a taint, an affinity or a lack of resources delayed it, after its description")
+	c.Flags().BoolVar(&opts.History, "history", false, "show the transitions of the condition of the PipelineRun, from the events recorded for it, after its description")
+	c.Flags().BoolVarP(&opts.Clean, "clean", "", false, "strip the fields set by the server (status, uid, resourceVersion...) when printing with --output, so the output can be edited and applied again")
+	c.Flags().BoolVar(&opts.SkipStatusTaskRuns, "skip-status-taskruns", false, "keep only the kind, name and pipeline task of the child references in the status of the PipelineRun when printing with --output, replacing the statuses of TaskRuns embedded by v1beta1, to keep the output of runs with many TaskRuns small")
+
+	c.Flags().BoolVar(&opts.All, "all", false, "describe all the PipelineRuns of the namespace along with their TaskRuns as one JSON array, reading them with a single list each, requires --output json")
+	c.Flags().DurationVar(&opts.Since, "since", 0, "only describe the PipelineRuns created within this duration with --all, e.g. 24h")
 
 	f.AddFlags(c)
 
@@ -149,3 +243,31 @@ func askPipelineRunName(opts *options.DescribeOptions, prs []string) error {
 
 	return nil
 }
+
+func describeAll(s *cli.Stream, p cli.Params, cs *cli.Clients, opts *options.DescribeOptions) error {
+	var since time.Time
+	if opts.Since > 0 {
+		since = p.Time().Now().Add(-opts.Since)
+	}
+	described, err := pipelinerunpkg.DescribeAll(cs, p.Namespace(), since)
+	if err != nil {
+		return err
+	}
+	if opts.SkipStatusTaskRuns {
+		for _, d := range described {
+			export.CompactChildReferences(d.PipelineRun)
+		}
+	}
+	if opts.Clean {
+		for _, d := range described {
+			export.RemoveServerFields(d.PipelineRun)
+			for _, tr := range d.TaskRuns {
+				export.RemoveServerFields(tr)
+			}
+		}
+	}
+
+	enc := json.NewEncoder(s.Out)
+	enc.SetIndent("", "    ")
+	return enc.Encode(described)
+}
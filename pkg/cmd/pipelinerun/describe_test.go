@@ -2687,3 +2687,199 @@ func TestPipelineRunDescribe_with_annotations_v1beta1(t *testing.T) {
 	}
 	golden.Assert(t, actual, fmt.Sprintf("%s.golden", t.Name()))
 }
+
+func TestPipelineRunDescribe_with_provenance_v1beta1(t *testing.T) {
+	clock := test.FakeClock()
+	// {"predicate":{"runDetails":{"builder":{"id":"https://tekton.dev/chains/v2"}}}}
+	payload := "eyJwcmVkaWNhdGUiOnsicnVuRGV0YWlscyI6eyJidWlsZGVyIjp7ImlkIjoiaHR0cHM6Ly90ZWt0b24uZGV2L2NoYWlucy92MiJ9fX19"
+	trs := []*v1beta1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "tr-1",
+				Labels: map[string]string{
+					"tekton.dev/task":         "task-1",
+					"tekton.dev/pipelineTask": "t-1",
+				},
+			},
+			Spec: v1beta1.TaskRunSpec{
+				TaskRef: &v1beta1.TaskRef{
+					ResolverRef: v1beta1.ResolverRef{Resolver: "bundles"},
+				},
+			},
+			Status: v1beta1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Status: corev1.ConditionTrue,
+							Reason: v1beta1.TaskRunReasonSuccessful.String(),
+						},
+					},
+				},
+				TaskRunStatusFields: v1beta1.TaskRunStatusFields{
+					StartTime:      &metav1.Time{Time: clock.Now()},
+					CompletionTime: &metav1.Time{Time: clock.Now().Add(5 * time.Minute)},
+					Provenance: &v1beta1.Provenance{
+						RefSource: &v1beta1.RefSource{
+							URI:    "gcr.io/tekton-releases/catalog/upstream/git-clone:0.9",
+							Digest: map[string]string{"sha256": "b7da9a1f"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	prun := []*v1beta1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pipeline-run",
+				Namespace: "ns",
+				Annotations: map[string]string{
+					"tekton.dev/tags":                            "testing",
+					"chains.tekton.dev/signed":                   "true",
+					"chains.tekton.dev/transparency":             "https://rekor.sigstore.dev/api/v1/log/entries?logIndex=42",
+					"chains.tekton.dev/payload-pipelinerun-0a":   payload,
+					"chains.tekton.dev/signature-pipelinerun-0a": "MEUCIQ",
+				},
+			},
+			Spec: v1beta1.PipelineRunSpec{
+				PipelineRef: &v1beta1.PipelineRef{
+					ResolverRef: v1beta1.ResolverRef{Resolver: "git"},
+				},
+			},
+			Status: v1beta1.PipelineRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Status: corev1.ConditionTrue,
+							Reason: v1beta1.PipelineRunReasonSuccessful.String(),
+						},
+					},
+				},
+				PipelineRunStatusFields: v1beta1.PipelineRunStatusFields{
+					StartTime:      &metav1.Time{Time: clock.Now()},
+					CompletionTime: &metav1.Time{Time: clock.Now().Add(5 * time.Minute)},
+					ChildReferences: []v1beta1.ChildStatusReference{
+						{
+							Name:             "tr-1",
+							PipelineTaskName: "t-1",
+							TypeMeta: runtime.TypeMeta{
+								Kind: "TaskRun",
+							},
+						},
+					},
+					Provenance: &v1beta1.Provenance{
+						RefSource: &v1beta1.RefSource{
+							URI:        "git+https://github.com/tektoncd/catalog.git@refs/heads/main",
+							Digest:     map[string]string{"sha1": "3c5e0b5d"},
+							EntryPoint: "pipeline/build/0.1/build.yaml",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	namespaces := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	version := "v1beta1"
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(
+		cb.UnstructuredV1beta1PR(prun[0], version),
+		cb.UnstructuredV1beta1TR(trs[0], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	cs, _ := test.SeedV1beta1TestData(t, test.Data{Namespaces: namespaces, PipelineRuns: prun, TaskRuns: trs})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun", "taskrun"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic, Clock: clock}
+
+	pipelinerun := Command(p)
+	clock.Advance(10 * time.Minute)
+	actual, err := test.ExecuteCommand(pipelinerun, "desc", "pipeline-run", "-n", "ns")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	golden.Assert(t, actual, fmt.Sprintf("%s.golden", t.Name()))
+}
+
+func TestPipelineRunDescribe_link(t *testing.T) {
+	prun := []*v1beta1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pipeline-run",
+				Namespace: "ns",
+			},
+			Spec: v1beta1.PipelineRunSpec{
+				PipelineRef: &v1beta1.PipelineRef{
+					Name: "pipeline",
+				},
+			},
+			Status: v1beta1.PipelineRunStatus{
+				PipelineRunStatusFields: v1beta1.PipelineRunStatusFields{
+					ChildReferences: []v1beta1.ChildStatusReference{
+						{
+							Name:             "pipeline-run-build",
+							PipelineTaskName: "build",
+							TypeMeta: runtime.TypeMeta{
+								Kind: "TaskRun",
+							},
+						},
+						{
+							Name:             "pipeline-run-deploy",
+							PipelineTaskName: "deploy",
+							TypeMeta: runtime.TypeMeta{
+								Kind: "TaskRun",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	namespaces := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	version := "v1beta1"
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(
+		cb.UnstructuredV1beta1PR(prun[0], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	cs, _ := test.SeedV1beta1TestData(t, test.Data{Namespaces: namespaces, PipelineRuns: prun})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun", "taskrun"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic}
+
+	_, err = test.ExecuteCommand(Command(p), "desc", "pipeline-run", "-n", "ns", "--link")
+	if err == nil || !strings.Contains(err.Error(), "no Tekton Dashboard is configured") {
+		t.Errorf("expected an error as no dashboard is configured, got %v", err)
+	}
+
+	p.TknProfile.Dashboard.URL = "https://dashboard.example.com"
+	actual, err := test.ExecuteCommand(Command(p), "desc", "pipeline-run", "-n", "ns", "--link")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	golden.Assert(t, actual, fmt.Sprintf("%s.golden", t.Name()))
+
+	_, err = test.ExecuteCommand(Command(p), "desc", "pipeline-run", "-n", "ns", "--link", "-o", "yaml")
+	if err == nil || err.Error() != "--link cannot be used with --output" {
+		t.Errorf("expected an error as --link is used with --output, got %v", err)
+	}
+}
@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
+	k8stest "k8s.io/client-go/testing"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
@@ -311,6 +312,143 @@ func TestPipelineRunDescribe_multiple_taskrun_ordering(t *testing.T) {
 
 }
 
+func TestPipelineRunDescribe_finally_taskrun(t *testing.T) {
+	clock := test.FakeClock()
+
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tr-1",
+				Namespace: "ns",
+			},
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					StartTime:      &metav1.Time{Time: clock.Now().Add(2 * time.Minute)},
+					CompletionTime: &metav1.Time{Time: clock.Now().Add(5 * time.Minute)},
+				},
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Status: corev1.ConditionTrue,
+							Type:   apis.ConditionSucceeded,
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tr-2",
+				Namespace: "ns",
+			},
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					StartTime:      &metav1.Time{Time: clock.Now().Add(5 * time.Minute)},
+					CompletionTime: &metav1.Time{Time: clock.Now().Add(9 * time.Minute)},
+				},
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Status: corev1.ConditionTrue,
+							Type:   apis.ConditionSucceeded,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pipelineRuns := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "pipeline-run",
+				Namespace:         "ns",
+				CreationTimestamp: metav1.Time{Time: clock.Now()},
+				Labels:            map[string]string{"tekton.dev/pipeline": "pipeline"},
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{
+					Name: "pipeline",
+				},
+				Timeouts: &v1.TimeoutFields{
+					Pipeline: &metav1.Duration{Duration: 1 * time.Hour},
+				},
+			},
+			Status: v1.PipelineRunStatus{
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					PipelineSpec: &v1.PipelineSpec{
+						Tasks: []v1.PipelineTask{
+							{Name: "t-1", TaskRef: &v1.TaskRef{Name: "task"}},
+						},
+						Finally: []v1.PipelineTask{
+							{Name: "cleanup", TaskRef: &v1.TaskRef{Name: "task"}},
+						},
+					},
+					ChildReferences: []v1.ChildStatusReference{
+						{
+							Name:             "tr-1",
+							PipelineTaskName: "t-1",
+							TypeMeta: runtime.TypeMeta{
+								Kind: "TaskRun",
+							},
+						},
+						{
+							Name:             "tr-2",
+							PipelineTaskName: "cleanup",
+							TypeMeta: runtime.TypeMeta{
+								Kind: "TaskRun",
+							},
+						},
+					},
+					StartTime:      &metav1.Time{Time: clock.Now()},
+					CompletionTime: &metav1.Time{Time: clock.Now().Add(15 * time.Minute)},
+				},
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Status: corev1.ConditionTrue,
+							Reason: v1.PipelineRunReasonSuccessful.String(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	namespaces := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	version := "v1"
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(
+		cb.UnstructuredPR(pipelineRuns[0], version),
+		cb.UnstructuredTR(trs[0], version),
+		cb.UnstructuredTR(trs[1], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Namespaces: namespaces, PipelineRuns: pipelineRuns,
+		TaskRuns: trs,
+	})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun", "taskrun"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic, Clock: clock}
+
+	pipelinerun := Command(p)
+	clock.Advance(10 * time.Minute)
+	actual, err := test.ExecuteCommand(pipelinerun, "desc", "pipeline-run", "-n", "ns")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	golden.Assert(t, actual, fmt.Sprintf("%s.golden", t.Name()))
+
+}
+
 func TestPipelineRunDescribe_multiple_taskrun_without_status(t *testing.T) {
 	clock := test.FakeClock()
 
@@ -1389,6 +1527,107 @@ func TestPipelineRunDescribe_custom_output(t *testing.T) {
 	}
 }
 
+func TestPipelineRunDescribe_output_yaml(t *testing.T) {
+	prun := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "pipeline-run",
+				Namespace:         "ns",
+				UID:               "5f9a1c2e-1d3b-4c8e-9a5f-0c1e2d3f4a5b",
+				ResourceVersion:   "4242",
+				Generation:        1,
+				CreationTimestamp: metav1.Time{Time: test.FakeClock().Now()},
+				Labels:            map[string]string{"tekton.dev/pipeline": "pipeline"},
+				Annotations: map[string]string{
+					"kubectl.kubernetes.io/last-applied-configuration": `{"apiVersion":"tekton.dev/v1"}`,
+				},
+				ManagedFields: []metav1.ManagedFieldsEntry{
+					{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate, APIVersion: "tekton.dev/v1"},
+				},
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{
+					Name: "pipeline",
+				},
+			},
+			Status: v1.PipelineRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Type:   apis.ConditionSucceeded,
+							Status: corev1.ConditionTrue,
+							Reason: v1.PipelineRunReasonSuccessful.String(),
+						},
+					},
+				},
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					ChildReferences: []v1.ChildStatusReference{
+						{
+							TypeMeta:         runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"},
+							Name:             "pipeline-run-build",
+							DisplayName:      "Build the sources",
+							PipelineTaskName: "build",
+						},
+						{
+							TypeMeta:         runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"},
+							Name:             "pipeline-run-test",
+							PipelineTaskName: "test",
+							WhenExpressions:  v1.WhenExpressions{{Input: "$(params.test)", Operator: selection.In, Values: []string{"true"}}},
+						},
+					},
+				},
+			},
+		},
+	}
+	namespaces := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	version := "v1"
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(
+		cb.UnstructuredPR(prun[0], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Namespaces: namespaces, PipelineRuns: prun})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun", "taskrun"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic}
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "raw", args: []string{}},
+		{name: "managed_fields", args: []string{"--show-managed-fields"}},
+		{name: "clean", args: []string{"--clean"}},
+		{name: "skip_status_taskruns", args: []string{"--skip-status-taskruns"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipelinerun := Command(p)
+			args := append([]string{"desc", "pipeline-run", "-n", "ns", "-o", "yaml"}, tt.args...)
+			got, err := test.ExecuteCommand(pipelinerun, args...)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			golden.Assert(t, got, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+		})
+	}
+
+	pipelinerun := Command(p)
+	_, err = test.ExecuteCommand(pipelinerun, "desc", "pipeline-run", "-n", "ns", "--clean")
+	test.AssertOutput(t, "--clean can only be used with --output", err.Error())
+
+	_, err = test.ExecuteCommand(Command(p), "desc", "pipeline-run", "-n", "ns", "--skip-status-taskruns")
+	test.AssertOutput(t, "--skip-status-taskruns can only be used with --output", err.Error())
+}
+
 func TestPipelineRunDescribe(t *testing.T) {
 	clock := test.FakeClock()
 	pipelinerunname := "pipeline-run"
@@ -2743,3 +2982,116 @@ func TestPipelineRunDescribe_with_annotations(t *testing.T) {
 	}
 	golden.Assert(t, actual, fmt.Sprintf("%s.golden", t.Name()))
 }
+
+func TestPipelineRunDescribe_all(t *testing.T) {
+	clock := test.FakeClock()
+	now := clock.Now()
+	run := func(name string, age time.Duration, children ...string) *v1.PipelineRun {
+		pr := &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "ns",
+				CreationTimestamp: metav1.Time{Time: now.Add(-age)},
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{Name: "pipeline"},
+			},
+		}
+		for _, child := range children {
+			pr.Status.ChildReferences = append(pr.Status.ChildReferences, v1.ChildStatusReference{
+				TypeMeta:         runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"},
+				Name:             child,
+				PipelineTaskName: strings.TrimPrefix(child, name+"-"),
+			})
+		}
+		return pr
+	}
+	prs := []*v1.PipelineRun{
+		run("pr-old", 48*time.Hour, "pr-old-build"),
+		run("pr-1", 2*time.Hour, "pr-1-build", "pr-1-test"),
+		run("pr-2", time.Hour),
+	}
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pr-old-build", Namespace: "ns", Labels: map[string]string{"tekton.dev/pipelineRun": "pr-old"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pr-1-build", Namespace: "ns", Labels: map[string]string{"tekton.dev/pipelineRun": "pr-1"}},
+		},
+		{
+			// without its labels the TaskRun is not part of the list
+			ObjectMeta: metav1.ObjectMeta{Name: "pr-1-test", Namespace: "ns"},
+		},
+	}
+
+	version := "v1"
+	gets := 0
+	tdc := testDynamic.Options{
+		PrependReactors: []testDynamic.PrependOpt{{
+			Verb:     "get",
+			Resource: "taskruns",
+			Action: func(_ k8stest.Action) (bool, runtime.Object, error) {
+				gets++
+				return false, nil, nil
+			},
+		}},
+	}
+	dynamic, err := tdc.Client(
+		cb.UnstructuredPR(prs[0], version),
+		cb.UnstructuredPR(prs[1], version),
+		cb.UnstructuredPR(prs[2], version),
+		cb.UnstructuredTR(trs[0], version),
+		cb.UnstructuredTR(trs[1], version),
+		cb.UnstructuredTR(trs[2], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs, TaskRuns: trs})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun", "taskrun"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic, Clock: clock}
+
+	got, err := test.ExecuteCommand(Command(p), "desc", "--all", "--since", "24h", "-o", "json", "-n", "ns")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	golden.Assert(t, got, fmt.Sprintf("%s.golden", t.Name()))
+	if gets != 1 {
+		t.Errorf("expected the TaskRun missing from the list to be read alone, got %d gets", gets)
+	}
+}
+
+func TestPipelineRunDescribe_all_invalid(t *testing.T) {
+	testParams := []struct {
+		name    string
+		command []string
+		want    string
+	}{
+		{
+			name:    "without json",
+			command: []string{"desc", "--all"},
+			want:    "--all can only be used with --output json",
+		},
+		{
+			name:    "with a name",
+			command: []string{"desc", "pr-1", "--all", "-o", "json"},
+			want:    "--all cannot be used with a PipelineRun name or --last",
+		},
+		{
+			name:    "since without all",
+			command: []string{"desc", "pr-1", "--since", "1h"},
+			want:    "--since can only be used with --all",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{}
+			_, err := test.ExecuteCommand(Command(p), tp.command...)
+			if err == nil {
+				t.Fatal("error expected here")
+			}
+			test.AssertOutput(t, tp.want, err.Error())
+		})
+	}
+}
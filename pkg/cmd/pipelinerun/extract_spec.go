@@ -0,0 +1,126 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/export"
+	"github.com/tektoncd/cli/pkg/formatted"
+	pipelinerunpkg "github.com/tektoncd/cli/pkg/pipelinerun"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+type extractSpecOptions struct {
+	Output string
+	Name   string
+	Tasks  bool
+}
+
+func extractSpecCommand(p cli.Params) *cobra.Command {
+	opts := &extractSpecOptions{}
+	eg := `Extract the Pipeline the PipelineRun foo of namespace bar ran:
+
+    tkn pipelinerun extract-spec foo -n bar --output pipeline.yaml
+
+Extract the Pipeline and the Tasks it references, so they no longer depend on resolvers or bundles:
+
+    tkn pipelinerun extract-spec foo -n bar --tasks --name build | kubectl apply -f -
+`
+
+	c := &cobra.Command{
+		Use:   "extract-spec PIPELINERUN",
+		Short: "Extracts the resolved Pipeline of a PipelineRun as a standalone resource",
+		Long: `Extracts the pipelineSpec a PipelineRun resolved, whether it was embedded,
+referenced or fetched by a resolver, into a standalone Pipeline.
+
+With --tasks, the taskSpecs resolved by its TaskRuns are extracted as Tasks too
+and the Pipeline references them by name.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:              cobra.ExactArgs(1),
+		Example:           eg,
+		SilenceUsage:      true,
+		ValidArgsFunction: formatted.ParentCompletion,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+
+			pr, err := pipelinerunpkg.GetPipelineRun(pipelineRunGroupResource, cs, args[0], p.Namespace())
+			if err != nil {
+				return err
+			}
+
+			specs, err := pipelinerunpkg.ExtractSpecs(cs, pr, opts.Name, opts.Tasks)
+			if err != nil {
+				return err
+			}
+
+			docs := []runtime.Object{specs.Pipeline}
+			for _, t := range specs.Tasks {
+				docs = append(docs, t)
+			}
+			var out bytes.Buffer
+			for i, d := range docs {
+				b, err := marshalExtracted(d)
+				if err != nil {
+					return err
+				}
+				if i != 0 {
+					out.WriteString("---\n")
+				}
+				out.Write(b)
+			}
+
+			if opts.Output == "" {
+				_, err = cmd.OutOrStdout().Write(out.Bytes())
+				return err
+			}
+			if err := os.WriteFile(opts.Output, out.Bytes(), 0o644); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Pipeline %s", specs.Pipeline.Name)
+			if len(specs.Tasks) != 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), " and %d Task(s)", len(specs.Tasks))
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), " written to %s\n", opts.Output)
+			return nil
+		},
+	}
+
+	c.Flags().StringVar(&opts.Output, "output", "", "write the resources to this file instead of the standard output")
+	c.Flags().StringVar(&opts.Name, "name", "", "name of the extracted Pipeline, defaults to the name of the Pipeline the PipelineRun referenced")
+	c.Flags().BoolVar(&opts.Tasks, "tasks", false, "extract the Tasks referenced by the Pipeline too")
+	return c
+}
+
+// marshalExtracted marshals a resource without the empty fields of its Go
+// type
+func marshalExtracted(obj runtime.Object) ([]byte, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	export.RemoveEmptyFields(content)
+	return yaml.Marshal(content)
+}
@@ -0,0 +1,142 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func extractSpecParams(t *testing.T, prs []*v1.PipelineRun, trs []*v1.TaskRun) *test.Params {
+	version := "v1"
+	objs := []runtime.Object{}
+	for _, pr := range prs {
+		objs = append(objs, cb.UnstructuredPR(pr, version))
+	}
+	for _, tr := range trs {
+		objs = append(objs, cb.UnstructuredTR(tr, version))
+	}
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(objs...)
+	if err != nil {
+		t.Fatalf("unable to create dynamic client: %v", err)
+	}
+	namespaces := []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Namespaces: namespaces, PipelineRuns: prs, TaskRuns: trs})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun", "taskrun"})
+	return &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic, Clock: test.FakeClock()}
+}
+
+func TestPipelineRunExtractSpec(t *testing.T) {
+	step := v1.Step{Name: "build", Image: "golang:1.22", Script: "go build ./..."}
+	prs := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "build-run", Namespace: "ns"},
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{ResolverRef: v1.ResolverRef{Resolver: "bundles"}},
+			},
+			Status: v1.PipelineRunStatus{
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					PipelineSpec: &v1.PipelineSpec{
+						Params: v1.ParamSpecs{{Name: "revision", Type: v1.ParamTypeString}},
+						Tasks: []v1.PipelineTask{
+							{
+								Name:    "build",
+								TaskRef: &v1.TaskRef{ResolverRef: v1.ResolverRef{Resolver: "bundles"}},
+								Params:  v1.Params{{Name: "revision", Value: *v1.NewStructuredValues("$(params.revision)")}},
+							},
+							{
+								Name:     "notify",
+								RunAfter: []string{"build"},
+								TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{Steps: []v1.Step{{Name: "echo", Image: "alpine:3", Script: "echo done"}}}},
+							},
+						},
+					},
+					ChildReferences: []v1.ChildStatusReference{
+						{TypeMeta: runtime.TypeMeta{Kind: "TaskRun"}, Name: "build-run-build", PipelineTaskName: "build"},
+						{TypeMeta: runtime.TypeMeta{Kind: "TaskRun"}, Name: "build-run-notify", PipelineTaskName: "notify"},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pending-run", Namespace: "ns"},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+		},
+	}
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "build-run-build",
+				Namespace: "ns",
+				Labels:    map[string]string{"tekton.dev/task": "go-build"},
+			},
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					TaskSpec: &v1.TaskSpec{
+						Params: v1.ParamSpecs{{Name: "revision", Type: v1.ParamTypeString}},
+						Steps:  []v1.Step{step},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("pipeline only", func(t *testing.T) {
+		p := extractSpecParams(t, prs, trs)
+		got, err := test.ExecuteCommand(Command(p), "extract-spec", "build-run", "-n", "ns", "--name", "build")
+		assert.NilError(t, err)
+		golden.Assert(t, got, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+	})
+
+	t.Run("with tasks", func(t *testing.T) {
+		p := extractSpecParams(t, prs, trs)
+		got, err := test.ExecuteCommand(Command(p), "extract-spec", "build-run", "-n", "ns", "--name", "build", "--tasks")
+		assert.NilError(t, err)
+		golden.Assert(t, got, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+	})
+
+	t.Run("output file", func(t *testing.T) {
+		p := extractSpecParams(t, prs, trs)
+		file := filepath.Join(t.TempDir(), "pipeline.yaml")
+		got, err := test.ExecuteCommand(Command(p), "extract-spec", "build-run", "-n", "ns", "--tasks", "--output", file)
+		assert.NilError(t, err)
+		assert.Equal(t, got, fmt.Sprintf("Pipeline build-run and 1 Task(s) written to %s\n", file))
+
+		b, err := os.ReadFile(file)
+		assert.NilError(t, err)
+		assert.Assert(t, strings.Contains(string(b), "kind: Task\n"))
+	})
+
+	t.Run("no resolved spec", func(t *testing.T) {
+		p := extractSpecParams(t, prs, trs)
+		_, err := test.ExecuteCommand(Command(p), "extract-spec", "pending-run", "-n", "ns")
+		assert.Error(t, err, "PipelineRun pending-run has no resolved pipelineSpec in its status yet")
+	})
+}
@@ -16,17 +16,25 @@ package pipelinerun
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"text/tabwriter"
 	"text/template"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/daemon"
+	"github.com/tektoncd/cli/pkg/dashboard"
+	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
 	prsort "github.com/tektoncd/cli/pkg/pipelinerun/sort"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	cliopts "k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -35,24 +43,49 @@ No PipelineRuns found
 {{ else -}}
 {{- if not $.NoHeaders -}}
 {{- if $.AllNamespaces -}}
-NAMESPACE	NAME	STARTED	DURATION	STATUS
+NAMESPACE	NAME	STARTED	DURATION	STATUS{{ if $.Links }}	LINK{{ end }}
 {{ else -}}
-NAME	STARTED	DURATION	STATUS
+NAME	STARTED	DURATION	STATUS{{ if $.Links }}	LINK{{ end }}
 {{ end -}}
 {{- end -}}
 {{- range $_, $pr := .PipelineRuns.Items }}{{- if $pr }}{{- if $.AllNamespaces -}}
-{{ $pr.Namespace }}	{{ $pr.Name }}	{{ formatAge $pr.Status.StartTime $.Time }}	{{ formatDuration $pr.Status.StartTime $pr.Status.CompletionTime }}	{{ formatCondition $pr.Status.Conditions }}
+{{ $pr.Namespace }}	{{ $pr.Name }}	{{ formatAge $pr.Status.StartTime $.Time }}	{{ formatDuration $pr.Status.StartTime $pr.Status.CompletionTime }}	{{ formatCondition $pr.Status.Conditions }}{{ if $.Links }}	{{ $.Links.Link "pipelineruns" $pr.Namespace $pr.Name }}{{ end }}
 {{ else -}}
-{{ $pr.Name }}	{{ formatAge $pr.Status.StartTime $.Time }}	{{ formatDuration $pr.Status.StartTime $pr.Status.CompletionTime }}	{{ formatCondition $pr.Status.Conditions }}
+{{ $pr.Name }}	{{ formatAge $pr.Status.StartTime $.Time }}	{{ formatDuration $pr.Status.StartTime $pr.Status.CompletionTime }}	{{ formatCondition $pr.Status.Conditions }}{{ if $.Links }}	{{ $.Links.Link "pipelineruns" $pr.Namespace $pr.Name }}{{ end }}
 {{ end -}}{{- end -}}{{- end -}}
 {{- end -}}`
 
+const listContextsTemplate = `{{- if not $.NoHeaders -}}
+CLUSTER	{{ if $.AllNamespaces }}NAMESPACE	{{ end }}NAME	STARTED	DURATION	STATUS
+{{ end -}}
+{{- range $_, $c := .Contexts }}{{- range $_, $pr := $c.PipelineRuns.Items -}}
+{{ $c.Context }}	{{ if $.AllNamespaces }}{{ $pr.Namespace }}	{{ end }}{{ $pr.Name }}	{{ formatAge $pr.Status.StartTime $.Time }}	{{ formatDuration $pr.Status.StartTime $pr.Status.CompletionTime }}	{{ formatCondition $pr.Status.Conditions }}
+{{ end -}}{{- end -}}`
+
+// contextParams is implemented by the Params which can talk to the other
+// contexts of the kubeconfig
+type contextParams interface {
+	ForContext(kubeContext, namespace string) cli.Params
+}
+
+// contextRuns are the PipelineRuns listed from a context
+type contextRuns struct {
+	Context      string
+	PipelineRuns *v1.PipelineRunList
+	Err          error
+}
+
 type ListOptions struct {
 	Limit         int
 	LabelSelector string
 	Reverse       bool
 	AllNamespaces bool
 	NoHeaders     bool
+	Link          bool
+	Status        string
+	Since         time.Duration
+	Script        flags.ScriptOutput
+	Contexts      []string
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -66,6 +99,22 @@ func listCommand(p cli.Params) *cobra.Command {
 List all PipelineRuns in a namespace 'foo':
 
     tkn pr list -n foo
+
+List the failed PipelineRuns started in the last 24 hours:
+
+    tkn pr list --status failed --since 24h
+
+List the PipelineRuns matching the saved query 'failed-today' of the tkn profile:
+
+    tkn pr list @failed-today
+
+List the PipelineRuns with their links in the Tekton Dashboard set in the tkn profile:
+
+    tkn pr list --link
+
+List the PipelineRuns of the contexts 'prod' and 'stage' of the kubeconfig in one table:
+
+    tkn pr list --contexts prod,stage
 `
 
 	c := &cobra.Command{
@@ -77,6 +126,11 @@ List all PipelineRuns in a namespace 'foo':
 		},
 		Example: eg,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			args, err := flags.ExpandQueries(p, cmd, args)
+			if err != nil {
+				return err
+			}
+
 			var pipeline string
 
 			if len(args) > 0 {
@@ -87,7 +141,15 @@ List all PipelineRuns in a namespace 'foo':
 				return fmt.Errorf("limit was %d but must be a positive number", opts.Limit)
 			}
 
-			prs, err := list(p, pipeline, opts.Limit, opts.LabelSelector, opts.AllNamespaces)
+			if opts.Status != "" && !slices.Contains(formatted.Phases, opts.Status) {
+				return fmt.Errorf("invalid value %q for --status, use one of %s", opts.Status, strings.Join(formatted.Phases, ", "))
+			}
+
+			if len(opts.Contexts) > 0 {
+				return listContexts(cmd, p, pipeline, opts)
+			}
+
+			prs, err := list(p, pipeline, opts.Limit, opts.LabelSelector, opts.AllNamespaces, opts.Status, opts.Since)
 			if err != nil {
 				return fmt.Errorf("failed to list PipelineRuns from namespace %s: %v", p.Namespace(), err)
 			}
@@ -98,7 +160,22 @@ List all PipelineRuns in a namespace 'foo':
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
+			}
+			if err := opts.Script.Validate(output); err != nil {
+				return err
+			}
+
+			if opts.Script.Enabled() {
+				if prs == nil {
+					return nil
+				}
+				for _, pr := range prs.Items {
+					if err := opts.Script.Print(cmd.OutOrStdout(), pr.Name, pr.Namespace, pr.Name, pipelineName(pr), formatted.Phase(pr.Status.Conditions), formatted.PorcelainTime(pr.Status.StartTime), formatted.PorcelainTime(pr.Status.CompletionTime)); err != nil {
+						return err
+					}
+				}
+				return nil
 			}
 
 			if output == "name" && prs != nil {
@@ -122,11 +199,18 @@ List all PipelineRuns in a namespace 'foo':
 				Err: cmd.OutOrStderr(),
 			}
 
+			var links *dashboard.Links
+			if opts.Link {
+				if links, err = dashboard.FromProfile(p); err != nil {
+					return err
+				}
+			}
+
 			if prs != nil {
-				err = printFormatted(stream, prs, p.Time(), opts.AllNamespaces, opts.NoHeaders)
+				err = printFormatted(stream, prs, p.Time(), opts.AllNamespaces, opts.NoHeaders, links)
 			}
 			if err != nil {
-				return fmt.Errorf("failed to print PipelineRuns: %v", err)
+				return fmt.Errorf("failed to print PipelineRuns: %w", err)
 			}
 
 			return nil
@@ -139,14 +223,93 @@ List all PipelineRuns in a namespace 'foo':
 	c.Flags().BoolVarP(&opts.Reverse, "reverse", "", opts.Reverse, "list PipelineRuns in reverse order")
 	c.Flags().BoolVarP(&opts.AllNamespaces, "all-namespaces", "A", opts.AllNamespaces, "list PipelineRuns from all namespaces")
 	c.Flags().BoolVarP(&opts.NoHeaders, "no-headers", "", opts.NoHeaders, "do not print column headers with output (default print column headers with output)")
+	c.Flags().BoolVar(&opts.Link, "link", false, "print the link to each of the PipelineRuns in the Tekton Dashboard set in the tkn profile")
+	c.Flags().StringVar(&opts.Status, "status", "", "only list the PipelineRuns in this phase: "+strings.Join(formatted.Phases, ", "))
+	opts.Script.AddFlags(c, "PipelineRun")
+	c.Flags().DurationVar(&opts.Since, "since", 0, "only list the PipelineRuns started within this duration, e.g. 24h")
+	c.Flags().StringSliceVar(&opts.Contexts, "contexts", nil, "list the PipelineRuns of these contexts of the kubeconfig concurrently, in one table with a CLUSTER column")
 	return c
 }
 
-func list(p cli.Params, pipeline string, limit int, labelselector string, allnamespaces bool) (*v1.PipelineRunList, error) {
+// listContexts lists the PipelineRuns of each of the contexts concurrently
+// and prints them in one table, the contexts which fail are reported on
+// stderr unless all of them fail
+func listContexts(cmd *cobra.Command, p cli.Params, pipeline string, opts *ListOptions) error {
+	cp, ok := p.(contextParams)
+	if !ok {
+		return fmt.Errorf("--contexts is not supported")
+	}
+	output, err := cmd.LocalFlags().GetString("output")
+	if err != nil {
+		return fmt.Errorf("output option not set properly: %w", err)
+	}
+	if output != "" || opts.Script.Enabled() || opts.Link {
+		return fmt.Errorf("--contexts only supports the table output, it cannot be used with --output, --link or the script output")
+	}
+
+	// use the namespace of each context unless one is asked for
+	ns := ""
+	if f := cmd.Flags().Lookup("namespace"); f != nil && f.Changed {
+		ns = p.Namespace()
+	}
+
+	results := make([]contextRuns, len(opts.Contexts))
+	var wg sync.WaitGroup
+	for i, kubeContext := range opts.Contexts {
+		wg.Add(1)
+		go func(i int, kubeContext string) {
+			defer wg.Done()
+			params := cp.ForContext(kubeContext, ns)
+			results[i].Context = kubeContext
+			results[i].PipelineRuns, results[i].Err = listWith(actions.ListV1Cluster, params, pipeline, opts.Limit, opts.LabelSelector, opts.AllNamespaces, opts.Status, opts.Since)
+		}(i, kubeContext)
+	}
+	wg.Wait()
+
+	var listed []contextRuns
+	found := 0
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Fprintf(cmd.OutOrStderr(), "Failed to list PipelineRuns from context %s: %v\n", r.Context, r.Err)
+			continue
+		}
+		if opts.Reverse {
+			reverse(r.PipelineRuns)
+		}
+		found += len(r.PipelineRuns.Items)
+		listed = append(listed, r)
+	}
+	if len(listed) == 0 {
+		return fmt.Errorf("failed to list PipelineRuns from contexts %s", strings.Join(opts.Contexts, ", "))
+	}
+	if found == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "No PipelineRuns found")
+		return nil
+	}
+
+	stream := &cli.Stream{
+		Out: cmd.OutOrStdout(),
+		Err: cmd.OutOrStderr(),
+	}
+	if err := printContextsFormatted(stream, listed, p.Time(), opts.AllNamespaces, opts.NoHeaders); err != nil {
+		return fmt.Errorf("failed to print PipelineRuns: %w", err)
+	}
+	return nil
+}
+
+// lister fetches the objects of a resource, actions.ListV1 or
+// actions.ListV1Cluster when the clusters of several contexts are listed
+type lister func(schema.GroupVersionResource, *cli.Clients, metav1.ListOptions, string, interface{}) error
+
+func list(p cli.Params, pipeline string, limit int, labelselector string, allnamespaces bool, status string, since time.Duration) (*v1.PipelineRunList, error) {
+	return listWith(actions.ListV1, p, pipeline, limit, labelselector, allnamespaces, status, since)
+}
+
+func listWith(listV1 lister, p cli.Params, pipeline string, limit int, labelselector string, allnamespaces bool, status string, since time.Duration) (*v1.PipelineRunList, error) {
 	var selector string
 	var options metav1.ListOptions
 
-	cs, err := p.Clients()
+	cs, err := daemon.Clients(p)
 	if err != nil {
 		return nil, err
 	}
@@ -172,10 +335,14 @@ func list(p cli.Params, pipeline string, limit int, labelselector string, allnam
 		ns = ""
 	}
 	var pipelineRuns *v1.PipelineRunList
-	if err := actions.ListV1(pipelineRunGroupResource, cs, options, ns, &pipelineRuns); err != nil {
+	if err := listV1(pipelineRunGroupResource, cs, options, ns, &pipelineRuns); err != nil {
 		return nil, err
 	}
 
+	if status != "" || since > 0 {
+		pipelineRuns.Items = filterPipelineRuns(pipelineRuns.Items, status, since, p.Time().Now())
+	}
+
 	prslen := len(pipelineRuns.Items)
 
 	if prslen != 0 {
@@ -211,17 +378,19 @@ func reverse(prs *v1.PipelineRunList) {
 	prs.Items = prItems
 }
 
-func printFormatted(s *cli.Stream, prs *v1.PipelineRunList, c clockwork.Clock, allnamespaces bool, noheaders bool) error {
+func printFormatted(s *cli.Stream, prs *v1.PipelineRunList, c clockwork.Clock, allnamespaces bool, noheaders bool, links *dashboard.Links) error {
 	var data = struct {
 		PipelineRuns  *v1.PipelineRunList
 		Time          clockwork.Clock
 		AllNamespaces bool
 		NoHeaders     bool
+		Links         *dashboard.Links
 	}{
 		PipelineRuns:  prs,
 		Time:          c,
 		AllNamespaces: allnamespaces,
 		NoHeaders:     noheaders,
+		Links:         links,
 	}
 
 	funcMap := template.FuncMap{
@@ -240,3 +409,64 @@ func printFormatted(s *cli.Stream, prs *v1.PipelineRunList, c clockwork.Clock, a
 
 	return w.Flush()
 }
+
+func printContextsFormatted(s *cli.Stream, contexts []contextRuns, c clockwork.Clock, allnamespaces bool, noheaders bool) error {
+	var data = struct {
+		Contexts      []contextRuns
+		Time          clockwork.Clock
+		AllNamespaces bool
+		NoHeaders     bool
+	}{
+		Contexts:      contexts,
+		Time:          c,
+		AllNamespaces: allnamespaces,
+		NoHeaders:     noheaders,
+	}
+
+	funcMap := template.FuncMap{
+		"formatAge":       formatted.Age,
+		"formatDuration":  formatted.Duration,
+		"formatCondition": formatted.Condition,
+	}
+
+	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	t := template.Must(template.New("List PipelineRuns of contexts").Funcs(funcMap).Parse(listContextsTemplate))
+
+	if err := t.Execute(w, data); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// filterPipelineRuns keeps the PipelineRuns in the phase status which started within
+// since
+func filterPipelineRuns(runs []v1.PipelineRun, status string, since time.Duration, now time.Time) []v1.PipelineRun {
+	filtered := runs[:0]
+	for _, run := range runs {
+		if status != "" && formatted.Phase(run.Status.Conditions) != status {
+			continue
+		}
+		started := run.CreationTimestamp
+		if run.Status.StartTime != nil {
+			started = *run.Status.StartTime
+		}
+		if since > 0 && started.Time.Before(now.Add(-since)) {
+			continue
+		}
+		filtered = append(filtered, run)
+	}
+	return filtered
+}
+
+// pipelineName returns the name of the Pipeline the run is for, empty for an
+// embedded spec
+func pipelineName(pr v1.PipelineRun) string {
+	if name := pr.Labels["tekton.dev/pipeline"]; name != "" {
+		return name
+	}
+	if pr.Spec.PipelineRef != nil {
+		return pr.Spec.PipelineRef.Name
+	}
+	return ""
+}
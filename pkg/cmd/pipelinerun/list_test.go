@@ -22,6 +22,7 @@ import (
 
 	"github.com/jonboulle/clockwork"
 	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/config"
 	"github.com/tektoncd/cli/pkg/test"
 	cb "github.com/tektoncd/cli/pkg/test/builder"
 	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
@@ -31,6 +32,7 @@ import (
 	"gotest.tools/v3/golden"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
@@ -517,6 +519,48 @@ func TestListPipelineRuns(t *testing.T) {
 			args:      []string{"list", "--all-namespaces", "--no-headers"},
 			wantError: false,
 		},
+		{
+			name:      "filter pipelineruns by status",
+			command:   command(t, prs, clock.Now(), ns, version, dc1),
+			args:      []string{"list", "-n", "namespace", "--status", "failed"},
+			wantError: false,
+		},
+		{
+			name:      "filter pipelineruns started since",
+			command:   command(t, prs, clock.Now(), ns, version, dc1),
+			args:      []string{"list", "-n", "namespace", "--since", "90m"},
+			wantError: false,
+		},
+		{
+			name:      "invalid status",
+			command:   command(t, prs, clock.Now(), ns, version, dc1),
+			args:      []string{"list", "-n", "namespace", "--status", "done"},
+			wantError: true,
+		},
+		{
+			name:      "saved query",
+			command:   command(t, prs, clock.Now(), ns, version, dc1),
+			args:      []string{"list", "@failed-recently"},
+			wantError: false,
+		},
+		{
+			name:      "saved query with flag taking precedence",
+			command:   command(t, prs, clock.Now(), ns, version, dc1),
+			args:      []string{"list", "@failed-recently", "--status", "succeeded"},
+			wantError: false,
+		},
+		{
+			name:      "unknown saved query",
+			command:   command(t, prs, clock.Now(), ns, version, dc1),
+			args:      []string{"list", "@failed-today", "-n", "namespace"},
+			wantError: true,
+		},
+		{
+			name:      "with links to the dashboard",
+			command:   command(t, prs, clock.Now(), ns, version, dc1),
+			args:      []string{"list", "-n", "namespace", "--link"},
+			wantError: false,
+		},
 	}
 
 	for _, td := range tests {
@@ -531,6 +575,100 @@ func TestListPipelineRuns(t *testing.T) {
 	}
 }
 
+func TestListPipelineRuns_contexts(t *testing.T) {
+	version := "v1"
+	clock := test.FakeClock()
+	started := clock.Now().Add(-10 * time.Minute)
+
+	run := func(name string) *v1.PipelineRun {
+		return &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "namespace",
+				Name:      name,
+				Labels:    map[string]string{"tekton.dev/pipeline": "pipeline"},
+			},
+			Status: v1.PipelineRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Status: corev1.ConditionTrue,
+							Reason: v1.PipelineRunReasonSuccessful.String(),
+						},
+					},
+				},
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					StartTime:      &metav1.Time{Time: started},
+					CompletionTime: &metav1.Time{Time: started.Add(time.Minute)},
+				},
+			},
+		}
+	}
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "namespace",
+			},
+		},
+	}
+
+	contextParams := func(prs ...*v1.PipelineRun) *test.Params {
+		cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs, Namespaces: ns})
+		cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun"})
+		var objs []runtime.Object
+		for _, pr := range prs {
+			objs = append(objs, cb.UnstructuredPR(pr, version))
+		}
+		tdc := testDynamic.Options{}
+		dc, err := tdc.Client(objs...)
+		if err != nil {
+			t.Errorf("unable to create dynamic client: %v", err)
+		}
+		return &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+	}
+
+	tests := []struct {
+		name      string
+		args      []string
+		wantError bool
+	}{
+		{
+			name: "all contexts",
+			args: []string{"list", "-n", "namespace", "--contexts", "prod,stage"},
+		},
+		{
+			name: "one context failing",
+			args: []string{"list", "-n", "namespace", "--contexts", "prod,dev"},
+		},
+		{
+			name:      "all contexts failing",
+			args:      []string{"list", "-n", "namespace", "--contexts", "dev,qa"},
+			wantError: true,
+		},
+		{
+			name:      "with output",
+			args:      []string{"list", "-n", "namespace", "--contexts", "prod,stage", "-o", "name"},
+			wantError: true,
+		},
+	}
+
+	for _, td := range tests {
+		t.Run(td.name, func(t *testing.T) {
+			p := contextParams()
+			p.Clock = clockwork.NewFakeClockAt(clock.Now())
+			p.Contexts = map[string]*test.Params{
+				"prod":  contextParams(run("pr-prod-1"), run("pr-prod-2")),
+				"stage": contextParams(run("pr-stage-1")),
+			}
+
+			got, err := test.ExecuteCommand(Command(p), td.args...)
+			if td.wantError != (err != nil) {
+				t.Errorf("unexpected error: %v", err)
+			}
+			golden.Assert(t, got, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+		})
+	}
+}
+
 func TestListPipeline_empty(t *testing.T) {
 	ns := []*corev1.Namespace{
 		{
@@ -579,7 +717,14 @@ func command(t *testing.T, prs []*v1.PipelineRun, now time.Time, ns []*corev1.Na
 	cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs, Namespaces: ns})
 	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun"})
 
-	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube, Dynamic: dc}
+	p := &test.Params{Tekton: cs.Pipeline, Clock: clock, Kube: cs.Kube, Dynamic: dc, TknProfile: listProfile}
 
 	return Command(p)
 }
+
+// listProfile is the tkn profile of the list commands, with a dashboard for
+// --link and a saved query
+var listProfile = config.Profile{
+	Dashboard: config.Dashboard{URL: "https://dashboard.example.com/"},
+	Queries:   map[string]string{"failed-recently": "--status failed --since 3h -n namespace"},
+}
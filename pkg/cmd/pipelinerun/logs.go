@@ -17,25 +17,35 @@ package pipelinerun
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	cmdversion "github.com/tektoncd/cli/pkg/cmd/version"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/cli/pkg/log"
+	"github.com/tektoncd/cli/pkg/notify"
 	"github.com/tektoncd/cli/pkg/options"
 	pipelinerunpkg "github.com/tektoncd/cli/pkg/pipelinerun"
 	tektonv1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"knative.dev/pkg/apis"
 )
 
 const (
 	defaultLimit = 5
+
+	sortByTask = "task"
+	sortByTime = "time"
 )
 
 func logCommand(p cli.Params) *cobra.Command {
 	opts := &options.LogOptions{Params: p}
+	var includeRetries, waitForRetries bool
 	eg := `Show the logs of PipelineRun named 'foo' from namespace 'bar':
 
     tkn pipelinerun logs foo -n bar
@@ -47,6 +57,50 @@ Show the logs of PipelineRun named 'microservice-1' for Task 'build' only from n
 Show the logs of PipelineRun named 'microservice-1' for all Tasks and steps (including init steps) from namespace 'foo':
 
     tkn pr logs microservice-1 -a -n foo
+
+Show the logs of all Tasks of the completed PipelineRun named 'microservice-1' merged chronologically from namespace 'foo':
+
+    tkn pr logs microservice-1 --sort time -n foo
+
+Show the logs of all Tasks of PipelineRun named 'microservice-1' written between 12:01:00 and 12:03:30, merged chronologically:
+
+    tkn pr logs microservice-1 --between 12:01:00,12:03:30 -n foo
+
+Follow the logs of PipelineRun named 'microservice-1' in CI, without interleaving the logs of the Tasks running in parallel:
+
+    tkn pr logs microservice-1 -f --buffered -n foo > build.log
+
+Save the logs of PipelineRun named 'microservice-1' as a tar archive with a file per step:
+
+    tkn pr logs microservice-1 -o tar -n foo > microservice-1.tar
+
+Save them signed with an AWS KMS key, the key and the version of tkn being recorded in its metadata.json:
+
+    tkn pr logs microservice-1 -o tar --sign-kms-key awskms:///arn:aws:kms:us-east-1:111122223333:key/1234abcd -n foo > microservice-1.tar
+
+Show the logs of PipelineRun named 'microservice-1' archived by Tekton Results, even while its pods still exist:
+
+    tkn pr logs microservice-1 --source results -n foo
+
+Save the logs of PipelineRun named 'microservice-1' to a file without the messages of tkn:
+
+    tkn pr logs microservice-1 --silent -n foo > microservice-1.log
+
+Fail when the logs of PipelineRun named 'microservice-1' likely leak a secret, e.g. as a CI policy:
+
+    tkn pr logs microservice-1 --fail-on-leak -n foo
+
+Show only the logs of the last attempt of the retried Tasks of PipelineRun named 'microservice-1':
+
+    tkn pr logs microservice-1 --include-retries=false -n foo
+
+Follow the logs of PipelineRun named 'microservice-1', pausing them or showing only the current step with keys while they stream:
+
+    tkn pr logs microservice-1 -f --interactive -n foo
+
+Show the logs of the last PipelineRun created by Pipelines-as-Code for the Repository 'microservice' from namespace 'foo':
+
+    tkn pr logs --last --repository microservice -n foo
    `
 
 	c := &cobra.Command{
@@ -63,12 +117,102 @@ Show the logs of PipelineRun named 'microservice-1' for all Tasks and steps (inc
 				opts.PipelineRunName = args[0]
 			}
 
+			runLabels := map[string]string{}
+			for _, l := range runLabelFlags {
+				if value, _ := cmd.Flags().GetString(l.flag); value != "" {
+					runLabels[l.label] = value
+				}
+			}
+			if len(runLabels) != 0 {
+				if opts.PipelineRunName != "" {
+					return fmt.Errorf("--pipeline, --repository and --trigger select the PipelineRun and cannot be used with its name")
+				}
+				opts.RunLabels = runLabels
+			}
+
 			if !opts.Fzf {
 				if _, ok := os.LookupEnv("TKN_USE_FZF"); ok {
 					opts.Fzf = true
 				}
 			}
 
+			if opts.FlushInterval < 0 {
+				return fmt.Errorf("--flush-interval must not be negative")
+			}
+
+			if opts.SummaryLines < 0 {
+				return fmt.Errorf("--summary-lines must not be negative")
+			}
+
+			if opts.MaxConcurrentStreams < 0 {
+				return fmt.Errorf("--max-concurrent-streams must not be negative")
+			}
+
+			if opts.Archive != "" && opts.Archive != log.ArchiveTar && opts.Archive != log.ArchiveZip {
+				return fmt.Errorf("invalid value %q for --output, use %s or %s", opts.Archive, log.ArchiveTar, log.ArchiveZip)
+			}
+			if err := opts.ValidateSigning(); err != nil {
+				return err
+			}
+
+			if opts.StdoutOnly && opts.StderrOnly {
+				return fmt.Errorf("option --stdout-only and option --stderr-only are not compatible")
+			}
+
+			if !slices.Contains(log.Sources, opts.Source) {
+				return fmt.Errorf("invalid value %q for --source, use one of %s", opts.Source, strings.Join(log.Sources, ", "))
+			}
+			if opts.StreamFrom != "" {
+				if opts.Source != log.SourceAuto && opts.Source != log.SourcePods {
+					return fmt.Errorf("--stream-from cannot be used with --source %s", opts.Source)
+				}
+				if opts.StdoutOnly || opts.StderrOnly {
+					return fmt.Errorf("--stream-from cannot be used with --stdout-only and --stderr-only")
+				}
+			}
+
+			if opts.Between != "" && opts.Follow {
+				return fmt.Errorf("--between cannot be used with --follow")
+			}
+
+			if opts.Journal != "" && !opts.Follow {
+				return fmt.Errorf("--journal can only be used with --follow")
+			}
+
+			if opts.NotifyTerminal && !opts.Follow {
+				return fmt.Errorf("--notify-terminal can only be used with --follow")
+			}
+
+			if opts.CaptureResults && !opts.Follow {
+				return fmt.Errorf("--capture-results can only be used with --follow")
+			}
+			if opts.Buffered && !opts.Follow {
+				return fmt.Errorf("--buffered can only be used with --follow")
+			}
+			if opts.Interactive {
+				if !opts.Follow {
+					return fmt.Errorf("--interactive can only be used with --follow")
+				}
+				if opts.Archive != "" || opts.Silent {
+					return fmt.Errorf("--interactive cannot be used with --output or --silent")
+				}
+			}
+			if cmd.Flags().Changed("wait-for-retries") && !opts.Follow {
+				return fmt.Errorf("--wait-for-retries can only be used with --follow")
+			}
+			opts.ExcludeRetries = !includeRetries
+			opts.StopAtFailedAttempt = !waitForRetries
+
+			switch opts.Sort {
+			case sortByTask:
+			case sortByTime:
+				if opts.Follow {
+					return fmt.Errorf("--sort time cannot be used with --follow")
+				}
+			default:
+				return fmt.Errorf("invalid value %q for --sort, use %s or %s", opts.Sort, sortByTask, sortByTime)
+			}
+
 			opts.Stream = &cli.Stream{
 				Out: cmd.OutOrStdout(),
 				Err: cmd.OutOrStderr(),
@@ -84,9 +228,40 @@ Show the logs of PipelineRun named 'microservice-1' for all Tasks and steps (inc
 	c.Flags().BoolVarP(&opts.Follow, "follow", "f", false, "stream live logs")
 	c.Flags().BoolVarP(&opts.Timestamps, "timestamps", "", false, "show logs with timestamp")
 	c.Flags().BoolVarP(&opts.Prefixing, "prefix", "", true, "prefix each log line with the log source (task name and step name)")
-	c.Flags().BoolVarP(&opts.ExitWithPrError, "exit-with-pipelinerun-error", "E", false, "exit with pipelinerun to the unix shell, 0 if success, 1 if error, 2 on unknown status")
+	c.Flags().BoolVarP(&opts.ExitWithPrError, "exit-with-pipelinerun-error", "E", false, "exit with pipelinerun to the unix shell, 0 if success, 5 if failed, 2 on unknown status")
 	c.Flags().StringSliceVarP(&opts.Tasks, "task", "t", []string{}, "show logs for mentioned Tasks only")
+	c.Flags().BoolVarP(&opts.SkipFinally, "skip-finally", "", false, "do not show logs of finally Tasks")
+	c.Flags().BoolVarP(&opts.Resume, "resume", "", false, "skip the Tasks whose logs were shown to the end by an earlier command run with --resume, e.g. after following the logs was interrupted")
+	c.Flags().DurationVarP(&opts.FlushInterval, "flush-interval", "", 0, "buffer logs and write them out at least at this interval, by default logs are buffered unless followed")
 	c.Flags().IntVarP(&opts.Limit, "limit", "", defaultLimit, "lists number of PipelineRuns")
+	c.Flags().StringVarP(&opts.Archive, "output", "o", "", "write the logs to an archive with a file per step and a metadata.json instead of printing them: tar or zip")
+	c.Flags().StringVarP(&opts.SignKey, "sign-key", "", "", "with --output, sign the metadata.json of the archive, which has the digests of its files, with this private key and write the signature to metadata.json.sig, to check with cosign verify-blob")
+	c.Flags().StringVarP(&opts.SignKMSKey, "sign-kms-key", "", "", "with --output, sign the archive like --sign-key with this KMS key, e.g. awskms:///arn:aws:kms:us-east-1:111122223333:key/1234abcd, recorded in metadata.json")
+	c.Flags().StringVarP(&opts.SignCert, "sign-cert", "", "", "certificate of the key signing the archive, whose identity and issuer are recorded in metadata.json")
+	c.Flags().StringVarP(&opts.Source, "source", "", log.SourceAuto, "where the logs are read from: pods, results for Tekton Results, storage for the object storage of the tkn profile, or auto to use the pods while they exist and then the first of the others having the logs")
+	c.Flags().BoolVar(&opts.KeepCompressed, "keep-compressed", false, "write the logs read from Tekton Results or the object storage compressed as they were transferred, with zstd or gzip, rather than decompressing them")
+	c.Flags().StringVarP(&opts.StreamFrom, "stream-from", "", "", "read the logs of the containers from this location instead of the cluster, the logs of a container being at <pod>/<container>.log under it: file:///path/to/dir or s3://bucket/prefix with the default AWS credentials")
+	c.Flags().IntVarP(&opts.MaxConcurrentStreams, "max-concurrent-streams", "", 0, "maximum number of TaskRuns whose logs are followed at the same time, the others wait for a free stream, 0 for no limit")
+	c.Flags().IntVarP(&opts.SummaryLines, "summary-lines", "", 10, "number of log lines of each failed step shown in the summary printed after the logs of a failed PipelineRun, 0 to not print the summary")
+	c.Flags().StringVarP(&opts.Sort, "sort", "", sortByTask, "order of the logs of a completed PipelineRun: task, Task by Task, or time, merged chronologically across Tasks")
+	c.Flags().BoolVarP(&opts.Verbose, "verbose", "", false, "when following, print a notice whenever a watch fails and the run or pod is listed again, and how many times it happened once done")
+	c.Flags().StringVarP(&opts.Journal, "journal", "", "", "when following, append the watch events of the PipelineRun, of its TaskRuns and of their pods, failures of the watches and timeouts to this file as JSON lines, to attach to bug reports")
+	c.Flags().StringVarP(&opts.Between, "between", "", "", "only show the log lines written within START,END, merged chronologically across Tasks, e.g. 12:01:00,12:03:30 or RFC3339 times, times of day are on the start date of the PipelineRun")
+	c.Flags().BoolVarP(&opts.NotifyTerminal, "notify-terminal", "", false, "when following, show the state of the PipelineRun in the title of the terminal, and ring its bell with a desktop notification, where supported, once it is done")
+	c.Flags().BoolVarP(&opts.NoBanner, "no-banner", "", false, "do not write the headers and the blank lines separating the logs of the steps, such as finally:")
+	c.Flags().BoolVarP(&opts.Silent, "silent", "", false, "only write the logs and the errors, without banners, progress messages, skipped Tasks or failure summary")
+	c.Flags().BoolVarP(&opts.ScanLeaks, "scan-leaks", "", false, "look for secrets such as AWS keys, GitHub tokens and JWTs in the logs and warn about the lines likely holding one")
+	c.Flags().BoolVarP(&opts.FailOnLeak, "fail-on-leak", "", false, "scan the logs for secrets like --scan-leaks and fail with exit code 10 when one is found")
+	c.Flags().BoolVarP(&opts.StdoutOnly, "stdout-only", "", false, "only show what the steps write to their standard output, needs the SplitStdoutAndStderr feature gate of Kubernetes 1.32 or later, both streams are shown otherwise")
+	c.Flags().BoolVarP(&opts.StderrOnly, "stderr-only", "", false, "only show what the steps write to their standard error, needs the SplitStdoutAndStderr feature gate of Kubernetes 1.32 or later, both streams are shown otherwise")
+	c.Flags().BoolVarP(&includeRetries, "include-retries", "", true, "show the logs of the earlier attempts of the retried TaskRuns before the logs of their last attempt, when following and false only the attempts made from now on are shown")
+	c.Flags().BoolVarP(&waitForRetries, "wait-for-retries", "", true, "when following, wait for the pods of the retries of the failed attempts of the TaskRuns and show their logs, false stops following a TaskRun after its current attempt")
+	c.Flags().BoolVarP(&opts.Buffered, "buffered", "", false, "when following and the logs are not written to a terminal, e.g. in CI, write the logs of each Task at once when it is done, in the order the Tasks started, rather than interleaving the logs of the Tasks running in parallel")
+	c.Flags().BoolVarP(&opts.Interactive, "interactive", "", false, "when following in a terminal, change how the logs are shown while they stream with keys: p to pause and resume, t to toggle the timestamps, s to show only the current step or all of them, v to change the verbosity of the notices and h for help")
+	c.Flags().BoolVarP(&opts.CaptureResults, "capture-results", "", false, "when following, read the results of the TaskRuns from the steps with exec while they run, for describe to show those not fitting in the termination messages of the steps")
+	for _, l := range runLabelFlags {
+		c.Flags().String(l.flag, "", l.usage)
+	}
 	return c
 }
 
@@ -100,46 +275,266 @@ func Run(opts *options.LogOptions) error {
 		}
 	}
 
+	if archived, err := log.FromArchive(opts, log.LogTypePipeline, opts.PipelineRunName); archived || err != nil {
+		return err
+	}
+
+	var window *log.Window
+	mergeByTime := opts.Between != "" || opts.Sort == sortByTime
+	keepTimestamps := opts.Timestamps
+	if mergeByTime {
+		clients, err := opts.Params.Clients()
+		if err != nil {
+			return err
+		}
+		pr, err := pipelinerunpkg.GetPipelineRun(pipelineRunGroupResource, clients, opts.PipelineRunName, opts.Params.Namespace())
+		if err != nil {
+			return err
+		}
+		if opts.Sort == sortByTime && !pr.IsDone() {
+			return fmt.Errorf("--sort time can only be used with completed PipelineRuns, PipelineRun %s is still running", pr.Name)
+		}
+		if opts.Between != "" {
+			if window, err = parseWindow(opts, pr); err != nil {
+				return err
+			}
+		}
+		// the timestamps are needed to merge the logs
+		opts.Timestamps = true
+	}
+
+	// the archive is written to the output, any other message goes to
+	// the error stream
+	out := opts.Stream.Out
+	if opts.Archive != "" {
+		opts.Stream = &cli.Stream{In: opts.Stream.In, Out: opts.Stream.Err, Err: opts.Stream.Err}
+	}
+
 	lr, err := log.NewReader(log.LogTypePipeline, opts)
 	if err != nil {
 		return err
 	}
+	defer lr.Close()
+
+	var terminal *notify.Terminal
+	if opts.NotifyTerminal && opts.Follow {
+		terminal = notify.NewTerminal(opts.Stream.Err)
+		terminal.Running("PipelineRun", opts.PipelineRunName)
+	}
 
 	logC, errC, err := lr.Read()
 	if err != nil {
 		return err
 	}
+	if mergeByTime {
+		logC = log.MergeByTime(logC, window, keepTimestamps)
+	}
+	var leaks *log.LeakScanner
+	if opts.ScanLeaks || opts.FailOnLeak {
+		leaks = log.NewLeakScanner()
+		logC = leaks.Tee(logC)
+	}
+	var tail *log.Tail
+	if opts.SummaryLines > 0 {
+		tail = log.NewTail(opts.SummaryLines)
+		logC, errC = tail.Tee(logC, errC)
+	}
+	stopControls := func() {}
+	if opts.Interactive {
+		if logC, stopControls, err = log.StartControls(opts, logC); err != nil {
+			return err
+		}
+	}
 
-	log.NewWriter(log.LogTypePipeline, opts.Prefixing).Write(opts.Stream, logC, errC)
+	var archive *log.Archive
+	if opts.Archive != "" {
+		if archive, err = log.NewArchive(opts.Archive, log.LogTypePipeline, out, opts.Params.Time().Now()); err != nil {
+			return err
+		}
+		if opts.SignKey != "" || opts.SignKMSKey != "" {
+			if err := archive.SignWith(opts.SignKey, opts.SignKMSKey, opts.SignCert); err != nil {
+				return err
+			}
+		}
+		if err := archive.Write(opts.Stream, logC, errC); err != nil {
+			return err
+		}
+	} else {
+		log.NewWriter(log.LogTypePipeline, opts.Prefixing).
+			SetBuffering(opts.Follow, opts.FlushInterval).
+			SetQuiet(opts.NoBanner, opts.Silent).
+			Write(opts.Stream, logC, errC)
+	}
+	stopControls()
+	if opts.Verbose && opts.Follow {
+		fmt.Fprintf(opts.Stream.Err, "--- watches listed again %d times while following the logs ---\n", lr.Relists())
+	}
 
-	// get pipelinerun status
-	if opts.ExitWithPrError {
-		clients, err := opts.Params.Clients()
-		if err != nil {
+	clients, err := opts.Params.Clients()
+	if err != nil {
+		return err
+	}
+	// the PipelineRun read before the logs is up to date unless they were
+	// followed
+	pr := lr.PipelineRun()
+	if opts.Follow {
+		if pr, err = followedPipelineRun(opts, clients); err != nil {
+			if archive != nil {
+				// keep the logs which were read in a valid archive
+				_ = archive.Close(nil)
+			}
 			return err
 		}
-		pr, err := pipelinerunpkg.GetPipelineRun(pipelineRunGroupResource, clients, opts.PipelineRunName, opts.Params.Namespace())
+	}
+
+	if archive != nil {
+		metadata, err := log.PipelineRunMetadata(clients, pr)
 		if err != nil {
 			return err
 		}
+		metadata.Producer = log.NewProducer(opts.Params, cmdversion.ClientVersion())
+		if err := archive.Close(metadata); err != nil {
+			return err
+		}
+	}
+
+	if terminal != nil {
+		terminal.Finished("PipelineRun", pr.Name, pr.Status.GetCondition(apis.ConditionSucceeded))
+	}
+
+	if !opts.Silent {
+		printSkippedTasks(opts, pr)
+	}
+
+	if tail != nil && !opts.Silent {
+		if err := printFailureSummary(opts, clients, pr, tail); err != nil {
+			return err
+		}
+	}
+
+	if leaks != nil {
+		if err := log.ReportLeaks(opts, leaks, log.LogTypePipeline, pr.Name); err != nil {
+			return err
+		}
+	}
+
+	// get pipelinerun status
+	if opts.ExitWithPrError {
 		os.Exit(prStatusToUnixStatus(pr))
 	}
 
 	return nil
 }
 
+// followedPipelineRun gets the PipelineRun once its logs were followed, it
+// may have been deleted in the meantime
+func followedPipelineRun(opts *options.LogOptions, clients *cli.Clients) (*tektonv1.PipelineRun, error) {
+	pr, err := pipelinerunpkg.GetPipelineRun(pipelineRunGroupResource, clients, opts.PipelineRunName, opts.Params.Namespace())
+	if errors.IsNotFound(err) {
+		return nil, &cli.ExitError{
+			Code: cli.ExitCodeRunDeleted,
+			Err:  fmt.Errorf("PipelineRun %s was deleted while streaming logs", opts.PipelineRunName),
+		}
+	}
+	return pr, err
+}
+
+// parseWindow parses --between, times of day are on the start date of the
+// PipelineRun in the local time zone
+func parseWindow(opts *options.LogOptions, pr *tektonv1.PipelineRun) (*log.Window, error) {
+	ref := opts.Params.Time().Now()
+	if pr.Status.StartTime != nil {
+		ref = pr.Status.StartTime.Time
+	}
+	return log.ParseWindow(opts.Between, ref.Local())
+}
+
+// printSkippedTasks lists on the error stream the tasks which have been
+// skipped and why, as they will never produce any logs
+func printSkippedTasks(opts *options.LogOptions, pr *tektonv1.PipelineRun) {
+	filter := map[string]bool{}
+	for _, t := range opts.Tasks {
+		filter[t] = true
+	}
+
+	skipped := []tektonv1.SkippedTask{}
+	for _, st := range pr.Status.SkippedTasks {
+		if len(filter) == 0 || filter[st.Name] {
+			skipped = append(skipped, st)
+		}
+	}
+	if len(skipped) == 0 {
+		return
+	}
+
+	fmt.Fprintf(opts.Stream.Err, "%s\n", formatted.DecorateAttr("bold", "skipped:"))
+	for _, st := range skipped {
+		fmt.Fprintf(opts.Stream.Err, "[%s] %s\n", st.Name, formatted.SkippedTaskReason(st))
+	}
+}
+
+// printFailureSummary prints the failed steps of a failed PipelineRun with
+// the last lines of their logs on the error stream, so the cause of the
+// failure is not buried far above
+func printFailureSummary(opts *options.LogOptions, clients *cli.Clients, pr *tektonv1.PipelineRun, tail *log.Tail) error {
+	cond := pr.Status.GetCondition(apis.ConditionSucceeded)
+	if cond == nil || cond.Status != corev1.ConditionFalse {
+		return nil
+	}
+	failures, err := pipelinerunpkg.Failures(clients, pr)
+	if err != nil {
+		return err
+	}
+	if len(failures) == 0 {
+		// the failure of the PipelineRun itself is already reported
+		return nil
+	}
+
+	out := opts.Stream.Err
+	fmt.Fprintf(out, "\n%s\n", formatted.DecorateAttr("bold", "failure summary:"))
+	fmt.Fprintf(out, "PipelineRun %s failed: %s\n", pr.Name, cond.Message)
+	for _, f := range failures {
+		if f.Step == "" {
+			fmt.Fprintf(out, "[%s] %s\n", f.Task, f.Message)
+			continue
+		}
+		fmt.Fprintf(out, "[%s : %s] exited with code %d", f.Task, f.Step, f.ExitCode)
+		if f.Message != "" {
+			fmt.Fprintf(out, " (%s)", f.Message)
+		}
+		fmt.Fprintln(out)
+		for _, l := range tail.Lines(f.Task, f.Step) {
+			fmt.Fprintf(out, "    %s\n", l)
+		}
+	}
+	return nil
+}
+
 func prStatusToUnixStatus(pr *tektonv1.PipelineRun) int {
 	if len(pr.Status.Conditions) == 0 {
 		return 2
 	}
 	if pr.Status.Conditions[0].Status == corev1.ConditionFalse {
-		return 1
+		return cli.ExitCodeRunFailed
 	}
 	return 0
 }
 
+// runLabelFlags scope the PipelineRuns selected with --last or by asking
+// with the labels set by Tekton, Tekton Triggers and Pipelines-as-Code
+var runLabelFlags = []struct {
+	flag, label, usage string
+}{
+	{"pipeline", "tekton.dev/pipeline", "select the PipelineRun with --last or by asking among the runs of this Pipeline"},
+	{"repository", "pipelinesascode.tekton.dev/repository", "select the PipelineRun with --last or by asking among the runs of this Pipelines-as-Code Repository"},
+	{"trigger", "triggers.tekton.dev/trigger", "select the PipelineRun with --last or by asking among the runs created by this Tekton Trigger"},
+}
+
 func askRunName(opts *options.LogOptions) error {
 	lOpts := metav1.ListOptions{}
+	if len(opts.RunLabels) != 0 {
+		lOpts.LabelSelector = labels.SelectorFromSet(opts.RunLabels).String()
+	}
 
 	// We are able to show much more than the default 5 with fzf, so let
 	// increase that limit limited to 100
@@ -158,7 +553,11 @@ func askRunName(opts *options.LogOptions) error {
 	}
 
 	if len(prs) == 0 {
-		fmt.Fprint(os.Stdout, "No PipelineRuns found")
+		if lOpts.LabelSelector != "" {
+			fmt.Fprintf(opts.Stream.Err, "No PipelineRuns found with labels %s", lOpts.LabelSelector)
+			return nil
+		}
+		fmt.Fprint(opts.Stream.Err, "No PipelineRuns found")
 		return nil
 	}
 
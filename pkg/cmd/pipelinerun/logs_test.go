@@ -16,25 +16,34 @@ package pipelinerun
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	"archive/tar"
+	"archive/zip"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/options"
 	"github.com/tektoncd/cli/pkg/pods/fake"
 	"github.com/tektoncd/cli/pkg/pods/stream"
+	"github.com/tektoncd/cli/pkg/state"
 	"github.com/tektoncd/cli/pkg/test"
 	cb "github.com/tektoncd/cli/pkg/test/builder"
 	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/golden"
+	"io"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
+	k8stest "k8s.io/client-go/testing"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
@@ -118,6 +127,26 @@ func TestLog_invalid_limit_v1beta1(t *testing.T) {
 	test.AssertOutput(t, expected, err.Error())
 }
 
+func TestLog_invalid_flush_interval(t *testing.T) {
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Namespaces: ns})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+	c := Command(p)
+
+	_, err := test.ExecuteCommand(c, "logs", "pr-1", "-n", "ns", "--flush-interval", "-1s")
+	if err == nil {
+		t.Errorf("Expecting error for negative --flush-interval")
+	}
+	test.AssertOutput(t, "--flush-interval must not be negative", err.Error())
+}
+
 func TestLog_invalid_limit(t *testing.T) {
 	ns := []*corev1.Namespace{
 		{
@@ -212,7 +241,7 @@ func TestLog_PrStatusToUnixStatus(t *testing.T) {
 					},
 				},
 			},
-			expected: 1,
+			expected: cli.ExitCodeRunFailed,
 		},
 		{
 			name: "Condition status true",
@@ -1165,6 +1194,669 @@ func updatePR(finalRuns []*v1.PipelineRun, watcher *watch.FakeWatcher) {
 	}()
 }
 
+func TestLog_between_with_follow(t *testing.T) {
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Namespaces: ns})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+	c := Command(p)
+
+	_, err := test.ExecuteCommand(c, "logs", "pr-1", "-n", "ns", "-f", "--between", "12:01,12:03")
+	if err == nil {
+		t.Errorf("Expecting error for --between with --follow")
+	}
+	test.AssertOutput(t, "--between cannot be used with --follow", err.Error())
+}
+
+func TestLog_invalid_sort(t *testing.T) {
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Namespaces: ns})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	_, err := test.ExecuteCommand(Command(p), "logs", "pr-1", "-n", "ns", "--sort", "name")
+	test.AssertOutput(t, `invalid value "name" for --sort, use task or time`, err.Error())
+
+	_, err = test.ExecuteCommand(Command(p), "logs", "pr-1", "-n", "ns", "--sort", "time", "-f")
+	test.AssertOutput(t, "--sort time cannot be used with --follow", err.Error())
+}
+
+func TestLog_buffered_without_follow(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	_, err := test.ExecuteCommand(Command(p), "logs", "pr-1", "-n", "ns", "--buffered")
+	test.AssertOutput(t, "--buffered can only be used with --follow", err.Error())
+}
+
+func TestLog_negative_max_concurrent_streams(t *testing.T) {
+	p := &test.Params{}
+
+	_, err := test.ExecuteCommand(Command(p), "logs", "pr-1", "-n", "ns", "-f", "--max-concurrent-streams", "-1")
+	test.AssertOutput(t, "--max-concurrent-streams must not be negative", err.Error())
+}
+
+func TestPipelinerunLogs_mergeByTime(t *testing.T) {
+	var (
+		prName = "build-1"
+		ns     = "namespace"
+		start  = time.Date(2026, 3, 4, 11, 58, 0, 0, time.UTC)
+	)
+
+	nsList := []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: ns}}}
+
+	taskRun := func(name, task, pod, step string) *v1.TaskRun {
+		return &v1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+			Spec:       v1.TaskRunSpec{TaskRef: &v1.TaskRef{Name: task}},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Status: corev1.ConditionTrue, Type: apis.ConditionSucceeded}},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					StartTime: &metav1.Time{Time: start},
+					PodName:   pod,
+					Steps: []v1.StepState{
+						{Name: step, ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}},
+					},
+				},
+			},
+		}
+	}
+	trs := []*v1.TaskRun{
+		taskRun("build-1-compile", "compile", "compile-pod", "go-build"),
+		taskRun("build-1-lint", "lint", "lint-pod", "golangci"),
+	}
+
+	prs := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: prName, Namespace: ns},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+			Status: v1.PipelineRunStatus{
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					StartTime: &metav1.Time{Time: start},
+					ChildReferences: []v1.ChildStatusReference{
+						{Name: trs[0].Name, PipelineTaskName: "compile", TypeMeta: runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"}},
+						{Name: trs[1].Name, PipelineTaskName: "lint", TypeMeta: runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"}},
+					},
+				},
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue, Reason: v1.PipelineRunReasonSuccessful.String()}},
+				},
+			},
+		},
+	}
+	pps := []*v1.Pipeline{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: ns},
+			Spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{
+					{Name: "compile", TaskRef: &v1.TaskRef{Name: "compile"}},
+					{Name: "lint", TaskRef: &v1.TaskRef{Name: "lint"}},
+				},
+			},
+		},
+	}
+	pods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "compile-pod", Namespace: ns},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "go-build"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "lint-pod", Namespace: ns},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "golangci"}}},
+		},
+	}
+
+	fakeLogs := fake.Logs(
+		fake.Task("compile-pod",
+			fake.Step("go-build",
+				"2026-03-04T12:00:30.000000000Z downloading modules",
+				"2026-03-04T12:01:10.000000000Z compiling",
+				"2026-03-04T12:03:00.000000000Z compiled",
+			),
+		),
+		fake.Task("lint-pod",
+			fake.Step("golangci",
+				"2026-03-04T12:01:05.000000000Z linting",
+				"2026-03-04T12:04:00.000000000Z no issues",
+			),
+		),
+	)
+
+	scenarios := []struct {
+		name         string
+		sort         string
+		between      string
+		timestamps   bool
+		expectedLogs []string
+	}{
+		{
+			name: "sorted by time",
+			sort: "time",
+			expectedLogs: []string{
+				"[compile : go-build] downloading modules\n",
+				"[lint : golangci] linting\n",
+				"[compile : go-build] compiling\n",
+				"[compile : go-build] compiled\n",
+				"[lint : golangci] no issues\n",
+			},
+		},
+		{
+			name:    "between",
+			sort:    "task",
+			between: "2026-03-04T12:01:00Z,2026-03-04T12:03:30Z",
+			expectedLogs: []string{
+				"[lint : golangci] linting\n",
+				"[compile : go-build] compiling\n",
+				"[compile : go-build] compiled\n",
+			},
+		},
+		{
+			name:       "between with timestamps",
+			sort:       "task",
+			between:    "2026-03-04T12:01:00Z,2026-03-04T12:03:30Z",
+			timestamps: true,
+			expectedLogs: []string{
+				"[lint : golangci] 2026-03-04T12:01:05.000000000Z linting\n",
+				"[compile : go-build] 2026-03-04T12:01:10.000000000Z compiling\n",
+				"[compile : go-build] 2026-03-04T12:03:00.000000000Z compiled\n",
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs, Pipelines: pps, TaskRuns: trs, Pods: pods, Namespaces: nsList})
+			cs.Pipeline.Resources = cb.APIResourceList(version, []string{"task", "taskrun", "pipeline", "pipelinerun"})
+			tdc := testDynamic.Options{}
+			dc, err := tdc.Client(
+				cb.UnstructuredP(pps[0], version),
+				cb.UnstructuredPR(prs[0], version),
+				cb.UnstructuredTR(trs[0], version),
+				cb.UnstructuredTR(trs[1], version),
+			)
+			if err != nil {
+				t.Errorf("unable to create dynamic client: %v", err)
+			}
+			prlo := logOpts(prName, ns, cs, dc, fake.Streamer(fakeLogs), false, false, true)
+			prlo.Timestamps = s.timestamps
+			prlo.Sort = s.sort
+			prlo.Between = s.between
+			output, err := fetchLogs(prlo)
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			test.AssertOutput(t, strings.Join(s.expectedLogs, ""), output)
+		})
+	}
+}
+
+func TestPipelinerunLogs_archive(t *testing.T) {
+	var (
+		prName = "build-1"
+		ns     = "namespace"
+		start  = metav1.NewTime(test.FakeClock().Now())
+		end    = metav1.NewTime(start.Add(90 * time.Second))
+	)
+
+	nsList := []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: ns}}}
+
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "build-1-compile"},
+			Spec:       v1.TaskRunSpec{TaskRef: &v1.TaskRef{Name: "compile"}},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Status: corev1.ConditionTrue, Type: apis.ConditionSucceeded, Reason: "Succeeded"}},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					StartTime:      &start,
+					CompletionTime: &end,
+					PodName:        "compile-pod",
+					Steps: []v1.StepState{
+						{Name: "go-build", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed", StartedAt: start, FinishedAt: metav1.NewTime(start.Add(time.Minute))}}},
+						{Name: "upload", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed", StartedAt: metav1.NewTime(start.Add(time.Minute)), FinishedAt: end}}},
+					},
+				},
+			},
+		},
+	}
+
+	prs := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: prName, Namespace: ns},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+			Status: v1.PipelineRunStatus{
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					StartTime:      &start,
+					CompletionTime: &end,
+					ChildReferences: []v1.ChildStatusReference{
+						{Name: trs[0].Name, PipelineTaskName: "compile", TypeMeta: runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"}},
+					},
+				},
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue, Reason: "Succeeded"}},
+				},
+			},
+		},
+	}
+	pps := []*v1.Pipeline{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: ns},
+			Spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{{Name: "compile", TaskRef: &v1.TaskRef{Name: "compile"}}},
+			},
+		},
+	}
+	pods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "compile-pod", Namespace: ns},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "step-go-build"}, {Name: "step-upload"}}},
+		},
+	}
+
+	fakeLogs := fake.Logs(
+		fake.Task("compile-pod",
+			fake.Step("step-go-build", "downloading modules", "compiling"),
+			fake.Step("step-upload", "uploaded build-1.tar.gz"),
+		),
+	)
+
+	for _, format := range []string{"tar", "zip"} {
+		t.Run(format, func(t *testing.T) {
+			cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs, Pipelines: pps, TaskRuns: trs, Pods: pods, Namespaces: nsList})
+			cs.Pipeline.Resources = cb.APIResourceList(version, []string{"task", "taskrun", "pipeline", "pipelinerun"})
+			tdc := testDynamic.Options{}
+			dc, err := tdc.Client(
+				cb.UnstructuredP(pps[0], version),
+				cb.UnstructuredPR(prs[0], version),
+				cb.UnstructuredTR(trs[0], version),
+			)
+			if err != nil {
+				t.Errorf("unable to create dynamic client: %v", err)
+			}
+			prlo := logOpts(prName, ns, cs, dc, fake.Streamer(fakeLogs), false, false, true)
+			prlo.Archive = format
+			out, errOut := new(bytes.Buffer), new(bytes.Buffer)
+			prlo.Stream = &cli.Stream{Out: out, Err: errOut}
+			if err := Run(prlo); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			test.AssertOutput(t, "", errOut.String())
+
+			golden.Assert(t, listArchive(t, format, out.Bytes()), "TestPipelinerunLogs_archive.golden")
+		})
+	}
+}
+
+// listArchive returns the names and contents of the files of an archive
+func listArchive(t *testing.T, format string, b []byte) string {
+	t.Helper()
+	var listing strings.Builder
+	add := func(name string, r io.Reader) {
+		content, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(&listing, "==> %s <==\n%s", name, content)
+	}
+
+	if format == "zip" {
+		zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			add(f.Name, rc)
+			rc.Close()
+		}
+		return listing.String()
+	}
+
+	tr := tar.NewReader(bytes.NewReader(b))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		add(hdr.Name, tr)
+	}
+	return listing.String()
+}
+
+func TestPipelinerunLogs_source(t *testing.T) {
+	var (
+		prName = "build-1"
+		ns     = "namespace"
+		start  = metav1.NewTime(test.FakeClock().Now())
+		end    = metav1.NewTime(start.Add(time.Minute))
+	)
+
+	nsList := []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: ns}}}
+
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "build-1-clone"},
+			Spec:       v1.TaskRunSpec{TaskRef: &v1.TaskRef{ResolverRef: v1.ResolverRef{Resolver: "git"}}},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Status: corev1.ConditionTrue, Type: apis.ConditionSucceeded, Reason: "Succeeded"}},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					StartTime:      &start,
+					CompletionTime: &end,
+					PodName:        "clone-pod",
+					Steps: []v1.StepState{
+						{Name: "clone", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed", StartedAt: start, FinishedAt: end}}},
+					},
+					Provenance: &v1.Provenance{
+						RefSource: &v1.RefSource{
+							URI:        "git+https://github.com/tektoncd/catalog.git",
+							Digest:     map[string]string{"sha1": "3c5e0b5d"},
+							EntryPoint: "task/git-clone/0.9/git-clone.yaml",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	prs := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: prName, Namespace: ns},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+			Status: v1.PipelineRunStatus{
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					StartTime:      &start,
+					CompletionTime: &end,
+					ChildReferences: []v1.ChildStatusReference{
+						{Name: trs[0].Name, PipelineTaskName: "clone", TypeMeta: runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"}},
+					},
+				},
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue, Reason: "Succeeded"}},
+				},
+			},
+		},
+	}
+	pps := []*v1.Pipeline{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: ns},
+			Spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{{Name: "clone", TaskRef: &v1.TaskRef{ResolverRef: v1.ResolverRef{Resolver: "git"}}}},
+			},
+		},
+	}
+	pods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "clone-pod", Namespace: ns},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "step-clone"}}},
+		},
+	}
+
+	fakeLogs := fake.Logs(
+		fake.Task("clone-pod",
+			fake.Step("step-clone", "cloned at 3c5e0b5d"),
+		),
+	)
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs, Pipelines: pps, TaskRuns: trs, Pods: pods, Namespaces: nsList})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"task", "taskrun", "pipeline", "pipelinerun"})
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(
+		cb.UnstructuredP(pps[0], version),
+		cb.UnstructuredPR(prs[0], version),
+		cb.UnstructuredTR(trs[0], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	prlo := logOpts(prName, ns, cs, dc, fake.Streamer(fakeLogs), false, false, true)
+	out, errOut := new(bytes.Buffer), new(bytes.Buffer)
+	prlo.Stream = &cli.Stream{Out: out, Err: errOut}
+	if err := Run(prlo); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "[clone : clone] cloned at 3c5e0b5d\n\n", out.String())
+	test.AssertOutput(t, "--- task clone from git+https://github.com/tektoncd/catalog.git@sha1:3c5e0b5d (task/git-clone/0.9/git-clone.yaml) ---\n", errOut.String())
+}
+
+func TestPipelinerunLogs_failureSummary(t *testing.T) {
+	var (
+		prName = "build-1"
+		ns     = "namespace"
+	)
+
+	nsList := []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: ns}}}
+
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "build-1-compile"},
+			Spec:       v1.TaskRunSpec{TaskRef: &v1.TaskRef{Name: "compile"}},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Status: corev1.ConditionFalse, Type: apis.ConditionSucceeded, Reason: "Failed"}},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					StartTime: &metav1.Time{Time: test.FakeClock().Now()},
+					PodName:   "compile-pod",
+					Steps: []v1.StepState{
+						{Name: "go-build", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 2, Reason: "Error"}}},
+						{Name: "upload", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Skipped"}}},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "build-1-deploy"},
+			Spec:       v1.TaskRunSpec{TaskRef: &v1.TaskRef{Name: "deploy"}},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Status: corev1.ConditionFalse, Type: apis.ConditionSucceeded, Reason: "TaskRunTimeout", Message: "TaskRun build-1-deploy failed to finish within 1m0s"}},
+				},
+			},
+		},
+	}
+
+	prs := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: prName, Namespace: ns},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+			Status: v1.PipelineRunStatus{
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					ChildReferences: []v1.ChildStatusReference{
+						{Name: trs[0].Name, PipelineTaskName: "compile", TypeMeta: runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"}},
+						{Name: trs[1].Name, PipelineTaskName: "deploy", TypeMeta: runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"}},
+					},
+				},
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionFalse, Message: "Tasks Completed: 2 (Failed: 2, Cancelled 0), Skipped: 0"}},
+				},
+			},
+		},
+	}
+	pps := []*v1.Pipeline{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: ns},
+			Spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{
+					{Name: "compile", TaskRef: &v1.TaskRef{Name: "compile"}},
+					{Name: "deploy", TaskRef: &v1.TaskRef{Name: "deploy"}},
+				},
+			},
+		},
+	}
+	pods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "compile-pod", Namespace: ns},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "step-go-build"}, {Name: "step-upload"}}},
+		},
+	}
+
+	fakeLogs := fake.Logs(
+		fake.Task("compile-pod",
+			fake.Step("step-go-build", "downloading modules", "compiling", "main.go:3:1: syntax error"),
+			fake.Step("step-upload"),
+		),
+	)
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs, Pipelines: pps, TaskRuns: trs, Pods: pods, Namespaces: nsList})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"task", "taskrun", "pipeline", "pipelinerun"})
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(
+		cb.UnstructuredP(pps[0], version),
+		cb.UnstructuredPR(prs[0], version),
+		cb.UnstructuredTR(trs[0], version),
+		cb.UnstructuredTR(trs[1], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	prlo := logOpts(prName, ns, cs, dc, fake.Streamer(fakeLogs), false, false, true)
+	prlo.SummaryLines = 2
+	output, err := fetchLogs(prlo)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	golden.Assert(t, output, fmt.Sprintf("%s.golden", t.Name()))
+}
+
+func TestPipelinerunLogs_resume(t *testing.T) {
+	var (
+		prName = "build-1"
+		ns     = "namespace"
+	)
+	stateDir := t.TempDir()
+	t.Setenv("TKN_STATE_DIR", stateDir)
+
+	nsList := []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: ns}}}
+
+	taskRun := func(task string) *v1.TaskRun {
+		return &v1.TaskRun{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: prName + "-" + task},
+			Spec:       v1.TaskRunSpec{TaskRef: &v1.TaskRef{Name: task}},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Status: corev1.ConditionTrue, Type: apis.ConditionSucceeded}},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					StartTime: &metav1.Time{Time: test.FakeClock().Now()},
+					PodName:   task + "-pod",
+					Steps:     []v1.StepState{{Name: "run", Container: "step-run"}},
+				},
+			},
+		}
+	}
+	trs := []*v1.TaskRun{taskRun("compile"), taskRun("deploy")}
+
+	prs := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: prName, Namespace: ns},
+			Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "build"}},
+			Status: v1.PipelineRunStatus{
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					ChildReferences: []v1.ChildStatusReference{
+						{Name: trs[0].Name, PipelineTaskName: "compile", TypeMeta: runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"}},
+						{Name: trs[1].Name, PipelineTaskName: "deploy", TypeMeta: runtime.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "TaskRun"}},
+					},
+				},
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue}},
+				},
+			},
+		},
+	}
+	pps := []*v1.Pipeline{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "build", Namespace: ns},
+			Spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{
+					{Name: "compile", TaskRef: &v1.TaskRef{Name: "compile"}},
+					{Name: "deploy", TaskRef: &v1.TaskRef{Name: "deploy"}},
+				},
+			},
+		},
+	}
+	pods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "compile-pod", Namespace: ns},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "step-run"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "deploy-pod", Namespace: ns},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "step-run"}}},
+		},
+	}
+
+	fakeLogs := fake.Logs(
+		fake.Task("compile-pod", fake.Step("step-run", "compiled")),
+		fake.Task("deploy-pod", fake.Step("step-run", "deployed")),
+	)
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs, Pipelines: pps, TaskRuns: trs, Pods: pods, Namespaces: nsList})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"task", "taskrun", "pipeline", "pipelinerun"})
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(
+		cb.UnstructuredP(pps[0], version),
+		cb.UnstructuredPR(prs[0], version),
+		cb.UnstructuredTR(trs[0], version),
+		cb.UnstructuredTR(trs[1], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+
+	// the logs of compile were shown by an earlier command
+	store := state.NewFileStore(stateDir)
+	if err := store.Put("checkpoints/logs/namespace/build-1", []byte("build-1-compile\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	prlo := logOpts(prName, ns, cs, dc, fake.Streamer(fakeLogs), false, false, true)
+	prlo.Resume = true
+	output, err := fetchLogs(prlo)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "[deploy : run] deployed\n\n", output)
+
+	checkpoint, err := store.Get("checkpoints/logs/namespace/build-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, "build-1-compile\nbuild-1-deploy\n", string(checkpoint))
+
+	prlo = logOpts(prName, ns, cs, dc, fake.Streamer(fakeLogs), false, false, true)
+	prlo.Resume = true
+	output, err = fetchLogs(prlo)
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "", output)
+}
+
 func TestPipelinerunLog_completed_taskrun_only_v1beta1(t *testing.T) {
 	var (
 		pipelineName = "output-pipeline"
@@ -1936,6 +2628,18 @@ func TestPipelinerunLog_follow_mode(t *testing.T) {
 	}
 	expected := strings.Join(expectedLogs, "\n") + "\n"
 	test.AssertOutput(t, expected, output)
+
+	// buffered follow writes the same logs
+	prlo = logOpts(prName, ns, cs, dc, fake.Streamer(fakeLogStream), false, true, true)
+	prlo.FlushInterval = 10 * time.Millisecond
+	output, _ = fetchLogs(prlo)
+	test.AssertOutput(t, expected, output)
+
+	// limiting the concurrent streams writes the same logs
+	prlo = logOpts(prName, ns, cs, dc, fake.Streamer(fakeLogStream), false, true, true)
+	prlo.MaxConcurrentStreams = 1
+	output, _ = fetchLogs(prlo)
+	test.AssertOutput(t, expected, output)
 }
 
 func TestLogs_error_log_v1beta1(t *testing.T) {
@@ -2902,6 +3606,61 @@ func TestLog_pipelinerun_status_done(t *testing.T) {
 	test.AssertOutput(t, "", output)
 }
 
+func TestLog_pipelinerun_deleted_while_following(t *testing.T) {
+	var (
+		pipelineName = "deleted-pipeline"
+		prName       = "deleted-run"
+		ns           = "namespace"
+	)
+
+	prs := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      prName,
+				Namespace: ns,
+			},
+			Spec: v1.PipelineRunSpec{
+				PipelineRef: &v1.PipelineRef{Name: pipelineName},
+			},
+			Status: v1.PipelineRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{Type: apis.ConditionSucceeded, Status: corev1.ConditionUnknown, Message: "Running"},
+					},
+				},
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: prs})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipeline", "pipelinerun"})
+	watcher := watch.NewFake()
+	cs.Pipeline.PrependWatchReactor("pipelineruns", k8stest.DefaultWatchReactor(watcher, nil))
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(cb.UnstructuredPR(prs[0], version))
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	prlo := logOpts(prName, ns, cs, dc, fake.Streamer([]fake.Log{}), false, true, true)
+
+	go func() {
+		time.Sleep(time.Second)
+		gvr := v1.SchemeGroupVersion.WithResource("pipelineruns")
+		if err := dc.Resource(gvr).Namespace(ns).Delete(context.Background(), prName, metav1.DeleteOptions{}); err != nil {
+			t.Errorf("unable to delete the PipelineRun: %v", err)
+		}
+		watcher.Delete(prs[0])
+	}()
+
+	output, err := fetchLogs(prlo)
+	if err == nil {
+		t.Fatal("expected the deletion of the PipelineRun to be an error")
+	}
+	test.AssertOutput(t, cli.ExitCodeRunDeleted, cli.ExitCode(err))
+	test.AssertOutput(t, "PipelineRun deleted-run was deleted while streaming logs", err.Error())
+	test.AssertOutput(t, "pipelinerun deleted-run has been deleted while streaming logs\n", output)
+}
+
 func TestLog_pipelinerun_last_v1beta1(t *testing.T) {
 	var (
 		pipelineName = "pipeline1"
@@ -3144,6 +3903,81 @@ func TestLog_pipelinerun_last(t *testing.T) {
 	test.AssertOutput(t, prName2, lopt.PipelineRunName)
 }
 
+func TestLog_pipelinerun_last_with_labels(t *testing.T) {
+	ns := "ns"
+	run := func(name string, started time.Duration, labels map[string]string) *v1.PipelineRun {
+		startTime := metav1.NewTime(time.Now().Add(-started))
+		return &v1.PipelineRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ns,
+				Labels:    labels,
+			},
+			Status: v1.PipelineRunStatus{
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{
+					StartTime: &startTime,
+				},
+			},
+		}
+	}
+	pipelineruns := []*v1.PipelineRun{
+		run("push-2", 0, map[string]string{"tekton.dev/pipeline": "push", "pipelinesascode.tekton.dev/repository": "frontend"}),
+		run("push-1", time.Minute, map[string]string{"tekton.dev/pipeline": "push", "pipelinesascode.tekton.dev/repository": "backend"}),
+		run("nightly-1", 2*time.Minute, map[string]string{"tekton.dev/pipeline": "nightly", "triggers.tekton.dev/trigger": "cron"}),
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		PipelineRuns: pipelineruns,
+		Namespaces:   []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: ns}}},
+	})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun"})
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(
+		cb.UnstructuredPR(pipelineruns[0], version),
+		cb.UnstructuredPR(pipelineruns[1], version),
+		cb.UnstructuredPR(pipelineruns[2], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	p := test.Params{
+		Kube:    cs.Kube,
+		Tekton:  cs.Pipeline,
+		Dynamic: dc,
+	}
+	p.SetNamespace(ns)
+
+	tests := []struct {
+		labels  map[string]string
+		want    string
+		wantErr string
+	}{
+		{labels: map[string]string{"tekton.dev/pipeline": "push"}, want: "push-2"},
+		{labels: map[string]string{"pipelinesascode.tekton.dev/repository": "backend"}, want: "push-1"},
+		{labels: map[string]string{"triggers.tekton.dev/trigger": "cron"}, want: "nightly-1"},
+		{labels: map[string]string{"tekton.dev/pipeline": "push", "triggers.tekton.dev/trigger": "cron"}, wantErr: "No PipelineRuns found with labels tekton.dev/pipeline=push,triggers.tekton.dev/trigger=cron"},
+	}
+	for _, tt := range tests {
+		errOut := &bytes.Buffer{}
+		lopt := options.LogOptions{
+			Params:    &p,
+			Last:      true,
+			Limit:     5,
+			RunLabels: tt.labels,
+			Stream:    &cli.Stream{Out: &bytes.Buffer{}, Err: errOut},
+		}
+		if err := askRunName(&lopt); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		test.AssertOutput(t, tt.want, lopt.PipelineRunName)
+		test.AssertOutput(t, tt.wantErr, errOut.String())
+	}
+
+	c := Command(&p)
+	_, err = test.ExecuteCommand(c, "logs", "push-1", "--pipeline", "push", "-n", ns)
+	test.AssertOutput(t, "--pipeline, --repository and --trigger select the PipelineRun and cannot be used with its name", err.Error())
+}
+
 func TestLog_pipelinerun_only_one_v1beta1(t *testing.T) {
 	var (
 		pipelineName = "pipeline1"
@@ -3567,7 +4401,7 @@ func TestPipelinerunLog_finally_v1beta1(t *testing.T) {
 
 	expectedLogs := []string{
 		"[output-task : writefile-step] wrote a file1\n",
-		"[finally-task : finally-step] Finally\n",
+		"finally:\n[finally-task : finally-step] Finally\n",
 	}
 	expected := strings.Join(expectedLogs, "\n") + "\n"
 	test.AssertOutput(t, expected, output)
@@ -3815,10 +4649,15 @@ func TestPipelinerunLog_finally(t *testing.T) {
 
 	expectedLogs := []string{
 		"[output-task : writefile-step] wrote a file1\n",
-		"[finally-task : finally-step] Finally\n",
+		"finally:\n[finally-task : finally-step] Finally\n",
 	}
 	expected := strings.Join(expectedLogs, "\n") + "\n"
 	test.AssertOutput(t, expected, output)
+
+	prlo = logOpts(prName, ns, cs, dc, fake.Streamer(fakeLogStream), false, false, true)
+	prlo.SkipFinally = true
+	output, _ = fetchLogs(prlo)
+	test.AssertOutput(t, "[output-task : writefile-step] wrote a file1\n\n", output)
 }
 
 func TestLogs_Cluster_Resolver(t *testing.T) {
@@ -4613,3 +5452,36 @@ func fetchLogs(lo *options.LogOptions) (string, error) {
 	err := Run(lo)
 	return out.String(), err
 }
+
+func TestLog_printSkippedTasks(t *testing.T) {
+	pr := &v1.PipelineRun{
+		Status: v1.PipelineRunStatus{
+			PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				SkippedTasks: []v1.SkippedTask{
+					{
+						Name:   "deploy",
+						Reason: v1.WhenExpressionsSkip,
+						WhenExpressions: []v1.WhenExpression{
+							{Input: "feature", Operator: selection.In, Values: []string{"main"}},
+						},
+					},
+					{
+						Name:   "notify",
+						Reason: v1.ParentTasksSkip,
+					},
+				},
+			},
+		},
+	}
+
+	out := new(bytes.Buffer)
+	opts := &options.LogOptions{Stream: &cli.Stream{Out: out, Err: out}}
+	printSkippedTasks(opts, pr)
+	expected := "skipped:\n[deploy] When Expressions evaluated to false: \"feature\" in [main]\n[notify] Parent Tasks were skipped\n"
+	test.AssertOutput(t, expected, out.String())
+
+	out.Reset()
+	opts.Tasks = []string{"build"}
+	printSkippedTasks(opts, pr)
+	test.AssertOutput(t, "", out.String())
+}
@@ -0,0 +1,152 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	pipelinerunpkg "github.com/tektoncd/cli/pkg/pipelinerun"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const pendingTemplate = `{{- $l := len .Runs -}}{{- if eq $l 0 -}}
+No pending PipelineRuns found
+{{ else -}}
+{{- if not $.NoHeaders -}}
+{{- if $.AllNamespaces -}}
+NAMESPACE	NAME	TASK	AGE	BLOCKED ON	REASON
+{{ else -}}
+NAME	TASK	AGE	BLOCKED ON	REASON
+{{ end -}}
+{{- end -}}
+{{- range $_, $run := .Runs }}{{- range $_, $b := $run.Blockers }}{{- if $.AllNamespaces -}}
+{{ $run.PipelineRun.Namespace }}	{{ $run.PipelineRun.Name }}	{{ formatTask $b.Task }}	{{ formatAge $run.PipelineRun.CreationTimestamp $.Time }}	{{ $b.Kind }}	{{ $b.Reason }}
+{{ else -}}
+{{ $run.PipelineRun.Name }}	{{ formatTask $b.Task }}	{{ formatAge $run.PipelineRun.CreationTimestamp $.Time }}	{{ $b.Kind }}	{{ $b.Reason }}
+{{ end -}}{{- end -}}{{- end -}}
+{{- end -}}`
+
+type pendingOptions struct {
+	LabelSelector string
+	AllNamespaces bool
+	NoHeaders     bool
+}
+
+type pendingRun struct {
+	PipelineRun *v1.PipelineRun
+	Blockers    []pipelinerunpkg.Blocker
+}
+
+func pendingCommand(p cli.Params) *cobra.Command {
+	opts := &pendingOptions{}
+	eg := `List PipelineRuns of namespace 'foo' which are blocked:
+
+    tkn pipelinerun pending -n foo
+
+List blocked PipelineRuns across all namespaces:
+
+    tkn pr pending -A
+`
+
+	c := &cobra.Command{
+		Use:   "pending",
+		Short: "Lists PipelineRuns which are blocked and why",
+		Long: `Lists the PipelineRuns which have not completed and are blocked on the
+resolution of their Pipeline or Tasks, the admission of their pods or the
+scheduling of their pods, along with the blocking reason extracted from the
+conditions and events of the PipelineRuns, their TaskRuns and their pods.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:    cobra.NoArgs,
+		Example: eg,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cs, err := p.Clients()
+			if err != nil {
+				return err
+			}
+
+			prs, err := list(p, "", 0, opts.LabelSelector, opts.AllNamespaces, "", 0)
+			if err != nil {
+				return fmt.Errorf("failed to list PipelineRuns from namespace %s: %v", p.Namespace(), err)
+			}
+
+			runs := []pendingRun{}
+			for i := range prs.Items {
+				pr := &prs.Items[i]
+				blockers, err := pipelinerunpkg.PendingBlockers(cs, pr)
+				if err != nil {
+					return fmt.Errorf("failed to find why PipelineRun %s is pending: %w", pr.Name, err)
+				}
+				if len(blockers) != 0 {
+					runs = append(runs, pendingRun{PipelineRun: pr, Blockers: blockers})
+				}
+			}
+
+			stream := &cli.Stream{
+				Out: cmd.OutOrStdout(),
+				Err: cmd.OutOrStderr(),
+			}
+			return printPending(stream, runs, p.Time(), opts)
+		},
+	}
+
+	c.Flags().StringVarP(&opts.LabelSelector, "label", "", opts.LabelSelector, "A selector (label query) to filter on, supports '=', '==', and '!='")
+	c.Flags().BoolVarP(&opts.AllNamespaces, "all-namespaces", "A", opts.AllNamespaces, "list pending PipelineRuns from all namespaces")
+	c.Flags().BoolVarP(&opts.NoHeaders, "no-headers", "", opts.NoHeaders, "do not print column headers with output (default print column headers with output)")
+	return c
+}
+
+func printPending(s *cli.Stream, runs []pendingRun, c clockwork.Clock, opts *pendingOptions) error {
+	var data = struct {
+		Runs          []pendingRun
+		Time          clockwork.Clock
+		AllNamespaces bool
+		NoHeaders     bool
+	}{
+		Runs:          runs,
+		Time:          c,
+		AllNamespaces: opts.AllNamespaces,
+		NoHeaders:     opts.NoHeaders,
+	}
+
+	funcMap := template.FuncMap{
+		"formatAge": func(t metav1.Time, c clockwork.Clock) string {
+			return formatted.Age(&t, c)
+		},
+		"formatTask": func(task string) string {
+			if task == "" {
+				return "---"
+			}
+			return task
+		},
+	}
+
+	w := tabwriter.NewWriter(s.Out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	t := template.Must(template.New("Pending PipelineRuns").Funcs(funcMap).Parse(pendingTemplate))
+
+	if err := t.Execute(w, data); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
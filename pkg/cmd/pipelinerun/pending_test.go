@@ -0,0 +1,216 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/golden"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestPipelineRunPending(t *testing.T) {
+	clock := test.FakeClock()
+	created := metav1.Time{Time: clock.Now()}
+
+	running := duckv1.Status{
+		Conditions: duckv1.Conditions{
+			{
+				Type:   apis.ConditionSucceeded,
+				Status: corev1.ConditionUnknown,
+				Reason: v1.PipelineRunReasonRunning.String(),
+			},
+		},
+	}
+
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "scheduling-build", Namespace: "ns"},
+			Status: v1.TaskRunStatus{
+				Status: running,
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					PodName: "scheduling-build-pod",
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "admission-build", Namespace: "ns"},
+			Status: v1.TaskRunStatus{
+				Status: running,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "resolution-build", Namespace: "ns"},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Type:    apis.ConditionSucceeded,
+							Status:  corev1.ConditionUnknown,
+							Reason:  v1.TaskRunReasonResolvingTaskRef,
+							Message: "waiting for the git resolver",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	childRef := func(name string) []v1.ChildStatusReference {
+		return []v1.ChildStatusReference{
+			{
+				Name:             name,
+				PipelineTaskName: "build",
+				TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+			},
+		}
+	}
+
+	prs := []*v1.PipelineRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "held", Namespace: "ns", CreationTimestamp: created},
+			Spec:       v1.PipelineRunSpec{Status: v1.PipelineRunSpecStatusPending},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "scheduling", Namespace: "ns", CreationTimestamp: created},
+			Status: v1.PipelineRunStatus{
+				Status:                  running,
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{ChildReferences: childRef("scheduling-build")},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "admission", Namespace: "ns", CreationTimestamp: created},
+			Status: v1.PipelineRunStatus{
+				Status:                  running,
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{ChildReferences: childRef("admission-build")},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "resolution", Namespace: "ns", CreationTimestamp: created},
+			Status: v1.PipelineRunStatus{
+				Status:                  running,
+				PipelineRunStatusFields: v1.PipelineRunStatusFields{ChildReferences: childRef("resolution-build")},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "ns", CreationTimestamp: created},
+			Status: v1.PipelineRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Type:   apis.ConditionSucceeded,
+							Status: corev1.ConditionTrue,
+							Reason: v1.PipelineRunReasonSuccessful.String(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "scheduling-build-pod", Namespace: "ns"},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{
+					{
+						Type:    corev1.PodScheduled,
+						Status:  corev1.ConditionFalse,
+						Reason:  corev1.PodReasonUnschedulable,
+						Message: "0/3 nodes are available: 3 Insufficient cpu.",
+					},
+				},
+			},
+		},
+	}
+
+	version := "v1"
+	objs := []runtime.Object{}
+	for _, pr := range prs {
+		objs = append(objs, cb.UnstructuredPR(pr, version))
+	}
+	for _, tr := range trs {
+		objs = append(objs, cb.UnstructuredTR(tr, version))
+	}
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(objs...)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Namespaces:   []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}},
+		PipelineRuns: prs,
+		TaskRuns:     trs,
+		Pods:         pods,
+	})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun", "taskrun"})
+
+	_, err = cs.Kube.CoreV1().Events("ns").Create(context.Background(), &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "admission-build.1", Namespace: "ns"},
+		InvolvedObject: corev1.ObjectReference{Kind: "TaskRun", Name: "admission-build", Namespace: "ns"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "FailedCreate",
+		Message:        `admission webhook "policy.example.com" denied the request`,
+	}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatalf("unable to create event: %v", err)
+	}
+
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic, Clock: clock}
+	clock.Advance(5 * time.Minute)
+
+	pipelinerun := Command(p)
+	got, err := test.ExecuteCommand(pipelinerun, "pending", "-n", "ns")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	golden.Assert(t, got, fmt.Sprintf("%s.golden", t.Name()))
+}
+
+func TestPipelineRunPending_none(t *testing.T) {
+	version := "v1"
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client()
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Namespaces: []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}},
+	})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"pipelinerun", "taskrun"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic}
+
+	pipelinerun := Command(p)
+	got, err := test.ExecuteCommand(pipelinerun, "pending", "-n", "ns")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "No pending PipelineRuns found\n", got)
+}
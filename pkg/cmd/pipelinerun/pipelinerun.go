@@ -38,12 +38,16 @@ func Command(p cli.Params) *cobra.Command {
 
 	flags.AddTektonOptions(c)
 	c.AddCommand(
+		analyzeCommand(p),
 		describeCommand(p),
 		listCommand(p),
 		logCommand(p),
 		cancelCommand(p),
 		deleteCommand(p),
 		exportCommand(p),
+		extractSpecCommand(p),
+		pendingCommand(p),
+		watchCommand(p),
 	)
 
 	return c
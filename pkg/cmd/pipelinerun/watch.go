@@ -0,0 +1,145 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	pipelinerunpkg "github.com/tektoncd/cli/pkg/pipelinerun"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/reconciler/events/cloudevent"
+	"knative.dev/pkg/apis"
+)
+
+const emitCloudEvents = "cloudevents"
+
+type watchOptions struct {
+	Emit    string
+	Sink    string
+	Timeout time.Duration
+}
+
+func (o *watchOptions) validate() error {
+	switch o.Emit {
+	case "":
+		if o.Sink != "" {
+			return fmt.Errorf("--sink requires --emit %s", emitCloudEvents)
+		}
+	case emitCloudEvents:
+		if o.Sink == "" {
+			return fmt.Errorf("--emit %s requires --sink", emitCloudEvents)
+		}
+	default:
+		return fmt.Errorf("invalid value %q for --emit, only %s is supported", o.Emit, emitCloudEvents)
+	}
+	return nil
+}
+
+func watchCommand(p cli.Params) *cobra.Command {
+	opts := &watchOptions{}
+	eg := `Watch the PipelineRun named 'foo' of namespace 'bar' until it finishes:
+
+    tkn pipelinerun watch foo -n bar
+
+Send a CloudEvent to http://el-listener.bar:8080 for every transition of the PipelineRun named 'foo':
+
+    tkn pr watch foo --emit cloudevents --sink http://el-listener.bar:8080 -n bar
+`
+
+	c := &cobra.Command{
+		Use:   "watch",
+		Short: "Watch a PipelineRun until it finishes",
+		Long: `Watch a PipelineRun until it finishes and print each transition of its
+Succeeded condition.
+
+With --emit cloudevents, every transition is also sent to --sink as a
+CloudEvent of the types the Tekton controller emits, e.g.
+dev.tekton.event.pipelinerun.running.v1, so automation expecting those
+events can be fed from the CLI where the controller does not send them.`,
+		Example: eg,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: formatted.ParentCompletion,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			s := &cli.Stream{
+				Out: cmd.OutOrStdout(),
+				Err: cmd.OutOrStderr(),
+			}
+			return watchPipelineRun(cmd.Context(), p, s, args[0], opts)
+		},
+	}
+
+	c.Flags().StringVar(&opts.Emit, "emit", "", "send the transitions of the PipelineRun to --sink, the only format is cloudevents")
+	c.Flags().StringVar(&opts.Sink, "sink", "", "URL the events are sent to")
+	c.Flags().DurationVar(&opts.Timeout, "timeout", 0, "how long to watch the PipelineRun for, 0 watches it until it finishes")
+	return c
+}
+
+func watchPipelineRun(ctx context.Context, p cli.Params, s *cli.Stream, prName string, opts *watchOptions) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var client cloudevents.Client
+	if opts.Emit == emitCloudEvents {
+		client, err = cloudevents.NewClientHTTP()
+		if err != nil {
+			return fmt.Errorf("failed to create the CloudEvents client: %w", err)
+		}
+		ctx = cloudevents.ContextWithTarget(ctx, opts.Sink)
+	}
+
+	_, err = pipelinerunpkg.WatchTransitions(cs, prName, p.Namespace(), opts.Timeout, func(pr *v1.PipelineRun) error {
+		cond := pr.Status.GetCondition(apis.ConditionSucceeded)
+		if cond.Message != "" {
+			fmt.Fprintf(s.Out, "PipelineRun %s is %s: %s\n", pr.Name, formatted.Condition(pr.Status.Conditions), cond.Message)
+		} else {
+			fmt.Fprintf(s.Out, "PipelineRun %s is %s\n", pr.Name, formatted.Condition(pr.Status.Conditions))
+		}
+		if client == nil {
+			return nil
+		}
+		// the type and the source of the event are derived from the
+		// kind, which is not set on the objects read through the clients
+		pr.SetGroupVersionKind(v1.SchemeGroupVersion.WithKind("PipelineRun"))
+		event, err := cloudevent.EventForObjectWithCondition(ctx, pr)
+		if err != nil {
+			return fmt.Errorf("failed to create the CloudEvent of PipelineRun %s: %w", pr.Name, err)
+		}
+		// a sink being down does not stop the watch, the next
+		// transitions may still be delivered
+		if result := client.Send(ctx, *event); !cloudevents.IsACK(result) {
+			fmt.Fprintf(s.Err, "Failed to send %s to %s: %v\n", event.Type(), opts.Sink, result)
+		}
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,178 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tu "github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stest "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func watchedPipelineRun(status corev1.ConditionStatus, reason, message string) *v1.PipelineRun {
+	return &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pr-1",
+			Namespace: "ns",
+		},
+		Spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{Name: "pipeline"},
+		},
+		Status: v1.PipelineRunStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{
+					{Type: apis.ConditionSucceeded, Status: status, Reason: reason, Message: message},
+				},
+			},
+		},
+	}
+}
+
+func TestPipelineRunWatch(t *testing.T) {
+	// the PipelineRun is read once per watch event, it goes through
+	// these states as it is read again
+	states := []*v1.PipelineRun{
+		watchedPipelineRun(corev1.ConditionUnknown, "Started", ""),
+		watchedPipelineRun(corev1.ConditionUnknown, "Running", "Tasks Completed: 0 (Failed: 0, Cancelled 0), Skipped: 0"),
+		watchedPipelineRun(corev1.ConditionUnknown, "Running", "Tasks Completed: 0 (Failed: 0, Cancelled 0), Skipped: 0"),
+		watchedPipelineRun(corev1.ConditionTrue, "Succeeded", ""),
+	}
+
+	var mu sync.Mutex
+	var received []string
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, r.Header.Get("Ce-Type")+" "+r.Header.Get("Ce-Source")+" "+r.Header.Get("Ce-Subject"))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer sink.Close()
+
+	testParams := []struct {
+		name       string
+		command    []string
+		wantEvents []string
+	}{
+		{
+			name:    "watch",
+			command: []string{"watch", "pr-1", "-n", "ns"},
+		},
+		{
+			name:    "emit cloudevents",
+			command: []string{"watch", "pr-1", "-n", "ns", "--emit", "cloudevents", "--sink", sink.URL},
+			wantEvents: []string{
+				"dev.tekton.event.pipelinerun.started.v1 /apis/tekton.dev/v1/namespaces/ns/PipelineRun/pr-1 pr-1",
+				"dev.tekton.event.pipelinerun.running.v1 /apis/tekton.dev/v1/namespaces/ns/PipelineRun/pr-1 pr-1",
+				"dev.tekton.event.pipelinerun.successful.v1 /apis/tekton.dev/v1/namespaces/ns/PipelineRun/pr-1 pr-1",
+			},
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			received = nil
+			cs, _ := tu.SeedTestData(t, pipelinetest.Data{PipelineRuns: []*v1.PipelineRun{states[0]}})
+			cs.Pipeline.Resources = cb.APIResourceList("v1", []string{"pipelinerun"})
+			watcher := watch.NewFakeWithChanSize(len(states), false)
+			for _, pr := range states[1:] {
+				watcher.Modify(cb.UnstructuredPR(pr, "v1"))
+			}
+			reads := 0
+			tdc := testDynamic.Options{
+				WatchResource: "pipelineruns",
+				Watcher:       watcher,
+				PrependReactors: []testDynamic.PrependOpt{{
+					Verb:     "get",
+					Resource: "pipelineruns",
+					Action: func(_ k8stest.Action) (bool, runtime.Object, error) {
+						pr := states[reads]
+						reads++
+						return true, cb.UnstructuredPR(pr, "v1"), nil
+					},
+				}},
+			}
+			dc, err := tdc.Client(cb.UnstructuredPR(states[0], "v1"))
+			if err != nil {
+				t.Fatalf("unable to create dynamic client: %v", err)
+			}
+			p := &tu.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+			got, err := tu.ExecuteCommand(Command(p), tp.command...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := `PipelineRun pr-1 is Running(Started)
+PipelineRun pr-1 is Running: Tasks Completed: 0 (Failed: 0, Cancelled 0), Skipped: 0
+PipelineRun pr-1 is Succeeded
+`
+			tu.AssertOutput(t, want, got)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if d := cmp.Diff(tp.wantEvents, received); d != "" {
+				t.Errorf("unexpected events (-want +got): %s", d)
+			}
+		})
+	}
+}
+
+func TestPipelineRunWatch_invalidFlags(t *testing.T) {
+	testParams := []struct {
+		name    string
+		command []string
+		want    string
+	}{
+		{
+			name:    "emit without sink",
+			command: []string{"watch", "pr-1", "--emit", "cloudevents"},
+			want:    "--emit cloudevents requires --sink",
+		},
+		{
+			name:    "sink without emit",
+			command: []string{"watch", "pr-1", "--sink", "http://localhost"},
+			want:    "--sink requires --emit cloudevents",
+		},
+		{
+			name:    "unknown format",
+			command: []string{"watch", "pr-1", "--emit", "json", "--sink", "http://localhost"},
+			want:    `invalid value "json" for --emit, only cloudevents is supported`,
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &tu.Params{}
+			_, err := tu.ExecuteCommand(Command(p), tp.command...)
+			if err == nil {
+				t.Fatal("error expected here")
+			}
+			tu.AssertOutput(t, tp.want, err.Error())
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"sync"
+
+	"github.com/spf13/cobra"
+)
+
+// profiling writes pprof profiles of the command which is run, for users to
+// attach to the bug reports about a slow or hot command
+type profiling struct {
+	cpu string
+	mem string
+	// cpuFile is the file the CPU profile is being written to
+	cpuFile *os.File
+}
+
+var (
+	profiles = &profiling{}
+	// the hooks are global to cobra, they are only added once however many
+	// times the commands are created
+	addProfilingHooks sync.Once
+	// profiling failures are warnings, they must not fail the command
+	profilingWarnings io.Writer = os.Stderr
+)
+
+// addProfilingFlags adds the hidden --profile-cpu and --profile-mem flags
+func addProfilingFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&profiles.cpu, "profile-cpu", "", "write a CPU profile of the command to this file, to read with go tool pprof")
+	cmd.PersistentFlags().StringVar(&profiles.mem, "profile-mem", "", "write a heap profile to this file once the command is done, to read with go tool pprof")
+	_ = cmd.PersistentFlags().MarkHidden("profile-cpu")
+	_ = cmd.PersistentFlags().MarkHidden("profile-mem")
+
+	addProfilingHooks.Do(func() {
+		cobra.OnInitialize(profiles.start)
+		cobra.OnFinalize(profiles.stop)
+	})
+}
+
+// start starts the CPU profile once the flags are parsed
+func (p *profiling) start() {
+	if p.cpu == "" {
+		return
+	}
+	f, err := os.Create(p.cpu)
+	if err != nil {
+		fmt.Fprintf(profilingWarnings, "Warning: failed to create the CPU profile: %v\n", err)
+		return
+	}
+	if err := pprof.StartCPUProfile(f); err != nil {
+		fmt.Fprintf(profilingWarnings, "Warning: failed to start the CPU profile: %v\n", err)
+		f.Close()
+		return
+	}
+	p.cpuFile = f
+}
+
+// stop stops the CPU profile and writes the heap profile once the command
+// is done, whether it failed or not
+func (p *profiling) stop() {
+	if p.cpuFile != nil {
+		pprof.StopCPUProfile()
+		if err := p.cpuFile.Close(); err != nil {
+			fmt.Fprintf(profilingWarnings, "Warning: failed to write the CPU profile: %v\n", err)
+		}
+		p.cpuFile = nil
+	}
+	if p.mem == "" {
+		return
+	}
+	f, err := os.Create(p.mem)
+	if err != nil {
+		fmt.Fprintf(profilingWarnings, "Warning: failed to create the heap profile: %v\n", err)
+		return
+	}
+	defer f.Close()
+	// collect the garbage for the profile to show the memory in use
+	runtime.GC()
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		fmt.Fprintf(profilingWarnings, "Warning: failed to write the heap profile: %v\n", err)
+	}
+}
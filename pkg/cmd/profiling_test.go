@@ -0,0 +1,53 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/assert"
+)
+
+func TestProfiling(t *testing.T) {
+	dir := t.TempDir()
+	cpu, mem := filepath.Join(dir, "cpu.pprof"), filepath.Join(dir, "mem.pprof")
+	warnings := &bytes.Buffer{}
+	profilingWarnings = warnings
+	defer func() {
+		profilingWarnings = os.Stderr
+		profiles.cpu, profiles.mem = "", ""
+	}()
+
+	out, err := test.ExecuteCommand(Root(&test.Params{}), "version", "--component", "client", "--profile-cpu", cpu, "--profile-mem", mem)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(out, "dev"))
+	assert.Equal(t, warnings.String(), "")
+	for _, f := range []string{cpu, mem} {
+		info, err := os.Stat(f)
+		assert.NilError(t, err)
+		assert.Assert(t, info.Size() > 0, "%s is empty", f)
+	}
+}
+
+func TestProfiling_hidden(t *testing.T) {
+	out, err := test.ExecuteCommand(Root(&test.Params{}), "--help")
+	assert.NilError(t, err)
+	assert.Assert(t, !strings.Contains(out, "profile-cpu"))
+}
@@ -0,0 +1,112 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/render"
+)
+
+type renderOptions struct {
+	Filename   string
+	ValueFiles []string
+	Set        []string
+}
+
+// Command returns the render command
+func Command(_ cli.Params) *cobra.Command {
+	opts := &renderOptions{}
+	eg := `Render a PipelineRun template with a values file and override one of the values:
+
+    tkn render -f pipelinerun.tmpl.yaml --values values.yaml --set git.revision=main
+
+Render a template and create the run:
+
+    tkn render -f pipelinerun.tmpl.yaml --set image=golang:1.22 | kubectl create -f -
+`
+
+	c := &cobra.Command{
+		Use:   "render",
+		Short: "Renders a templated Tekton manifest",
+		Long: `Renders a Go template of Tekton manifests with values.
+
+Values are read from the --values files in order, later files overriding the
+former ones, then from --set which takes precedence over the files. They are
+available in the template as .Values, alongside the usual functions of helm
+templates such as default, required, quote, toYaml or nindent.`,
+		Annotations: map[string]string{
+			"commandType": "utility",
+		},
+		Args:    cobra.NoArgs,
+		Example: eg,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.run(cmd.InOrStdin(), cmd.OutOrStdout())
+		},
+	}
+
+	c.Flags().StringVarP(&opts.Filename, "filename", "f", "", "template to render, - to read it from stdin")
+	c.Flags().StringSliceVar(&opts.ValueFiles, "values", []string{}, "YAML file with values for the template, can be repeated")
+	c.Flags().StringArrayVar(&opts.Set, "set", []string{}, "set a value, e.g. --set git.revision=main, can be repeated")
+	return c
+}
+
+func (opts *renderOptions) run(in io.Reader, out io.Writer) error {
+	if opts.Filename == "" {
+		return errors.New("a template must be provided with --filename")
+	}
+
+	tmpl, err := readFile(opts.Filename, in)
+	if err != nil {
+		return fmt.Errorf("failed to read template %s: %w", opts.Filename, err)
+	}
+
+	values := render.Values{}
+	for _, f := range opts.ValueFiles {
+		b, err := readFile(f, in)
+		if err != nil {
+			return fmt.Errorf("failed to read values file %s: %w", f, err)
+		}
+		v, err := render.ParseValues(b)
+		if err != nil {
+			return fmt.Errorf("failed to parse values file %s: %w", f, err)
+		}
+		values.Merge(v)
+	}
+	for _, s := range opts.Set {
+		if err := values.Set(s); err != nil {
+			return err
+		}
+	}
+
+	rendered, err := render.Render(opts.Filename, tmpl, values)
+	if err != nil {
+		return fmt.Errorf("failed to render %s: %w", opts.Filename, err)
+	}
+	_, err = out.Write(rendered)
+	return err
+}
+
+func readFile(name string, in io.Reader) ([]byte, error) {
+	if name == "-" {
+		return io.ReadAll(in)
+	}
+	return os.ReadFile(name)
+}
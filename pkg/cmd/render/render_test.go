@@ -0,0 +1,84 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/golden"
+)
+
+func TestRender(t *testing.T) {
+	testParams := []struct {
+		name    string
+		command []string
+		input   string
+		wantErr string
+	}{
+		{
+			name:    "values file and set",
+			command: []string{"render", "-f", "testdata/pipelinerun.tmpl.yaml", "--values", "testdata/values.yaml", "--set", "git.revision=main", "--set", "race=true"},
+		},
+		{
+			name:    "stdin",
+			command: []string{"render", "-f", "-", "--set", "git.revision=main"},
+			input:   "apiVersion: tekton.dev/v1\nkind: PipelineRun\nmetadata:\n  name: {{ .Values.git.revision }}\n",
+		},
+		{
+			name:    "missing required value",
+			command: []string{"render", "-f", "testdata/pipelinerun.tmpl.yaml", "--set", "git.revision=main"},
+			wantErr: "image is required",
+		},
+		{
+			name:    "no template",
+			command: []string{"render"},
+			wantErr: "a template must be provided with --filename",
+		},
+		{
+			name:    "invalid set",
+			command: []string{"render", "-f", "testdata/pipelinerun.tmpl.yaml", "--set", "revision"},
+			wantErr: `invalid value "revision", expected key=value`,
+		},
+		{
+			name:    "invalid yaml",
+			command: []string{"render", "-f", "-"},
+			input:   "kind: [PipelineRun\n",
+			wantErr: "document 1 of the rendered - is not valid YAML",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			c := Command(&test.Params{})
+			if tp.input != "" {
+				c.SetIn(strings.NewReader(tp.input))
+			}
+			got, err := test.ExecuteCommand(c, tp.command[1:]...)
+			if tp.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tp.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tp.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			golden.Assert(t, got, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+		})
+	}
+}
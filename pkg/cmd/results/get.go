@@ -0,0 +1,118 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package results
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/results"
+	"sigs.k8s.io/yaml"
+)
+
+func getCommand(p cli.Params) *cobra.Command {
+	var output string
+	eg := `Print the PipelineRun stored in the Record 'c3d4' of the Result 'a1b2':
+
+    tkn results get a1b2/c3d4 -n foo
+`
+
+	c := &cobra.Command{
+		Use:   "get RESULT/RECORD",
+		Short: "Prints the run stored in a Record",
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:    cobra.ExactArgs(1),
+		Example: eg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "yaml" && output != "json" {
+				return fmt.Errorf("invalid output format %q, only yaml and json are supported", output)
+			}
+
+			name, err := recordName(p.Namespace(), args[0])
+			if err != nil {
+				return err
+			}
+			client, err := newClient(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			record, err := client.GetRecord(context.Background(), name)
+			if err != nil {
+				return fmt.Errorf("failed to get Record %s: %w", args[0], err)
+			}
+			return printRecord(cmd.OutOrStdout(), record, output)
+		},
+	}
+	c.Flags().StringVarP(&output, "output", "o", "yaml", "output format, one of yaml or json")
+	return c
+}
+
+func printRecord(w io.Writer, record *results.Record, output string) error {
+	data := record.Data.Value
+	if output == "yaml" {
+		var err error
+		if data, err = yaml.JSONToYAML(data); err != nil {
+			return fmt.Errorf("failed to convert Record %s to yaml: %w", record.Name, err)
+		}
+	}
+	_, err := w.Write(data)
+	if err == nil && output == "json" {
+		_, err = fmt.Fprintln(w)
+	}
+	return err
+}
+
+func logsCommand(p cli.Params) *cobra.Command {
+	eg := `Print the archived logs of the run stored in the Record 'c3d4' of the Result 'a1b2':
+
+    tkn results logs a1b2/c3d4 -n foo
+`
+
+	c := &cobra.Command{
+		Use:   "logs RESULT/RECORD",
+		Short: "Prints the archived logs of the run stored in a Record",
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:    cobra.ExactArgs(1),
+		Example: eg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name, err := recordName(p.Namespace(), args[0])
+			if err != nil {
+				return err
+			}
+			client, err := newClient(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			logs, err := client.GetLog(context.Background(), results.LogName(name))
+			if err != nil {
+				return fmt.Errorf("failed to get the logs of Record %s: %w", args[0], err)
+			}
+			defer logs.Close()
+
+			_, err = io.Copy(cmd.OutOrStdout(), logs)
+			return err
+		},
+	}
+	return c
+}
@@ -0,0 +1,179 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package results
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/results"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const listTemplate = `{{- if eq (len .Results) 0 -}}
+No Results found
+{{ else -}}
+NAME	TYPE	STARTED	DURATION	STATUS
+{{ range $_, $r := .Results -}}
+{{ shortName $r.Name }}	{{ summaryType $r }}	{{ formatAge (startTime $r) $.Time }}	{{ formatDuration (startTime $r) (endTime $r) }}	{{ summaryStatus $r }}
+{{ end -}}
+{{- end -}}`
+
+const recordsTemplate = `{{- if eq (len .Records) 0 -}}
+No Records found
+{{ else -}}
+NAME	TYPE	CREATED
+{{ range $_, $r := .Records -}}
+{{ shortName $r.Name }}	{{ $r.Data.Type }}	{{ formatAge (metaTime $r.CreateTime) $.Time }}
+{{ end -}}
+{{- end -}}`
+
+var funcMap = template.FuncMap{
+	"shortName":      shortName,
+	"formatAge":      formatted.Age,
+	"formatDuration": formatted.Duration,
+	"metaTime":       metaTime,
+	"startTime": func(r results.Result) *metav1.Time {
+		if r.Summary == nil {
+			return &metav1.Time{}
+		}
+		return metaTime(r.Summary.StartTime)
+	},
+	"endTime": func(r results.Result) *metav1.Time {
+		if r.Summary == nil {
+			return &metav1.Time{}
+		}
+		return metaTime(r.Summary.EndTime)
+	},
+	"summaryType": func(r results.Result) string {
+		if r.Summary == nil || r.Summary.Type == "" {
+			return "---"
+		}
+		return r.Summary.Type
+	},
+	"summaryStatus": func(r results.Result) string {
+		if r.Summary == nil || r.Summary.Status == "" {
+			return "---"
+		}
+		return r.Summary.Status
+	},
+}
+
+func metaTime(t time.Time) *metav1.Time {
+	return &metav1.Time{Time: t}
+}
+
+func listCommand(p cli.Params) *cobra.Command {
+	var filter string
+	eg := `List the Results of namespace 'foo':
+
+    tkn results list -n foo
+
+List the Results of PipelineRuns which failed:
+
+    tkn results list --filter 'summary.status == FAILURE'
+`
+
+	c := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "Lists the Results of a namespace",
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:    cobra.NoArgs,
+		Example: eg,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := newClient(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := client.ListResults(context.Background(), p.Namespace(), filter)
+			if err != nil {
+				return fmt.Errorf("failed to list Results of namespace %s: %v", p.Namespace(), err)
+			}
+
+			data := struct {
+				Results []results.Result
+				Time    clockwork.Clock
+			}{res, p.Time()}
+			return print(cmd, listTemplate, data)
+		},
+	}
+	c.Flags().StringVar(&filter, "filter", "", "CEL expression the Results have to match")
+	return c
+}
+
+func recordsCommand(p cli.Params) *cobra.Command {
+	var filter string
+	eg := `List the Records of the Result 'a1b2' of namespace 'foo':
+
+    tkn results records a1b2 -n foo
+
+List the PipelineRun Records of all the Results of namespace 'foo':
+
+    tkn results records -n foo --filter 'data_type == "tekton.dev/v1.PipelineRun"'
+`
+
+	c := &cobra.Command{
+		Use:   "records [RESULT]",
+		Short: "Lists the Records of a Result",
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:    cobra.MaximumNArgs(1),
+		Example: eg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := newClient(p, cmd)
+			if err != nil {
+				return err
+			}
+
+			result := "-"
+			if len(args) != 0 {
+				result = args[0]
+			}
+			records, err := client.ListRecords(context.Background(), fmt.Sprintf("%s/results/%s", p.Namespace(), result), filter)
+			if err != nil {
+				return fmt.Errorf("failed to list Records: %w", err)
+			}
+
+			data := struct {
+				Records []results.Record
+				Time    clockwork.Clock
+			}{records, p.Time()}
+			return print(cmd, recordsTemplate, data)
+		},
+	}
+	c.Flags().StringVar(&filter, "filter", "", "CEL expression the Records have to match")
+	return c
+}
+
+func print(cmd *cobra.Command, tmpl string, data interface{}) error {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 5, 3, ' ', tabwriter.TabIndent)
+	t := template.Must(template.New("Results").Funcs(funcMap).Parse(tmpl))
+	if err := t.Execute(w, data); err != nil {
+		return err
+	}
+	return w.Flush()
+}
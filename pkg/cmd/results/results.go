@@ -0,0 +1,115 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package results
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cli/prerun"
+	"github.com/tektoncd/cli/pkg/flags"
+	"github.com/tektoncd/cli/pkg/keyring"
+	"github.com/tektoncd/cli/pkg/results"
+)
+
+const (
+	addrFlag     = "addr"
+	tokenFlag    = "token"
+	insecureFlag = "insecure-skip-tls-verify"
+)
+
+// Command instantiates the results command
+func Command(p cli.Params) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "results",
+		Short: "Query runs and logs stored in Tekton Results",
+		Long: `Query the runs and logs archived by Tekton Results through the REST
+endpoint of its API, the gRPC endpoint is not supported.
+
+The address of the API is read from --addr or from the results.addr setting
+of the active tkn profile, the bearer token from --token, $TKN_RESULTS_TOKEN
+or the keychain, where it is stored with tkn auth token set results.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		PersistentPreRunE: prerun.PersistentPreRunE(p),
+	}
+
+	flags.AddTektonOptions(cmd)
+	cmd.PersistentFlags().String(addrFlag, "", "address of the REST endpoint of the Results API, e.g. https://tekton-results.example.com, the gRPC endpoint is not supported")
+	cmd.PersistentFlags().String(tokenFlag, "", "bearer token used to authenticate to the Results API (default: $"+results.TokenEnv+")")
+	cmd.PersistentFlags().Bool(insecureFlag, false, "do not verify the certificate of the Results API")
+
+	cmd.AddCommand(
+		listCommand(p),
+		recordsCommand(p),
+		getCommand(p),
+		logsCommand(p),
+	)
+	return cmd
+}
+
+// newClient creates a client for the Results API, flags take precedence
+// over the settings of the active profile
+func newClient(p cli.Params, cmd *cobra.Command) (*results.Client, error) {
+	profile, err := p.Profile()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := results.Options{
+		Addr:                  profile.Results.Addr,
+		InsecureSkipTLSVerify: profile.Results.InsecureSkipTLSVerify,
+		Token:                 os.Getenv(results.TokenEnv),
+	}
+	if addr, _ := cmd.Flags().GetString(addrFlag); addr != "" {
+		opts.Addr = addr
+	}
+	if token, _ := cmd.Flags().GetString(tokenFlag); token != "" {
+		opts.Token = token
+	}
+	if opts.Token == "" {
+		opts.Token = keyring.Lookup(profile, keyring.Results)
+	}
+	if cmd.Flags().Changed(insecureFlag) {
+		opts.InsecureSkipTLSVerify, _ = cmd.Flags().GetBool(insecureFlag)
+	}
+	return results.NewClient(opts)
+}
+
+// recordName expands RESULT/RECORD into the full name of a record in the
+// namespace, full names are returned as they are
+func recordName(ns, name string) (string, error) {
+	if strings.Contains(name, "/results/") {
+		return name, nil
+	}
+	parts := strings.Split(name, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", fmt.Errorf("invalid record %q, expected RESULT/RECORD or the full name of the record", name)
+	}
+	return fmt.Sprintf("%s/results/%s/records/%s", ns, parts[0], parts[1]), nil
+}
+
+// shortName strips the namespace and collection names from the name of a
+// result or a record
+func shortName(name string) string {
+	if i := strings.Index(name, "/results/"); i != -1 {
+		name = name[i+len("/results/"):]
+	}
+	return strings.Replace(name, "/records/", "/", 1)
+}
@@ -0,0 +1,109 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package results
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/results"
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+)
+
+func resultsServer(t *testing.T) *httptest.Server {
+	created := test.FakeClock().Now().Format(time.RFC3339)
+	finished := test.FakeClock().Now().Add(2 * time.Minute).Format(time.RFC3339)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/apis/results.tekton.dev/v1alpha2/parents/ns/results":
+			fmt.Fprintf(w, `{"results":[
+				{"name":"ns/results/a1b2","summary":{"type":"tekton.dev/v1.PipelineRun","status":"SUCCESS","startTime":%q,"endTime":%q}},
+				{"name":"ns/results/e5f6"}
+			]}`, created, finished)
+		case "/apis/results.tekton.dev/v1alpha2/parents/ns/results/a1b2/records":
+			fmt.Fprintf(w, `{"records":[
+				{"name":"ns/results/a1b2/records/c3d4","data":{"type":"tekton.dev/v1.PipelineRun"},"createTime":%q}
+			]}`, created)
+		case "/apis/results.tekton.dev/v1alpha2/parents/ns/results/a1b2/records/c3d4":
+			// {"kind":"PipelineRun","metadata":{"name":"build"}}
+			_, _ = io.WriteString(w, `{"name":"ns/results/a1b2/records/c3d4","data":{"type":"tekton.dev/v1.PipelineRun","value":"eyJraW5kIjoiUGlwZWxpbmVSdW4iLCJtZXRhZGF0YSI6eyJuYW1lIjoiYnVpbGQifX0="}}`)
+		case "/apis/results.tekton.dev/v1alpha3/parents/ns/results/a1b2/logs/c3d4":
+			_, _ = io.WriteString(w, "[build : step-compile] compiling\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"message":"not found"}`)
+		}
+	}))
+}
+
+func run(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	t.Setenv(results.TokenEnv, "")
+
+	srv := resultsServer(t)
+	t.Cleanup(srv.Close)
+
+	clock := test.FakeClock()
+	clock.Advance(10 * time.Minute)
+	p := &test.Params{Clock: clock}
+	return test.ExecuteCommand(Command(p), append(args, "--addr", srv.URL, "-n", "ns")...)
+}
+
+func TestResults_list(t *testing.T) {
+	got, err := run(t, "list")
+	assert.NilError(t, err)
+	golden.Assert(t, got, fmt.Sprintf("%s.golden", t.Name()))
+}
+
+func TestResults_records(t *testing.T) {
+	got, err := run(t, "records", "a1b2")
+	assert.NilError(t, err)
+	golden.Assert(t, got, fmt.Sprintf("%s.golden", t.Name()))
+}
+
+func TestResults_get(t *testing.T) {
+	got, err := run(t, "get", "a1b2/c3d4")
+	assert.NilError(t, err)
+	test.AssertOutput(t, "kind: PipelineRun\nmetadata:\n  name: build\n", got)
+
+	got, err = run(t, "get", "ns/results/a1b2/records/c3d4", "-o", "json")
+	assert.NilError(t, err)
+	test.AssertOutput(t, `{"kind":"PipelineRun","metadata":{"name":"build"}}`+"\n", got)
+
+	_, err = run(t, "get", "a1b2")
+	assert.ErrorContains(t, err, `invalid record "a1b2"`)
+
+	_, err = run(t, "get", "a1b2/nope")
+	assert.ErrorContains(t, err, "failed to get Record a1b2/nope: results API returned 404 Not Found: not found")
+}
+
+func TestResults_logs(t *testing.T) {
+	got, err := run(t, "logs", "a1b2/c3d4")
+	assert.NilError(t, err)
+	test.AssertOutput(t, "[build : step-compile] compiling\n", got)
+}
+
+func TestResults_noAddr(t *testing.T) {
+	_, err := test.ExecuteCommand(Command(&test.Params{}), "list", "-n", "ns")
+	assert.Assert(t, err != nil && strings.Contains(err.Error(), "the address of the Results API is not set"))
+}
@@ -22,20 +22,36 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/cli/prerun"
+	"github.com/tektoncd/cli/pkg/cmd/apply"
+	"github.com/tektoncd/cli/pkg/cmd/auth"
 	"github.com/tektoncd/cli/pkg/cmd/bundle"
 	"github.com/tektoncd/cli/pkg/cmd/chain"
 	"github.com/tektoncd/cli/pkg/cmd/clustertask"
 	"github.com/tektoncd/cli/pkg/cmd/clustertriggerbinding"
 	"github.com/tektoncd/cli/pkg/cmd/completion"
 	"github.com/tektoncd/cli/pkg/cmd/customrun"
+	"github.com/tektoncd/cli/pkg/cmd/daemon"
+	"github.com/tektoncd/cli/pkg/cmd/diff"
 	"github.com/tektoncd/cli/pkg/cmd/eventlistener"
+	"github.com/tektoncd/cli/pkg/cmd/export"
+	"github.com/tektoncd/cli/pkg/cmd/history"
+	tknhub "github.com/tektoncd/cli/pkg/cmd/hub"
+	"github.com/tektoncd/cli/pkg/cmd/interceptor"
+	"github.com/tektoncd/cli/pkg/cmd/local"
+	"github.com/tektoncd/cli/pkg/cmd/logs"
+	"github.com/tektoncd/cli/pkg/cmd/namespace"
+	"github.com/tektoncd/cli/pkg/cmd/pin"
 	"github.com/tektoncd/cli/pkg/cmd/pipeline"
 	"github.com/tektoncd/cli/pkg/cmd/pipelinerun"
+	"github.com/tektoncd/cli/pkg/cmd/render"
+	"github.com/tektoncd/cli/pkg/cmd/results"
+	"github.com/tektoncd/cli/pkg/cmd/setup"
 	"github.com/tektoncd/cli/pkg/cmd/task"
 	"github.com/tektoncd/cli/pkg/cmd/taskrun"
 	"github.com/tektoncd/cli/pkg/cmd/triggerbinding"
 	"github.com/tektoncd/cli/pkg/cmd/triggertemplate"
 	"github.com/tektoncd/cli/pkg/cmd/version"
+	"github.com/tektoncd/cli/pkg/i18n"
 	"github.com/tektoncd/cli/pkg/plugins"
 	"github.com/tektoncd/cli/pkg/suggestion"
 	hubApp "github.com/tektoncd/hub/api/pkg/cli/app"
@@ -100,23 +116,39 @@ func Root(p cli.Params) *cobra.Command {
 	cobra.AddTemplateFunc("HasMainSubCommands", hasMainSubCommands)
 	cobra.AddTemplateFunc("HasUtilitySubCommands", hasUtilitySubCommands)
 	cmd.SetUsageTemplate(usageTemplate)
+	cmd.PersistentFlags().Var(&languageValue{}, "language", "language of the messages, one of "+i18n.Supported()+" (default: $LC_ALL, $LC_MESSAGES or $LANG)")
+	addProfilingFlags(cmd)
 
 	cmd.AddCommand(
+		apply.Command(p),
+		auth.Command(p),
 		bundle.Command(p),
 		chain.Command(p),
 		clustertask.Command(p),
 		clustertriggerbinding.Command(p),
 		completion.Command(),
 		eventlistener.Command(p),
+		export.Command(p),
+		history.Command(p),
+		setup.Command(p),
+		daemon.Command(p),
+		interceptor.Command(p),
+		local.Command(p),
+		logs.Command(p),
+		namespace.Command(p),
+		pin.Command(p),
 		pipeline.Command(p),
 		pipelinerun.Command(p),
+		render.Command(p),
+		results.Command(p),
 		task.Command(p),
 		taskrun.Command(p),
 		customrun.Command(p),
+		diff.Command(p),
 		triggerbinding.Command(p),
 		triggertemplate.Command(p),
 		version.Command(p),
-		hub.Root(hubApp.New()),
+		hubCommand(p),
 	)
 	visitCommands(cmd, reconfigureCmdWithSubcmd)
 	addPluginsToHelp()
@@ -126,6 +158,35 @@ func Root(p cli.Params) *cobra.Command {
 	return cmd
 }
 
+// hubCommand adds the commands of tkn to the ones of the Tekton Hub CLI
+func hubCommand(p cli.Params) *cobra.Command {
+	cmd := hub.Root(hubApp.New())
+	cmd.AddCommand(tknhub.PublishCommand(p))
+	return cmd
+}
+
+// languageValue sets the language of the messages when the flag is parsed,
+// so that it applies whichever command is run
+type languageValue struct {
+	lang string
+}
+
+func (l *languageValue) String() string {
+	return l.lang
+}
+
+func (l *languageValue) Set(lang string) error {
+	if err := i18n.SetLanguage(lang); err != nil {
+		return err
+	}
+	l.lang = lang
+	return nil
+}
+
+func (l *languageValue) Type() string {
+	return "string"
+}
+
 func commandName(cmd *cobra.Command) string {
 	if prerun.IsExperimental(cmd) {
 		return fmt.Sprintf("%s*", cmd.Name())
@@ -50,7 +50,7 @@ func TestCommand_suggest(t *testing.T) {
 	if err == nil {
 		t.Errorf("No errors was defined. Output: %s", out)
 	}
-	expected := "unknown command \"pi\" for \"tkn\"\n\nDid you mean this?\n\tpipeline\n\tpipelinerun\n"
+	expected := "unknown command \"pi\" for \"tkn\"\n\nDid you mean this?\n\tpin\n\tpipeline\n\tpipelinerun\n"
 	test.AssertOutput(t, expected, err.Error())
 }
 
@@ -0,0 +1,365 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package setup
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/cli/prerun"
+	"github.com/tektoncd/cli/pkg/cmd/completion"
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/flags"
+	"github.com/tektoncd/cli/pkg/taskrun"
+	"github.com/tektoncd/cli/pkg/version"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// helloWorldImage is the image of the step of the smoke test
+	helloWorldImage = "busybox"
+	shellNone       = "none"
+)
+
+var shells = []string{"bash", "zsh", "fish", shellNone}
+
+type initOptions struct {
+	Profile         string
+	PipelineTimeout string
+	Shell           string
+	SmokeTest       bool
+	Timeout         time.Duration
+	NoPrompt        bool
+	AskOpts         survey.AskOpt
+}
+
+// Command returns the init command
+func Command(p cli.Params) *cobra.Command {
+	opts := &initOptions{
+		AskOpts: func(opt *survey.AskOptions) error {
+			opt.Stdio = terminal.Stdio{
+				In:  os.Stdin,
+				Out: os.Stdout,
+				Err: os.Stderr,
+			}
+			return nil
+		},
+	}
+	eg := `Set up tkn interactively:
+
+    tkn init
+
+Set up the profile 'dev' targeting the namespace 'ci' with the zsh completion and
+check that a TaskRun can run, without prompting:
+
+    tkn init --profile dev -n ci --shell zsh --smoke-test --no-prompt
+`
+
+	c := &cobra.Command{
+		Use:   "init",
+		Short: "Set up tkn for a cluster",
+		Long: `Set up tkn for a cluster, step by step:
+
+- check that the cluster of the kubeconfig context can be reached
+- detect the Tekton components installed and their versions
+- write a profile of the configuration file with the namespace commands target
+  and the default timeout of the PipelineRuns started
+- install the completion script of the shell
+- optionally run a hello-world TaskRun, to check that Tekton runs tasks
+
+The answers are prompted for, unless they are passed as flags or --no-prompt is
+passed. The other settings of an existing profile are left untouched.`,
+		Example: eg,
+		Annotations: map[string]string{
+			"commandType": "utility",
+		},
+		Args:              cobra.NoArgs,
+		SilenceUsage:      true,
+		PersistentPreRunE: prerun.PersistentPreRunE(p),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return opts.run(cmd, p)
+		},
+	}
+
+	flags.AddTektonOptions(c)
+	c.Flags().StringVar(&opts.Profile, "profile", "", "name of the profile to write, the active profile by default")
+	c.Flags().StringVar(&opts.PipelineTimeout, "pipeline-timeout", "", "default timeout of the PipelineRuns started, e.g. 1h")
+	c.Flags().StringVar(&opts.Shell, "shell", "", "shell to install the completion for, one of bash, zsh, fish or none")
+	c.Flags().BoolVar(&opts.SmokeTest, "smoke-test", false, "run a hello-world TaskRun in the namespace of the profile")
+	c.Flags().DurationVar(&opts.Timeout, "timeout", 5*time.Minute, "how long to wait for the hello-world TaskRun to finish")
+	c.Flags().BoolVar(&opts.NoPrompt, "no-prompt", false, "do not prompt, use the flags and the defaults")
+	return c
+}
+
+func (opts *initOptions) run(cmd *cobra.Command, p cli.Params) error {
+	out := cmd.OutOrStdout()
+
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+	info, err := cs.Kube.Discovery().ServerVersion()
+	if err != nil {
+		return fmt.Errorf("failed to reach the cluster, check the kubeconfig or pass --context: %w", err)
+	}
+	fmt.Fprintf(out, "Connected to the cluster, Kubernetes %s\n", info.GitVersion)
+
+	pipelines := detectComponents(out, cs)
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	name := cfg.ProfileName()
+	if opts.Profile != "" {
+		name = opts.Profile
+	}
+	profile := cfg.Profiles[name]
+	ns := p.Namespace()
+	if !cmd.Flags().Changed("namespace") && profile.Namespace != "" {
+		ns = profile.Namespace
+	}
+	timeout := profile.Timeouts.Pipeline
+	if opts.PipelineTimeout != "" {
+		timeout = opts.PipelineTimeout
+	}
+	if !opts.NoPrompt {
+		qs := []*survey.Question{}
+		if !cmd.Flags().Changed("profile") {
+			qs = append(qs, &survey.Question{
+				Name:     "profile",
+				Prompt:   &survey.Input{Message: "Name of the profile:", Default: name},
+				Validate: survey.Required,
+			})
+		}
+		if !cmd.Flags().Changed("namespace") {
+			qs = append(qs, &survey.Question{
+				Name:     "namespace",
+				Prompt:   &survey.Input{Message: "Namespace the commands target:", Default: ns},
+				Validate: survey.Required,
+			})
+		}
+		if !cmd.Flags().Changed("pipeline-timeout") {
+			qs = append(qs, &survey.Question{
+				Name:   "timeout",
+				Prompt: &survey.Input{Message: "Default timeout of the PipelineRuns started (empty for the one of the cluster):", Default: timeout},
+			})
+		}
+		answers := struct {
+			Profile   string
+			Namespace string
+			Timeout   string
+		}{name, ns, timeout}
+		if err := survey.Ask(qs, &answers, opts.AskOpts); err != nil {
+			return err
+		}
+		name, ns, timeout = answers.Profile, answers.Namespace, answers.Timeout
+	}
+	if timeout != "" {
+		if _, err := time.ParseDuration(timeout); err != nil {
+			return fmt.Errorf("invalid default timeout %q: %w", timeout, err)
+		}
+	}
+
+	err = config.Update(func(cfg *config.Config) error {
+		profile := cfg.Profiles[name]
+		profile.Namespace = ns
+		profile.Timeouts.Pipeline = timeout
+		if cfg.Profiles == nil {
+			cfg.Profiles = map[string]config.Profile{}
+		}
+		cfg.Profiles[name] = profile
+		cfg.CurrentProfile = name
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save the configuration: %w", err)
+	}
+	path, _ := config.Path()
+	fmt.Fprintf(out, "Profile %s saved in %s\n", name, path)
+
+	if err := opts.installCompletion(cmd); err != nil {
+		return err
+	}
+
+	smokeTest := opts.SmokeTest
+	if !opts.NoPrompt && !cmd.Flags().Changed("smoke-test") && pipelines {
+		prompt := &survey.Confirm{
+			Message: fmt.Sprintf("Run a hello-world TaskRun in namespace %s to check that Tekton runs tasks?", ns),
+			Default: true,
+		}
+		if err := survey.AskOne(prompt, &smokeTest, opts.AskOpts); err != nil {
+			return err
+		}
+	}
+	if !smokeTest {
+		return nil
+	}
+	if !pipelines {
+		return fmt.Errorf("the hello-world TaskRun cannot run without Tekton Pipelines")
+	}
+	return opts.runHelloWorld(out, cs, ns)
+}
+
+// detectComponents prints the versions of the Tekton components installed
+// and returns whether Tekton Pipelines is
+func detectComponents(out io.Writer, cs *cli.Clients) bool {
+	components := []struct {
+		name    string
+		version func(*cli.Clients, string) (string, error)
+	}{
+		{"Pipelines", version.GetPipelineVersion},
+		{"Triggers", version.GetTriggerVersion},
+		{"Chains", version.GetChainsVersion},
+		{"Dashboard", version.GetDashboardVersion},
+		{"Operator", version.GetOperatorVersion},
+	}
+	pipelines := false
+	for _, c := range components {
+		v, _ := c.version(cs, "")
+		if v == "" {
+			fmt.Fprintf(out, "Tekton %s: not found\n", c.name)
+			continue
+		}
+		if c.name == "Pipelines" {
+			pipelines = true
+		}
+		fmt.Fprintf(out, "Tekton %s: %s\n", c.name, v)
+	}
+	return pipelines
+}
+
+func (opts *initOptions) installCompletion(cmd *cobra.Command) error {
+	shell := opts.Shell
+	if shell == "" {
+		shell = filepath.Base(os.Getenv("SHELL"))
+		if !validShell(shell) {
+			shell = shellNone
+		}
+		if !opts.NoPrompt {
+			prompt := &survey.Select{
+				Message: "Install the completion for:",
+				Options: shells,
+				Default: shell,
+			}
+			if err := survey.AskOne(prompt, &shell, opts.AskOpts); err != nil {
+				return err
+			}
+		}
+	}
+	if !validShell(shell) {
+		return fmt.Errorf("invalid value %q for --shell, use one of bash, zsh, fish or none", shell)
+	}
+	if shell == shellNone {
+		return nil
+	}
+
+	path, err := completionPath(shell)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := completion.Write(cmd, shell, f); err != nil {
+		return fmt.Errorf("failed to write the completion for %s: %w", shell, err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Completion for %s installed in %s\n", shell, path)
+	if shell == "zsh" {
+		fmt.Fprintf(cmd.OutOrStdout(), "Add %s to the fpath of ~/.zshrc, before compinit, to load it\n", filepath.Dir(path))
+	}
+	return nil
+}
+
+func validShell(shell string) bool {
+	for _, s := range shells {
+		if s == shell {
+			return true
+		}
+	}
+	return false
+}
+
+// completionPath returns where the completion script of shell is loaded
+// from by default, zsh has no such directory
+func completionPath(shell string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	switch shell {
+	case "bash":
+		data := os.Getenv("XDG_DATA_HOME")
+		if data == "" {
+			data = filepath.Join(home, ".local", "share")
+		}
+		return filepath.Join(data, "bash-completion", "completions", "tkn"), nil
+	case "fish":
+		cfg := os.Getenv("XDG_CONFIG_HOME")
+		if cfg == "" {
+			cfg = filepath.Join(home, ".config")
+		}
+		return filepath.Join(cfg, "fish", "completions", "tkn.fish"), nil
+	}
+	return filepath.Join(home, ".zsh", "completions", "_tkn"), nil
+}
+
+func (opts *initOptions) runHelloWorld(out io.Writer, cs *cli.Clients, ns string) error {
+	tr := &v1beta1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "hello-world-",
+			Namespace:    ns,
+		},
+		Spec: v1beta1.TaskRunSpec{
+			TaskSpec: &v1beta1.TaskSpec{
+				Steps: []v1beta1.Step{{
+					Name:   "hello",
+					Image:  helloWorldImage,
+					Script: "echo Hello from Tekton",
+				}},
+			},
+		},
+	}
+	created, err := taskrun.Create(cs, tr, metav1.CreateOptions{}, ns)
+	if err != nil {
+		return fmt.Errorf("failed to create the hello-world TaskRun: %w", err)
+	}
+	fmt.Fprintf(out, "TaskRun %s started, waiting for it to finish\n", created.Name)
+
+	done, err := taskrun.WaitForCompletion(cs, created.Name, ns, opts.Timeout)
+	if err != nil {
+		return err
+	}
+	cond := done.Status.GetCondition(apis.ConditionSucceeded)
+	if !cond.IsTrue() {
+		return fmt.Errorf("TaskRun %s failed: %s: %s", done.Name, cond.Reason, cond.Message)
+	}
+	fmt.Fprintf(out, "TaskRun %s succeeded, Tekton is ready\n", done.Name)
+	return nil
+}
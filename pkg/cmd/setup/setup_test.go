@@ -0,0 +1,171 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package setup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	goexpect "github.com/Netflix/go-expect"
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	"github.com/tektoncd/cli/test/prompt"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8stest "k8s.io/client-go/testing"
+)
+
+// initParams returns the params of a cluster running Tekton Pipelines, on
+// which the TaskRuns created succeed right away
+func initParams(t *testing.T) *test.Params {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_DATA_HOME", "")
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("TKN_PROFILE", "")
+	t.Setenv("TKN_CONFIG", filepath.Join(home, "tkn", "config.yaml"))
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		ConfigMaps: []*corev1.ConfigMap{{
+			ObjectMeta: metav1.ObjectMeta{Name: "pipelines-info", Namespace: "tekton-pipelines"},
+			Data:       map[string]string{"version": "v0.68.0"},
+		}},
+	})
+	cs.Kube.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.31.0"}
+	cs.Pipeline.Resources = cb.APIResourceList("v1", []string{"taskrun"})
+
+	tdc := testDynamic.Options{
+		PrependReactors: []testDynamic.PrependOpt{{
+			Verb:     "create",
+			Resource: "taskruns",
+			Action: func(action k8stest.Action) (bool, runtime.Object, error) {
+				tr := action.(k8stest.CreateAction).GetObject().(*unstructured.Unstructured)
+				tr.SetName(tr.GetGenerateName() + "x7k2p")
+				conditions := []interface{}{map[string]interface{}{"type": "Succeeded", "status": "True", "reason": "Succeeded"}}
+				_ = unstructured.SetNestedSlice(tr.Object, conditions, "status", "conditions")
+				return false, nil, nil
+			},
+		}},
+	}
+	dc, err := tdc.Client()
+	if err != nil {
+		t.Fatalf("unable to create dynamic client: %v", err)
+	}
+	return &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+}
+
+func TestInit(t *testing.T) {
+	p := initParams(t)
+
+	got, err := test.ExecuteCommand(Command(p), "--profile", "dev", "-n", "ci", "--pipeline-timeout", "2h", "--shell", "bash", "--smoke-test", "--no-prompt")
+	assert.NilError(t, err)
+	home := os.Getenv("HOME")
+	golden.Assert(t, strings.ReplaceAll(got, home, "$HOME"), fmt.Sprintf("%s.golden", t.Name()))
+
+	c, err := config.Load()
+	assert.NilError(t, err)
+	assert.Equal(t, c.CurrentProfile, "dev")
+	assert.Equal(t, c.Profiles["dev"].Namespace, "ci")
+	assert.Equal(t, c.Profiles["dev"].Timeouts.Pipeline, "2h")
+
+	script, err := os.ReadFile(filepath.Join(home, ".local", "share", "bash-completion", "completions", "tkn"))
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(string(script), "bash completion for"))
+}
+
+func TestInit_keepsProfile(t *testing.T) {
+	p := initParams(t)
+	assert.NilError(t, config.Save(&config.Config{
+		Profiles: map[string]config.Profile{
+			"default": {Context: "kind-dev", Namespace: "old"},
+		},
+	}))
+
+	_, err := test.ExecuteCommand(Command(p), "-n", "ci", "--shell", "none", "--no-prompt")
+	assert.NilError(t, err)
+
+	c, err := config.Load()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, c.Profiles["default"], config.Profile{Context: "kind-dev", Namespace: "ci"})
+}
+
+func TestInit_errors(t *testing.T) {
+	p := initParams(t)
+
+	_, err := test.ExecuteCommand(Command(p), "--shell", "tcsh", "--no-prompt")
+	assert.Error(t, err, `invalid value "tcsh" for --shell, use one of bash, zsh, fish or none`)
+
+	_, err = test.ExecuteCommand(Command(p), "--pipeline-timeout", "soon", "--no-prompt")
+	assert.Error(t, err, `invalid default timeout "soon": time: invalid duration "soon"`)
+}
+
+func TestInit_prompt(t *testing.T) {
+	p := initParams(t)
+	p.SetNamespace("default")
+
+	pt := prompt.Prompt{
+		Procedure: func(c *goexpect.Console) error {
+			steps := []struct {
+				expect, send string
+			}{
+				{"Name of the profile:", "ci"},
+				{"Namespace the commands target:", "builds"},
+				{"Default timeout of the PipelineRuns started", "30m"},
+				{"Install the completion for:", string(terminal.KeyArrowDown)},
+				{"Run a hello-world TaskRun in namespace builds", "n"},
+			}
+			for _, s := range steps {
+				if _, err := c.ExpectString(s.expect); err != nil {
+					return err
+				}
+				if _, err := c.SendLine(s.send); err != nil {
+					return err
+				}
+			}
+			_, err := c.ExpectEOF()
+			return err
+		},
+	}
+	pt.RunTest(t, pt.Procedure, func(stdio terminal.Stdio) error {
+		t.Setenv("SHELL", "/bin/fish")
+		opts := &initOptions{Timeout: time.Minute, AskOpts: prompt.WithStdio(stdio)}
+		cmd := Command(p)
+		cmd.SetOut(stdio.Out)
+		return opts.run(cmd, p)
+	})
+
+	c, err := config.Load()
+	assert.NilError(t, err)
+	assert.Equal(t, c.CurrentProfile, "ci")
+	assert.DeepEqual(t, c.Profiles["ci"], config.Profile{Namespace: "builds", Timeouts: config.Timeouts{Pipeline: "30m"}})
+	// the arrow moves the selection from fish to none
+	_, err = os.Stat(filepath.Join(os.Getenv("HOME"), ".config", "fish", "completions", "tkn.fish"))
+	assert.Assert(t, os.IsNotExist(err))
+}
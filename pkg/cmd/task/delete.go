@@ -23,6 +23,7 @@ import (
 	"github.com/tektoncd/cli/pkg/deleter"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/cli/pkg/options"
+	"github.com/tektoncd/cli/pkg/references"
 	"github.com/tektoncd/cli/pkg/task"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"go.uber.org/multierr"
@@ -89,6 +90,10 @@ or
 				return errs
 			}
 
+			if err := checkTaskReferences(opts, s, p, availableTaskNames); err != nil {
+				return err
+			}
+
 			if err := opts.CheckOptions(s, availableTaskNames, p.Namespace()); err != nil {
 				return err
 			}
@@ -100,7 +105,7 @@ or
 		},
 	}
 	f.AddFlags(c)
-	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion (default: false)")
+	c.Flags().BoolVarP(&opts.ForceDelete, "force", "f", false, "Whether to force deletion, also of the Tasks referenced by Pipelines, TriggerTemplates or recent TaskRuns (default: false)")
 	c.Flags().BoolVarP(&opts.DeleteRelated, "trs", "", false, "Whether to delete Task(s) and related resources (TaskRuns) (default: false)")
 	c.Flags().BoolVarP(&opts.DeleteAllNs, "all", "", false, "Delete all Tasks in a namespace (default: false)")
 
@@ -133,6 +138,8 @@ func deleteTask(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, taskNa
 	default:
 		d.Delete(taskNames)
 	}
+	d.Audit(p)
+
 	if !opts.DeleteAllNs {
 		d.PrintSuccesses(s)
 	} else if opts.DeleteAllNs {
@@ -163,3 +170,25 @@ func taskRunLister(cs *cli.Clients, ns string) func(string) ([]string, error) {
 		return names, nil
 	}
 }
+
+// checkTaskReferences lists the resources referencing the Tasks about to
+// be deleted, which are only deleted with --force when referenced
+func checkTaskReferences(opts *options.DeleteOptions, s *cli.Stream, p cli.Params, names []string) error {
+	// with --all every one of them goes, names given along are refused by
+	// CheckOptions
+	if opts.DeleteAllNs {
+		return nil
+	}
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+	// the runs deleted along are not references
+	refOpts := references.Options{
+		Since:    p.Time().Now().Add(-references.RecentRuns),
+		SkipRuns: opts.DeleteRelated,
+	}
+	return references.Check(s.Err, "Task", names, opts.ForceDelete, func(name string) ([]references.Reference, error) {
+		return references.ToTask(cs, p.Namespace(), name, refOpts)
+	})
+}
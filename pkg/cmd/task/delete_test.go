@@ -657,3 +657,68 @@ func TestTaskDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskDelete_referenced(t *testing.T) {
+	version := "v1"
+	tdata := []*v1.Task{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "task", Namespace: "ns"},
+		},
+	}
+	pdata := []*v1.Pipeline{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pipeline", Namespace: "ns"},
+			Spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{
+					{Name: "build", TaskRef: &v1.TaskRef{Name: "task", Kind: v1.NamespacedTaskKind}},
+				},
+			},
+		},
+	}
+
+	testParams := []struct {
+		name      string
+		command   []string
+		wantError string
+		want      string
+	}{
+		{
+			name:      "Without force delete flag",
+			command:   []string{"rm", "task", "-n", "ns"},
+			wantError: "refusing to delete the referenced Task(s) \"task\", use --force to delete them anyway",
+			want:      "Task \"task\" is referenced by:\n  - Pipeline pipeline (pipeline tasks build)\nError: refusing to delete the referenced Task(s) \"task\", use --force to delete them anyway\n",
+		},
+		{
+			name:    "With force delete flag",
+			command: []string{"rm", "task", "-n", "ns", "-f"},
+			want:    "Task \"task\" is referenced by:\n  - Pipeline pipeline (pipeline tasks build)\nTasks deleted: \"task\"\n",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			cs, _ := test.SeedTestData(t, pipelinetest.Data{Tasks: tdata, Pipelines: pdata})
+			cs.Pipeline.Resources = cb.APIResourceList(version, []string{"task", "taskrun", "pipeline"})
+			tdc := testDynamic.Options{}
+			dc, err := tdc.Client(
+				cb.UnstructuredT(tdata[0], version),
+				cb.UnstructuredP(pdata[0], version),
+			)
+			if err != nil {
+				t.Errorf("unable to create dynamic client: %v", err)
+			}
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+			out, err := test.ExecuteCommand(Command(p), tp.command...)
+			if tp.wantError != "" {
+				if err == nil {
+					t.Errorf("error expected here")
+				}
+				test.AssertOutput(t, tp.wantError, err.Error())
+			} else if err != nil {
+				t.Errorf("unexpected Error: %v", err)
+			}
+			test.AssertOutput(t, tp.want, out)
+		})
+	}
+}
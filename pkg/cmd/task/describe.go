@@ -136,7 +136,7 @@ or
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
 			}
 
 			cs, err := p.Clients()
@@ -186,7 +186,7 @@ func printTaskDescription(s *cli.Stream, p cli.Params, tname string) error {
 
 	t, err := getTask(taskGroupResource, cs, tname, p.Namespace())
 	if err != nil {
-		return fmt.Errorf("failed to get Task %s: %v", tname, err)
+		return fmt.Errorf("failed to get Task %s: %w", tname, err)
 	}
 
 	opts := metav1.ListOptions{
@@ -195,7 +195,7 @@ func printTaskDescription(s *cli.Stream, p cli.Params, tname string) error {
 
 	var taskRuns *v1.TaskRunList
 	if err := actions.ListV1(taskrunGroupResource, cs, opts, p.Namespace(), &taskRuns); err != nil {
-		return fmt.Errorf("failed to get TaskRuns for Task %s: %v", tname, err)
+		return fmt.Errorf("failed to get TaskRuns for Task %s: %w", tname, err)
 	}
 
 	// this is required as the same label is getting added for both task and ClusterTask
@@ -228,7 +228,7 @@ func printTaskDescription(s *cli.Stream, p cli.Params, tname string) error {
 	tparsed := template.Must(template.New("Describe Task").Funcs(funcMap).Parse(describeTemplate))
 	err = tparsed.Execute(w, data)
 	if err != nil {
-		return fmt.Errorf("failed to execute template: %v", err)
+		return fmt.Errorf("failed to execute template: %w", err)
 	}
 
 	return w.Flush()
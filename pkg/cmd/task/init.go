@@ -0,0 +1,110 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/export"
+	"github.com/tektoncd/cli/pkg/task"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+type initOptions struct {
+	task.ScaffoldOptions
+	Filename string
+}
+
+func initCommand() *cobra.Command {
+	opts := &initOptions{}
+	eg := `Scaffold a Task running the Go tests of a source workspace:
+
+    tkn task init go-test --image golang:1.22 --script 'go test ./...' --workspace source
+
+Scaffold a Task with a param and a result and write it to a file:
+
+    tkn task init build --image golang:1.22 --param package=./... --result digest -f build.yaml
+`
+
+	c := &cobra.Command{
+		Use:   "init TASK",
+		Short: "Scaffolds a new Task",
+		Long: `Scaffolds a Task running a script in a container image, with stubs for
+its params, workspaces and results.
+
+Params are passed to the step as environment variables, e.g. git-url becomes
+$GIT_URL, which is safer than substituting them in the script.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+			"kubernetes":  "false",
+		},
+		Args:         cobra.ExactArgs(1),
+		Example:      eg,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Image == "" {
+				return errors.New("an image must be provided with --image")
+			}
+			opts.Name = args[0]
+
+			t, err := task.Scaffold(opts.ScaffoldOptions)
+			if err != nil {
+				return err
+			}
+			b, err := marshalScaffold(t)
+			if err != nil {
+				return err
+			}
+
+			if opts.Filename == "" {
+				_, err = cmd.OutOrStdout().Write(b)
+				return err
+			}
+			if _, err := os.Stat(opts.Filename); err == nil {
+				return fmt.Errorf("%s already exists", opts.Filename)
+			}
+			if err := os.WriteFile(opts.Filename, b, 0o644); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Task %s written to %s\n", t.Name, opts.Filename)
+			return nil
+		},
+	}
+
+	c.Flags().StringVar(&opts.Image, "image", "", "container image the step runs in")
+	c.Flags().StringVar(&opts.Script, "script", "", "script the step runs, a stub is generated by default")
+	c.Flags().StringVar(&opts.Description, "description", "", "description of the Task")
+	c.Flags().StringArrayVar(&opts.Params, "param", []string{}, "declare a string param, as name or name=default, can be repeated")
+	c.Flags().StringArrayVarP(&opts.Workspaces, "workspace", "w", []string{}, "declare a workspace, can be repeated")
+	c.Flags().StringArrayVar(&opts.Results, "result", []string{}, "declare a string result, can be repeated")
+	c.Flags().StringVarP(&opts.Filename, "filename", "f", "", "write the Task to this file instead of the standard output")
+	return c
+}
+
+// marshalScaffold marshals the Task without the empty fields a hand written
+// Task would not have
+func marshalScaffold(t *v1.Task) ([]byte, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(t)
+	if err != nil {
+		return nil, err
+	}
+	export.RemoveEmptyFields(content)
+	return yaml.Marshal(content)
+}
@@ -0,0 +1,82 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+)
+
+func TestTaskInit(t *testing.T) {
+	testParams := []struct {
+		name    string
+		command []string
+		wantErr string
+	}{
+		{
+			name:    "minimal",
+			command: []string{"init", "go-test", "--image", "golang:1.22"},
+		},
+		{
+			name: "params workspaces and results",
+			command: []string{"init", "go-test", "--image", "golang:1.22", "--script", "go test $PACKAGE",
+				"--param", "package=./...", "--param", "git-url", "-w", "source", "--result", "coverage", "--description", "Runs the Go tests"},
+		},
+		{
+			name:    "no image",
+			command: []string{"init", "go-test"},
+			wantErr: "an image must be provided with --image",
+		},
+		{
+			name:    "invalid name",
+			command: []string{"init", "Go_Test", "--image", "golang:1.22"},
+			wantErr: "invalid Task",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			got, err := test.ExecuteCommand(Command(&test.Params{}), tp.command...)
+			if tp.wantErr != "" {
+				assert.ErrorContains(t, err, tp.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			golden.Assert(t, got, strings.ReplaceAll(fmt.Sprintf("%s.golden", t.Name()), "/", "-"))
+		})
+	}
+}
+
+func TestTaskInit_filename(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "task.yaml")
+
+	got, err := test.ExecuteCommand(Command(&test.Params{}), "init", "go-test", "--image", "golang:1.22", "-f", path)
+	assert.NilError(t, err)
+	test.AssertOutput(t, fmt.Sprintf("Task go-test written to %s\n", path), got)
+
+	b, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(string(b), "name: go-test"))
+
+	_, err = test.ExecuteCommand(Command(&test.Params{}), "init", "go-test", "--image", "golang:1.22", "-f", path)
+	assert.Error(t, err, path+" already exists")
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -51,6 +52,7 @@ NAME	DESCRIPTION	AGE
 type ListOptions struct {
 	AllNamespaces bool
 	NoHeaders     bool
+	Script        flags.ScriptOutput
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -72,7 +74,10 @@ func listCommand(p cli.Params) *cobra.Command {
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("error: output option not set properly: %v", err)
+				return fmt.Errorf("error: output option not set properly: %w", err)
+			}
+			if err := opts.Script.Validate(output); err != nil {
+				return err
 			}
 
 			ns := p.Namespace()
@@ -80,6 +85,19 @@ func listCommand(p cli.Params) *cobra.Command {
 				ns = ""
 			}
 
+			if opts.Script.Enabled() {
+				var tasks *v1.TaskList
+				if err := actions.ListV1(taskGroupResource, cs, metav1.ListOptions{}, ns, &tasks); err != nil {
+					return fmt.Errorf("failed to list Tasks from namespace %s: %w", ns, err)
+				}
+				for _, t := range tasks.Items {
+					if err := opts.Script.Print(cmd.OutOrStdout(), t.Name, t.Namespace, t.Name, formatted.PorcelainTime(&t.CreationTimestamp)); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+
 			if output != "" {
 				p, err := f.ToPrinter()
 				if err != nil {
@@ -97,6 +115,7 @@ func listCommand(p cli.Params) *cobra.Command {
 	f.AddFlags(c)
 	c.Flags().BoolVarP(&opts.AllNamespaces, "all-namespaces", "A", opts.AllNamespaces, "list Tasks from all namespaces")
 	c.Flags().BoolVarP(&opts.NoHeaders, "no-headers", "", opts.NoHeaders, "do not print column headers with output (default print column headers with output)")
+	opts.Script.AddFlags(c, "Task")
 
 	return c
 }
@@ -114,7 +133,7 @@ func printTaskDetails(s *cli.Stream, p cli.Params, allnamespaces bool, noheaders
 
 	var tasks *v1.TaskList
 	if err := actions.ListV1(taskGroupResource, cs, metav1.ListOptions{}, ns, &tasks); err != nil {
-		return fmt.Errorf("failed to list Tasks from namespace %s: %v", ns, err)
+		return fmt.Errorf("failed to list Tasks from namespace %s: %w", ns, err)
 	}
 
 	var data = struct {
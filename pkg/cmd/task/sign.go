@@ -77,11 +77,11 @@ or using kms
 
 			crd := &v1beta1.Task{}
 			if err := yaml.Unmarshal(b, &crd); err != nil {
-				return fmt.Errorf("error unmarshalling Task: %v", err)
+				return fmt.Errorf("error unmarshalling Task: %w", err)
 			}
 			// Sign the task and save to file
 			if err := trustedresources.Sign(crd, opts.keyfile, opts.kmsKey, opts.targetFile); err != nil {
-				return fmt.Errorf("error signing Task: %v", err)
+				return fmt.Errorf("error signing Task: %w", err)
 			}
 			fmt.Fprintf(s.Out, "Task %s is signed successfully \n", args[0])
 			return nil
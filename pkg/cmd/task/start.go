@@ -25,6 +25,7 @@ import (
 	"github.com/google/go-containerregistry/pkg/name"
 	remoteimg "github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/tektoncd/cli/pkg/bundle"
+	"github.com/tektoncd/cli/pkg/i18n"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"fmt"
@@ -33,6 +34,7 @@ import (
 	"github.com/AlecAivazis/survey/v2/terminal"
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/audit"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/cmd/taskrun"
 	"github.com/tektoncd/cli/pkg/file"
@@ -42,6 +44,7 @@ import (
 	"github.com/tektoncd/cli/pkg/options"
 	"github.com/tektoncd/cli/pkg/params"
 	"github.com/tektoncd/cli/pkg/pods"
+	"github.com/tektoncd/cli/pkg/resolverref"
 	traction "github.com/tektoncd/cli/pkg/taskrun"
 	"github.com/tektoncd/cli/pkg/workspaces"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
@@ -51,6 +54,16 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
+// uploadTimeout bounds how long the pods uploading local directories to
+// workspaces may take to start
+const uploadTimeout = 5 * time.Minute
+
+// uploadExec extracts the local directories uploaded to workspaces in the
+// upload pods with the clients of p
+var uploadExec = func(p cli.Params) pods.ExecInputFunc {
+	return pods.NewExecutor(p).ExecInput
+}
+
 var (
 	errNoTask             = errors.New("missing Task name")
 	errInvalidTask        = "Task name %s does not exist in namespace %s"
@@ -64,12 +77,15 @@ type startOptions struct {
 	ServiceAccountName    string
 	Last                  bool
 	Labels                []string
+	Annotations           []string
 	ShowLog               bool
+	NotifyTerminal        bool
 	Filename              string
 	Image                 string
 	TimeOut               string
 	DryRun                bool
 	Output                string
+	Script                flags.ScriptOutput
 	UseTaskRun            string
 	PrefixName            string
 	Workspaces            []string
@@ -79,7 +95,13 @@ type startOptions struct {
 	UseParamDefaults      bool
 	PodTemplate           string
 	SkipOptionalWorkspace bool
+	UploadImage           string
 	remoteOptions         bundle.RemoteOptions
+	// TaskRef is the bundle or hub reference the Task is resolved from,
+	// e.g. hub://tekton/git-clone@0.9
+	TaskRef        string
+	ResolverParams []string
+	resolverRef    *resolverref.Ref
 }
 
 // NameArg validates that the first argument is a valid task name
@@ -130,6 +152,10 @@ func startCommand(p cli.Params) *cobra.Command {
 
     tkn task start foo -s ServiceAccountName -n bar
 
+Start Task foo with labels and annotations set on the run for cost attribution:
+
+    tkn task start foo --label team=payments --annotation cost-center=cc-42 -n bar
+
 The Task can either be specified by reference in a cluster using the positional argument, 
 an oci bundle using the --image argument and the positional argument or in a file using the --filename argument
 
@@ -139,6 +165,13 @@ Authentication:
 	2. Additionally, you can supply a Bearer Token via --remote-bearer
 	3. Additionally, you can use Basic auth via --remote-username and --remote-password
 
+Start a Task out of the cluster, resolved by Tekton when the TaskRun starts
+from a Tekton Bundle with the bundles resolver or from a hub with the hub
+resolver, the params are passed as strings with --param:
+
+    tkn task start --task-ref bundle://gcr.io/org/tasks:v1#build -p image=foo -n bar
+    tkn task start --task-ref hub://tekton/git-clone@0.9 -p url=https://github.com/org/repo -w name=output,emptyDir= -n bar
+
 For params values, if you want to provide multiple values, provide them comma separated
 like cat,foo,bar
 
@@ -156,6 +189,8 @@ For passing the workspaces via flags:
    resources:
      requests:
        storage: 1Gi
+- In case of a local directory, you can pass it like -w name=my-source,localDir=.
+  the directory is uploaded to a new PersistentVolumeClaim owned by the TaskRun, which extracts it in an upload pod
 - In case of binding a CSI workspace, you can pass it like -w name=my-csi,csiFile=csi.yaml
   but you need to create a csi.yaml file before hand. Sample contents of the file are as follows:
   
@@ -180,6 +215,32 @@ For passing the workspaces via flags:
 			if format != "" && opt.ShowLog {
 				return errors.New("cannot use --output option with --showlog option")
 			}
+			if err := opt.Script.Validate(opt.Output); err != nil {
+				return err
+			}
+			if opt.Script.Enabled() && (opt.ShowLog || opt.DryRun) {
+				return errors.New("--quiet and --porcelain cannot be used with --showlog or --dry-run")
+			}
+			if opt.NotifyTerminal && !opt.ShowLog {
+				return errors.New("--notify-terminal can only be used with --showlog")
+			}
+			if opt.TaskRef != "" {
+				if len(args) != 0 || opt.Filename != "" || opt.Image != "" || opt.Last || opt.UseTaskRun != "" {
+					return errors.New("cannot use a Task name, --filename, --image, --last or --use-taskrun options with --task-ref")
+				}
+				ref, err := resolverref.Parse(opt.TaskRef, "task")
+				if err != nil {
+					return err
+				}
+				if err := ref.SetParams(opt.ResolverParams); err != nil {
+					return err
+				}
+				opt.resolverRef = ref
+				return nil
+			}
+			if len(opt.ResolverParams) != 0 {
+				return errors.New("--resolver-param can only be used with --task-ref")
+			}
 			// classic with no image
 			if len(args) != 0 && opt.Image == "" {
 				return NameArg(args, p, &opt)
@@ -218,6 +279,7 @@ For passing the workspaces via flags:
 	}
 
 	c.Flags().StringArrayVarP(&opt.Params, "param", "p", []string{}, "pass the param as key=value for string type, or key=value1,value2,... for array type, or key=\"key1:value1, key2:value2\" for object type")
+	_ = c.RegisterFlagCompletionFunc("param", formatted.ParamCompletion("task"))
 	c.Flags().StringVarP(&opt.ServiceAccountName, "serviceaccount", "s", "", "pass the serviceaccount name")
 	_ = c.RegisterFlagCompletionFunc("serviceaccount",
 		func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
@@ -232,18 +294,25 @@ For passing the workspaces via flags:
 		},
 	)
 	c.Flags().StringSliceVarP(&opt.Labels, "labels", "l", []string{}, "pass labels as label=value.")
+	c.Flags().StringSliceVarP(&opt.Annotations, "annotation", "", []string{}, "pass annotations of the TaskRun as annotation=value, Tekton propagates them with the labels to the pods of the run")
+	flags.AddAliases(c, map[string]string{"label": "labels"})
 	c.Flags().StringArrayVarP(&opt.Workspaces, "workspace", "w", []string{}, "pass one or more workspaces to map to the corresponding physical volumes")
 	c.Flags().BoolVarP(&opt.ShowLog, "showlog", "", false, "show logs right after starting the Task")
+	c.Flags().BoolVarP(&opt.NotifyTerminal, "notify-terminal", "", false, "when using --showlog, show the state of the TaskRun in the title of the terminal, and ring its bell with a desktop notification, where supported, once it is done")
 	c.Flags().StringVarP(&opt.Filename, "filename", "f", "", "local or remote file name containing a Task definition to start a TaskRun")
 	c.Flags().StringVarP(&opt.Image, "image", "i", "", "use an oci bundle")
+	c.Flags().StringVar(&opt.TaskRef, "task-ref", "", "start the Task resolved from a Tekton Bundle as bundle://registry/repo:tag#name or from a hub as hub://catalog/name@version, without applying it to the cluster")
+	c.Flags().StringArrayVar(&opt.ResolverParams, "resolver-param", []string{}, "pass a param of the resolver as key=value with --task-ref, e.g. serviceAccount=puller")
 
 	c.Flags().StringVarP(&opt.TimeOut, "timeout", "", "", "timeout for TaskRun")
 	c.Flags().BoolVarP(&opt.DryRun, "dry-run", "", false, "preview TaskRun without running it")
 	c.Flags().StringVarP(&opt.Output, "output", "", "", "format of TaskRun (yaml or json)")
+	opt.Script.AddFlags(c, "TaskRun")
 	c.Flags().StringVarP(&opt.PrefixName, "prefix-name", "", "", "specify a prefix for the TaskRun name (must be lowercase alphanumeric characters)")
 	c.Flags().BoolVarP(&opt.UseParamDefaults, "use-param-defaults", "", false, "use default parameter values without prompting for input")
 	c.Flags().StringVar(&opt.PodTemplate, "pod-template", "", "local or remote file containing a PodTemplate definition")
 	c.Flags().BoolVarP(&opt.SkipOptionalWorkspace, "skip-optional-workspace", "", false, "skips the prompt for optional workspaces")
+	c.Flags().StringVarP(&opt.UploadImage, "upload-image", "", workspaces.DefaultUploadImage, "image of the pod extracting the local directories of localDir workspaces, it needs sh and tar")
 	bundle.AddRemoteFlags(c.Flags(), &opt.remoteOptions)
 
 	return c
@@ -303,6 +372,22 @@ func startTask(opt startOptions, args []string) error {
 	var tname string
 
 	switch {
+	case opt.resolverRef != nil:
+		// the definition is only fetched by the resolver, the params are
+		// passed as strings with --param rather than prompted
+		tname = opt.resolverRef.Name
+		task := &v1beta1.Task{ObjectMeta: metav1.ObjectMeta{Name: tname}}
+		values, err := params.ParseParams(opt.Params)
+		if err != nil {
+			return err
+		}
+		for name := range values {
+			task.Spec.Params = append(task.Spec.Params, v1beta1.ParamSpec{Name: name, Type: v1beta1.ParamTypeString})
+		}
+		opt.task = task
+		tr.Spec = v1beta1.TaskRunSpec{
+			TaskRef: &v1beta1.TaskRef{ResolverRef: opt.resolverRef.ResolverRef()},
+		}
 	case len(args) > 0 && opt.Image == "":
 		tname = args[0]
 		tr.Spec = v1beta1.TaskRunSpec{
@@ -326,11 +411,19 @@ func startTask(opt startOptions, args []string) error {
 			TaskSpec: &task.Spec,
 		}
 	case opt.Image != "":
-		ref, err := name.ParseReference(opt.Image)
+		image, err := bundle.ExpandReference(context.Background(), opt.Image)
+		if err != nil {
+			return err
+		}
+		ref, err := name.ParseReference(image)
+		if err != nil {
+			return err
+		}
+		profile, err := opt.cliparams.Profile()
 		if err != nil {
 			return err
 		}
-		img, err := remoteimg.Image(ref, opt.remoteOptions.ToOptions()...)
+		img, err := remoteimg.Image(ref, opt.remoteOptions.ToOptions(profile)...)
 		if err != nil {
 			return err
 		}
@@ -386,17 +479,30 @@ func startTask(opt startOptions, args []string) error {
 		tr.Spec.Timeout = &metav1.Duration{Duration: timeoutDuration}
 	}
 
+	annotations, err := labels.MergeAnnotations(tr.ObjectMeta.Annotations, opt.Annotations)
+	if err != nil {
+		return err
+	}
+	tr.ObjectMeta.Annotations = annotations
+
 	labels, err := labels.MergeLabels(tr.ObjectMeta.Labels, opt.Labels)
 	if err != nil {
 		return err
 	}
 	tr.ObjectMeta.Labels = labels
 
-	workspaces, err := workspaces.Merge(tr.Spec.Workspaces, opt.Workspaces, cs.HTTPClient)
+	localDirs, ws, err := workspaces.SplitLocalDirs(opt.Workspaces)
 	if err != nil {
 		return err
 	}
-	tr.Spec.Workspaces = workspaces
+	if len(localDirs) != 0 && opt.DryRun {
+		return errors.New("cannot use --dry-run option with localDir workspaces, they are uploaded when the TaskRun is started")
+	}
+	bindings, err := workspaces.Merge(tr.Spec.Workspaces, ws, cs.HTTPClient)
+	if err != nil {
+		return err
+	}
+	tr.Spec.Workspaces = bindings
 
 	param, err := params.MergeParam(tr.Spec.Params, opt.Params)
 	if err != nil {
@@ -435,10 +541,30 @@ func startTask(opt startOptions, args []string) error {
 		return printTaskRun(opt.Output, opt.stream, tr)
 	}
 
+	var claims []string
+	for _, dir := range localDirs {
+		fmt.Fprintf(opt.stream.Err, "Uploading %s to workspace %s...\n", dir.Dir, dir.Workspace)
+		claim, err := dir.Upload(cs.Kube, opt.cliparams.Namespace(), opt.UploadImage, uploadExec(opt.cliparams), uploadTimeout)
+		if err != nil {
+			deleteClaims(cs, opt.cliparams.Namespace(), claims)
+			return err
+		}
+		claims = append(claims, claim)
+		tr.Spec.Workspaces = setBinding(tr.Spec.Workspaces, dir.Binding(claim))
+	}
+
 	trCreated, err := traction.Create(cs, tr, metav1.CreateOptions{}, opt.cliparams.Namespace())
 	if err != nil {
-		return err
+		deleteClaims(cs, opt.cliparams.Namespace(), claims)
+		return actions.DescribeRejection("TaskRun", err)
+	}
+	owner := metav1.OwnerReference{APIVersion: tr.APIVersion, Kind: tr.Kind, Name: trCreated.Name, UID: trCreated.UID}
+	for _, claim := range claims {
+		if err := workspaces.OwnClaim(cs.Kube, opt.cliparams.Namespace(), claim, owner); err != nil {
+			fmt.Fprintf(opt.stream.Err, "failed to make TaskRun %s own PersistentVolumeClaim %s, delete it once the run is done: %v\n", trCreated.Name, claim, err)
+		}
 	}
+	audit.Record(opt.cliparams, audit.ActionStart, "TaskRun", trCreated.Name)
 
 	if opt.Output != "" {
 		gvr, err := actions.GetGroupVersionResource(taskrunGroupResource, cs.Tekton.Discovery())
@@ -458,30 +584,50 @@ func startTask(opt startOptions, args []string) error {
 		return printTaskRun(opt.Output, opt.stream, trCreated)
 	}
 
-	fmt.Fprintf(opt.stream.Out, "TaskRun started: %s\n", trCreated.Name)
+	if opt.Script.Enabled() {
+		return opt.Script.Print(opt.stream.Out, trCreated.Name, trCreated.Namespace, trCreated.Name)
+	}
+
+	i18n.Fprintf(opt.stream.Out, "TaskRun started: %s\n", trCreated.Name)
 	if !opt.ShowLog {
-		inOrderString := "\nIn order to track the TaskRun progress run:\ntkn taskrun "
-		if opt.TektonOptions.Context != "" {
-			inOrderString += fmt.Sprintf("--context=%s ", opt.TektonOptions.Context)
-		}
+		inOrderString := i18n.T("\nIn order to track the TaskRun progress run:\n") + "tkn taskrun "
+		inOrderString += opt.TektonOptions.Args()
 		inOrderString += fmt.Sprintf("logs %s -f -n %s\n", trCreated.Name, trCreated.Namespace)
 
 		fmt.Fprint(opt.stream.Out, inOrderString)
 		return nil
 	}
 
-	fmt.Fprintf(opt.stream.Out, "Waiting for logs to be available...\n")
+	i18n.Fprintf(opt.stream.Out, "Waiting for logs to be available...\n")
 	runLogOpts := &options.LogOptions{
-		TaskrunName: trCreated.Name,
-		Stream:      opt.stream,
-		Follow:      true,
-		Prefixing:   true,
-		Params:      opt.cliparams,
-		AllSteps:    false,
+		TaskrunName:    trCreated.Name,
+		Stream:         opt.stream,
+		Follow:         true,
+		Prefixing:      true,
+		Params:         opt.cliparams,
+		AllSteps:       false,
+		NotifyTerminal: opt.NotifyTerminal,
 	}
 	return taskrun.Run(runLogOpts)
 }
 
+// setBinding replaces the binding of the workspace, or adds it
+func setBinding(ws []v1beta1.WorkspaceBinding, binding v1beta1.WorkspaceBinding) []v1beta1.WorkspaceBinding {
+	for i := range ws {
+		if ws[i].Name == binding.Name {
+			ws[i] = binding
+			return ws
+		}
+	}
+	return append(ws, binding)
+}
+
+func deleteClaims(cs *cli.Clients, ns string, claims []string) {
+	for _, claim := range claims {
+		_ = cs.Kube.CoreV1().PersistentVolumeClaims(ns).Delete(context.Background(), claim, metav1.DeleteOptions{})
+	}
+}
+
 func printTaskRun(output string, s *cli.Stream, tr interface{}) error {
 	format := strings.ToLower(output)
 	if format == "" || format == "yaml" {
@@ -15,7 +15,12 @@
 package task
 
 import (
+	"archive/tar"
+	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -23,6 +28,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/pods"
 	"github.com/tektoncd/cli/pkg/test"
 	cb "github.com/tektoncd/cli/pkg/test/builder"
 	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
@@ -31,6 +38,7 @@ import (
 	pipelinetest "github.com/tektoncd/pipeline/test"
 	"gotest.tools/v3/golden"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -1446,6 +1454,65 @@ func Test_start_task_client_error_v1beta1(t *testing.T) {
 	test.AssertOutput(t, expected, got)
 }
 
+func Test_start_task_webhook_rejection(t *testing.T) {
+	tasks := []*v1beta1.Task{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "task-1",
+				Namespace: "ns",
+			},
+			Spec: v1beta1.TaskSpec{
+				Steps: []v1beta1.Step{
+					{
+						Name:  "hello",
+						Image: "busybox",
+					},
+				},
+			},
+		},
+	}
+
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	cs, _ := test.SeedV1beta1TestData(t, test.Data{Tasks: tasks, Namespaces: ns})
+	cs.Pipeline.Resources = cb.APIResourceList(versionv1beta1, []string{"task", "taskrun"})
+	tdc := testDynamic.Options{
+		PrependReactors: []testDynamic.PrependOpt{
+			{
+				Resource: "taskruns",
+				Verb:     "create",
+				Action: func(_ k8stest.Action) (bool, runtime.Object, error) {
+					return true, nil, apierrors.NewBadRequest(`admission webhook "validate.kyverno.svc-fail" denied the request: 
+
+resource TaskRun/ns/ was blocked due to the following policies 
+
+require-team-label:
+  check-team: 'validation error: label team is required. rule check-team failed at path /metadata/labels/team/'
+`)
+				},
+			},
+		},
+	}
+	dc, _ := tdc.Client(
+		cb.UnstructuredV1beta1T(tasks[0], versionv1beta1),
+	)
+
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+	task := Command(p)
+	got, _ := test.ExecuteCommand(task, "start", "task-1", "-n", "ns")
+	expected := `Error: TaskRun rejected by admission webhook "validate.kyverno.svc-fail"
+- [require-team-label/check-team] validation error: label team is required. rule check-team failed at path /metadata/labels/team/ (field /metadata/labels/team/)
+`
+	test.AssertOutput(t, expected, got)
+}
+
 func Test_start_task_invalid_workspace_v1beta1(t *testing.T) {
 	tasks := []*v1beta1.Task{
 		{
@@ -1879,6 +1946,37 @@ func TestTaskStart_ExecuteCommand_v1beta1(t *testing.T) {
 			wantError:  false,
 			goldenFile: true,
 		},
+		{
+			name: "Dry Run with --label and --annotation",
+			command: []string{"start", "task-1",
+				"-p=myarg=arg",
+				"-p=task-param=arg",
+				"-s=svc1",
+				"--label=team=payments",
+				"--annotation=cost-center=cc-42",
+				"-n", "ns",
+				"--dry-run"},
+			namespace:  "",
+			dynamic:    dc,
+			input:      cs,
+			wantError:  false,
+			goldenFile: true,
+		},
+		{
+			name: "Dry Run with invalid --annotation",
+			command: []string{"start", "task-1",
+				"-p=myarg=arg",
+				"-p=task-param=arg",
+				"-s=svc1",
+				"--annotation=cost-center",
+				"-n", "ns",
+				"--dry-run"},
+			namespace: "",
+			dynamic:   dc,
+			input:     cs,
+			wantError: true,
+			want:      "invalid input format for annotation parameter: cost-center",
+		},
 		{
 			name: "Dry Run with --use-param-defaults and specified params",
 			command: []string{"start", "task-1",
@@ -1906,6 +2004,33 @@ func TestTaskStart_ExecuteCommand_v1beta1(t *testing.T) {
 			wantError:  false,
 			goldenFile: true,
 		},
+		{
+			name: "Dry Run from a bundle reference",
+			command: []string{"start",
+				"--task-ref", "bundle://gcr.io/org/tasks:v1#build",
+				"-p=image=foo",
+				"--resolver-param=serviceAccount=puller",
+				"-w=name=source,emptyDir=",
+				"-n", "ns",
+				"--dry-run"},
+			namespace:  "",
+			dynamic:    dc,
+			input:      cs,
+			wantError:  false,
+			goldenFile: true,
+		},
+		{
+			name: "Dry Run with a task name and --task-ref",
+			command: []string{"start", "task-1",
+				"--task-ref", "hub://tekton/git-clone@0.9",
+				"-n", "ns",
+				"--dry-run"},
+			namespace: "",
+			dynamic:   dc,
+			input:     cs,
+			wantError: true,
+			want:      "cannot use a Task name, --filename, --image, --last or --use-taskrun options with --task-ref",
+		},
 		{
 			name: "Dry Run with --use-param-defaults, --last and --use-taskrun",
 			command: []string{"start", "task-1",
@@ -2145,3 +2270,215 @@ func Test_start_task_with_skip_optional_workspace_flag_v1beta1(t *testing.T) {
 	expected := "TaskRun started: \n\nIn order to track the TaskRun progress run:\ntkn taskrun logs  -f -n ns\n"
 	test.AssertOutput(t, expected, got)
 }
+
+func Test_start_task_local_dir_v1beta1(t *testing.T) {
+	tasks := []*v1beta1.Task{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "task-1",
+				Namespace: "ns",
+			},
+			Spec: v1beta1.TaskSpec{
+				Workspaces: []v1beta1.WorkspaceDeclaration{
+					{
+						Name: "source",
+					},
+				},
+				Steps: []v1beta1.Step{
+					{
+						Name:  "build",
+						Image: "busybox",
+					},
+				},
+			},
+		},
+	}
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "src"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("package main\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cs, _ := test.SeedV1beta1TestData(t, test.Data{Tasks: tasks, Namespaces: []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}}})
+	cs.Pipeline.Resources = cb.APIResourceList(versionv1beta1, []string{"task", "taskrun"})
+	// the fake clientset neither generates names nor runs pods
+	var uploadPod *corev1.Pod
+	cs.Kube.PrependReactor("create", "*", func(action k8stest.Action) (bool, runtime.Object, error) {
+		obj := action.(k8stest.CreateAction).GetObject().(metav1.Object)
+		obj.SetName(obj.GetGenerateName() + "x1y2z")
+		if pod, ok := obj.(*corev1.Pod); ok {
+			pod.Status.Phase = corev1.PodRunning
+			uploadPod = pod.DeepCopy()
+		}
+		return false, nil, nil
+	})
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(
+		cb.UnstructuredV1beta1T(tasks[0], versionv1beta1))
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+	extracted := map[string]string{}
+	uploadExec = func(cli.Params) pods.ExecInputFunc {
+		return func(ns, pod, container, command string, in io.Reader) ([]byte, error) {
+			test.AssertOutput(t, "tkn-upload-x1y2z/upload: tar -xf - -C /workspace", pod+"/"+container+": "+command)
+			tr := tar.NewReader(in)
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					return nil, nil
+				}
+				if err != nil {
+					return nil, err
+				}
+				content, err := io.ReadAll(tr)
+				if err != nil {
+					return nil, err
+				}
+				extracted[hdr.Name] = string(content)
+			}
+		}
+	}
+	defer func() { uploadExec = func(p cli.Params) pods.ExecInputFunc { return pods.NewExecutor(p).ExecInput } }()
+
+	task := Command(p)
+	got, err := test.ExecuteCommand(task, "start", "task-1", "-w=name=source,localDir="+dir, "-n=ns")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "Uploading " + dir + " to workspace source...\nTaskRun started: \n\nIn order to track the TaskRun progress run:\ntkn taskrun logs  -f -n ns\n"
+	test.AssertOutput(t, expected, got)
+	test.AssertOutput(t, map[string]string{"src": "", "src/main.go": "package main\n"}, extracted)
+
+	clients, _ := p.Clients()
+	var tr *v1beta1.TaskRunList
+	if err := actions.ListV1(taskrunGroupResource, clients, metav1.ListOptions{}, "ns", &tr); err != nil {
+		t.Errorf("Error listing taskruns %s", err.Error())
+	}
+	test.AssertOutput(t, "source", tr.Items[0].Spec.Workspaces[0].Name)
+	test.AssertOutput(t, "tkn-upload-x1y2z", tr.Items[0].Spec.Workspaces[0].PersistentVolumeClaim.ClaimName)
+
+	pvc, err := cs.Kube.CoreV1().PersistentVolumeClaims("ns").Get(context.Background(), "tkn-upload-x1y2z", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, "1Gi", pvc.Spec.Resources.Requests.Storage().String())
+	test.AssertOutput(t, []metav1.OwnerReference{{APIVersion: "tekton.dev/v1beta1", Kind: "TaskRun"}}, pvc.OwnerReferences)
+
+	if _, err := cs.Kube.CoreV1().Pods("ns").Get(context.Background(), "tkn-upload-x1y2z", metav1.GetOptions{}); err == nil {
+		t.Errorf("expected the upload pod to be deleted")
+	}
+	// the upload pod passes the restricted pod security standard
+	test.AssertOutput(t, true, *uploadPod.Spec.SecurityContext.RunAsNonRoot)
+	test.AssertOutput(t, corev1.SeccompProfileTypeRuntimeDefault, uploadPod.Spec.SecurityContext.SeccompProfile.Type)
+	test.AssertOutput(t, []corev1.Capability{"ALL"}, uploadPod.Spec.Containers[0].SecurityContext.Capabilities.Drop)
+	test.AssertOutput(t, false, *uploadPod.Spec.Containers[0].SecurityContext.AllowPrivilegeEscalation)
+
+	_, err = test.ExecuteCommand(task, "start", "task-1", "-w=name=source,localDir="+dir, "-n=ns", "--dry-run")
+	test.AssertOutput(t, "cannot use --dry-run option with localDir workspaces, they are uploaded when the TaskRun is started", err.Error())
+}
+
+func Test_start_task_script_output_v1beta1(t *testing.T) {
+	tasks := []*v1beta1.Task{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "task",
+				Namespace: "ns",
+			},
+			Spec: v1beta1.TaskSpec{
+				Steps: []v1beta1.Step{
+					{
+						Name:  "hello",
+						Image: "busybox",
+					},
+				},
+			},
+		},
+	}
+
+	taskruns := []*v1beta1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "taskrun-123",
+				Namespace: "ns",
+				Labels:    map[string]string{"tekton.dev/task": "task"},
+			},
+			Spec: v1beta1.TaskRunSpec{
+				TaskRef: &v1beta1.TaskRef{
+					Name: "task",
+					Kind: v1beta1.NamespacedTaskKind,
+				},
+			},
+		},
+	}
+
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		args      []string
+		want      string
+		wantError bool
+	}{
+		{
+			name: "quiet",
+			args: []string{"start", "task", "-n=ns", "--last", "-q"},
+			want: "random\n",
+		},
+		{
+			name: "porcelain",
+			args: []string{"start", "task", "-n=ns", "--last", "--porcelain"},
+			want: "ns\trandom\n",
+		},
+		{
+			name:      "quiet with showlog",
+			args:      []string{"start", "task", "-n=ns", "--last", "-q", "--showlog"},
+			want:      "Error: --quiet and --porcelain cannot be used with --showlog or --dry-run\n",
+			wantError: true,
+		},
+		{
+			name:      "porcelain with output",
+			args:      []string{"start", "task", "-n=ns", "--last", "--porcelain", "--output=yaml"},
+			want:      "Error: --quiet and --porcelain cannot be used with --output\n",
+			wantError: true,
+		},
+	}
+
+	for _, td := range tests {
+		t.Run(td.name, func(t *testing.T) {
+			seedData, _ := test.SeedV1beta1TestData(t, test.Data{Namespaces: ns, Tasks: tasks, TaskRuns: taskruns})
+			objs := []runtime.Object{tasks[0], taskruns[0]}
+			_, tdc := newV1beta1PipelineClient(objs...)
+			cs := pipelinetest.Clients{
+				Pipeline: seedData.Pipeline,
+				Kube:     seedData.Kube,
+			}
+			cs.Pipeline.Resources = cb.APIResourceList(versionv1beta1, []string{"task", "taskrun"})
+			dc, _ := tdc.Client(
+				cb.UnstructuredV1beta1T(tasks[0], versionv1beta1),
+				cb.UnstructuredV1beta1TR(taskruns[0], versionv1beta1))
+			p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+			got, err := test.ExecuteCommand(Command(p), td.args...)
+			if err != nil && !td.wantError {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if err == nil && td.wantError {
+				t.Error("Expected an error")
+			}
+			test.AssertOutput(t, td.want, got)
+		})
+	}
+}
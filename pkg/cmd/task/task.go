@@ -40,12 +40,14 @@ func Command(p cli.Params) *cobra.Command {
 	cmd.AddCommand(
 		deleteCommand(p),
 		describeCommand(p),
+		initCommand(),
 		listCommand(p),
 		logCommand(p),
 		startCommand(p),
 		createCommand(p),
 		signCommand(),
 		verifyCommand(),
+		testCommand(p),
 	)
 	return cmd
 }
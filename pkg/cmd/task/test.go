@@ -0,0 +1,265 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/file"
+	"github.com/tektoncd/cli/pkg/flags"
+	"github.com/tektoncd/cli/pkg/log"
+	"github.com/tektoncd/cli/pkg/options"
+	"github.com/tektoncd/cli/pkg/params"
+	"github.com/tektoncd/cli/pkg/pods/stream"
+	"github.com/tektoncd/cli/pkg/taskrun"
+	"github.com/tektoncd/cli/pkg/tasktest"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type testOptions struct {
+	params   cli.Params
+	stream   *cli.Stream
+	streamer stream.NewStreamerFunc
+	Filename string
+	Fixtures string
+	Timeout  time.Duration
+	Keep     bool
+}
+
+func testCommand(p cli.Params) *cobra.Command {
+	opts := &testOptions{params: p}
+	eg := `Run the test cases of the fixtures directory against the Task of task.yaml
+in namespace 'task-tests':
+
+    tkn task test -f task.yaml --fixtures tests/ -n task-tests
+
+A test case is a YAML file of the fixtures directory:
+
+    name: builds-the-default-branch
+    params:
+      revision: main
+    workspaces:
+    - name: source
+      emptyDir: {}
+    timeout: 5m
+    expect:
+      status: Succeeded
+      results:
+        commit:
+          matches: "^[0-9a-f]{40}$"
+      logs:
+      - step: clone
+        matches: "Successfully cloned"
+      steps:
+        clone: 0
+`
+
+	c := &cobra.Command{
+		Use:   "test",
+		Short: "Run the test cases of a Task and evaluate their assertions",
+		Long: `Run the test cases of a Task and evaluate their assertions
+
+Every test case of the fixtures directory starts a TaskRun of the Task with
+the params and workspaces of the case. Once the TaskRuns finished, their
+status, the values of their results, the logs and the exit codes of their
+steps are checked against the expectations of the cases. Each case is
+reported as PASS or FAIL, and the command exits with code 5 when any of
+them failed.
+
+The TaskRuns are deleted once evaluated unless --keep is set.`,
+		Example:      eg,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := flags.InitParams(p, cmd); err != nil {
+				return err
+			}
+			opts.stream = &cli.Stream{
+				In:  cmd.InOrStdin(),
+				Out: cmd.OutOrStdout(),
+				Err: cmd.OutOrStderr(),
+			}
+			return runTests(opts)
+		},
+	}
+
+	c.Flags().StringVarP(&opts.Filename, "filename", "f", "", "local or remote file name containing the Task definition to test")
+	c.Flags().StringVar(&opts.Fixtures, "fixtures", "", "directory of the YAML files of the test cases")
+	c.Flags().DurationVar(&opts.Timeout, "timeout", 10*time.Minute, "how long to wait for each TaskRun to finish")
+	c.Flags().BoolVar(&opts.Keep, "keep", false, "keep the TaskRuns of the test cases rather than deleting them")
+	_ = c.MarkFlagRequired("filename")
+	_ = c.MarkFlagRequired("fixtures")
+	return c
+}
+
+func runTests(opts *testOptions) error {
+	cs, err := opts.params.Clients()
+	if err != nil {
+		return err
+	}
+
+	b, err := file.LoadFileContent(cs.HTTPClient, opts.Filename, file.IsYamlFile(), fmt.Errorf("invalid file format for %s: .yaml or .yml file extension and format required", opts.Filename))
+	if err != nil {
+		return err
+	}
+	task, err := parseTask(b)
+	if err != nil {
+		return err
+	}
+	if task.Spec.Params != nil {
+		params.FilterParamsByType(task.Spec.Params)
+	}
+	cases, err := tasktest.LoadCases(opts.Fixtures)
+	if err != nil {
+		return err
+	}
+
+	// the TaskRuns of all the cases are started before waiting for them so
+	// that they run side by side
+	ns := opts.params.Namespace()
+	runs := make([]string, len(cases))
+	for i, c := range cases {
+		tr := &v1beta1.TaskRun{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "tekton.dev/v1beta1",
+				Kind:       "TaskRun",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns,
+				GenerateName: task.Name + "-test-",
+			},
+			Spec: v1beta1.TaskRunSpec{
+				TaskSpec:   &task.Spec,
+				Params:     c.TaskRunParams(),
+				Workspaces: c.Workspaces,
+				Timeout:    c.Timeout,
+			},
+		}
+		created, err := taskrun.Create(cs, tr, metav1.CreateOptions{}, ns)
+		if err != nil {
+			return fmt.Errorf("failed to start the TaskRun of test case %s: %w", c.Name, actions.DescribeRejection("TaskRun", err))
+		}
+		runs[i] = created.Name
+	}
+
+	failed := 0
+	for i, c := range cases {
+		failures := evaluateCase(opts, cs, c, runs[i])
+		if len(failures) == 0 {
+			fmt.Fprintf(opts.stream.Out, "PASS %s (TaskRun %s)\n", c.Name, runs[i])
+		} else {
+			failed++
+			fmt.Fprintf(opts.stream.Out, "FAIL %s (TaskRun %s)\n", c.Name, runs[i])
+			for _, f := range failures {
+				fmt.Fprintf(opts.stream.Out, "    - %s\n", f)
+			}
+		}
+		if !opts.Keep {
+			if err := actions.DeleteV1(taskrunGroupResource, cs, runs[i], ns, metav1.DeleteOptions{}); err != nil {
+				fmt.Fprintf(opts.stream.Err, "failed to delete TaskRun %s: %s\n", runs[i], err)
+			}
+		}
+	}
+
+	fmt.Fprintf(opts.stream.Out, "\n%d passed, %d failed\n", len(cases)-failed, failed)
+	if failed > 0 {
+		return &cli.ExitError{Code: cli.ExitCodeRunFailed, Err: fmt.Errorf("%d of %d test cases failed", failed, len(cases))}
+	}
+	return nil
+}
+
+// evaluateCase waits for the TaskRun of a test case to finish and returns
+// the assertions of the case which failed on it
+func evaluateCase(opts *testOptions, cs *cli.Clients, c *tasktest.Case, name string) []string {
+	tr, err := taskrun.WaitForCompletion(cs, name, opts.params.Namespace(), opts.Timeout)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	// a TaskRun which failed before starting, e.g. on a missing param, has
+	// no logs to read
+	var logs map[string]string
+	if tr.HasStarted() {
+		if logs, err = stepLogs(opts, name); err != nil {
+			return []string{fmt.Sprintf("failed to read the logs: %s", err)}
+		}
+	}
+	return c.Evaluate(tr, logs)
+}
+
+// stepLogs reads the logs of the steps of a finished TaskRun by step name
+func stepLogs(opts *testOptions, name string) (map[string]string, error) {
+	lo := &options.LogOptions{
+		TaskrunName: name,
+		AllSteps:    true,
+		Params:      opts.params,
+		Streamer:    opts.streamer,
+		Stream:      &cli.Stream{Out: io.Discard, Err: io.Discard},
+	}
+	lr, err := log.NewReader(log.LogTypeTask, lo)
+	if err != nil {
+		return nil, err
+	}
+	defer lr.Close()
+	logC, errC, err := lr.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	steps := map[string]*strings.Builder{}
+	for logC != nil || errC != nil {
+		select {
+		case l, ok := <-logC:
+			if !ok {
+				logC = nil
+				continue
+			}
+			if l.Notice || l.Log == "EOFLOG" {
+				continue
+			}
+			step, ok := steps[l.Step]
+			if !ok {
+				step = &strings.Builder{}
+				steps[l.Step] = step
+			}
+			step.WriteString(l.Log)
+			if !l.Continued {
+				step.WriteString("\n")
+			}
+		case e, ok := <-errC:
+			if !ok {
+				errC = nil
+				continue
+			}
+			fmt.Fprintf(opts.stream.Err, "%s\n", e)
+		}
+	}
+
+	logs := map[string]string{}
+	for step, b := range steps {
+		logs[step] = b.String()
+	}
+	return logs, nil
+}
@@ -0,0 +1,186 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/pods/fake"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stest "k8s.io/client-go/testing"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// finishedTaskRuns returns the options of a dynamic client where the
+// TaskRuns are finished as soon as they are created: their step
+// build-sources succeeds and emits the param foobar as the result url
+func finishedTaskRuns(t *testing.T) testDynamic.Options {
+	scheme := runtime.NewScheme()
+	codecs := serializer.NewCodecFactory(scheme)
+	metav1.AddToGroupVersion(scheme, schema.GroupVersion{Version: "v1"})
+	util.Must(v1.AddToScheme(scheme))
+	o := k8stest.NewObjectTracker(scheme, codecs.UniversalDecoder())
+
+	created := 0
+	return testDynamic.Options{
+		AddReactorRes:  "*",
+		AddReactorVerb: "*",
+		AddReactorFun:  k8stest.ObjectReaction(o),
+		WatchResource:  "*",
+		WatchReactionFun: func(action k8stest.Action) (bool, watch.Interface, error) {
+			w, err := o.Watch(action.GetResource(), action.GetNamespace())
+			return true, w, err
+		},
+		PrependReactors: []testDynamic.PrependOpt{
+			{
+				Resource: "taskruns",
+				Verb:     "create",
+				Action: func(action k8stest.Action) (bool, runtime.Object, error) {
+					create := action.(k8stest.CreateActionImpl)
+					tr := &v1.TaskRun{}
+					if err := runtime.DefaultUnstructuredConverter.FromUnstructured(create.GetObject().(*unstructured.Unstructured).Object, tr); err != nil {
+						t.Fatal(err)
+					}
+					created++
+					tr.Name = fmt.Sprintf("%s%d", tr.GenerateName, created)
+					tr.Status = v1.TaskRunStatus{
+						Status: duckv1.Status{
+							Conditions: duckv1.Conditions{
+								{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue},
+							},
+						},
+						TaskRunStatusFields: v1.TaskRunStatusFields{
+							PodName:   tr.Name + "-pod",
+							StartTime: &metav1.Time{Time: time.Now()},
+							Results: []v1.TaskRunResult{
+								{Name: "url", Type: v1.ResultsTypeString, Value: *tr.Spec.Params[0].Value.DeepCopy()},
+							},
+							Steps: []v1.StepState{
+								{
+									Name:           "build-sources",
+									ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}},
+								},
+							},
+						},
+					}
+					if err := o.Add(tr); err != nil {
+						return true, nil, err
+					}
+					return true, cb.UnstructuredTR(tr, version), nil
+				},
+			},
+			{
+				Resource: "taskruns",
+				Verb:     "get",
+				Action: func(action k8stest.Action) (bool, runtime.Object, error) {
+					get := action.(k8stest.GetActionImpl)
+					obj, err := o.Get(get.GetResource(), get.GetNamespace(), get.GetName())
+					if err != nil {
+						return true, nil, err
+					}
+					return true, cb.UnstructuredTR(obj.(*v1.TaskRun), version), nil
+				},
+			},
+			{
+				Resource: "taskruns",
+				Verb:     "delete",
+				Action:   k8stest.ObjectReaction(o),
+			},
+		},
+	}
+}
+
+func testPod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "build-sources", Image: "alpine"}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+	}
+}
+
+func TestTaskTest(t *testing.T) {
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		Pods: []*corev1.Pod{testPod("task-v1-test-1-pod"), testPod("task-v1-test-2-pod")},
+	})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"task", "taskrun"})
+	tdc := finishedTaskRuns(t)
+	dc, err := tdc.Client()
+	assert.NilError(t, err)
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+	p.SetNamespace("ns")
+
+	logs := fake.Logs(
+		fake.Task("task-v1-test-1-pod", fake.Step("build-sources", "built https://tekton.dev")),
+		fake.Task("task-v1-test-2-pod", fake.Step("build-sources", "built ")),
+	)
+	out := &bytes.Buffer{}
+	opts := &testOptions{
+		params:   p,
+		stream:   &cli.Stream{Out: out, Err: out},
+		streamer: fake.Streamer(logs),
+		Filename: "./testdata/task-v1.yaml",
+		Fixtures: "./testdata/tasktest",
+		Timeout:  time.Second,
+	}
+	err = runTests(opts)
+
+	var exitErr *cli.ExitError
+	assert.Assert(t, errors.As(err, &exitErr))
+	assert.Equal(t, exitErr.Code, cli.ExitCodeRunFailed)
+	assert.Error(t, err, "1 of 2 test cases failed")
+	test.AssertOutput(t, `PASS publishes-url (TaskRun task-v1-test-1)
+FAIL rejects-empty-url (TaskRun task-v1-test-2)
+    - expected the TaskRun to be Failed, it is Succeeded
+    - result url is "", expected to match ".+"
+
+1 passed, 1 failed
+`, out.String())
+
+	for _, name := range []string{"task-v1-test-1", "task-v1-test-2"} {
+		_, err := dc.Resource(taskrunGroupResource.GroupResource().WithVersion(version)).Namespace("ns").Get(context.Background(), name, metav1.GetOptions{})
+		assert.Assert(t, apierrors.IsNotFound(err), "TaskRun %s was not deleted", name)
+	}
+}
+
+func TestTaskTest_requires_fixtures(t *testing.T) {
+	c := Command(&test.Params{})
+
+	out, err := test.ExecuteCommand(c, "test", "-n", "ns", "-f", "./testdata/task-v1.yaml")
+	assert.Error(t, err, `required flag(s) "fixtures" not set`)
+	test.AssertOutput(t, "Error: required flag(s) \"fixtures\" not set\n", out)
+}
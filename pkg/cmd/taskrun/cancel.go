@@ -15,25 +15,20 @@
 package taskrun
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/audit"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/i18n"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
-type patchStringValue struct {
-	Op    string `json:"op"`
-	Path  string `json:"path"`
-	Value string `json:"value"`
-}
-
 func cancelCommand(p cli.Params) *cobra.Command {
 	eg := `Cancel the TaskRun named 'foo' from namespace 'bar':
 
@@ -72,7 +67,7 @@ func cancelTaskRun(p cli.Params, s *cli.Stream, trName string) error {
 	var taskrun *v1.TaskRun
 	err = actions.GetV1(taskrunGroupResource, cs, trName, p.Namespace(), metav1.GetOptions{}, &taskrun)
 	if err != nil {
-		return fmt.Errorf("failed to find TaskRun: %s", trName)
+		return cli.WithCause(err, "failed to find TaskRun: %s", trName)
 	}
 
 	if len(taskrun.Status.Conditions) > 0 {
@@ -82,24 +77,24 @@ func cancelTaskRun(p cli.Params, s *cli.Stream, trName string) error {
 	}
 
 	if _, err := patch(cs, trName, metav1.PatchOptions{}, p.Namespace()); err != nil {
-		return fmt.Errorf("failed to cancel TaskRun %s: %v", trName, err)
+		return fmt.Errorf("failed to cancel TaskRun %s: %w", trName, err)
 	}
 
-	fmt.Fprintf(s.Out, "TaskRun cancelled: %s\n", taskrun.Name)
+	audit.Record(p, audit.ActionCancel, "TaskRun", taskrun.Name)
+	i18n.Fprintf(s.Out, "TaskRun cancelled: %s\n", taskrun.Name)
 	return nil
 }
 
 func patch(c *cli.Clients, trname string, opts metav1.PatchOptions, ns string) (*v1.TaskRun, error) {
-	payload := []patchStringValue{{
+	ops := []actions.JSONPatchOperation{{
 		Op:    "replace",
 		Path:  "/spec/status",
 		Value: v1.TaskRunSpecStatusCancelled,
 	}}
 
-	data, _ := json.Marshal(payload)
 	var taskrun *v1.TaskRun
 	var trGroupResource = schema.GroupVersionResource{Group: "tekton.dev", Resource: "taskruns"}
-	err := actions.Patch(trGroupResource, c, trname, data, opts, ns, &taskrun)
+	err := actions.JSONPatch(trGroupResource, c, trname, ops, opts, ns, &taskrun)
 	if err != nil {
 		return nil, err
 	}
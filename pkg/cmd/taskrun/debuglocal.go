@@ -0,0 +1,225 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/debuglocal"
+	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const syncTimeout = 5 * time.Minute
+
+type debugLocalOptions struct {
+	Step           string
+	Runtime        string
+	Dir            string
+	SyncWorkspaces bool
+	SyncImage      string
+	NoPrompt       bool
+	AskOpts        survey.AskOpt
+}
+
+func debugLocalCommand(p cli.Params) *cobra.Command {
+	opts := &debugLocalOptions{
+		AskOpts: func(opt *survey.AskOptions) error {
+			opt.Stdio = terminal.Stdio{
+				In:  os.Stdin,
+				Out: os.Stdout,
+				Err: os.Stderr,
+			}
+			return nil
+		},
+	}
+	eg := `Reproduce the failed step of TaskRun 'foo' in namespace 'bar' with docker:
+
+    tkn taskrun debug-local foo -n bar
+
+Reproduce the step 'build' with podman, copying the content of the PersistentVolumeClaims bound to the workspaces:
+
+    tkn taskrun debug-local foo --step build --runtime podman --sync-workspaces
+`
+
+	c := &cobra.Command{
+		Use:   "debug-local TASKRUN",
+		Short: "Prepares a local container run reproducing a step of a TaskRun",
+		Long: `Extract the image, command, script, environment and workspaces of a step of a
+TaskRun into a local directory and print the docker or podman command running it,
+for a fast reproduction of a failing step without the cluster.
+
+The values of the environment variables coming from Secrets are not read from
+the cluster, they are prompted for and written to a file of the directory, or
+passed from the local environment with --no-prompt. The workspaces are empty
+directories unless --sync-workspaces is passed, which copies the content of the
+PersistentVolumeClaims bound to them through a temporary pod.`,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		Args:         cobra.ExactArgs(1),
+		Example:      eg,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Runtime != "docker" && opts.Runtime != "podman" {
+				return fmt.Errorf("invalid value %q for --runtime, use docker or podman", opts.Runtime)
+			}
+			s := &cli.Stream{Out: cmd.OutOrStdout(), Err: cmd.OutOrStderr()}
+			return debugLocal(s, p, args[0], opts)
+		},
+	}
+	c.Flags().StringVarP(&opts.Step, "step", "", "", "name of the step to reproduce (default: the step which failed)")
+	c.Flags().StringVarP(&opts.Runtime, "runtime", "", "docker", "container engine to run the step with, docker or podman")
+	c.Flags().StringVarP(&opts.Dir, "dir", "", "", "directory to write the scripts, workspaces and results of the step to (default: tkn-debug-TASKRUN)")
+	c.Flags().BoolVarP(&opts.SyncWorkspaces, "sync-workspaces", "", false, "copy the content of the PersistentVolumeClaims bound to the workspaces")
+	c.Flags().StringVarP(&opts.SyncImage, "sync-image", "", debuglocal.DefaultSyncImage, "image of the pod copying the content of the PersistentVolumeClaims")
+	c.Flags().BoolVarP(&opts.NoPrompt, "no-prompt", "", false, "do not prompt for the values of the Secrets, pass them from the local environment")
+	return c
+}
+
+func debugLocal(s *cli.Stream, p cli.Params, name string, opts *debugLocalOptions) error {
+	cs, err := p.Clients()
+	if err != nil {
+		return err
+	}
+	ns := p.Namespace()
+
+	tr, err := taskrunpkg.GetTaskRun(taskrunGroupResource, cs, name, ns)
+	if err != nil {
+		return fmt.Errorf("failed to get TaskRun %s: %w", name, err)
+	}
+	stepName := opts.Step
+	if stepName == "" {
+		if stepName = debuglocal.FailedStep(tr); stepName == "" {
+			return fmt.Errorf("no step of TaskRun %s failed, use --step to choose the step to reproduce", name)
+		}
+	}
+	step, err := debuglocal.FromTaskRun(tr, stepName)
+	if err != nil {
+		return err
+	}
+
+	dir := opts.Dir
+	if dir == "" {
+		dir = "tkn-debug-" + tr.Name
+	}
+	if dir, err = filepath.Abs(dir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "results"), 0o755); err != nil {
+		return err
+	}
+	if step.Script != "" {
+		if err := os.MkdirAll(filepath.Join(dir, "scripts"), 0o755); err != nil {
+			return err
+		}
+		// nolint: gosec
+		if err := os.WriteFile(filepath.Join(dir, "scripts", step.Name), []byte(step.Script), 0o755); err != nil {
+			return err
+		}
+	}
+
+	for _, ws := range step.Workspaces {
+		wsDir := filepath.Join(dir, "workspaces", ws.Name)
+		if err := os.MkdirAll(wsDir, 0o755); err != nil {
+			return err
+		}
+		switch {
+		case ws.ConfigMap != "":
+			cm, err := cs.Kube.CoreV1().ConfigMaps(ns).Get(context.Background(), ws.ConfigMap, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to get ConfigMap %s of workspace %s: %w", ws.ConfigMap, ws.Name, err)
+			}
+			for k, v := range cm.Data {
+				if err := os.WriteFile(filepath.Join(wsDir, k), []byte(v), 0o644); err != nil { // nolint: gosec
+					return err
+				}
+			}
+		case ws.Secret != "":
+			fmt.Fprintf(s.Err, "Workspace %s is bound to Secret %s, its content is not copied\n", ws.Name, ws.Secret)
+		case ws.Claim != "" && opts.SyncWorkspaces:
+			fmt.Fprintf(s.Err, "Copying PersistentVolumeClaim %s of workspace %s...\n", ws.Claim, ws.Name)
+			if err := debuglocal.SyncClaim(cs.Kube, ns, ws.Claim, opts.SyncImage, wsDir, syncTimeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	values, err := envValues(cs, ns, step, opts)
+	if err != nil {
+		return err
+	}
+	secrets := []string{}
+	for _, e := range step.Env {
+		if v, ok := values[e.Name]; ok && e.Secret != "" {
+			secrets = append(secrets, e.Name+"="+v)
+		}
+	}
+	if len(secrets) > 0 {
+		sort.Strings(secrets)
+		if err := os.WriteFile(filepath.Join(dir, debuglocal.SecretsFile), []byte(strings.Join(secrets, "\n")+"\n"), 0o600); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(s.Out, "# step %s of TaskRun %s is set up in %s, run it with:\n", step.Name, tr.Name, dir)
+	fmt.Fprintln(s.Out, debuglocal.ShellQuote(append([]string{opts.Runtime}, step.RunArgs(dir, values)...)))
+	return nil
+}
+
+// envValues resolves the environment variables of the step coming from
+// ConfigMaps and prompts for the ones coming from Secrets
+func envValues(cs *cli.Clients, ns string, step *debuglocal.Step, opts *debugLocalOptions) (map[string]string, error) {
+	values := map[string]string{}
+	for _, e := range step.Env {
+		switch {
+		case e.ConfigMap != "":
+			name, key, _ := strings.Cut(e.ConfigMap, "/")
+			cm, err := cs.Kube.CoreV1().ConfigMaps(ns).Get(context.Background(), name, metav1.GetOptions{})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to get ConfigMap %s: %w", name, err)
+			}
+			if v, ok := cm.Data[key]; ok {
+				values[e.Name] = v
+			}
+		case e.Secret != "" && !opts.NoPrompt:
+			var v string
+			prompt := &survey.Password{
+				Message: fmt.Sprintf("Value of %s, from Secret %s (leave empty to pass it from your environment):", e.Name, e.Secret),
+			}
+			if err := survey.AskOne(prompt, &v, opts.AskOpts); err != nil {
+				return nil, err
+			}
+			if v != "" {
+				values[e.Name] = v
+			}
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,133 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	goexpect "github.com/Netflix/go-expect"
+	"github.com/tektoncd/cli/pkg/debuglocal"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	"github.com/tektoncd/cli/test/prompt"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func debugLocalParams(t *testing.T) *test.Params {
+	tr := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-run", Namespace: "ns"},
+		Spec: v1.TaskRunSpec{
+			Params: v1.Params{{Name: "target", Value: *v1.NewStructuredValues("linux")}},
+			Workspaces: []v1.WorkspaceBinding{
+				{Name: "source", PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "source-pvc"}},
+				{Name: "config", ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}}},
+			},
+		},
+		Status: v1.TaskRunStatus{
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				Steps: []v1.StepState{
+					{Name: "compile", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 2, Reason: "Error"}}},
+				},
+				TaskSpec: &v1.TaskSpec{
+					Params:     v1.ParamSpecs{{Name: "target", Type: v1.ParamTypeString}},
+					Workspaces: []v1.WorkspaceDeclaration{{Name: "source"}, {Name: "config"}},
+					Steps: []v1.Step{{
+						Name:       "compile",
+						Image:      "golang:1.23",
+						Script:     "GOOS=$(params.target) go build ./...",
+						WorkingDir: "$(workspaces.source.path)",
+						Env: []corev1.EnvVar{
+							{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "git"}, Key: "token"}}},
+							{Name: "GOPROXY", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}, Key: "goproxy"}}},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		TaskRuns:   []*v1.TaskRun{tr},
+		ConfigMaps: []*corev1.ConfigMap{{ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "ns"}, Data: map[string]string{"goproxy": "https://proxy.golang.org"}}},
+		Namespaces: []*corev1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}},
+	})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"taskrun"})
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(cb.UnstructuredTR(tr, version))
+	assert.NilError(t, err)
+	return &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+}
+
+func TestTaskRunDebugLocal(t *testing.T) {
+	dir := t.TempDir()
+	got, err := test.ExecuteCommand(Command(debugLocalParams(t)), "debug-local", "build-run", "-n", "ns", "--no-prompt", "--runtime", "podman", "--dir", dir)
+	assert.NilError(t, err)
+	golden.Assert(t, strings.ReplaceAll(got, dir, "DIR"), fmt.Sprintf("%s.golden", t.Name()))
+
+	script, err := os.ReadFile(filepath.Join(dir, "scripts", "compile"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(script), "#!/bin/sh\nset -e\nGOOS=linux go build ./...")
+	goproxy, err := os.ReadFile(filepath.Join(dir, "workspaces", "config", "goproxy"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(goproxy), "https://proxy.golang.org")
+	_, err = os.Stat(filepath.Join(dir, debuglocal.SecretsFile))
+	assert.Assert(t, os.IsNotExist(err))
+}
+
+func TestTaskRunDebugLocal_errors(t *testing.T) {
+	_, err := test.ExecuteCommand(Command(debugLocalParams(t)), "debug-local", "build-run", "-n", "ns", "--runtime", "containerd")
+	assert.Error(t, err, `invalid value "containerd" for --runtime, use docker or podman`)
+
+	_, err = test.ExecuteCommand(Command(debugLocalParams(t)), "debug-local", "build-run", "-n", "ns", "--step", "test", "--dir", t.TempDir())
+	assert.Error(t, err, `step "test" not found in TaskRun build-run, use one of compile`)
+}
+
+func TestTaskRunDebugLocal_prompt(t *testing.T) {
+	p := debugLocalParams(t)
+	cs, err := p.Clients()
+	assert.NilError(t, err)
+	step := &debuglocal.Step{Env: []debuglocal.Env{{Name: "TOKEN", Secret: "git/token"}}}
+
+	pt := prompt.Prompt{
+		Procedure: func(c *goexpect.Console) error {
+			if _, err := c.ExpectString("Value of TOKEN, from Secret git/token"); err != nil {
+				return err
+			}
+			if _, err := c.SendLine("s3cr3t"); err != nil {
+				return err
+			}
+			_, err := c.ExpectEOF()
+			return err
+		},
+	}
+	var values map[string]string
+	pt.RunTest(t, pt.Procedure, func(stdio terminal.Stdio) error {
+		opts := &debugLocalOptions{AskOpts: prompt.WithStdio(stdio)}
+		values, err = envValues(cs, "ns", step, opts)
+		return err
+	})
+	assert.DeepEqual(t, values, map[string]string{"TOKEN": "s3cr3t"})
+}
@@ -73,6 +73,14 @@ func deleteCommand(p cli.Params) *cobra.Command {
 or
 
     tkn tr rm foo bar -n quux
+
+Delete TaskRun 'foo' but keep the resources it created, e.g. the PVCs of its volumeClaimTemplates:
+
+    tkn tr rm foo --cascade orphan -n quux
+
+Delete TaskRun 'foo' and wait until its finalizers have run:
+
+    tkn tr rm foo --wait -n quux
 `
 
 	c := &cobra.Command{
@@ -117,6 +125,10 @@ or
 				return fmt.Errorf("since option should not be lower than 0")
 			}
 
+			if _, err := opts.DeleteOptions(); err != nil {
+				return err
+			}
+
 			if (opts.Keep > 0 || opts.KeepSince > 0) && opts.ParentResourceName == "" {
 				opts.DeleteAllNs = true
 			}
@@ -145,6 +157,9 @@ or
 	c.Flags().StringVarP(&deleteOpts.TaskName, "task", "t", "", "The name of a Task whose TaskRuns should be deleted (does not delete the task)")
 	c.Flags().StringVarP(&deleteOpts.ClusterTaskName, "clustertask", "", "", "The name of a ClusterTask whose TaskRuns should be deleted (does not delete the ClusterTask)")
 	c.Flags().BoolVarP(&opts.DeleteAllNs, "all", "", false, "Delete all TaskRuns in a namespace (default: false)")
+	c.Flags().StringVar(&opts.Cascade, "cascade", options.CascadeBackground, "what happens to the pods and PVCs of volumeClaimTemplates the TaskRuns own: background deletes them after the TaskRuns, foreground before them, orphan keeps them")
+	c.Flags().BoolVar(&opts.Wait, "wait", false, "wait until the deleted TaskRuns are gone, i.e. their finalizers have run")
+	c.Flags().DurationVar(&opts.WaitTimeout, "wait-timeout", 5*time.Minute, "how long --wait waits for the TaskRuns to be gone")
 	c.Flags().IntVarP(&opts.Keep, "keep", "", 0, "Keep n most recent number of TaskRuns")
 	c.Flags().IntVarP(&opts.KeepSince, "keep-since", "", 0, "When deleting all TaskRuns keep the ones that has been completed since n minutes")
 	c.Flags().BoolVarP(&opts.IgnoreRunning, "ignore-running", "i", true, "ignore running TaskRun")
@@ -159,11 +174,15 @@ func deleteTaskRuns(s *cli.Stream, p cli.Params, trNames []string, opts *options
 	if err != nil {
 		return fmt.Errorf("failed to create tekton client")
 	}
+	delOpts, err := opts.DeleteOptions()
+	if err != nil {
+		return err
+	}
 	var d *deleter.Deleter
 	switch {
 	case opts.DeleteAllNs:
 		d = deleter.New("TaskRun", func(taskRunName string) error {
-			return actions.Delete(taskrunGroupResource, cs.Dynamic, cs.Tekton.Discovery(), taskRunName, p.Namespace(), metav1.DeleteOptions{})
+			return actions.DeleteV1(taskrunGroupResource, cs, taskRunName, p.Namespace(), delOpts)
 		})
 		trToDelete, trToKeep, err := allTaskRunNames(cs, opts.Keep, opts.KeepSince, opts.IgnoreRunning, opts.IgnoreRunningPipelinerun, opts.LabelSelector, p.Namespace(), "")
 		if err != nil {
@@ -174,7 +193,7 @@ func deleteTaskRuns(s *cli.Stream, p cli.Params, trNames []string, opts *options
 		d.Delete(trToDelete)
 	case opts.ParentResourceName == "":
 		d = deleter.New("TaskRun", func(taskRunName string) error {
-			return actions.Delete(taskrunGroupResource, cs.Dynamic, cs.Tekton.Discovery(), taskRunName, p.Namespace(), metav1.DeleteOptions{})
+			return actions.DeleteV1(taskrunGroupResource, cs, taskRunName, p.Namespace(), delOpts)
 		})
 		var processedTrNames []string
 
@@ -221,7 +240,7 @@ func deleteTaskRuns(s *cli.Stream, p cli.Params, trNames []string, opts *options
 		numberOfKeptTr = len(trToKeep)
 		// Delete the TaskRuns associated with a Task or ClusterTask
 		d.WithRelated("TaskRun", taskRunLister(p, opts.Keep, opts.KeepSince, opts.ParentResource, cs, opts.IgnoreRunning, opts.IgnoreRunningPipelinerun), func(taskRunName string) error {
-			return actions.Delete(taskrunGroupResource, cs.Dynamic, cs.Tekton.Discovery(), taskRunName, p.Namespace(), metav1.DeleteOptions{})
+			return actions.DeleteV1(taskrunGroupResource, cs, taskRunName, p.Namespace(), delOpts)
 		})
 
 		if opts.Keep > 0 && opts.Keep == len(trToKeep) && len(trToDelete) == 0 {
@@ -235,6 +254,13 @@ func deleteTaskRuns(s *cli.Stream, p cli.Params, trNames []string, opts *options
 		d.DeleteRelated([]string{opts.ParentResourceName})
 	}
 
+	d.Audit(p)
+	if opts.Wait {
+		d.Wait("TaskRun", func(names []string) error {
+			return actions.WaitForDeletion(taskrunGroupResource, cs.Dynamic, cs.Tekton.Discovery(), names, p.Namespace(), opts.WaitTimeout)
+		})
+	}
+
 	if !opts.DeleteAllNs {
 		if d.Errors() == nil {
 			switch {
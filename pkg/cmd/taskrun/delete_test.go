@@ -30,7 +30,9 @@ import (
 	pipelinetest "github.com/tektoncd/pipeline/test"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic"
+	k8stest "k8s.io/client-go/testing"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
@@ -2923,3 +2925,39 @@ func Test_TaskRuns_Delete_With_Successful_PipelineRun(t *testing.T) {
 	}
 	test.AssertOutput(t, 1, len(tr.Items))
 }
+
+func TestTaskRunDelete_wait(t *testing.T) {
+	version := "v1"
+	trdata := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "tr0-1",
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{TaskRuns: trdata})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"taskrun"})
+	tdc := testDynamic.Options{
+		PrependReactors: []testDynamic.PrependOpt{{
+			Verb:     "delete",
+			Resource: "taskruns",
+			Action: func(_ k8stest.Action) (bool, runtime.Object, error) {
+				// the TaskRun is kept by its finalizers
+				return true, nil, nil
+			},
+		}},
+	}
+	dc, err := tdc.Client(cb.UnstructuredTR(trdata[0], version))
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+
+	_, err = test.ExecuteCommand(Command(p), "rm", "tr0-1", "-n", "ns", "-f", "--cascade", "orphan", "--wait", "--wait-timeout", "10ms")
+	if err == nil {
+		t.Fatal("error expected here")
+	}
+	test.AssertOutput(t, `timed out after 10ms waiting for the deletion of taskruns "tr0-1"`, err.Error())
+}
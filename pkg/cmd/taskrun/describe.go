@@ -22,6 +22,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/daemon"
+	"github.com/tektoncd/cli/pkg/dashboard"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/cli/pkg/options"
 	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
@@ -43,6 +45,11 @@ func describeCommand(p cli.Params) *cobra.Command {
 or
 
     tkn tr desc foo -n bar
+
+Describe a TaskRun of name 'foo' in namespace 'bar' with what backs its
+workspaces and the steps they are mounted in:
+
+    tkn tr desc foo -n bar --wide
 `
 
 	c := &cobra.Command{
@@ -63,7 +70,19 @@ or
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
+			}
+			if output != "" && opts.Link {
+				return fmt.Errorf("--link cannot be used with --output")
+			}
+			if opts.History && (output != "" || opts.Link) {
+				return fmt.Errorf("--history cannot be used with --output or --link")
+			}
+			if opts.Scheduling && (output != "" || opts.Link) {
+				return fmt.Errorf("--scheduling cannot be used with --output or --link")
+			}
+			if opts.Wide && (output != "" || opts.Link) {
+				return fmt.Errorf("--wide cannot be used with --output or --link")
 			}
 
 			if !opts.Fzf {
@@ -72,7 +91,7 @@ or
 				}
 			}
 
-			cs, err := p.Clients()
+			cs, err := daemon.Clients(p)
 			if err != nil {
 				return err
 			}
@@ -115,13 +134,45 @@ or
 				return actions.PrintObjectV1(taskrunGroupResource, opts.TaskrunName, cmd.OutOrStdout(), cs, printer, p.Namespace())
 			}
 
-			return taskrunpkg.PrintTaskRunDescription(s.Out, cs, opts.Params.Namespace(), opts.TaskrunName, opts.Params.Time())
+			if opts.Link {
+				links, err := dashboard.FromProfile(p)
+				if err != nil {
+					return err
+				}
+				return taskrunpkg.PrintTaskRunLink(s.Out, cs, opts.Params.Namespace(), opts.TaskrunName, links)
+			}
+
+			if err := taskrunpkg.PrintTaskRunDescription(s.Out, cs, opts.Params.Namespace(), opts.TaskrunName, opts.Params.Time()); err != nil {
+				return err
+			}
+			if opts.History {
+				if err := taskrunpkg.PrintTaskRunConditionHistory(s.Out, cs, opts.Params.Namespace(), opts.TaskrunName, opts.Params.Time()); err != nil {
+					return err
+				}
+			}
+			if opts.Wide {
+				profile, err := p.Profile()
+				if err != nil {
+					return err
+				}
+				if err := taskrunpkg.PrintTaskRunWorkspaceBindings(s.Out, cs, opts.Params.Namespace(), opts.TaskrunName, profile.Logs.StepPrefixes); err != nil {
+					return err
+				}
+			}
+			if opts.Scheduling {
+				return taskrunpkg.PrintTaskRunScheduling(s.Out, cs, opts.Params.Namespace(), opts.TaskrunName)
+			}
+			return nil
 		},
 	}
 
 	c.Flags().BoolVarP(&opts.Last, "last", "L", false, "show description for last TaskRun")
 	c.Flags().IntVarP(&opts.Limit, "limit", "", defaultTaskRunLimit, "lists number of TaskRuns when selecting a TaskRun to describe")
 	c.Flags().BoolVarP(&opts.Fzf, "fzf", "F", false, "use fzf to select a taskrun to describe")
+	c.Flags().BoolVar(&opts.Link, "link", false, "print the link to the TaskRun in the Tekton Dashboard set in the tkn profile instead of describing it")
+	c.Flags().BoolVar(&opts.Scheduling, "scheduling", false, "show the node the pod of the TaskRun was scheduled on, how long it took, the node selector and tolerations used and whether a taint, an affinity or a lack of resources delayed it, after its description")
+	c.Flags().BoolVar(&opts.Wide, "wide", false, "show what backs each workspace of the TaskRun, e.g. the name, class and size of its PersistentVolumeClaim, its mount path and the steps it is mounted in, after its description")
+	c.Flags().BoolVar(&opts.History, "history", false, "show the transitions of the condition of the TaskRun, from the events recorded for it, after its description")
 
 	f.AddFlags(c)
 
@@ -15,11 +15,13 @@
 package taskrun
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
 	"github.com/tektoncd/cli/pkg/test"
 	cb "github.com/tektoncd/cli/pkg/test/builder"
 	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
@@ -28,6 +30,7 @@ import (
 	pipelinetest "github.com/tektoncd/pipeline/test"
 	"gotest.tools/v3/golden"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -2399,6 +2402,84 @@ func TestTaskRunDescribe_With_Results(t *testing.T) {
 	golden.Assert(t, got, fmt.Sprintf("%s.golden", t.Name()))
 }
 
+func TestTaskRunDescribe_With_Captured_Results(t *testing.T) {
+	t.Setenv("TKN_STATE_DIR", t.TempDir())
+	captured := &taskrunpkg.CapturedResults{
+		Pod:     "tr-1-pod",
+		Results: map[string]string{"result-1": "value-1", "report": strings.Repeat("x", 80)},
+	}
+	if err := taskrunpkg.SaveCapturedResults("ns", "tr-1", captured); err != nil {
+		t.Fatal(err)
+	}
+
+	clock := test.FakeClock()
+	taskRuns := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "tr-1",
+				Labels:    map[string]string{"tekton.dev/task": "task-1"},
+			},
+			Spec: v1.TaskRunSpec{
+				TaskRef: &v1.TaskRef{
+					Name: "task-1",
+				},
+			},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Status: corev1.ConditionFalse,
+							Reason: v1.TaskRunReasonFailed.String(),
+						},
+					},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					StartTime:      &metav1.Time{Time: clock.Now().Add(-10 * time.Minute)},
+					CompletionTime: &metav1.Time{Time: clock.Now().Add(-5 * time.Minute)},
+					PodName:        "tr-1-pod",
+					Results: []v1.TaskRunResult{
+						{
+							Name: "result-1",
+							Value: v1.ParamValue{
+								Type:      v1.ParamTypeString,
+								StringVal: "value-1",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	namespaces := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "ns",
+			},
+		},
+	}
+
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(
+		cb.UnstructuredTR(taskRuns[0], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Namespaces: namespaces, TaskRuns: taskRuns})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"taskrun"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic}
+	p.SetNamespace("ns")
+	taskrun := Command(p)
+	got, err := test.ExecuteCommand(taskrun, "desc", "tr-1")
+
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	golden.Assert(t, got, fmt.Sprintf("%s.golden", t.Name()))
+}
+
 func TestTaskRunDescribe_zero_timeout(t *testing.T) {
 	trs := []*v1.TaskRun{
 		{
@@ -2637,3 +2718,343 @@ func TestTaskRunDescribe_with_annotations(t *testing.T) {
 	}
 	golden.Assert(t, got, fmt.Sprintf("%s.golden", t.Name()))
 }
+
+func TestTaskRunDescribe_history(t *testing.T) {
+	clock := test.FakeClock()
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tr-retried",
+				Namespace: "ns",
+			},
+			Spec: v1.TaskRunSpec{
+				TaskRef: &v1.TaskRef{
+					Name: "t1",
+				},
+			},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Type:               apis.ConditionSucceeded,
+							Status:             corev1.ConditionTrue,
+							Reason:             "Succeeded",
+							Message:            "All Steps have completed executing",
+							LastTransitionTime: apis.VolatileTime{Inner: metav1.Time{Time: clock.Now().Add(-time.Minute)}},
+						},
+					},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					StartTime:      &metav1.Time{Time: clock.Now().Add(-10 * time.Minute)},
+					CompletionTime: &metav1.Time{Time: clock.Now().Add(-time.Minute)},
+				},
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		TaskRuns: trs,
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+	events := []struct {
+		eventType, reason, message string
+		ago                        time.Duration
+	}{
+		{corev1.EventTypeNormal, "Started", "", 10 * time.Minute},
+		{corev1.EventTypeNormal, "Running", "Not all Steps in the Task have finished executing", 9 * time.Minute},
+		{corev1.EventTypeWarning, "Failed", `"step-test" exited with code 1`, 6 * time.Minute},
+		{corev1.EventTypeNormal, "Running", "Not all Steps in the Task have finished executing", 5 * time.Minute},
+	}
+	for i, e := range events {
+		_, err := cs.Kube.CoreV1().Events("ns").Create(context.Background(), &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: fmt.Sprintf("tr-retried.%d", i), Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "TaskRun", Name: "tr-retried", Namespace: "ns"},
+			Type:           e.eventType,
+			Reason:         e.reason,
+			Message:        e.message,
+			FirstTimestamp: metav1.Time{Time: clock.Now().Add(-e.ago)},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(
+		cb.UnstructuredTR(trs[0], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"taskrun"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic, Clock: clock}
+	taskrun := Command(p)
+	actual, err := test.ExecuteCommand(taskrun, "desc", "tr-retried", "-n", "ns", "--history")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	golden.Assert(t, actual, fmt.Sprintf("%s.golden", t.Name()))
+
+	_, err = test.ExecuteCommand(taskrun, "desc", "tr-retried", "-n", "ns", "--history", "-o", "yaml")
+	test.AssertOutput(t, "--history cannot be used with --output or --link", err.Error())
+}
+
+func TestTaskRunDescribe_wide(t *testing.T) {
+	clock := test.FakeClock()
+	class := "standard"
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tr-build",
+				Namespace: "ns",
+			},
+			Spec: v1.TaskRunSpec{
+				TaskRef: &v1.TaskRef{
+					Name: "t1",
+				},
+				Workspaces: []v1.WorkspaceBinding{
+					{Name: "source", PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "source-pvc"}},
+					{Name: "cache", VolumeClaimTemplate: &corev1.PersistentVolumeClaim{}},
+					{Name: "creds", Secret: &corev1.SecretVolumeSource{SecretName: "git-creds"}},
+					{Name: "scratch", EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory}},
+				},
+			},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Type:   apis.ConditionSucceeded,
+							Status: corev1.ConditionTrue,
+							Reason: "Succeeded",
+						},
+					},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					PodName:        "tr-build-pod",
+					StartTime:      &metav1.Time{Time: clock.Now().Add(-10 * time.Minute)},
+					CompletionTime: &metav1.Time{Time: clock.Now().Add(-time.Minute)},
+					TaskSpec: &v1.TaskSpec{
+						Workspaces: []v1.WorkspaceDeclaration{
+							{Name: "source"},
+							{Name: "cache"},
+							{Name: "creds", MountPath: "/etc/creds", ReadOnly: true},
+							{Name: "scratch"},
+						},
+					},
+				},
+			},
+		},
+	}
+	pods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tr-build-pod",
+				Namespace: "ns",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "step-clone",
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: "ws-abcde", MountPath: "/workspace/source"},
+							{Name: "ws-fghij", MountPath: "/etc/creds", ReadOnly: true},
+						},
+					},
+					{
+						Name: "step-build",
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: "ws-abcde", MountPath: "/workspace/source"},
+							{Name: "ws-klmno", MountPath: "/workspace/cache"},
+							{Name: "ws-pqrst", MountPath: "/workspace/scratch"},
+						},
+					},
+				},
+				Volumes: []corev1.Volume{
+					{Name: "ws-abcde", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "source-pvc"}}},
+					{Name: "ws-klmno", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc-0a1b2c3d4e"}}},
+					{Name: "ws-fghij", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "git-creds"}}},
+					{Name: "ws-pqrst", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory}}},
+				},
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		TaskRuns: trs,
+		Pods:     pods,
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+	claims := []*corev1.PersistentVolumeClaim{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "source-pvc", Namespace: "ns"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: &class,
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				},
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Phase:    corev1.ClaimBound,
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pvc-0a1b2c3d4e", Namespace: "ns"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: &class,
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("500Mi")},
+				},
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Phase: corev1.ClaimPending,
+			},
+		},
+	}
+	for _, claim := range claims {
+		if _, err := cs.Kube.CoreV1().PersistentVolumeClaims("ns").Create(context.Background(), claim, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(
+		cb.UnstructuredTR(trs[0], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"taskrun"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic, Clock: clock}
+	taskrun := Command(p)
+	actual, err := test.ExecuteCommand(taskrun, "desc", "tr-build", "-n", "ns", "--wide")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	golden.Assert(t, actual, fmt.Sprintf("%s.golden", t.Name()))
+
+	_, err = test.ExecuteCommand(taskrun, "desc", "tr-build", "-n", "ns", "--wide", "-o", "yaml")
+	test.AssertOutput(t, "--wide cannot be used with --output or --link", err.Error())
+}
+
+func TestTaskRunDescribe_scheduling(t *testing.T) {
+	clock := test.FakeClock()
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tr-build",
+				Namespace: "ns",
+			},
+			Spec: v1.TaskRunSpec{
+				TaskRef: &v1.TaskRef{
+					Name: "t1",
+				},
+			},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Type:   apis.ConditionSucceeded,
+							Status: corev1.ConditionTrue,
+							Reason: "Succeeded",
+						},
+					},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					PodName:        "tr-build-pod",
+					StartTime:      &metav1.Time{Time: clock.Now().Add(-10 * time.Minute)},
+					CompletionTime: &metav1.Time{Time: clock.Now().Add(-time.Minute)},
+				},
+			},
+		},
+	}
+	pods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "tr-build-pod",
+				Namespace:         "ns",
+				CreationTimestamp: metav1.Time{Time: clock.Now().Add(-10 * time.Minute)},
+			},
+			Spec: corev1.PodSpec{
+				NodeName:     "worker-2",
+				NodeSelector: map[string]string{"kubernetes.io/os": "linux", "pool": "ci"},
+				Tolerations: []corev1.Toleration{
+					{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "ci", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "node.kubernetes.io/not-ready", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+				Conditions: []corev1.PodCondition{
+					{
+						Type:               corev1.PodScheduled,
+						Status:             corev1.ConditionTrue,
+						LastTransitionTime: metav1.Time{Time: clock.Now().Add(-9 * time.Minute)},
+					},
+				},
+			},
+		},
+	}
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		TaskRuns: trs,
+		Pods:     pods,
+		Namespaces: []*corev1.Namespace{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ns",
+				},
+			},
+		},
+	})
+	_, err := cs.Kube.CoreV1().Events("ns").Create(context.Background(), &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "tr-build-pod.1", Namespace: "ns"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "tr-build-pod", Namespace: "ns"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "FailedScheduling",
+		Message:        "0/3 nodes are available: 1 node(s) had untolerated taint {gpu: true}, 2 node(s) didn't match Pod's node affinity/selector.",
+		LastTimestamp:  metav1.Time{Time: clock.Now().Add(-10 * time.Minute)},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tdc := testDynamic.Options{}
+	dynamic, err := tdc.Client(
+		cb.UnstructuredTR(trs[0], version),
+	)
+	if err != nil {
+		t.Errorf("unable to create dynamic client: %v", err)
+	}
+
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"taskrun"})
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dynamic, Clock: clock}
+	taskrun := Command(p)
+	actual, err := test.ExecuteCommand(taskrun, "desc", "tr-build", "-n", "ns", "--scheduling")
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	golden.Assert(t, actual, fmt.Sprintf("%s.golden", t.Name()))
+
+	_, err = test.ExecuteCommand(taskrun, "desc", "tr-build", "-n", "ns", "--scheduling", "--link")
+	test.AssertOutput(t, "--scheduling cannot be used with --output or --link", err.Error())
+}
@@ -17,13 +17,19 @@ package taskrun
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"text/tabwriter"
 	"text/template"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/daemon"
+	"github.com/tektoncd/cli/pkg/dashboard"
+	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
 	taskpkg "github.com/tektoncd/cli/pkg/task"
 	trsort "github.com/tektoncd/cli/pkg/taskrun/sort"
@@ -37,15 +43,15 @@ No TaskRuns found
 {{ else -}}
 {{- if not $.NoHeaders -}}
 {{- if $.AllNamespaces -}}
-NAMESPACE	NAME	STARTED	DURATION	STATUS
+NAMESPACE	NAME	STARTED	DURATION	STATUS{{ if $.Links }}	LINK{{ end }}
 {{ else -}}
-NAME	STARTED	DURATION	STATUS
+NAME	STARTED	DURATION	STATUS{{ if $.Links }}	LINK{{ end }}
 {{ end -}}
 {{- end -}}
 {{- range $_, $tr := .TaskRuns.Items -}}{{- if $tr -}}{{- if $.AllNamespaces -}}
-{{ $tr.Namespace }}	{{ $tr.Name }}	{{ formatAge $tr.Status.StartTime $.Time }}	{{ formatDuration $tr.Status.StartTime $tr.Status.CompletionTime }}	{{ formatCondition $tr.Status.Conditions }}
+{{ $tr.Namespace }}	{{ $tr.Name }}	{{ formatAge $tr.Status.StartTime $.Time }}	{{ formatDuration $tr.Status.StartTime $tr.Status.CompletionTime }}	{{ formatCondition $tr.Status.Conditions }}{{ if $.Links }}	{{ $.Links.Link "taskruns" $tr.Namespace $tr.Name }}{{ end }}
 {{ else -}}
-{{ $tr.Name }}	{{ formatAge $tr.Status.StartTime $.Time }}	{{ formatDuration $tr.Status.StartTime $tr.Status.CompletionTime }}	{{ formatCondition $tr.Status.Conditions }}
+{{ $tr.Name }}	{{ formatAge $tr.Status.StartTime $.Time }}	{{ formatDuration $tr.Status.StartTime $tr.Status.CompletionTime }}	{{ formatCondition $tr.Status.Conditions }}{{ if $.Links }}	{{ $.Links.Link "taskruns" $tr.Namespace $tr.Name }}{{ end }}
 {{ end -}}{{- end -}}{{- end -}}
 {{- end -}}
 `
@@ -56,6 +62,10 @@ type ListOptions struct {
 	Reverse       bool
 	AllNamespaces bool
 	NoHeaders     bool
+	Link          bool
+	Status        string
+	Since         time.Duration
+	Script        flags.ScriptOutput
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -69,6 +79,14 @@ func listCommand(p cli.Params) *cobra.Command {
 List all TaskRuns of Task 'foo' in namespace 'bar':
 
     tkn taskrun list foo -n bar
+
+List the failed TaskRuns started in the last 24 hours:
+
+    tkn tr list --status failed --since 24h
+
+List the TaskRuns matching the saved query 'failed-today' of the tkn profile:
+
+    tkn tr list @failed-today
 `
 
 	c := &cobra.Command{
@@ -80,6 +98,11 @@ List all TaskRuns of Task 'foo' in namespace 'bar':
 		},
 		Example: eg,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			args, err := flags.ExpandQueries(p, cmd, args)
+			if err != nil {
+				return err
+			}
+
 			var task string
 			if len(args) > 0 {
 				task = args[0]
@@ -89,7 +112,11 @@ List all TaskRuns of Task 'foo' in namespace 'bar':
 				return fmt.Errorf("limit was %d but must be a positive number", opts.Limit)
 			}
 
-			trs, err := list(p, task, opts.Limit, opts.LabelSelector, opts.AllNamespaces)
+			if opts.Status != "" && !slices.Contains(formatted.Phases, opts.Status) {
+				return fmt.Errorf("invalid value %q for --status, use one of %s", opts.Status, strings.Join(formatted.Phases, ", "))
+			}
+
+			trs, err := list(p, task, opts.Limit, opts.LabelSelector, opts.AllNamespaces, opts.Status, opts.Since)
 			if err != nil {
 				return fmt.Errorf("failed to list TaskRuns from namespace %s: %v", p.Namespace(), err)
 			}
@@ -100,7 +127,22 @@ List all TaskRuns of Task 'foo' in namespace 'bar':
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
+			}
+			if err := opts.Script.Validate(output); err != nil {
+				return err
+			}
+
+			if opts.Script.Enabled() {
+				if trs == nil {
+					return nil
+				}
+				for _, tr := range trs.Items {
+					if err := opts.Script.Print(cmd.OutOrStdout(), tr.Name, tr.Namespace, tr.Name, runTaskName(tr), formatted.Phase(tr.Status.Conditions), formatted.PorcelainTime(tr.Status.StartTime), formatted.PorcelainTime(tr.Status.CompletionTime)); err != nil {
+						return err
+					}
+				}
+				return nil
 			}
 			if output == "name" && trs != nil {
 				w := cmd.OutOrStdout()
@@ -124,8 +166,15 @@ List all TaskRuns of Task 'foo' in namespace 'bar':
 				Err: cmd.OutOrStderr(),
 			}
 
+			var links *dashboard.Links
+			if opts.Link {
+				if links, err = dashboard.FromProfile(p); err != nil {
+					return err
+				}
+			}
+
 			if trs != nil {
-				err = printFormatted(stream, trs, p.Time(), opts.AllNamespaces, opts.NoHeaders)
+				err = printFormatted(stream, trs, p.Time(), opts.AllNamespaces, opts.NoHeaders, links)
 			}
 
 			if err != nil {
@@ -143,6 +192,10 @@ List all TaskRuns of Task 'foo' in namespace 'bar':
 	c.Flags().BoolVarP(&opts.Reverse, "reverse", "", opts.Reverse, "list TaskRuns in reverse order")
 	c.Flags().BoolVarP(&opts.AllNamespaces, "all-namespaces", "A", opts.AllNamespaces, "list TaskRuns from all namespaces")
 	c.Flags().BoolVarP(&opts.NoHeaders, "no-headers", "", opts.NoHeaders, "do not print column headers with output (default print column headers with output)")
+	c.Flags().BoolVar(&opts.Link, "link", false, "print the link to each of the TaskRuns in the Tekton Dashboard set in the tkn profile")
+	c.Flags().StringVar(&opts.Status, "status", "", "only list the TaskRuns in this phase: "+strings.Join(formatted.Phases, ", "))
+	opts.Script.AddFlags(c, "TaskRun")
+	c.Flags().DurationVar(&opts.Since, "since", 0, "only list the TaskRuns started within this duration, e.g. 24h")
 	return c
 }
 
@@ -158,7 +211,7 @@ func reverse(trs *v1.TaskRunList) {
 	trs.Items = trItems
 }
 
-func list(p cli.Params, task string, limit int, labelselector string, allnamespaces bool) (*v1.TaskRunList, error) {
+func list(p cli.Params, task string, limit int, labelselector string, allnamespaces bool, status string, since time.Duration) (*v1.TaskRunList, error) {
 	var selector string
 	var options metav1.ListOptions
 
@@ -178,7 +231,7 @@ func list(p cli.Params, task string, limit int, labelselector string, allnamespa
 		}
 	}
 
-	cs, err := p.Clients()
+	cs, err := daemon.Clients(p)
 	if err != nil {
 		return nil, err
 	}
@@ -198,6 +251,10 @@ func list(p cli.Params, task string, limit int, labelselector string, allnamespa
 		trs.Items = taskpkg.FilterByRef(trs.Items, string(v1.NamespacedTaskKind))
 	}
 
+	if status != "" || since > 0 {
+		trs.Items = filterTaskRuns(trs.Items, status, since, p.Time().Now())
+	}
+
 	trslen := len(trs.Items)
 
 	if trslen != 0 {
@@ -221,18 +278,20 @@ func list(p cli.Params, task string, limit int, labelselector string, allnamespa
 	return trs, nil
 }
 
-func printFormatted(s *cli.Stream, trs *v1.TaskRunList, c clockwork.Clock, allnamespaces bool, noheaders bool) error {
+func printFormatted(s *cli.Stream, trs *v1.TaskRunList, c clockwork.Clock, allnamespaces bool, noheaders bool, links *dashboard.Links) error {
 
 	var data = struct {
 		TaskRuns      *v1.TaskRunList
 		Time          clockwork.Clock
 		AllNamespaces bool
 		NoHeaders     bool
+		Links         *dashboard.Links
 	}{
 		TaskRuns:      trs,
 		Time:          c,
 		AllNamespaces: allnamespaces,
 		NoHeaders:     noheaders,
+		Links:         links,
 	}
 
 	funcMap := template.FuncMap{
@@ -251,3 +310,35 @@ func printFormatted(s *cli.Stream, trs *v1.TaskRunList, c clockwork.Clock, allna
 
 	return w.Flush()
 }
+
+// filterTaskRuns keeps the TaskRuns in the phase status which started within
+// since
+func filterTaskRuns(runs []v1.TaskRun, status string, since time.Duration, now time.Time) []v1.TaskRun {
+	filtered := runs[:0]
+	for _, run := range runs {
+		if status != "" && formatted.Phase(run.Status.Conditions) != status {
+			continue
+		}
+		started := run.CreationTimestamp
+		if run.Status.StartTime != nil {
+			started = *run.Status.StartTime
+		}
+		if since > 0 && started.Time.Before(now.Add(-since)) {
+			continue
+		}
+		filtered = append(filtered, run)
+	}
+	return filtered
+}
+
+// runTaskName returns the name of the Task the run is for, empty for an
+// embedded spec
+func runTaskName(tr v1.TaskRun) string {
+	if name := tr.Labels["tekton.dev/task"]; name != "" {
+		return name
+	}
+	if tr.Spec.TaskRef != nil {
+		return tr.Spec.TaskRef.Name
+	}
+	return ""
+}
@@ -366,6 +366,12 @@ func TestListTaskRuns_v1beta1(t *testing.T) {
 			args:      []string{"list", "-n", "invalid"},
 			wantError: true,
 		},
+		{
+			name:      "filter taskruns by status",
+			command:   commandV1beta1(t, trs, now, ns, dc1),
+			args:      []string{"list", "-n", "foo", "--status", "failed"},
+			wantError: false,
+		},
 		{
 			name:      "filter taskruns by label",
 			command:   commandV1beta1(t, trs, now, ns, dc1),
@@ -940,3 +946,102 @@ func command(t *testing.T, trs []*v1.TaskRun, now time.Time, ns []*corev1.Namesp
 
 	return Command(p)
 }
+
+func TestListTaskRuns_script(t *testing.T) {
+	now := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "tr0-1",
+				Labels:    map[string]string{"tekton.dev/task": "random"},
+			},
+			Spec: v1.TaskRunSpec{
+				TaskRef: &v1.TaskRef{Name: "random"},
+			},
+			Status: v1.TaskRunStatus{
+				Status: duckv1.Status{
+					Conditions: duckv1.Conditions{
+						{
+							Status: corev1.ConditionTrue,
+							Reason: v1.TaskRunReasonSuccessful.String(),
+						},
+					},
+				},
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					StartTime:      &metav1.Time{Time: now},
+					CompletionTime: &metav1.Time{Time: now.Add(time.Minute)},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "tr1-1",
+			},
+			Spec: v1.TaskRunSpec{
+				TaskSpec: &v1.TaskSpec{},
+			},
+		},
+	}
+	ns := []*corev1.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "foo",
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		args      []string
+		want      string
+		wantError bool
+	}{
+		{
+			name: "quiet",
+			args: []string{"list", "-n", "foo", "-q"},
+			want: "tr1-1\ntr0-1\n",
+		},
+		{
+			name: "porcelain",
+			args: []string{"list", "-n", "foo", "--porcelain"},
+			want: "foo\ttr1-1\t-\tpending\t-\t-\n" +
+				"foo\ttr0-1\trandom\tsucceeded\t2026-10-15T09:00:00Z\t2026-10-15T09:01:00Z\n",
+		},
+		{
+			name:      "quiet with porcelain",
+			args:      []string{"list", "-n", "foo", "-q", "--porcelain"},
+			want:      "Error: --quiet and --porcelain cannot be used together\n",
+			wantError: true,
+		},
+		{
+			name:      "porcelain with output",
+			args:      []string{"list", "-n", "foo", "--porcelain", "-o", "yaml"},
+			want:      "Error: --quiet and --porcelain cannot be used with --output\n",
+			wantError: true,
+		},
+	}
+
+	for _, td := range tests {
+		t.Run(td.name, func(t *testing.T) {
+			tdc := testDynamic.Options{}
+			dc, err := tdc.Client(
+				cb.UnstructuredTR(trs[0], version),
+				cb.UnstructuredTR(trs[1], version),
+			)
+			if err != nil {
+				t.Errorf("unable to create dynamic client: %v", err)
+			}
+			got, err := test.ExecuteCommand(command(t, trs, now, ns, dc), td.args...)
+			if err != nil && !td.wantError {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if err == nil && td.wantError {
+				t.Error("Expected an error")
+			}
+			test.AssertOutput(t, td.want, got)
+		})
+	}
+}
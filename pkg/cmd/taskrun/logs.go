@@ -17,15 +17,22 @@ package taskrun
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	prcmd "github.com/tektoncd/cli/pkg/cmd/pipelinerun"
+	cmdversion "github.com/tektoncd/cli/pkg/cmd/version"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/cli/pkg/log"
+	"github.com/tektoncd/cli/pkg/notify"
 	"github.com/tektoncd/cli/pkg/options"
 	"github.com/tektoncd/cli/pkg/taskrun"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
 )
 
 const (
@@ -34,6 +41,7 @@ const (
 
 func logCommand(p cli.Params) *cobra.Command {
 	opts := &options.LogOptions{Params: p}
+	var includeRetries, waitForRetries bool
 	eg := `
 Show the logs of TaskRun named 'foo' from the namespace 'bar':
 
@@ -46,6 +54,58 @@ Show the live logs of TaskRun named 'foo' from namespace 'bar':
 Show the logs of TaskRun named 'microservice-1' for step 'build' only from namespace 'bar':
 
     tkn tr logs microservice-1 -s build -n bar
+
+Show the logs of the ephemeral container 'debugger' attached to the pod of TaskRun 'foo' with kubectl debug:
+
+    tkn tr logs foo --container debugger -f
+
+Save the logs of TaskRun named 'foo' as a zip archive with a file per step:
+
+    tkn tr logs foo -o zip > foo.zip
+
+Show the logs of TaskRun named 'foo' from the object storage of the tkn profile:
+
+    tkn tr logs foo --source storage
+
+Follow the logs of TaskRun named 'foo' and cancel it when no logs are written for 10 minutes:
+
+    tkn tr logs foo -f --activity-timeout 10m --on-timeout cancel-run
+
+Follow the logs of TaskRun named 'foo' and list the processes of a step writing no logs for 5 minutes:
+
+    tkn tr logs foo -f --hang-threshold 5m --hang-dump
+
+Show the logs of the whole PipelineRun TaskRun 'foo' is part of, e.g. when only its name is in an alert:
+
+    tkn tr logs foo --whole-pipeline -f
+
+Show how the logs of the last attempt of TaskRun 'foo' differ from the previous attempt:
+
+    tkn tr logs diff foo
+
+Save the logs of TaskRun named 'foo' to a file, the messages of tkn go to the standard error:
+
+    tkn tr logs foo --no-banner > foo.log
+
+Warn about the lines of the logs of TaskRun named 'foo' likely leaking a secret:
+
+    tkn tr logs foo --scan-leaks
+
+Show the logs of the second attempt of TaskRun named 'foo', waiting for it when following:
+
+    tkn tr logs foo --attempt 2 -f
+
+Follow the logs of TaskRun named 'foo' until its current attempt is done, without waiting for its retries:
+
+    tkn tr logs foo -f --wait-for-retries=false
+
+Follow the logs of TaskRun named 'foo', pausing them or toggling their timestamps with keys while they stream:
+
+    tkn tr logs foo -f --interactive
+
+Follow the logs of TaskRun named 'foo' and keep its results in full for describe, even when larger than 4KB:
+
+    tkn tr logs foo -f --capture-results
 `
 	c := &cobra.Command{
 		Use:          "logs",
@@ -75,6 +135,76 @@ Show the logs of TaskRun named 'microservice-1' for step 'build' only from names
 			if len(opts.Steps) > 0 && opts.AllSteps {
 				return fmt.Errorf("option --all and option --step are not compatible")
 			}
+			if len(opts.Containers) > 0 && opts.AllSteps {
+				return fmt.Errorf("option --all and option --container are not compatible")
+			}
+
+			if opts.WholePipeline && (len(opts.Steps) > 0 || len(opts.Containers) > 0) {
+				return fmt.Errorf("option --whole-pipeline and options --step and --container are not compatible")
+			}
+
+			if opts.FlushInterval < 0 {
+				return fmt.Errorf("--flush-interval must not be negative")
+			}
+
+			if opts.Archive != "" && opts.Archive != log.ArchiveTar && opts.Archive != log.ArchiveZip {
+				return fmt.Errorf("invalid value %q for --output, use %s or %s", opts.Archive, log.ArchiveTar, log.ArchiveZip)
+			}
+			if err := opts.ValidateSigning(); err != nil {
+				return err
+			}
+
+			if opts.StdoutOnly && opts.StderrOnly {
+				return fmt.Errorf("option --stdout-only and option --stderr-only are not compatible")
+			}
+
+			if !slices.Contains(log.Sources, opts.Source) {
+				return fmt.Errorf("invalid value %q for --source, use one of %s", opts.Source, strings.Join(log.Sources, ", "))
+			}
+			if opts.StreamFrom != "" {
+				if opts.Source != log.SourceAuto && opts.Source != log.SourcePods {
+					return fmt.Errorf("--stream-from cannot be used with --source %s", opts.Source)
+				}
+				if opts.StdoutOnly || opts.StderrOnly {
+					return fmt.Errorf("--stream-from cannot be used with --stdout-only and --stderr-only")
+				}
+			}
+
+			if opts.ActivityTimeout < 0 {
+				return fmt.Errorf("--activity-timeout must not be negative")
+			}
+			if opts.HangThreshold < 0 {
+				return fmt.Errorf("--hang-threshold must not be negative")
+			}
+			if opts.HangDump && (opts.HangThreshold == 0 || !opts.Follow) {
+				return fmt.Errorf("--hang-dump requires --follow and --hang-threshold")
+			}
+			if opts.Journal != "" && !opts.Follow {
+				return fmt.Errorf("--journal can only be used with --follow")
+			}
+			if opts.NotifyTerminal && !opts.Follow {
+				return fmt.Errorf("--notify-terminal can only be used with --follow")
+			}
+			if opts.CaptureResults && !opts.Follow {
+				return fmt.Errorf("--capture-results can only be used with --follow")
+			}
+			if opts.Interactive {
+				if !opts.Follow {
+					return fmt.Errorf("--interactive can only be used with --follow")
+				}
+				if opts.Archive != "" || opts.Silent {
+					return fmt.Errorf("--interactive cannot be used with --output or --silent")
+				}
+			}
+			if err := setRetryOptions(cmd, opts, includeRetries, waitForRetries); err != nil {
+				return err
+			}
+
+			switch opts.OnTimeout {
+			case log.OnTimeoutContinue, log.OnTimeoutFail, log.OnTimeoutCancelRun:
+			default:
+				return fmt.Errorf("invalid value %q for --on-timeout, use %s, %s or %s", opts.OnTimeout, log.OnTimeoutContinue, log.OnTimeoutFail, log.OnTimeoutCancelRun)
+			}
 
 			return Run(opts)
 		},
@@ -88,10 +218,63 @@ Show the logs of TaskRun named 'microservice-1' for step 'build' only from names
 	c.Flags().IntVarP(&opts.Limit, "limit", "", defaultLimit, "lists number of TaskRuns")
 	c.Flags().BoolVarP(&opts.Fzf, "fzf", "F", false, "use fzf to select a TaskRun")
 	c.Flags().StringSliceVarP(&opts.Steps, "step", "s", []string{}, "show logs for mentioned steps only")
+	c.Flags().StringSliceVarP(&opts.Containers, "container", "", []string{}, "show logs for mentioned containers only, including ephemeral containers attached for debugging")
+	c.Flags().StringVarP(&opts.Archive, "output", "o", "", "write the logs to an archive with a file per step and a metadata.json instead of printing them: tar or zip")
+	c.Flags().StringVarP(&opts.SignKey, "sign-key", "", "", "with --output, sign the metadata.json of the archive, which has the digests of its files, with this private key and write the signature to metadata.json.sig, to check with cosign verify-blob")
+	c.Flags().StringVarP(&opts.SignKMSKey, "sign-kms-key", "", "", "with --output, sign the archive like --sign-key with this KMS key, e.g. awskms:///arn:aws:kms:us-east-1:111122223333:key/1234abcd, recorded in metadata.json")
+	c.Flags().StringVarP(&opts.SignCert, "sign-cert", "", "", "certificate of the key signing the archive, whose identity and issuer are recorded in metadata.json")
+	c.Flags().StringVarP(&opts.Source, "source", "", log.SourceAuto, "where the logs are read from: pods, results for Tekton Results, storage for the object storage of the tkn profile, or auto to use the pods while they exist and then the first of the others having the logs")
+	c.Flags().BoolVar(&opts.KeepCompressed, "keep-compressed", false, "write the logs read from Tekton Results or the object storage compressed as they were transferred, with zstd or gzip, rather than decompressing them")
+	c.Flags().StringVarP(&opts.StreamFrom, "stream-from", "", "", "read the logs of the containers from this location instead of the cluster, the logs of a container being at <pod>/<container>.log under it: file:///path/to/dir or s3://bucket/prefix with the default AWS credentials")
+	c.Flags().DurationVarP(&opts.FlushInterval, "flush-interval", "", 0, "buffer logs and write them out at least at this interval, by default logs are buffered unless followed")
+	c.Flags().DurationVarP(&opts.ActivityTimeout, "activity-timeout", "", 0, "when following, how long the pod may take to start and a step may go without writing logs, 0 to wait 10s for the pod and forever for the logs")
+	c.Flags().StringVarP(&opts.OnTimeout, "on-timeout", "", log.OnTimeoutFail, "what happens when the activity timeout is reached: continue to keep following, fail to stop with exit code 9 or cancel-run to also cancel the TaskRun")
+	c.Flags().DurationVarP(&opts.HangThreshold, "hang-threshold", "", 0, "when following, report a step writing no logs for this long as hung, 0 to never report it")
+	c.Flags().BoolVarP(&opts.HangDump, "hang-dump", "", false, "exec into the container of a hung step, when allowed, and add what the hang dump commands of the tkn profile print to the logs, by default the list of processes")
+	c.Flags().BoolVarP(&opts.Verbose, "verbose", "", false, "when following, print a notice whenever a watch fails and the pod is listed again, and how many times it happened once done")
+	c.Flags().StringVarP(&opts.Journal, "journal", "", "", "when following, append the watch events of the TaskRun and of its pods, failures of the watches and timeouts to this file as JSON lines, to attach to bug reports")
+	c.Flags().BoolVarP(&opts.WholePipeline, "whole-pipeline", "", false, "show the logs of the PipelineRun the TaskRun is part of, found from its owner references or labels")
+	c.Flags().BoolVarP(&opts.NotifyTerminal, "notify-terminal", "", false, "when following, show the state of the TaskRun in the title of the terminal, and ring its bell with a desktop notification, where supported, once it is done")
+	c.Flags().BoolVarP(&opts.NoBanner, "no-banner", "", false, "do not write the blank lines separating the logs of the steps")
+	c.Flags().BoolVarP(&opts.Silent, "silent", "", false, "only write the logs and the errors, without banners or progress messages")
+	c.Flags().BoolVarP(&opts.ScanLeaks, "scan-leaks", "", false, "look for secrets such as AWS keys, GitHub tokens and JWTs in the logs and warn about the lines likely holding one")
+	c.Flags().BoolVarP(&opts.FailOnLeak, "fail-on-leak", "", false, "scan the logs for secrets like --scan-leaks and fail with exit code 10 when one is found")
+	c.Flags().BoolVarP(&opts.StdoutOnly, "stdout-only", "", false, "only show what the steps write to their standard output, needs the SplitStdoutAndStderr feature gate of Kubernetes 1.32 or later, both streams are shown otherwise")
+	c.Flags().BoolVarP(&opts.StderrOnly, "stderr-only", "", false, "only show what the steps write to their standard error, needs the SplitStdoutAndStderr feature gate of Kubernetes 1.32 or later, both streams are shown otherwise")
+	c.Flags().BoolVarP(&includeRetries, "include-retries", "", true, "show the logs of the earlier attempts of a retried TaskRun before the logs of its last attempt, when following and false only the attempts made from now on are shown")
+	c.Flags().IntVarP(&opts.Attempt, "attempt", "", 0, "only show the logs of this attempt of the TaskRun, the first one being 1, when following the attempt is waited for until the TaskRun is done")
+	c.Flags().BoolVarP(&waitForRetries, "wait-for-retries", "", true, "when following, wait for the pods of the retries of a failed attempt and show their logs, false stops after the current attempt")
+	c.Flags().BoolVarP(&opts.Interactive, "interactive", "", false, "when following in a terminal, change how the logs are shown while they stream with keys: p to pause and resume, t to toggle the timestamps, s to show only the current step or all of them, v to change the verbosity of the notices and h for help")
+	c.Flags().BoolVarP(&opts.CaptureResults, "capture-results", "", false, "when following, read the results of the TaskRun from the steps with exec while they run, for describe to show those not fitting in the termination messages of the steps")
+
+	c.AddCommand(logsDiffCommand(p))
 
 	return c
 }
 
+// setRetryOptions checks the flags choosing the attempts of the TaskRuns
+// whose logs are shown and sets them in opts
+func setRetryOptions(cmd *cobra.Command, opts *options.LogOptions, includeRetries, waitForRetries bool) error {
+	flags := cmd.Flags()
+	if flags.Changed("attempt") {
+		if opts.Attempt < 1 {
+			return fmt.Errorf("--attempt must be 1 or more")
+		}
+		if flags.Changed("include-retries") || flags.Changed("wait-for-retries") {
+			return fmt.Errorf("--attempt cannot be used with --include-retries or --wait-for-retries")
+		}
+		if opts.WholePipeline {
+			return fmt.Errorf("--attempt cannot be used with --whole-pipeline")
+		}
+	}
+	if flags.Changed("wait-for-retries") && !opts.Follow {
+		return fmt.Errorf("--wait-for-retries can only be used with --follow")
+	}
+	opts.ExcludeRetries = !includeRetries
+	opts.StopAtFailedAttempt = !waitForRetries
+	return nil
+}
+
 func Run(opts *options.LogOptions) error {
 	if opts.TaskrunName == "" {
 		if err := opts.ValidateOpts(); err != nil {
@@ -102,20 +285,209 @@ func Run(opts *options.LogOptions) error {
 		}
 	}
 
+	if opts.WholePipeline {
+		return wholePipelineLogs(opts)
+	}
+
+	if archived, err := log.FromArchive(opts, log.LogTypeTask, opts.TaskrunName); archived || err != nil {
+		return err
+	}
+
+	if opts.Archive != "" {
+		return archiveLogs(opts)
+	}
+
 	lr, err := log.NewReader(log.LogTypeTask, opts)
 	if err != nil {
 		return err
 	}
+	defer lr.Close()
+
+	var terminal *notify.Terminal
+	if opts.NotifyTerminal && opts.Follow {
+		terminal = notify.NewTerminal(opts.Stream.Err)
+		terminal.Running("TaskRun", opts.TaskrunName)
+	}
+
+	logC, errC, err := lr.Read()
+	if err != nil {
+		return err
+	}
+	logC, leaks := scanLeaks(opts, logC)
+	stopControls := func() {}
+	if opts.Interactive {
+		if logC, stopControls, err = log.StartControls(opts, logC); err != nil {
+			return err
+		}
+	}
+
+	log.NewWriter(log.LogTypeTask, opts.Prefixing).
+		SetBuffering(opts.Follow, opts.FlushInterval).
+		SetQuiet(opts.NoBanner, opts.Silent).
+		Write(opts.Stream, logC, errC)
+	stopControls()
+	if opts.Verbose && opts.Follow {
+		fmt.Fprintf(opts.Stream.Err, "--- watches listed again %d times while following the logs ---\n", lr.Relists())
+	}
+	if leaks != nil {
+		if err := log.ReportLeaks(opts, leaks, log.LogTypeTask, opts.TaskrunName); err != nil {
+			return err
+		}
+	}
+
+	if lr.TimedOut() {
+		return onActivityTimeout(opts)
+	}
+	if !opts.Follow {
+		return nil
+	}
+
+	clients, err := opts.Params.Clients()
+	if err != nil {
+		return err
+	}
+	tr, err := taskrun.GetTaskRun(taskrunGroupResource, clients, opts.TaskrunName, opts.Params.Namespace())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &cli.ExitError{
+				Code: cli.ExitCodeRunDeleted,
+				Err:  fmt.Errorf("TaskRun %s was deleted while streaming logs", opts.TaskrunName),
+			}
+		}
+		return err
+	}
+	if terminal != nil {
+		terminal.Finished("TaskRun", tr.Name, tr.Status.GetCondition(apis.ConditionSucceeded))
+	}
+	return nil
+}
 
+// wholePipelineLogs shows the logs of the PipelineRun the TaskRun is part of
+func wholePipelineLogs(opts *options.LogOptions) error {
+	clients, err := opts.Params.Clients()
+	if err != nil {
+		return err
+	}
+	tr, err := taskrun.GetTaskRun(taskrunGroupResource, clients, opts.TaskrunName, opts.Params.Namespace())
+	if err != nil {
+		return err
+	}
+	pr := taskrun.OwnerPipelineRun(tr)
+	if pr == "" {
+		return fmt.Errorf("TaskRun %s is not part of a PipelineRun", opts.TaskrunName)
+	}
+
+	if !opts.Silent {
+		fmt.Fprintf(opts.Stream.Err, "Showing the logs of PipelineRun %s, TaskRun %s is part of it\n", pr, opts.TaskrunName)
+	}
+	opts.PipelineRunName = pr
+	opts.TaskrunName = ""
+	return prcmd.Run(opts)
+}
+
+// archiveLogs writes the logs to an archive on the output, any other message
+// goes to the error stream
+func archiveLogs(opts *options.LogOptions) error {
+	out := opts.Stream.Out
+	opts.Stream = &cli.Stream{In: opts.Stream.In, Out: opts.Stream.Err, Err: opts.Stream.Err}
+
+	lr, err := log.NewReader(log.LogTypeTask, opts)
+	if err != nil {
+		return err
+	}
+	defer lr.Close()
 	logC, errC, err := lr.Read()
 	if err != nil {
 		return err
 	}
+	logC, leaks := scanLeaks(opts, logC)
+
+	archive, err := log.NewArchive(opts.Archive, log.LogTypeTask, out, opts.Params.Time().Now())
+	if err != nil {
+		return err
+	}
+	if opts.SignKey != "" || opts.SignKMSKey != "" {
+		if err := archive.SignWith(opts.SignKey, opts.SignKMSKey, opts.SignCert); err != nil {
+			return err
+		}
+	}
+	if err := archive.Write(opts.Stream, logC, errC); err != nil {
+		return err
+	}
+	if lr.TimedOut() {
+		// keep the logs which were read in a valid archive
+		_ = archive.Close(nil)
+		return onActivityTimeout(opts)
+	}
 
-	log.NewWriter(log.LogTypeTask, opts.Prefixing).Write(opts.Stream, logC, errC)
+	clients, err := opts.Params.Clients()
+	if err != nil {
+		return err
+	}
+	tr, err := taskrun.GetTaskRun(taskrunGroupResource, clients, opts.TaskrunName, opts.Params.Namespace())
+	if err != nil {
+		// keep the logs which were read in a valid archive
+		_ = archive.Close(nil)
+		if errors.IsNotFound(err) {
+			return &cli.ExitError{
+				Code: cli.ExitCodeRunDeleted,
+				Err:  fmt.Errorf("TaskRun %s was deleted while streaming logs", opts.TaskrunName),
+			}
+		}
+		return err
+	}
+	metadata := log.TaskRunMetadata(taskName(tr), tr)
+	metadata.Producer = log.NewProducer(opts.Params, cmdversion.ClientVersion())
+	if err := archive.Close(metadata); err != nil {
+		return err
+	}
+	if leaks != nil {
+		return log.ReportLeaks(opts, leaks, log.LogTypeTask, opts.TaskrunName)
+	}
 	return nil
 }
 
+// scanLeaks passes the logs through a LeakScanner when opts ask for it
+func scanLeaks(opts *options.LogOptions, logC <-chan log.Log) (<-chan log.Log, *log.LeakScanner) {
+	if !opts.ScanLeaks && !opts.FailOnLeak {
+		return logC, nil
+	}
+	leaks := log.NewLeakScanner()
+	return leaks.Tee(logC), leaks
+}
+
+// onActivityTimeout applies the --on-timeout policy once the logs stopped
+// being followed because the activity timeout was reached
+func onActivityTimeout(opts *options.LogOptions) error {
+	if opts.OnTimeout == log.OnTimeoutContinue {
+		return nil
+	}
+
+	err := fmt.Errorf("activity timeout reached while following the logs of TaskRun %s", opts.TaskrunName)
+	if opts.OnTimeout == log.OnTimeoutCancelRun {
+		clients, cerr := opts.Params.Clients()
+		if cerr != nil {
+			return cerr
+		}
+		if _, cerr := patch(clients, opts.TaskrunName, metav1.PatchOptions{}, opts.Params.Namespace()); cerr != nil {
+			return fmt.Errorf("failed to cancel TaskRun %s: %v", opts.TaskrunName, cerr)
+		}
+		err = fmt.Errorf("activity timeout reached while following the logs of TaskRun %s, TaskRun cancelled", opts.TaskrunName)
+	}
+	return &cli.ExitError{Code: cli.ExitCodeActivityTimeout, Err: err}
+}
+
+// taskName is the name of the Task of a TaskRun as shown in its logs
+func taskName(tr *v1.TaskRun) string {
+	if name, ok := tr.Labels["tekton.dev/pipelineTask"]; ok {
+		return name
+	}
+	if tr.Spec.TaskRef != nil && tr.Spec.TaskRef.Name != "" {
+		return tr.Spec.TaskRef.Name
+	}
+	return tr.Name
+}
+
 func askRunName(opts *options.LogOptions) error {
 	lOpts := metav1.ListOptions{}
 
@@ -0,0 +1,152 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/log"
+	"github.com/tektoncd/cli/pkg/pods"
+	"github.com/tektoncd/cli/pkg/pods/stream"
+	"github.com/tektoncd/cli/pkg/taskrun"
+)
+
+type logsDiffOptions struct {
+	Params   cli.Params
+	Attempts string
+	Streamer stream.NewStreamerFunc
+}
+
+func logsDiffCommand(p cli.Params) *cobra.Command {
+	opts := &logsDiffOptions{Params: p}
+	eg := `Show how the logs of the last attempt of TaskRun 'foo' differ from the previous attempt:
+
+    tkn taskrun logs diff foo
+
+Show how the logs of the second attempt of TaskRun 'foo' differ from the first one:
+
+    tkn taskrun logs diff foo --attempts 1,2
+
+Show how the logs of TaskRun 'bar' differ from the logs of TaskRun 'foo':
+
+    tkn taskrun logs diff foo bar
+`
+	c := &cobra.Command{
+		Use:          "diff TASKRUN [TASKRUN]",
+		Short:        "Show how the logs of two attempts or two TaskRuns differ, step by step",
+		Example:      eg,
+		Args:         cobra.RangeArgs(1, 2),
+		SilenceUsage: true,
+		Annotations: map[string]string{
+			"commandType": "main",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return logsDiff(cmd, opts, args)
+		},
+	}
+
+	c.Flags().StringVarP(&opts.Attempts, "attempts", "", "", "the two attempts to compare, starting at 1, as OLD,NEW; by default the last two attempts of a TaskRun or the last attempt of each of two TaskRuns")
+
+	return c
+}
+
+func logsDiff(cmd *cobra.Command, opts *logsDiffOptions, args []string) error {
+	cs, err := opts.Params.Clients()
+	if err != nil {
+		return err
+	}
+	ns := opts.Params.Namespace()
+
+	profile, err := opts.Params.Profile()
+	if err != nil {
+		return err
+	}
+	streamer := opts.Streamer
+	if streamer == nil {
+		streamOpts, err := stream.ProfileOptions(profile.Stream)
+		if err != nil {
+			return err
+		}
+		streamer = pods.NewStreamWithOptions(streamOpts)
+	}
+
+	oldTr, err := taskrun.GetTaskRun(taskrunGroupResource, cs, args[0], ns)
+	if err != nil {
+		return fmt.Errorf("failed to get TaskRun %s: %w", args[0], err)
+	}
+	newTr := oldTr
+	if len(args) == 2 {
+		if newTr, err = taskrun.GetTaskRun(taskrunGroupResource, cs, args[1], ns); err != nil {
+			return fmt.Errorf("failed to get TaskRun %s: %w", args[1], err)
+		}
+	}
+
+	oldAttempt, newAttempt := len(oldTr.Status.RetriesStatus), len(newTr.Status.RetriesStatus)+1
+	if len(args) == 2 {
+		oldAttempt++
+	}
+	if opts.Attempts != "" {
+		if oldAttempt, newAttempt, err = parseAttempts(opts.Attempts); err != nil {
+			return err
+		}
+	}
+	if len(args) == 1 && oldAttempt == 0 {
+		return fmt.Errorf("TaskRun %s has a single attempt, use a second TaskRun to compare its logs with", oldTr.Name)
+	}
+
+	oldPod, err := log.AttemptPod(oldTr, oldAttempt)
+	if err != nil {
+		return err
+	}
+	newPod, err := log.AttemptPod(newTr, newAttempt)
+	if err != nil {
+		return err
+	}
+
+	oldLogs, err := log.PodStepLogs(cs, streamer, ns, oldPod, log.PodSteps(oldTr, oldPod), profile.Logs.StepPrefixes)
+	if err != nil {
+		return fmt.Errorf("failed to get the logs of attempt %d of TaskRun %s: %w", oldAttempt, oldTr.Name, err)
+	}
+	newLogs, err := log.PodStepLogs(cs, streamer, ns, newPod, log.PodSteps(newTr, newPod), profile.Logs.StepPrefixes)
+	if err != nil {
+		return fmt.Errorf("failed to get the logs of attempt %d of TaskRun %s: %w", newAttempt, newTr.Name, err)
+	}
+
+	log.PrintStepsDiff(cmd.OutOrStdout(),
+		fmt.Sprintf("%s attempt %d", oldTr.Name, oldAttempt),
+		fmt.Sprintf("%s attempt %d", newTr.Name, newAttempt),
+		oldLogs, newLogs)
+	return nil
+}
+
+func parseAttempts(attempts string) (int, int, error) {
+	parts := strings.Split(attempts, ",")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid value %q for --attempts, use OLD,NEW such as 1,2", attempts)
+	}
+	var numbers [2]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || n < 1 {
+			return 0, 0, fmt.Errorf("invalid value %q for --attempts, use OLD,NEW such as 1,2", attempts)
+		}
+		numbers[i] = n
+	}
+	return numbers[0], numbers[1], nil
+}
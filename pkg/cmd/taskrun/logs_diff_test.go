@@ -0,0 +1,242 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/pods/fake"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLogsDiff(t *testing.T) {
+	ns := "ns"
+	stepPod := func(name string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "prepare"}},
+				Containers: []corev1.Container{
+					{Name: "step-build"},
+					{Name: "step-test"},
+				},
+			},
+		}
+	}
+
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "flaky", Namespace: ns},
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					PodName: "flaky-pod-retry2",
+					RetriesStatus: []v1.TaskRunStatus{
+						{TaskRunStatusFields: v1.TaskRunStatusFields{PodName: "flaky-pod"}},
+						{TaskRunStatusFields: v1.TaskRunStatusFields{PodName: "flaky-pod-retry1"}},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "once", Namespace: ns},
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{PodName: "once-pod"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "renamed", Namespace: ns},
+			Status: v1.TaskRunStatus{
+				TaskRunStatusFields: v1.TaskRunStatusFields{
+					PodName: "renamed-pod",
+					Steps: []v1.StepState{
+						{Name: "build", Container: "tekton-step-build"},
+						{Name: "test", Container: "tekton-step-test"},
+					},
+				},
+			},
+		},
+	}
+	// a build of Tekton renaming the containers of the steps, they are
+	// given in the status of the steps of the TaskRun
+	renamedPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "renamed-pod",
+			Namespace: ns,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "tekton-step-build"},
+				{Name: "tekton-step-test"},
+				{Name: "istio-proxy"},
+			},
+		},
+	}
+
+	build := fake.Step("step-build", "compiling", "done")
+	logs := fake.Logs(
+		fake.Task("flaky-pod", build,
+			fake.Step("step-test", "test a", "test b", "test c", "test d", "test e", "test f", "connection refused", "FAIL")),
+		fake.Task("flaky-pod-retry1", build,
+			fake.Step("step-test", "test a", "test b", "test c", "test d", "test e", "test f", "timeout", "FAIL")),
+		fake.Task("flaky-pod-retry2", build,
+			fake.Step("step-test", "test a", "test b", "test c", "test d", "test e", "test f", "PASS")),
+		fake.Task("once-pod", build,
+			fake.Step("step-test", "test a", "PASS")),
+		fake.Task("renamed-pod",
+			fake.Step("tekton-step-build", "compiling", "done"),
+			fake.Step("tekton-step-test", "test a", "FAIL"),
+			fake.Step("istio-proxy", "envoy started")),
+	)
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{
+		TaskRuns: trs,
+		Pods:     []*corev1.Pod{stepPod("flaky-pod"), stepPod("flaky-pod-retry1"), stepPod("flaky-pod-retry2"), stepPod("once-pod"), renamedPod},
+	})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"taskrun"})
+	tdc := testDynamic.Options{}
+	dc, err := tdc.Client(
+		cb.UnstructuredTR(trs[0], version),
+		cb.UnstructuredTR(trs[1], version),
+		cb.UnstructuredTR(trs[2], version),
+	)
+	if err != nil {
+		t.Fatalf("unable to create dynamic client: %v", err)
+	}
+
+	testParams := []struct {
+		name     string
+		args     []string
+		attempts string
+		want     string
+		wantErr  string
+	}{
+		{
+			name: "last two attempts",
+			args: []string{"flaky"},
+			want: `--- flaky attempt 2
++++ flaky attempt 3
+
+[build] identical
+
+[test]
+  test d
+  test e
+  test f
+- timeout
+- FAIL
++ PASS
+`,
+		},
+		{
+			name:     "given attempts",
+			args:     []string{"flaky"},
+			attempts: "1,2",
+			want: `--- flaky attempt 1
++++ flaky attempt 2
+
+[build] identical
+
+[test]
+  test d
+  test e
+  test f
+- connection refused
++ timeout
+  FAIL
+`,
+		},
+		{
+			name: "two taskruns",
+			args: []string{"flaky", "once"},
+			want: `--- flaky attempt 3
++++ once attempt 1
+
+[build] identical
+
+[test]
+  test a
+- test b
+- test c
+- test d
+- test e
+- test f
+  PASS
+`,
+		},
+		{
+			name: "renamed step containers",
+			args: []string{"once", "renamed"},
+			want: `--- once attempt 1
++++ renamed attempt 1
+
+[build] identical
+
+[test]
+  test a
+- PASS
++ FAIL
+`,
+		},
+		{
+			name:    "single attempt",
+			args:    []string{"once"},
+			wantErr: "TaskRun once has a single attempt, use a second TaskRun to compare its logs with",
+		},
+		{
+			name:     "missing attempt",
+			args:     []string{"flaky"},
+			attempts: "1,4",
+			wantErr:  "TaskRun flaky has no attempt 4, it has 3",
+		},
+		{
+			name:     "invalid attempts",
+			args:     []string{"flaky"},
+			attempts: "0,1",
+			wantErr:  `invalid value "0,1" for --attempts, use OLD,NEW such as 1,2`,
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			p := &test.Params{Kube: cs.Kube, Tekton: cs.Pipeline, Dynamic: dc}
+			p.SetNamespace(ns)
+			opts := &logsDiffOptions{Params: p, Attempts: tp.attempts, Streamer: fake.Streamer(logs)}
+			out := new(bytes.Buffer)
+			cmd := &cobra.Command{}
+			cmd.SetOut(out)
+
+			err := logsDiff(cmd, opts, tp.args)
+			if tp.wantErr != "" {
+				if err == nil {
+					t.Fatalf("expected error %q", tp.wantErr)
+				}
+				test.AssertOutput(t, tp.wantErr, err.Error())
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			test.AssertOutput(t, tp.want, out.String())
+		})
+	}
+}
@@ -44,6 +44,7 @@ func Command(p cli.Params) *cobra.Command {
 		cancelCommand(p),
 		describeCommand(p),
 		exportCommand(p),
+		debugLocalCommand(p),
 	)
 
 	return cmd
@@ -119,6 +119,8 @@ func deleteTriggerBindings(s *cli.Stream, p cli.Params, tbNames []string, delete
 	}
 	d.Delete(tbNames)
 
+	d.Audit(p)
+
 	if !deleteAll {
 		d.PrintSuccesses(s)
 	} else if deleteAll {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/cli/pkg/triggerbinding"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
@@ -35,6 +36,7 @@ const (
 type listOptions struct {
 	AllNamespaces bool
 	NoHeaders     bool
+	Script        flags.ScriptOutput
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -72,15 +74,26 @@ or
 			tbs, err := triggerbinding.List(cs, metav1.ListOptions{}, namespace)
 			if err != nil {
 				if opts.AllNamespaces {
-					return fmt.Errorf("failed to list TriggerBindings from all namespaces: %v", err)
+					return fmt.Errorf("failed to list TriggerBindings from all namespaces: %w", err)
 				}
-				return fmt.Errorf("failed to list TriggerBindings from %s namespace: %v", namespace, err)
+				return fmt.Errorf("failed to list TriggerBindings from %s namespace: %w", namespace, err)
 			}
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
 				return errors.New("output option not set properly")
 			}
+			if err := opts.Script.Validate(output); err != nil {
+				return err
+			}
+			if opts.Script.Enabled() {
+				for _, tb := range tbs.Items {
+					if err := opts.Script.Print(cmd.OutOrStdout(), tb.Name, tb.Namespace, tb.Name, formatted.PorcelainTime(&tb.CreationTimestamp)); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
 
 			stream := &cli.Stream{
 				Out: cmd.OutOrStdout(),
@@ -115,6 +128,7 @@ or
 	f.AddFlags(c)
 	c.Flags().BoolVarP(&opts.AllNamespaces, "all-namespaces", "A", opts.AllNamespaces, "list TriggerBindings from all namespaces")
 	c.Flags().BoolVar(&opts.NoHeaders, "no-headers", opts.NoHeaders, "do not print column headers with output (default print column headers with output)")
+	opts.Script.AddFlags(c, "TriggerBinding")
 	return c
 }
 
@@ -122,6 +122,8 @@ func deleteTriggerTemplates(s *cli.Stream, p cli.Params, ttNames []string, delet
 	}
 	d.Delete(ttNames)
 
+	d.Audit(p)
+
 	if !deleteAll {
 		d.PrintSuccesses(s)
 	} else if deleteAll {
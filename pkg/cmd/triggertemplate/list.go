@@ -20,6 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/flags"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"github.com/tektoncd/cli/pkg/triggertemplate"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
@@ -34,6 +35,7 @@ const (
 type ListOptions struct {
 	AllNamespaces bool
 	NoHeaders     bool
+	Script        flags.ScriptOutput
 }
 
 func listCommand(p cli.Params) *cobra.Command {
@@ -70,14 +72,25 @@ or
 			tts, err := triggertemplate.List(cs, metav1.ListOptions{}, namespace)
 			if err != nil {
 				if opts.AllNamespaces {
-					return fmt.Errorf("failed to list TriggerTemplates from all namespaces: %v", err)
+					return fmt.Errorf("failed to list TriggerTemplates from all namespaces: %w", err)
 				}
-				return fmt.Errorf("failed to list TriggerTemplates from %s namespace: %v", namespace, err)
+				return fmt.Errorf("failed to list TriggerTemplates from %s namespace: %w", namespace, err)
 			}
 
 			output, err := cmd.LocalFlags().GetString("output")
 			if err != nil {
-				return fmt.Errorf("output option not set properly: %v", err)
+				return fmt.Errorf("output option not set properly: %w", err)
+			}
+			if err := opts.Script.Validate(output); err != nil {
+				return err
+			}
+			if opts.Script.Enabled() {
+				for _, tt := range tts.Items {
+					if err := opts.Script.Print(cmd.OutOrStdout(), tt.Name, tt.Namespace, tt.Name, formatted.PorcelainTime(&tt.CreationTimestamp)); err != nil {
+						return err
+					}
+				}
+				return nil
 			}
 
 			stream := &cli.Stream{
@@ -94,7 +107,7 @@ or
 			}
 
 			if err = printFormatted(stream, tts, p, opts.AllNamespaces, opts.NoHeaders); err != nil {
-				return fmt.Errorf("failed to print TriggerTemplates: %v", err)
+				return fmt.Errorf("failed to print TriggerTemplates: %w", err)
 			}
 			return nil
 
@@ -105,6 +118,7 @@ or
 
 	c.Flags().BoolVarP(&opts.AllNamespaces, "all-namespaces", "A", opts.AllNamespaces, "list TriggerTemplates from all namespaces")
 	c.Flags().BoolVar(&opts.NoHeaders, "no-headers", opts.NoHeaders, "do not print column headers with output (default print column headers with output)")
+	opts.Script.AddFlags(c, "TriggerTemplate")
 	return c
 }
 
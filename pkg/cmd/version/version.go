@@ -47,6 +47,12 @@ var (
 	component = ""
 )
 
+// ClientVersion returns the version of tkn, dev when it is not set at build
+// time
+func ClientVersion() string {
+	return clientVersion
+}
+
 // Command returns version command
 func Command(p cli.Params) *cobra.Command {
 	var check bool
@@ -0,0 +1,123 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// Transition is a change of the condition of a run
+type Transition struct {
+	Time      metav1.Time
+	Condition apis.Condition
+}
+
+// History returns the transitions of the condition of a run, oldest first.
+// The condition only holds its latest value, the former ones are
+// reconstructed from the events recorded by Tekton for the run
+func History(c *cli.Clients, ns, kind, name string, current *apis.Condition) ([]Transition, error) {
+	events, err := c.Kube.CoreV1().Events(ns).List(context.Background(), metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=%s,involvedObject.name=%s", kind, name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var history []Transition
+	for _, e := range events.Items {
+		if e.InvolvedObject.Kind != kind || e.InvolvedObject.Name != name {
+			continue
+		}
+		history = append(history, Transition{Time: eventTime(e), Condition: eventCondition(e)})
+	}
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].Time.Before(&history[j].Time)
+	})
+
+	if current != nil && !recorded(history, current) {
+		history = append(history, Transition{Time: current.LastTransitionTime.Inner, Condition: *current})
+	}
+	return history, nil
+}
+
+// PrintHistory prints the transitions of the condition of a run
+func PrintHistory(out io.Writer, history []Transition, clock clockwork.Clock) {
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintf(w, "\n%s%s\n\n", formatted.DecorateAttr("history", ""), formatted.DecorateAttr("underline bold", "Condition History"))
+	if len(history) == 0 {
+		fmt.Fprintln(w, " No condition history")
+		w.Flush()
+		return
+	}
+	fmt.Fprintln(w, " TIME\tSTATUS\tMESSAGE")
+	for _, t := range history {
+		message := t.Condition.Message
+		if message == "" {
+			message = "---"
+		}
+		fmt.Fprintf(w, " %s\t%s\t%s\n", formatted.Age(&t.Time, clock), formatted.Condition(duckv1.Conditions{t.Condition}), message)
+	}
+	w.Flush()
+}
+
+func eventTime(e corev1.Event) metav1.Time {
+	switch {
+	case !e.EventTime.IsZero():
+		return metav1.Time{Time: e.EventTime.Time}
+	case !e.FirstTimestamp.IsZero():
+		return e.FirstTimestamp
+	}
+	return e.CreationTimestamp
+}
+
+// eventCondition returns the condition a run moved to when the event was
+// recorded, Tekton names the events after the reason of the condition
+func eventCondition(e corev1.Event) apis.Condition {
+	status := corev1.ConditionUnknown
+	switch {
+	case e.Reason == "Succeeded":
+		status = corev1.ConditionTrue
+	case e.Reason == "Failed", e.Type == corev1.EventTypeWarning:
+		status = corev1.ConditionFalse
+	}
+	return apis.Condition{
+		Type:    apis.ConditionSucceeded,
+		Status:  status,
+		Reason:  e.Reason,
+		Message: e.Message,
+	}
+}
+
+// recorded tells whether an event already recorded the condition
+func recorded(history []Transition, c *apis.Condition) bool {
+	for _, t := range history {
+		if t.Condition.Status == c.Status && t.Condition.Message == c.Message {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conditions
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"knative.dev/pkg/apis"
+)
+
+func TestHistory(t *testing.T) {
+	clock := test.FakeClock()
+	event := func(name, kind, object, eventType, reason, message string, ago time.Duration) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: object},
+			Type:           eventType,
+			Reason:         reason,
+			Message:        message,
+			FirstTimestamp: metav1.Time{Time: clock.Now().Add(-ago)},
+		}
+	}
+
+	kube := fake.NewSimpleClientset(
+		event("e3", "PipelineRun", "build", corev1.EventTypeWarning, "Failed", "Tasks Completed: 1 (Failed: 1)", time.Minute),
+		event("e1", "PipelineRun", "build", corev1.EventTypeNormal, "Started", "", 10*time.Minute),
+		event("e2", "PipelineRun", "build", corev1.EventTypeNormal, "Running", "Tasks Completed: 0, Incomplete: 1", 9*time.Minute),
+		event("other", "PipelineRun", "deploy", corev1.EventTypeNormal, "Started", "", 5*time.Minute),
+		event("pod", "Pod", "build", corev1.EventTypeNormal, "Scheduled", "", 5*time.Minute),
+	)
+	c := &cli.Clients{Kube: kube}
+
+	current := &apis.Condition{
+		Type:               apis.ConditionSucceeded,
+		Status:             corev1.ConditionFalse,
+		Reason:             "Failed",
+		Message:            "Tasks Completed: 1 (Failed: 1)",
+		LastTransitionTime: apis.VolatileTime{Inner: metav1.Time{Time: clock.Now().Add(-time.Minute)}},
+	}
+	history, err := History(c, "ns", "PipelineRun", "build", current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := new(bytes.Buffer)
+	PrintHistory(out, history, clock)
+	test.AssertOutput(t, `
+Condition History
+
+ TIME             STATUS             MESSAGE
+ 10 minutes ago   Running(Started)   ---
+ 9 minutes ago    Running            Tasks Completed: 0, Incomplete: 1
+ 1 minute ago     Failed             Tasks Completed: 1 (Failed: 1)
+`, out.String())
+
+	// without events, the current condition is all there is
+	current.Status = corev1.ConditionTrue
+	current.Reason = "Succeeded"
+	current.Message = "All Tasks have completed executing"
+	history, err = History(c, "ns", "PipelineRun", "deploy-2", current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	PrintHistory(out, history, clock)
+	test.AssertOutput(t, `
+Condition History
+
+ TIME           STATUS      MESSAGE
+ 1 minute ago   Succeeded   All Tasks have completed executing
+`, out.String())
+}
@@ -0,0 +1,238 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/tektoncd/cli/pkg/state"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	configFileEnv  = "TKN_CONFIG"
+	profileEnv     = "TKN_PROFILE"
+	configFile     = "~/.config/tkn/config.yaml"
+	DefaultProfile = "default"
+)
+
+// Config is the content of the tkn configuration file
+type Config struct {
+	// CurrentProfile is the profile used when $TKN_PROFILE is not set
+	CurrentProfile string             `json:"currentProfile,omitempty"`
+	Profiles       map[string]Profile `json:"profiles,omitempty"`
+}
+
+// Profile holds the defaults applied to commands
+type Profile struct {
+	// Context is the kubeconfig context commands target when --context
+	// is not passed, the current-context of the kubeconfig is left untouched
+	Context string `json:"context,omitempty"`
+	// Namespace is the namespace commands target when --namespace is not
+	// passed, rather than the one of the kubeconfig context. It is not used
+	// when --context picks another context than the one of the profile.
+	Namespace string   `json:"namespace,omitempty"`
+	Timeouts  Timeouts `json:"timeouts,omitempty"`
+	Stream    Stream   `json:"stream,omitempty"`
+	Results   Results  `json:"results,omitempty"`
+	Audit     Audit    `json:"audit,omitempty"`
+	// Dashboard is the Tekton Dashboard the links printed with --link
+	// point to
+	Dashboard Dashboard `json:"dashboard,omitempty"`
+	Logs      Logs      `json:"logs,omitempty"`
+	Policies  Policies  `json:"policies,omitempty"`
+	// Queries are saved flags and arguments of list commands, e.g.
+	// failed-today: --status failed --since 24h, run with @failed-today
+	Queries map[string]string `json:"queries,omitempty"`
+	// CredentialsHelper is the docker credential helper tokens are kept in,
+	// e.g. pass, by default the one of the keychain of the operating system
+	CredentialsHelper string `json:"credentialsHelper,omitempty"`
+}
+
+// Audit configures the local audit log of the commands changing resources
+type Audit struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// Dashboard describes the links to the Tekton Dashboard
+type Dashboard struct {
+	URL string `json:"url,omitempty"`
+	// Pattern is the link to a resource, with the placeholders {url},
+	// {namespace}, {kind} and {name}
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// Logs describes where the logs of runs whose pods are gone are kept
+type Logs struct {
+	// Storage is the URL of the logs of a run in an object storage, with
+	// the placeholders {namespace}, {kind} and {name}
+	Storage string `json:"storage,omitempty"`
+	// HangDumpCommands are the shell commands run in the container of a
+	// followed step which looks hung, e.g. py-spy dump --pid 1, by default
+	// the processes are listed
+	HangDumpCommands []string `json:"hangDumpCommands,omitempty"`
+	// ExcludedStepPatterns are glob patterns of the names of the steps and
+	// containers whose logs are hidden unless all the logs are asked for,
+	// e.g. istio-proxy
+	ExcludedStepPatterns []string `json:"excludedStepPatterns,omitempty"`
+	// StepPrefixes are the prefixes of the names of the containers of the
+	// steps for the builds of Tekton which rename them, tried before step-
+	// for the containers the TaskRun does not give
+	StepPrefixes []string `json:"stepPrefixes,omitempty"`
+}
+
+// Policies are the local policies the PipelineRuns are checked against
+// before being started
+type Policies struct {
+	// Files are rego (.rego) and CUE (.cue) policies
+	Files []string `json:"files,omitempty"`
+	// WarnOnly turns the violations of the policies into warnings rather
+	// than refusing to start the runs
+	WarnOnly bool `json:"warnOnly,omitempty"`
+}
+
+// Timeouts are the default timeouts used when starting a Pipeline
+type Timeouts struct {
+	Pipeline string `json:"pipeline,omitempty"`
+	Tasks    string `json:"tasks,omitempty"`
+	Finally  string `json:"finally,omitempty"`
+}
+
+// Results describes how to reach the REST endpoint of Tekton Results
+type Results struct {
+	Addr                  string `json:"addr,omitempty"`
+	InsecureSkipTLSVerify bool   `json:"insecureSkipTLSVerify,omitempty"`
+}
+
+// Stream tunes the connections logs are streamed through
+type Stream struct {
+	ReadBufferSize int    `json:"readBufferSize,omitempty"`
+	MaxLineLength  int    `json:"maxLineLength,omitempty"`
+	PingInterval   string `json:"pingInterval,omitempty"`
+	PingTimeout    string `json:"pingTimeout,omitempty"`
+	IdleTimeout    string `json:"idleTimeout,omitempty"`
+	InformerResync string `json:"informerResync,omitempty"`
+}
+
+// Path returns the location of the configuration file
+func Path() (string, error) {
+	// if TKN_CONFIG is set, follow it
+	if path := os.Getenv(configFileEnv); path != "" {
+		return path, nil
+	}
+	// Respect XDG_CONFIG_HOME if set
+	if xdgHome := os.Getenv("XDG_CONFIG_HOME"); xdgHome != "" {
+		return filepath.Join(xdgHome, "tkn", "config.yaml"), nil
+	}
+	// Fallback to default configFile (~/.config/tkn/config.yaml)
+	return homedir.Expand(configFile)
+}
+
+// store returns the Store the configuration file is kept in and its key,
+// for the file to be locked and replaced atomically like the state of tkn
+func store() (state.Store, string, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, "", err
+	}
+	return state.NewFileStore(filepath.Dir(path)), filepath.Base(path), nil
+}
+
+// Load reads the configuration file, a missing file results in an empty Config
+func Load() (*Config, error) {
+	s, key, err := store()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.Get(key)
+	if errors.Is(err, state.ErrNotFound) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parse(b)
+}
+
+func parse(b []byte) (*Config, error) {
+	c := &Config{}
+	if err := yaml.Unmarshal(b, c); err != nil {
+		path, _ := Path()
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Save writes the configuration file, creating its directory if needed
+func Save(c *Config) error {
+	return Update(func(old *Config) error {
+		*old = *c
+		return nil
+	})
+}
+
+// Update changes the configuration with fn while holding the lock of the
+// configuration file, so that concurrent changes of the profiles are not
+// lost
+func Update(fn func(*Config) error) error {
+	s, key, err := store()
+	if err != nil {
+		return err
+	}
+	return s.Update(key, func(b []byte) ([]byte, error) {
+		c := &Config{}
+		if b != nil {
+			var err error
+			if c, err = parse(b); err != nil {
+				return nil, err
+			}
+		}
+		if err := fn(c); err != nil {
+			return nil, err
+		}
+		return yaml.Marshal(c)
+	})
+}
+
+// ProfileName returns the name of the active profile
+func (c *Config) ProfileName() string {
+	if name := os.Getenv(profileEnv); name != "" {
+		return name
+	}
+	if c.CurrentProfile != "" {
+		return c.CurrentProfile
+	}
+	return DefaultProfile
+}
+
+// Profile returns the active profile, an empty Profile is returned if it
+// is not defined in the configuration
+func (c *Config) Profile() Profile {
+	return c.Profiles[c.ProfileName()]
+}
+
+// ActiveProfile loads the configuration file and returns the active profile
+func ActiveProfile() (Profile, error) {
+	c, err := Load()
+	if err != nil {
+		return Profile{}, err
+	}
+	return c.Profile(), nil
+}
@@ -0,0 +1,108 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+const testConfig = `currentProfile: dev
+profiles:
+  dev:
+    timeouts:
+      pipeline: 1h
+  prod:
+    timeouts:
+      pipeline: 3h
+      tasks: 2h
+`
+
+func writeConfig(t *testing.T, content string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.NilError(t, os.WriteFile(path, []byte(content), 0600))
+	t.Setenv(configFileEnv, path)
+}
+
+func TestPath(t *testing.T) {
+	t.Setenv(configFileEnv, "")
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	path, err := Path()
+	assert.NilError(t, err)
+	assert.Equal(t, path, "/xdg/tkn/config.yaml")
+
+	t.Setenv(configFileEnv, "/custom/config.yaml")
+	path, err = Path()
+	assert.NilError(t, err)
+	assert.Equal(t, path, "/custom/config.yaml")
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	t.Setenv(configFileEnv, filepath.Join(t.TempDir(), "missing.yaml"))
+	c, err := Load()
+	assert.NilError(t, err)
+	assert.Equal(t, c.ProfileName(), DefaultProfile)
+	assert.DeepEqual(t, c.Profile(), Profile{})
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	writeConfig(t, "profiles: [")
+	_, err := Load()
+	assert.ErrorContains(t, err, "failed to parse config file")
+}
+
+func TestActiveProfile(t *testing.T) {
+	writeConfig(t, testConfig)
+	t.Setenv(profileEnv, "")
+
+	p, err := ActiveProfile()
+	assert.NilError(t, err)
+	assert.Equal(t, p.Timeouts.Pipeline, "1h")
+
+	t.Setenv(profileEnv, "prod")
+	p, err = ActiveProfile()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, p.Timeouts, Timeouts{Pipeline: "3h", Tasks: "2h"})
+}
+
+func TestUpdate(t *testing.T) {
+	writeConfig(t, testConfig)
+	t.Setenv(profileEnv, "")
+
+	wg := sync.WaitGroup{}
+	for _, name := range []string{"stage", "qa", "ci"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Check(t, Update(func(c *Config) error {
+				c.Profiles[name] = Profile{Namespace: name}
+				return nil
+			}))
+		}()
+	}
+	wg.Wait()
+
+	c, err := Load()
+	assert.NilError(t, err)
+	assert.Equal(t, c.Profile().Timeouts.Pipeline, "1h")
+	for _, name := range []string{"stage", "qa", "ci"} {
+		assert.Equal(t, c.Profiles[name].Namespace, name)
+	}
+}
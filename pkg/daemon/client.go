@@ -0,0 +1,200 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// requestTimeout bounds the requests to the daemon, the API server is asked
+// when the daemon does not answer in time
+const requestTimeout = 2 * time.Second
+
+// client talks to the daemon over its unix socket
+type client struct {
+	http *http.Client
+}
+
+func newClient(socket string) *client {
+	return &client{http: &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+			},
+		},
+	}}
+}
+
+// get decodes what the daemon serves at path into obj and tells whether it
+// did, the daemon not running or not caching the object are not errors as
+// the API server is asked instead
+func (c *client) get(path string, query url.Values, obj json.Unmarshaler) bool {
+	u := url.URL{Scheme: "http", Host: "tkn-daemon", Path: path, RawQuery: query.Encode()}
+	resp, err := c.http.Get(u.String())
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false
+	}
+	return obj.UnmarshalJSON(b) == nil
+}
+
+// served tells whether a daemon may serve on socket, it must be in a
+// directory only the user can access
+func served(socket string) bool {
+	if _, err := os.Stat(socket); err != nil {
+		return false
+	}
+	return checkPrivate(filepath.Dir(socket)) == nil
+}
+
+// Wrap returns a dynamic client answering the lists and the gets of the
+// cached resources from the daemon serving on socket, when there is one,
+// and passing everything else to dc
+func Wrap(dc dynamic.Interface, socket string) dynamic.Interface {
+	if !served(socket) {
+		return dc
+	}
+	return &cachedClient{Interface: dc, client: newClient(socket)}
+}
+
+// Clients returns the clients of p whose dynamic client reads the runs from
+// the daemon caching them for the cluster, the user and the impersonation
+// of p. Only the commands reading runs, e.g. list and describe, use them,
+// the others always talk to the API server.
+func Clients(p cli.Params) (*cli.Clients, error) {
+	cs, err := p.Clients()
+	if err != nil {
+		return nil, err
+	}
+	cp, ok := p.(cli.ConfigParams)
+	if !ok || cp.RESTConfig() == nil {
+		return cs, nil
+	}
+	cached := *cs
+	cached.Dynamic = Wrap(cs.Dynamic, SocketPath(cp.RESTConfig()))
+	return &cached, nil
+}
+
+// Unwrap returns the client wrapped by Wrap, dc itself when it is not
+// wrapped
+func Unwrap(dc dynamic.Interface) dynamic.Interface {
+	if c, ok := dc.(*cachedClient); ok {
+		return c.Interface
+	}
+	return dc
+}
+
+type cachedClient struct {
+	dynamic.Interface
+	client *client
+}
+
+func (c *cachedClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	r := c.Interface.Resource(gvr)
+	if !cached(gvr) {
+		return r
+	}
+	return &cachedResource{NamespaceableResourceInterface: r, gvr: gvr, client: c.client}
+}
+
+type cachedResource struct {
+	dynamic.NamespaceableResourceInterface
+	gvr    schema.GroupVersionResource
+	client *client
+}
+
+func (r *cachedResource) Namespace(ns string) dynamic.ResourceInterface {
+	return &cachedNamespacedResource{
+		ResourceInterface: r.NamespaceableResourceInterface.Namespace(ns),
+		gvr:               r.gvr,
+		ns:                ns,
+		client:            r.client,
+	}
+}
+
+type cachedNamespacedResource struct {
+	dynamic.ResourceInterface
+	gvr    schema.GroupVersionResource
+	ns     string
+	client *client
+}
+
+func (r *cachedNamespacedResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	// the caches only hold the latest version of the objects and are not
+	// paginated
+	if r.ns == metav1.NamespaceAll || opts.FieldSelector != "" || opts.ResourceVersion != "" || opts.Limit != 0 || opts.Continue != "" || opts.Watch {
+		return r.ResourceInterface.List(ctx, opts)
+	}
+	query := url.Values{}
+	if opts.LabelSelector != "" {
+		query.Set("labelSelector", opts.LabelSelector)
+	}
+	list := &unstructured.UnstructuredList{}
+	if r.client.get(path(r.gvr, r.ns, ""), query, list) {
+		return list, nil
+	}
+	return r.ResourceInterface.List(ctx, opts)
+}
+
+func (r *cachedNamespacedResource) Get(ctx context.Context, name string, opts metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if opts.ResourceVersion != "" || len(subresources) != 0 {
+		return r.ResourceInterface.Get(ctx, name, opts, subresources...)
+	}
+	obj := &unstructured.Unstructured{}
+	if r.client.get(path(r.gvr, r.ns, name), nil, obj) {
+		return obj, nil
+	}
+	return r.ResourceInterface.Get(ctx, name, opts, subresources...)
+}
+
+// Names returns the names of the objects of the resource in namespace ns
+// cached by the daemon serving on socket, it returns false when there is no
+// daemon or it does not cache them
+func Names(socket string, gvr schema.GroupVersionResource, ns string) ([]string, bool) {
+	if !served(socket) {
+		return nil, false
+	}
+	list := &unstructured.UnstructuredList{}
+	if !newClient(socket).get(path(gvr, ns, ""), nil, list) {
+		return nil, false
+	}
+	names := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		names = append(names, item.GetName())
+	}
+	return names, true
+}
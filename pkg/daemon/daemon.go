@@ -0,0 +1,96 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package daemon keeps the runs of a cluster in informer caches and serves
+// them over a unix socket, so that listing them does not need a round trip
+// to the API server.
+package daemon
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+)
+
+// socketEnv overrides the path of the socket of the daemon
+const socketEnv = "TKN_DAEMON_SOCKET"
+
+// Resources are the resources kept in the caches of the daemon
+var Resources = []string{"pipelineruns", "taskruns"}
+
+// SocketPath returns the path of the socket of the daemon caching the
+// cluster whose clients are configured by config. Each cluster, user and
+// impersonation has its own socket so that a daemon never answers for
+// another context or with the permissions of another user.
+func SocketPath(config *rest.Config) string {
+	if path := os.Getenv(socketEnv); path != "" {
+		return path
+	}
+	dir := os.Getenv("XDG_RUNTIME_DIR")
+	if dir != "" {
+		dir = filepath.Join(dir, "tkn")
+	} else {
+		dir = filepath.Join(os.TempDir(), fmt.Sprintf("tkn-%d", os.Getuid()))
+	}
+	sum := sha256.Sum256([]byte(identity(config)))
+	return filepath.Join(dir, "daemon-"+hex.EncodeToString(sum[:6])+".sock")
+}
+
+// identity describes the API server, the credentials and the impersonation
+// of config
+func identity(config *rest.Config) string {
+	user := []string{config.Username, config.BearerToken, config.BearerTokenFile, config.CertFile, string(config.CertData)}
+	if config.ExecProvider != nil {
+		user = append(user, config.ExecProvider.Command)
+		user = append(user, config.ExecProvider.Args...)
+	}
+	if config.AuthProvider != nil {
+		user = append(user, config.AuthProvider.Name)
+	}
+	groups := append([]string{}, config.Impersonate.Groups...)
+	sort.Strings(groups)
+	impersonate := []string{config.Impersonate.UserName, config.Impersonate.UID, strings.Join(groups, ",")}
+	return strings.Join([]string{config.Host, strings.Join(user, "\x00"), strings.Join(impersonate, "\x00")}, "\n")
+}
+
+// cached tells whether the resource is kept in the caches of the daemon
+func cached(gvr schema.GroupVersionResource) bool {
+	if gvr.Group != pipeline.GroupName {
+		return false
+	}
+	for _, r := range Resources {
+		if gvr.Resource == r {
+			return true
+		}
+	}
+	return false
+}
+
+// path returns the path the daemon serves the objects of the resource in
+// namespace ns at, or the object named name when it is not empty
+func path(gvr schema.GroupVersionResource, ns, name string) string {
+	p := fmt.Sprintf("/apis/%s/%s/namespaces/%s/%s", gvr.Group, gvr.Version, ns, gvr.Resource)
+	if name != "" {
+		p += "/" + name
+	}
+	return p
+}
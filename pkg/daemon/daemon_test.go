@@ -0,0 +1,170 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+var (
+	pipelineRuns = v1.SchemeGroupVersion.WithResource("pipelineruns")
+	taskRuns     = v1.SchemeGroupVersion.WithResource("taskruns")
+)
+
+func pipelineRun(ns, name string, labels map[string]string) *unstructured.Unstructured {
+	return cb.UnstructuredPR(&v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, Labels: labels},
+	}, "v1")
+}
+
+func dynamicClient(t *testing.T, objs ...runtime.Object) dynamic.Interface {
+	t.Helper()
+	dc, err := (&testDynamic.Options{}).Client(objs...)
+	if err != nil {
+		t.Fatalf("unable to create dynamic client: %v", err)
+	}
+	return dc
+}
+
+// serve starts a daemon caching the runs of namespace ci and returns the
+// path of its socket
+func serve(t *testing.T, objs ...runtime.Object) string {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	s := NewServer(dynamicClient(t, objs...), []string{"ci"}, []schema.GroupVersionResource{pipelineRuns, taskRuns})
+	assert.NilError(t, s.Start(ctx))
+	socket := filepath.Join(privateDir(t), "daemon.sock")
+	l, err := Listen(socket)
+	assert.NilError(t, err)
+	go func() { _ = s.Serve(ctx, l) }()
+	return socket
+}
+
+// privateDir returns a temporary directory only the user can access
+func privateDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	assert.NilError(t, os.Chmod(dir, 0o700))
+	return dir
+}
+
+func names(list *unstructured.UnstructuredList) []string {
+	names := []string{}
+	for _, item := range list.Items {
+		names = append(names, item.GetName())
+	}
+	return names
+}
+
+func TestWrap(t *testing.T) {
+	socket := serve(t,
+		pipelineRun("ci", "pr-2", map[string]string{"app": "web"}),
+		pipelineRun("ci", "pr-1", map[string]string{"app": "api"}),
+		pipelineRun("other", "pr-3", nil),
+	)
+	// the API server has a run created after the caches were filled and
+	// the runs of namespace other
+	api := dynamicClient(t,
+		pipelineRun("ci", "pr-new", nil),
+		pipelineRun("other", "pr-3", nil),
+	)
+	dc := Wrap(api, socket)
+	ctx := context.Background()
+
+	list, err := dc.Resource(pipelineRuns).Namespace("ci").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names(list), []string{"pr-1", "pr-2"})
+	assert.Equal(t, list.GetKind(), "PipelineRunList")
+
+	list, err = dc.Resource(pipelineRuns).Namespace("ci").List(ctx, metav1.ListOptions{LabelSelector: "app=web"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names(list), []string{"pr-2"})
+
+	// the namespaces which are not cached are listed from the API server
+	list, err = dc.Resource(pipelineRuns).Namespace("other").List(ctx, metav1.ListOptions{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, names(list), []string{"pr-3"})
+
+	pr, err := dc.Resource(pipelineRuns).Namespace("ci").Get(ctx, "pr-1", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, pr.GetLabels()["app"], "api")
+
+	// the objects missing from the caches are read from the API server
+	pr, err = dc.Resource(pipelineRuns).Namespace("ci").Get(ctx, "pr-new", metav1.GetOptions{})
+	assert.NilError(t, err)
+	assert.Equal(t, pr.GetName(), "pr-new")
+
+	assert.Equal(t, Unwrap(dc), api)
+}
+
+func TestWrap_noDaemon(t *testing.T) {
+	api := dynamicClient(t)
+	assert.Equal(t, Wrap(api, filepath.Join(t.TempDir(), "daemon.sock")), api)
+}
+
+func TestNames(t *testing.T) {
+	socket := serve(t, pipelineRun("ci", "pr-1", nil))
+
+	got, ok := Names(socket, pipelineRuns, "ci")
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, got, []string{"pr-1"})
+
+	_, ok = Names(socket, pipelineRuns, "other")
+	assert.Assert(t, !ok)
+}
+
+func TestListen_served(t *testing.T) {
+	socket := serve(t)
+	_, err := Listen(socket)
+	assert.Error(t, err, "a daemon is already serving on "+socket)
+}
+
+func TestSocketPath(t *testing.T) {
+	t.Setenv(socketEnv, "")
+	t.Setenv("XDG_RUNTIME_DIR", "/run/user/1000")
+
+	alice := &rest.Config{Host: "https://cluster", Username: "alice"}
+	path := SocketPath(alice)
+	assert.Equal(t, filepath.Dir(path), "/run/user/1000/tkn")
+	assert.Equal(t, SocketPath(&rest.Config{Host: "https://cluster", Username: "alice"}), path)
+
+	for name, config := range map[string]*rest.Config{
+		"other cluster": {Host: "https://other", Username: "alice"},
+		"other user":    {Host: "https://cluster", Username: "bob"},
+		"impersonation": {Host: "https://cluster", Username: "alice", Impersonate: rest.ImpersonationConfig{UserName: "admin"}},
+		"groups":        {Host: "https://cluster", Username: "alice", Impersonate: rest.ImpersonationConfig{Groups: []string{"system:masters"}}},
+	} {
+		assert.Assert(t, SocketPath(config) != path, "%s shares the socket of alice", name)
+	}
+
+	t.Setenv(socketEnv, "/tmp/tkn.sock")
+	assert.Equal(t, SocketPath(alice), "/tmp/tkn.sock")
+}
@@ -0,0 +1,41 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !unix
+
+package daemon
+
+import (
+	"fmt"
+	"net"
+	"os"
+)
+
+// checkPrivate returns an error unless dir is a directory and not a link,
+// the owner and the mode of files are not available
+func checkPrivate(dir string) error {
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s must be a directory", dir)
+	}
+	return nil
+}
+
+// listenPrivate listens on the socket path
+func listenPrivate(path string) (net.Listener, error) {
+	return net.Listen("unix", path)
+}
@@ -0,0 +1,66 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unix
+
+package daemon
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestListen_notPrivate(t *testing.T) {
+	dir := privateDir(t)
+	assert.NilError(t, os.Chmod(dir, 0o755))
+	socket := filepath.Join(dir, "daemon.sock")
+
+	_, err := Listen(socket)
+	assert.ErrorContains(t, err, "refusing to serve on "+socket)
+
+	link := filepath.Join(privateDir(t), "link")
+	assert.NilError(t, os.Symlink(privateDir(t), link))
+	_, err = Listen(filepath.Join(link, "daemon.sock"))
+	assert.ErrorContains(t, err, "must be a directory owned by the user with mode 0700")
+}
+
+func TestListen_mode(t *testing.T) {
+	socket := filepath.Join(privateDir(t), "daemon.sock")
+	l, err := Listen(socket)
+	assert.NilError(t, err)
+	defer l.Close()
+
+	info, err := os.Stat(socket)
+	assert.NilError(t, err)
+	assert.Equal(t, info.Mode().Perm(), os.FileMode(0o600))
+}
+
+func TestWrap_notPrivate(t *testing.T) {
+	// a socket served by another user in a directory others can write to
+	dir := privateDir(t)
+	socket := filepath.Join(dir, "daemon.sock")
+	l, err := net.Listen("unix", socket)
+	assert.NilError(t, err)
+	defer l.Close()
+	assert.NilError(t, os.Chmod(dir, 0o777))
+
+	api := dynamicClient(t)
+	assert.Equal(t, Wrap(api, socket), api)
+	_, ok := Names(socket, pipelineRuns, "ci")
+	assert.Assert(t, !ok)
+}
@@ -0,0 +1,216 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Server keeps the resources of some namespaces in informer caches and
+// serves them over HTTP
+type Server struct {
+	factories []dynamicinformer.DynamicSharedInformerFactory
+	// listers are the listers of the caches by namespace and resource, the
+	// listers of metav1.NamespaceAll cache all the namespaces
+	listers map[string]map[schema.GroupVersionResource]cache.GenericLister
+}
+
+// NewServer returns a Server caching the resources gvrs of namespaces, read
+// with dc. An empty namespace caches all the namespaces.
+func NewServer(dc dynamic.Interface, namespaces []string, gvrs []schema.GroupVersionResource) *Server {
+	s := &Server{listers: map[string]map[schema.GroupVersionResource]cache.GenericLister{}}
+	for _, ns := range namespaces {
+		f := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dc, 0, ns, nil)
+		s.listers[ns] = map[schema.GroupVersionResource]cache.GenericLister{}
+		for _, gvr := range gvrs {
+			s.listers[ns][gvr] = f.ForResource(gvr).Lister()
+		}
+		s.factories = append(s.factories, f)
+	}
+	return s
+}
+
+// Start starts the informers and waits for their caches to be filled
+func (s *Server) Start(ctx context.Context) error {
+	for _, f := range s.factories {
+		f.Start(ctx.Done())
+	}
+	for _, f := range s.factories {
+		for gvr, synced := range f.WaitForCacheSync(ctx.Done()) {
+			if !synced {
+				return fmt.Errorf("failed to fill the cache of %s", gvr.Resource)
+			}
+		}
+	}
+	return nil
+}
+
+// lister returns the lister of the resource in namespace ns, nil when it
+// is not cached
+func (s *Server) lister(gvr schema.GroupVersionResource, ns string) cache.GenericNamespaceLister {
+	if l, ok := s.listers[metav1.NamespaceAll][gvr]; ok {
+		return l.ByNamespace(ns)
+	}
+	if l, ok := s.listers[ns][gvr]; ok {
+		return l.ByNamespace(ns)
+	}
+	return nil
+}
+
+// Handler returns the handler serving the caches. The objects are served at
+// the paths of the API server, a 404 tells that the object or the resource
+// is not cached and that the API server is to be asked.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /apis/{group}/{version}/namespaces/{namespace}/{resource}", s.list)
+	mux.HandleFunc("GET /apis/{group}/{version}/namespaces/{namespace}/{resource}/{name}", s.get)
+	return mux
+}
+
+func requestedResource(r *http.Request) schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    r.PathValue("group"),
+		Version:  r.PathValue("version"),
+		Resource: r.PathValue("resource"),
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request) {
+	gvr := requestedResource(r)
+	l := s.lister(gvr, r.PathValue("namespace"))
+	if l == nil {
+		http.NotFound(w, r)
+		return
+	}
+	selector, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	objs, err := l.List(selector)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	list := &unstructured.UnstructuredList{Object: map[string]interface{}{}}
+	list.SetAPIVersion(gvr.GroupVersion().String())
+	list.SetKind(listKind(gvr))
+	for _, obj := range objs {
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			list.Items = append(list.Items, *u)
+		}
+	}
+	// the API server lists objects by name
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].GetName() < list.Items[j].GetName()
+	})
+	writeJSON(w, list)
+}
+
+func (s *Server) get(w http.ResponseWriter, r *http.Request) {
+	l := s.lister(requestedResource(r), r.PathValue("namespace"))
+	if l == nil {
+		http.NotFound(w, r)
+		return
+	}
+	// an object missing from the cache may have just been created, it is
+	// left to the API server to tell whether it exists
+	obj, err := l.Get(r.PathValue("name"))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, obj)
+}
+
+func writeJSON(w http.ResponseWriter, obj interface{}) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+// listKind returns the kind of the lists of the resource, e.g.
+// PipelineRunList for pipelineruns
+func listKind(gvr schema.GroupVersionResource) string {
+	switch gvr.Resource {
+	case "pipelineruns":
+		return "PipelineRunList"
+	case "taskruns":
+		return "TaskRunList"
+	}
+	return strings.TrimSuffix(gvr.Resource, "s") + "List"
+}
+
+// Listen listens on the unix socket at path, only readable by the user. A
+// socket left over by a daemon which died is replaced, one still served is
+// an error.
+func Listen(path string) (net.Listener, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	// the directory may have been created by another user
+	if err := checkPrivate(dir); err != nil {
+		return nil, fmt.Errorf("refusing to serve on %s: %w", path, err)
+	}
+	if _, err := os.Stat(path); err == nil {
+		if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+			conn.Close()
+			return nil, fmt.Errorf("a daemon is already serving on %s", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	return listenPrivate(path)
+}
+
+// Serve serves the caches on l until ctx is done
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	srv := &http.Server{Handler: s.Handler(), ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdown)
+	}()
+	if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/config"
+)
+
+// DefaultPattern is the link to a resource in the Tekton Dashboard
+const DefaultPattern = "{url}/#/namespaces/{namespace}/{kind}/{name}"
+
+// Kinds of resources as they appear in the links
+const (
+	KindPipelineRun = "pipelineruns"
+	KindTaskRun     = "taskruns"
+)
+
+// ErrNotConfigured is returned when the profile sets no Dashboard
+var ErrNotConfigured = errors.New("no Tekton Dashboard is configured, set dashboard.url in the tkn profile")
+
+// Links builds the links to the resources of a Tekton Dashboard
+type Links struct {
+	url     string
+	pattern string
+}
+
+// New returns the Links of the Dashboard of the settings
+func New(d config.Dashboard) (*Links, error) {
+	if d.URL == "" {
+		return nil, ErrNotConfigured
+	}
+	u, err := url.Parse(d.URL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid value %q for dashboard.url: must be an absolute URL", d.URL)
+	}
+	pattern := d.Pattern
+	if pattern == "" {
+		pattern = DefaultPattern
+	}
+	return &Links{url: strings.TrimSuffix(d.URL, "/"), pattern: pattern}, nil
+}
+
+// FromProfile returns the Links of the Dashboard of the active profile of p
+func FromProfile(p cli.Params) (*Links, error) {
+	profile, err := p.Profile()
+	if err != nil {
+		return nil, err
+	}
+	return New(profile.Dashboard)
+}
+
+// Link returns the link to the resource of kind named name in the namespace
+func (l *Links) Link(kind, namespace, name string) string {
+	return strings.NewReplacer(
+		"{url}", l.url,
+		"{namespace}", url.PathEscape(namespace),
+		"{kind}", kind,
+		"{name}", url.PathEscape(name),
+	).Replace(l.pattern)
+}
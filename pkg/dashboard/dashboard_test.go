@@ -0,0 +1,40 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/config"
+	"gotest.tools/v3/assert"
+)
+
+func TestLinks_Link(t *testing.T) {
+	links, err := New(config.Dashboard{URL: "https://dashboard.example.com/"})
+	assert.NilError(t, err)
+	assert.Equal(t, links.Link(KindPipelineRun, "ns", "build-x7k2p"), "https://dashboard.example.com/#/namespaces/ns/pipelineruns/build-x7k2p")
+
+	links, err = New(config.Dashboard{URL: "https://tekton.example.com", Pattern: "{url}/{namespace}/{kind}/{name}/logs"})
+	assert.NilError(t, err)
+	assert.Equal(t, links.Link(KindTaskRun, "ns", "build-x7k2p-clone"), "https://tekton.example.com/ns/taskruns/build-x7k2p-clone/logs")
+}
+
+func TestNew_invalid(t *testing.T) {
+	_, err := New(config.Dashboard{})
+	assert.Equal(t, err, ErrNotConfigured)
+
+	_, err = New(config.Dashboard{URL: "dashboard.example.com"})
+	assert.Error(t, err, `invalid value "dashboard.example.com" for dashboard.url: must be an absolute URL`)
+}
@@ -0,0 +1,325 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package debuglocal turns a step of a TaskRun into an invocation of a local
+// container engine, so that a failing step can be reproduced without a cluster
+package debuglocal
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/substitution"
+)
+
+const (
+	// ScriptsDir is where the scripts of the steps are mounted, as in the
+	// pods of the TaskRuns
+	ScriptsDir = "/tekton/scripts"
+	// ResultsDir is where the steps write their results
+	ResultsDir = "/tekton/results"
+	// SecretsFile is the env file holding the values of the Secrets
+	SecretsFile = "secrets.env"
+
+	workspacesDir  = "/workspace"
+	defaultShebang = "#!/bin/sh\nset -e\n"
+)
+
+// Env is an environment variable of the step, the values coming from a
+// Secret or a ConfigMap are not resolved
+type Env struct {
+	Name  string
+	Value string
+	// Secret and ConfigMap name the object holding the value as NAME/KEY
+	Secret    string
+	ConfigMap string
+}
+
+// Workspace is a workspace of the Task mounted in the step
+type Workspace struct {
+	Name      string
+	MountPath string
+	// Claim is the PersistentVolumeClaim bound to the workspace by the
+	// TaskRun, ConfigMap and Secret the objects bound to it
+	Claim     string
+	ConfigMap string
+	Secret    string
+	// HostPath is the directory of the host mounted for the workspace,
+	// instead of the one of the workspace in the directory of the step
+	HostPath string
+}
+
+// Step is everything needed to run a step of a TaskRun locally
+type Step struct {
+	Name       string
+	Image      string
+	Command    []string
+	Args       []string
+	WorkingDir string
+	// Script is the script of the step with its parameters substituted, it
+	// is run from ScriptsDir
+	Script     string
+	Env        []Env
+	Workspaces []Workspace
+}
+
+// FailedStep returns the name of the first step of the TaskRun which failed
+func FailedStep(tr *v1.TaskRun) string {
+	for _, s := range tr.Status.Steps {
+		if s.Terminated != nil && s.Terminated.ExitCode != 0 && s.Terminated.Reason != "Skipped" {
+			return s.Name
+		}
+	}
+	return ""
+}
+
+// FromTaskRun returns the step of the TaskRun named name, with the
+// parameters and context variables of the TaskRun substituted
+func FromTaskRun(tr *v1.TaskRun, name string) (*Step, error) {
+	spec := tr.Status.TaskSpec
+	if spec == nil {
+		spec = tr.Spec.TaskSpec
+	}
+	if spec == nil {
+		return nil, fmt.Errorf("the spec of the Task of TaskRun %s is not known yet", tr.Name)
+	}
+
+	steps, err := v1.MergeStepsWithStepTemplate(spec.StepTemplate, spec.Steps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply the step template of TaskRun %s: %w", tr.Name, err)
+	}
+	var step *v1.Step
+	names := []string{}
+	for i := range steps {
+		names = append(names, steps[i].Name)
+		if steps[i].Name == name {
+			step = &steps[i]
+		}
+	}
+	if step == nil {
+		return nil, fmt.Errorf("step %q not found in TaskRun %s, use one of %s", name, tr.Name, strings.Join(names, ", "))
+	}
+	if step.Ref != nil {
+		return nil, fmt.Errorf("step %q references a StepAction, which can't be run locally", name)
+	}
+
+	workspaces := taskWorkspaces(tr, spec)
+	strs, arrays := replacements(tr, spec, workspaces)
+
+	local := &Step{
+		Name:       step.Name,
+		Image:      substitution.ApplyReplacements(step.Image, strs),
+		WorkingDir: substitution.ApplyReplacements(step.WorkingDir, strs),
+		Script:     substitution.ApplyReplacements(step.Script, strs),
+		Workspaces: workspaces,
+	}
+	for _, c := range step.Command {
+		local.Command = append(local.Command, substitution.ApplyArrayReplacements(c, strs, arrays)...)
+	}
+	for _, a := range step.Args {
+		local.Args = append(local.Args, substitution.ApplyArrayReplacements(a, strs, arrays)...)
+	}
+	if local.Script != "" {
+		if !strings.HasPrefix(local.Script, "#!") {
+			local.Script = defaultShebang + local.Script
+		}
+		local.Command = []string{path.Join(ScriptsDir, local.Name)}
+	}
+
+	for _, e := range step.Env {
+		env := Env{Name: e.Name, Value: substitution.ApplyReplacements(e.Value, strs)}
+		if from := e.ValueFrom; from != nil {
+			switch {
+			case from.SecretKeyRef != nil:
+				env.Secret = from.SecretKeyRef.Name + "/" + from.SecretKeyRef.Key
+			case from.ConfigMapKeyRef != nil:
+				env.ConfigMap = from.ConfigMapKeyRef.Name + "/" + from.ConfigMapKeyRef.Key
+			default:
+				// the values read from the pod or its resources only make
+				// sense in the cluster
+				continue
+			}
+		}
+		local.Env = append(local.Env, env)
+	}
+	return local, nil
+}
+
+// taskWorkspaces returns the workspaces declared by the Task with what the
+// TaskRun bound to them
+func taskWorkspaces(tr *v1.TaskRun, spec *v1.TaskSpec) []Workspace {
+	bindings := map[string]v1.WorkspaceBinding{}
+	for _, b := range tr.Spec.Workspaces {
+		bindings[b.Name] = b
+	}
+
+	workspaces := []Workspace{}
+	for _, decl := range spec.Workspaces {
+		ws := Workspace{Name: decl.Name, MountPath: decl.GetMountPath()}
+		if ws.MountPath == "" {
+			ws.MountPath = path.Join(workspacesDir, decl.Name)
+		}
+		b, ok := bindings[decl.Name]
+		if !ok {
+			if decl.Optional {
+				continue
+			}
+		}
+		switch {
+		case b.PersistentVolumeClaim != nil:
+			ws.Claim = b.PersistentVolumeClaim.ClaimName
+		case b.ConfigMap != nil:
+			ws.ConfigMap = b.ConfigMap.Name
+		case b.Secret != nil:
+			ws.Secret = b.Secret.SecretName
+		}
+		workspaces = append(workspaces, ws)
+	}
+	return workspaces
+}
+
+// replacements returns the values of the variables the step may reference,
+// as the controller substitutes them when creating the pod of the TaskRun
+func replacements(tr *v1.TaskRun, spec *v1.TaskSpec, workspaces []Workspace) (map[string]string, map[string][]string) {
+	strs := map[string]string{
+		"context.taskRun.name":      tr.Name,
+		"context.taskRun.namespace": tr.Namespace,
+		"context.taskRun.uid":       string(tr.UID),
+		"context.task.name":         tr.Labels["tekton.dev/task"],
+		"context.task.retry-count":  fmt.Sprint(len(tr.Status.RetriesStatus)),
+	}
+	arrays := map[string][]string{}
+
+	values := map[string]v1.ParamValue{}
+	for _, p := range spec.Params {
+		if p.Default != nil {
+			values[p.Name] = *p.Default
+		}
+	}
+	for _, p := range tr.Spec.Params {
+		values[p.Name] = p.Value
+	}
+	for name, v := range values {
+		for _, key := range []string{"params." + name, fmt.Sprintf("params[%q]", name), fmt.Sprintf("params['%s']", name)} {
+			switch v.Type {
+			case v1.ParamTypeArray:
+				arrays[key] = v.ArrayVal
+				for i, item := range v.ArrayVal {
+					strs[fmt.Sprintf("%s[%d]", key, i)] = item
+				}
+			case v1.ParamTypeObject:
+				for k, item := range v.ObjectVal {
+					strs[key+"."+k] = item
+				}
+			default:
+				strs[key] = v.StringVal
+			}
+		}
+	}
+
+	for _, ws := range workspaces {
+		strs["workspaces."+ws.Name+".path"] = ws.MountPath
+		strs["workspaces."+ws.Name+".bound"] = "true"
+		strs["workspaces."+ws.Name+".claim"] = ws.Claim
+	}
+	for _, decl := range spec.Workspaces {
+		if _, ok := strs["workspaces."+decl.Name+".bound"]; !ok {
+			strs["workspaces."+decl.Name+".bound"] = "false"
+			strs["workspaces."+decl.Name+".path"] = ""
+		}
+	}
+	for _, r := range spec.Results {
+		strs["results."+r.Name+".path"] = path.Join(ResultsDir, r.Name)
+	}
+	return strs, arrays
+}
+
+// RunArgs returns the arguments of `<engine> run` reproducing the step, with
+// the scripts, workspaces and results directories of dir mounted in the
+// container. values holds the values of the environment variables coming
+// from ConfigMaps and Secrets, the values of the Secrets are read from
+// SecretsFile in dir so that they do not show on the command line, and the
+// ones missing are passed from the environment of the engine
+func (s *Step) RunArgs(dir string, values map[string]string) []string {
+	return s.runArgs([]string{"run", "--rm", "-it"}, dir, values)
+}
+
+// BatchRunArgs returns the arguments of `<engine> run` as RunArgs does,
+// without a terminal attached so that the output of the step can be read
+func (s *Step) BatchRunArgs(dir string, values map[string]string) []string {
+	return s.runArgs([]string{"run", "--rm"}, dir, values)
+}
+
+func (s *Step) runArgs(args []string, dir string, values map[string]string) []string {
+	if s.WorkingDir != "" {
+		args = append(args, "-w", s.WorkingDir)
+	}
+
+	env := append([]Env{}, s.Env...)
+	sort.SliceStable(env, func(i, j int) bool { return env[i].Name < env[j].Name })
+	secrets := false
+	for _, e := range env {
+		v, ok := values[e.Name]
+		switch {
+		case e.Secret == "" && e.ConfigMap == "":
+			args = append(args, "-e", e.Name+"="+e.Value)
+		case !ok:
+			args = append(args, "-e", e.Name)
+		case e.Secret != "":
+			secrets = true
+		default:
+			args = append(args, "-e", e.Name+"="+v)
+		}
+	}
+	if secrets {
+		args = append(args, "--env-file", path.Join(dir, SecretsFile))
+	}
+
+	if s.Script != "" {
+		args = append(args, "-v", path.Join(dir, "scripts")+":"+ScriptsDir+":ro")
+	}
+	args = append(args, "-v", path.Join(dir, "results")+":"+ResultsDir)
+	for _, ws := range s.Workspaces {
+		hostPath := ws.HostPath
+		if hostPath == "" {
+			hostPath = path.Join(dir, "workspaces", ws.Name)
+		}
+		args = append(args, "-v", hostPath+":"+ws.MountPath)
+	}
+
+	if len(s.Command) > 0 {
+		args = append(args, "--entrypoint", s.Command[0], s.Image)
+		args = append(args, s.Command[1:]...)
+	} else {
+		args = append(args, s.Image)
+	}
+	return append(args, s.Args...)
+}
+
+// ShellQuote quotes the arguments of a command line so that it can be
+// pasted in a POSIX shell
+func ShellQuote(args []string) string {
+	quoted := make([]string, 0, len(args))
+	for _, a := range args {
+		if a != "" && strings.Trim(a, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_./:=@%+,") == "" {
+			quoted = append(quoted, a)
+			continue
+		}
+		quoted = append(quoted, "'"+strings.ReplaceAll(a, "'", `'\''`)+"'")
+	}
+	return strings.Join(quoted, " ")
+}
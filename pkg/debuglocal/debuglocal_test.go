@@ -0,0 +1,265 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debuglocal
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func taskRun() *v1.TaskRun {
+	return &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-run", Namespace: "ns", Labels: map[string]string{"tekton.dev/task": "build"}},
+		Spec: v1.TaskRunSpec{
+			Params: v1.Params{
+				{Name: "flags", Value: *v1.NewStructuredValues("-v", "-race")},
+				{Name: "package", Value: *v1.NewStructuredValues("./cmd/...")},
+			},
+			Workspaces: []v1.WorkspaceBinding{
+				{Name: "source", PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "source-pvc"}},
+				{Name: "config", ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}}},
+			},
+		},
+		Status: v1.TaskRunStatus{
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				Steps: []v1.StepState{
+					{Name: "fetch", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}},
+					{Name: "test", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}}},
+					{Name: "upload", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Skipped"}}},
+				},
+				TaskSpec: &v1.TaskSpec{
+					Params: v1.ParamSpecs{
+						{Name: "flags", Type: v1.ParamTypeArray},
+						{Name: "package", Type: v1.ParamTypeString},
+						{Name: "go", Type: v1.ParamTypeString, Default: v1.NewStructuredValues("1.23")},
+					},
+					Workspaces: []v1.WorkspaceDeclaration{
+						{Name: "source"},
+						{Name: "config", MountPath: "/etc/build"},
+						{Name: "cache", Optional: true},
+					},
+					Results:      []v1.TaskResult{{Name: "coverage"}},
+					StepTemplate: &v1.StepTemplate{Env: []corev1.EnvVar{{Name: "CGO_ENABLED", Value: "0"}}},
+					Steps: []v1.Step{
+						{Name: "fetch", Image: "alpine/git", Script: "git fetch"},
+						{
+							Name:       "test",
+							Image:      "golang:$(params.go)",
+							Command:    []string{"go", "test"},
+							Args:       []string{"$(params.flags[*])", "-coverprofile=$(results.coverage.path)", "$(params.package)"},
+							WorkingDir: "$(workspaces.source.path)",
+							Env: []corev1.EnvVar{
+								{Name: "GOFLAGS", Value: "-mod=mod"},
+								{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "git"}, Key: "token"}}},
+								{Name: "PROXY", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "proxy"}, Key: "url"}}},
+								{Name: "POD", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestFailedStep(t *testing.T) {
+	tr := taskRun()
+	assert.Equal(t, FailedStep(tr), "test")
+
+	tr.Status.Steps = tr.Status.Steps[:1]
+	assert.Equal(t, FailedStep(tr), "")
+}
+
+func TestFromTaskRun(t *testing.T) {
+	step, err := FromTaskRun(taskRun(), "test")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, step, &Step{
+		Name:       "test",
+		Image:      "golang:1.23",
+		Command:    []string{"go", "test"},
+		Args:       []string{"-v", "-race", "-coverprofile=/tekton/results/coverage", "./cmd/..."},
+		WorkingDir: "/workspace/source",
+		Env: []Env{
+			{Name: "GOFLAGS", Value: "-mod=mod"},
+			{Name: "TOKEN", Secret: "git/token"},
+			{Name: "PROXY", ConfigMap: "proxy/url"},
+			{Name: "CGO_ENABLED", Value: "0"},
+		},
+		Workspaces: []Workspace{
+			{Name: "source", MountPath: "/workspace/source", Claim: "source-pvc"},
+			{Name: "config", MountPath: "/etc/build", ConfigMap: "settings"},
+		},
+	})
+
+	step, err = FromTaskRun(taskRun(), "fetch")
+	assert.NilError(t, err)
+	assert.Equal(t, step.Script, "#!/bin/sh\nset -e\ngit fetch")
+	assert.DeepEqual(t, step.Command, []string{"/tekton/scripts/fetch"})
+
+	_, err = FromTaskRun(taskRun(), "deploy")
+	assert.Error(t, err, `step "deploy" not found in TaskRun build-run, use one of fetch, test`)
+
+	tr := taskRun()
+	tr.Status.TaskSpec = nil
+	_, err = FromTaskRun(tr, "test")
+	assert.Error(t, err, "the spec of the Task of TaskRun build-run is not known yet")
+}
+
+func TestStep_RunArgs(t *testing.T) {
+	step, err := FromTaskRun(taskRun(), "test")
+	assert.NilError(t, err)
+
+	args := step.RunArgs("/tmp/debug", map[string]string{"TOKEN": "s3cr3t", "PROXY": "http://proxy:3128"})
+	assert.Equal(t, ShellQuote(append([]string{"docker"}, args...)),
+		"docker run --rm -it -w /workspace/source -e CGO_ENABLED=0 -e GOFLAGS=-mod=mod -e PROXY=http://proxy:3128 --env-file /tmp/debug/secrets.env "+
+			"-v /tmp/debug/results:/tekton/results -v /tmp/debug/workspaces/source:/workspace/source -v /tmp/debug/workspaces/config:/etc/build "+
+			"--entrypoint go golang:1.23 test -v -race -coverprofile=/tekton/results/coverage ./cmd/...")
+
+	args = step.RunArgs("/tmp/debug", nil)
+	assert.DeepEqual(t, args[7:13], []string{"-e", "GOFLAGS=-mod=mod", "-e", "PROXY", "-e", "TOKEN"})
+}
+
+func TestStep_BatchRunArgs(t *testing.T) {
+	step, err := FromTaskRun(taskRun(), "test")
+	assert.NilError(t, err)
+	step.Workspaces[0].HostPath = "/home/me/src"
+
+	args := step.BatchRunArgs("/tmp/local", nil)
+	assert.Equal(t, ShellQuote(append([]string{"podman"}, args...)),
+		"podman run --rm -w /workspace/source -e CGO_ENABLED=0 -e GOFLAGS=-mod=mod -e PROXY -e TOKEN "+
+			"-v /tmp/local/results:/tekton/results -v /home/me/src:/workspace/source -v /tmp/local/workspaces/config:/etc/build "+
+			"--entrypoint go golang:1.23 test -v -race -coverprofile=/tekton/results/coverage ./cmd/...")
+}
+
+func TestShellQuote(t *testing.T) {
+	assert.Equal(t, ShellQuote([]string{"sh", "-c", "echo $HOME", "", "it's"}), `sh -c 'echo $HOME' '' 'it'\''s'`)
+}
+
+func TestUntar(t *testing.T) {
+	archive := func(entries ...*tar.Header) *bytes.Buffer {
+		b := &bytes.Buffer{}
+		w := tar.NewWriter(b)
+		for _, hdr := range entries {
+			assert.NilError(t, w.WriteHeader(hdr))
+			if hdr.Typeflag == tar.TypeReg {
+				_, err := w.Write([]byte("content of " + hdr.Name))
+				assert.NilError(t, err)
+			}
+		}
+		assert.NilError(t, w.Close())
+		return b
+	}
+	file := func(name string) *tar.Header {
+		return &tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len("content of " + name))}
+	}
+
+	dest := t.TempDir()
+	err := Untar(archive(
+		&tar.Header{Name: "./", Typeflag: tar.TypeDir, Mode: 0o755},
+		file("./go.mod"),
+		file("./cmd/main.go"),
+		&tar.Header{Name: "./main.go", Typeflag: tar.TypeSymlink, Linkname: "cmd/main.go"},
+	), dest)
+	assert.NilError(t, err)
+
+	b, err := os.ReadFile(filepath.Join(dest, "main.go"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(b), "content of ./cmd/main.go")
+
+	err = Untar(archive(file("../escape")), dest)
+	assert.Error(t, err, `invalid entry "../escape" in the archive`)
+}
+
+func TestUntar_malicious_archive(t *testing.T) {
+	archive := func(entries ...*tar.Header) *bytes.Buffer {
+		b := &bytes.Buffer{}
+		w := tar.NewWriter(b)
+		for _, hdr := range entries {
+			if hdr.Typeflag == tar.TypeReg {
+				hdr.Size = int64(len("pwned"))
+			}
+			assert.NilError(t, w.WriteHeader(hdr))
+			if hdr.Typeflag == tar.TypeReg {
+				_, err := w.Write([]byte("pwned"))
+				assert.NilError(t, err)
+			}
+		}
+		assert.NilError(t, w.Close())
+		return b
+	}
+
+	outside := t.TempDir()
+	tests := []struct {
+		name    string
+		entries []*tar.Header
+		err     string
+	}{
+		{
+			name:    "absolute link",
+			entries: []*tar.Header{{Name: "./etc", Typeflag: tar.TypeSymlink, Linkname: outside}},
+			err:     fmt.Sprintf(`invalid link "./etc" to %q in the archive`, outside),
+		},
+		{
+			name:    "link outside",
+			entries: []*tar.Header{{Name: "./cmd/up", Typeflag: tar.TypeSymlink, Linkname: "../../outside"}},
+			err:     `invalid link "./cmd/up" to "../../outside" in the archive`,
+		},
+		{
+			name: "file written through a link",
+			entries: []*tar.Header{
+				{Name: "./cmd", Typeflag: tar.TypeDir, Mode: 0o755},
+				{Name: "./link", Typeflag: tar.TypeSymlink, Linkname: "cmd"},
+				{Name: "./link/main.go", Typeflag: tar.TypeReg, Mode: 0o644},
+			},
+			err: `invalid entry "./link/main.go" in the archive: `,
+		},
+		{
+			name: "file overwriting a link",
+			entries: []*tar.Header{
+				{Name: "./go.mod", Typeflag: tar.TypeReg, Mode: 0o644},
+				{Name: "./main.go", Typeflag: tar.TypeSymlink, Linkname: "go.mod"},
+				{Name: "./main.go", Typeflag: tar.TypeReg, Mode: 0o644},
+			},
+			err: `invalid entry "./main.go" in the archive: `,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dest := t.TempDir()
+			err := Untar(archive(tt.entries...), dest)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	// a link planted by the user inside dest, e.g. by an earlier sync, is
+	// not followed either
+	dest := t.TempDir()
+	assert.NilError(t, os.Symlink(outside, filepath.Join(dest, "planted")))
+	err := Untar(archive(&tar.Header{Name: "./planted/escape", Typeflag: tar.TypeReg, Mode: 0o644}), dest)
+	assert.ErrorContains(t, err, `invalid entry "./planted/escape" in the archive: `)
+
+	entries, err := os.ReadDir(outside)
+	assert.NilError(t, err)
+	assert.Equal(t, len(entries), 0)
+}
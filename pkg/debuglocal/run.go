@@ -0,0 +1,66 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debuglocal
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+
+	"github.com/tektoncd/cli/pkg/log"
+)
+
+// maxLineSize is the size of the longest line of the output of a step which
+// is read as a whole
+const maxLineSize = 1024 * 1024
+
+// Run runs the step with the container engine and waits for it to end, the
+// lines it writes to its standard output and error are sent to logC as the
+// logs of the step of task
+func (s *Step) Run(engine, dir string, values map[string]string, task string, logC chan<- log.Log) error {
+	cmd := exec.Command(engine, s.BatchRunArgs(dir, values)...)
+	r, w := io.Pipe()
+	cmd.Stdout, cmd.Stderr = w, w
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to run step %s with %s: %w", s.Name, engine, err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+		for scanner.Scan() {
+			logC <- log.Log{Task: task, Step: s.Name, Log: scanner.Text()}
+		}
+		// keep the step from blocking on a line too long to be read
+		_, _ = io.Copy(io.Discard, r)
+	}()
+
+	err := cmd.Wait()
+	_ = w.Close()
+	<-done
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return fmt.Errorf("step %s failed with exit code %d", s.Name, exitErr.ExitCode())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to run step %s with %s: %w", s.Name, engine, err)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package debuglocal
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// DefaultSyncImage is the image of the pod copying the content of a
+	// PersistentVolumeClaim, it only needs sh, tar and base64
+	DefaultSyncImage = "busybox"
+
+	syncMountPath    = "/data"
+	syncPollInterval = time.Second
+)
+
+// SyncClaim copies the content of the PersistentVolumeClaim to dest. The
+// content is read from the logs of a pod mounting the claim, which writes it
+// as a base64 encoded tar archive, so that no exec access to the cluster is
+// needed
+func SyncClaim(kube k8s.Interface, ns, claim, image, dest string, timeout time.Duration) error {
+	pods := kube.CoreV1().Pods(ns)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "tkn-debug-sync-",
+			Labels:       map[string]string{"app.kubernetes.io/managed-by": "tkn"},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{{
+				Name:         "sync",
+				Image:        image,
+				Command:      []string{"sh", "-c", fmt.Sprintf("tar -C %s -cf - . | base64", syncMountPath)},
+				VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: syncMountPath, ReadOnly: true}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim, ReadOnly: true},
+				},
+			}},
+		},
+	}
+
+	ctx := context.Background()
+	created, err := pods.Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create the pod copying PersistentVolumeClaim %s: %w", claim, err)
+	}
+	defer func() {
+		_ = pods.Delete(ctx, created.Name, metav1.DeleteOptions{})
+	}()
+
+	err = wait.PollUntilContextTimeout(ctx, syncPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		p, err := pods.Get(ctx, created.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch p.Status.Phase {
+		case corev1.PodSucceeded:
+			return true, nil
+		case corev1.PodFailed:
+			return false, fmt.Errorf("pod %s failed: %s", created.Name, p.Status.Message)
+		}
+		return false, nil
+	})
+	if wait.Interrupted(err) {
+		return fmt.Errorf("timed out after %s waiting for the copy of PersistentVolumeClaim %s", timeout, claim)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to copy PersistentVolumeClaim %s: %w", claim, err)
+	}
+
+	logs, err := pods.GetLogs(created.Name, &corev1.PodLogOptions{Container: "sync"}).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read the content of PersistentVolumeClaim %s: %w", claim, err)
+	}
+	defer logs.Close()
+
+	if err := Untar(base64.NewDecoder(base64.StdEncoding, logs), dest); err != nil {
+		return fmt.Errorf("failed to extract the content of PersistentVolumeClaim %s: %w", claim, err)
+	}
+	return nil
+}
+
+// Untar extracts the regular files, directories and symbolic links of a tar
+// archive to dest. The entries and the links pointing outside of dest are
+// refused, and nothing is written through a link, so that the content of a
+// claim cannot write anywhere else on the machine.
+func Untar(r io.Reader, dest string) error {
+	dest = filepath.Clean(dest)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dest, filepath.FromSlash(hdr.Name))
+		if !within(dest, target) {
+			return fmt.Errorf("invalid entry %q in the archive", hdr.Name)
+		}
+		if err := checkNoLinks(dest, filepath.Dir(target)); err != nil {
+			return fmt.Errorf("invalid entry %q in the archive: %w", hdr.Name, err)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir, tar.TypeReg:
+			if err := checkNoLinks(dest, target); err != nil {
+				return fmt.Errorf("invalid entry %q in the archive: %w", hdr.Name, err)
+			}
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC|oNoFollow, os.FileMode(hdr.Mode).Perm())
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr) // nolint: gosec
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			link := filepath.FromSlash(hdr.Linkname)
+			if filepath.IsAbs(link) || strings.HasPrefix(hdr.Linkname, "/") || !within(dest, filepath.Join(filepath.Dir(target), link)) {
+				return fmt.Errorf("invalid link %q to %q in the archive", hdr.Name, hdr.Linkname)
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			if err := os.Symlink(link, target); err != nil && !os.IsExist(err) {
+				return err
+			}
+		}
+	}
+}
+
+// within tells whether path is dest or is below it
+func within(dest, path string) bool {
+	path = filepath.Clean(path)
+	return path == dest || strings.HasPrefix(path, dest+string(os.PathSeparator))
+}
+
+// checkNoLinks checks that none of the existing directories from dest to
+// path, path included, is a symbolic link
+func checkNoLinks(dest, path string) error {
+	rel, err := filepath.Rel(dest, path)
+	if err != nil || rel == "." {
+		return err
+	}
+	p := dest
+	for _, part := range strings.Split(rel, string(os.PathSeparator)) {
+		p = filepath.Join(p, part)
+		fi, err := os.Lstat(p)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if fi.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("%s is a symbolic link", p)
+		}
+	}
+	return nil
+}
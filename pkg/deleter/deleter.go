@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/tektoncd/cli/pkg/audit"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/i18n"
 	"github.com/tektoncd/cli/pkg/names"
 	"go.uber.org/multierr"
 )
@@ -53,7 +55,7 @@ func (d *Deleter) WithRelated(kind string, listFunc func(string) ([]string, erro
 func (d *Deleter) Delete(resourceNames []string) []string {
 	for _, name := range resourceNames {
 		if err := d.delete(name); err != nil {
-			d.appendError(fmt.Errorf("failed to delete %s %q: %s", d.kind, name, err))
+			d.appendError(i18n.Errorf("failed to delete %s %q: %s", d.kind, name, err))
 		} else {
 			d.successfulDeletes = append(d.successfulDeletes, name)
 		}
@@ -81,7 +83,7 @@ func (d *Deleter) deleteRelatedList(resourceName string) {
 		if len(related) > 0 {
 			for _, subresource := range related {
 				if err := d.deleteRelated(subresource); err != nil {
-					err = fmt.Errorf("failed to delete %s %q: %s", d.relatedKind, subresource, err)
+					err = i18n.Errorf("failed to delete %s %q: %s", d.relatedKind, subresource, err)
 					d.appendError(err)
 				} else {
 					d.successfulRelatedDeletes = append(d.successfulRelatedDeletes, subresource)
@@ -97,10 +99,35 @@ func (d *Deleter) deleteRelatedList(resourceName string) {
 // PrintSuccesses writes success messages to the provided stdout stream.
 func (d *Deleter) PrintSuccesses(streams *cli.Stream) {
 	if len(d.successfulRelatedDeletes) > 0 {
-		fmt.Fprintf(streams.Out, "%ss deleted: %s\n", d.relatedKind, names.QuotedList(d.successfulRelatedDeletes))
+		i18n.Fprintf(streams.Out, "%ss deleted: %s\n", d.relatedKind, names.QuotedList(d.successfulRelatedDeletes))
 	}
 	if len(d.successfulDeletes) > 0 {
-		fmt.Fprintf(streams.Out, "%ss deleted: %s\n", d.kind, names.QuotedList(d.successfulDeletes))
+		i18n.Fprintf(streams.Out, "%ss deleted: %s\n", d.kind, names.QuotedList(d.successfulDeletes))
+	}
+}
+
+// Audit records the successful deletions in the audit log
+func (d *Deleter) Audit(p cli.Params) {
+	audit.Record(p, audit.ActionDelete, d.relatedKind, d.successfulRelatedDeletes...)
+	audit.Record(p, audit.ActionDelete, d.kind, d.successfulDeletes...)
+}
+
+// Wait calls waitFunc with the names of the resources of kind which were
+// deleted, to block until they are gone. Its error is aggregated with the
+// ones of the deletions.
+func (d *Deleter) Wait(kind string, waitFunc func([]string) error) {
+	var deleted []string
+	if d.kind == kind {
+		deleted = append(deleted, d.successfulDeletes...)
+	}
+	if d.relatedKind == kind {
+		deleted = append(deleted, d.successfulRelatedDeletes...)
+	}
+	if len(deleted) == 0 {
+		return
+	}
+	if err := waitFunc(deleted); err != nil {
+		d.appendError(err)
 	}
 }
 
@@ -1,6 +1,7 @@
 package deleter
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
@@ -150,3 +151,33 @@ func successfulListFunc(returnedNames ...string) func(string) ([]string, error)
 		return returnedNames, nil
 	}
 }
+
+func TestWait(t *testing.T) {
+	d := New("FooBar", func(name string) error {
+		if name == "bar" {
+			return errors.New("forbidden")
+		}
+		return nil
+	})
+	d.WithRelated("Baz", func(string) ([]string, error) { return []string{"baz"}, nil }, func(string) error { return nil })
+	d.Delete([]string{"foo", "bar"})
+	d.DeleteRelated([]string{"foo"})
+
+	var waited []string
+	d.Wait("FooBar", func(names []string) error {
+		waited = append(waited, names...)
+		return errors.New("timed out")
+	})
+	d.Wait("Qux", func([]string) error {
+		t.Error("nothing of kind Qux was deleted")
+		return nil
+	})
+
+	if strings.Join(waited, ",") != "foo" {
+		t.Errorf("expected to wait for foo, waited for %v", waited)
+	}
+	expectedErr := "failed to delete FooBar \"bar\": forbidden; timed out"
+	if err := d.Errors(); err == nil || err.Error() != expectedErr {
+		t.Errorf("expected error %q received %v", expectedErr, err)
+	}
+}
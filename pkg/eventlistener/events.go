@@ -0,0 +1,159 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlistener
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// eventIDKey is the key of the ID of the event in the logs of the
+	// EventListener
+	eventIDKey = "/triggers-eventid"
+	// eventMessage starts the debug message the EventListener logs for each
+	// event it receives
+	eventMessage = "handling event with path "
+)
+
+var headerEntry = regexp.MustCompile(`([A-Za-z0-9-]+):\[([^\]]*)\]`)
+
+// Event is an event received by an EventListener, as recorded in its logs
+type Event struct {
+	ID       string      `json:"id"`
+	Received time.Time   `json:"received"`
+	Path     string      `json:"path"`
+	Header   http.Header `json:"header"`
+	Payload  string      `json:"payload"`
+}
+
+// Type returns the type of the event given by the usual headers of the
+// providers sending events, e.g. push for a GitHub push
+func (e Event) Type() string {
+	for _, h := range []string{"X-Github-Event", "X-Gitlab-Event", "X-Event-Key", "Ce-Type"} {
+		if v := e.Header.Get(h); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+type logLine struct {
+	TS      json.RawMessage `json:"ts"`
+	Msg     string          `json:"msg"`
+	EventID string          `json:"/triggers-eventid"`
+}
+
+// ParseEvents reads the events from the logs of an EventListener, oldest
+// first. The EventListener only logs the events it receives when its log
+// level is debug
+func ParseEvents(r io.Reader) ([]Event, error) {
+	var events []Event
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if !bytes.Contains(line, []byte(eventMessage)) {
+			continue
+		}
+		var l logLine
+		if err := json.Unmarshal(line, &l); err != nil || l.EventID == "" || !strings.HasPrefix(l.Msg, eventMessage) {
+			continue
+		}
+		e, ok := parseEventMessage(l.Msg)
+		if !ok {
+			continue
+		}
+		e.ID = l.EventID
+		e.Received = parseTS(l.TS)
+		events = append(events, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Received.Before(events[j].Received)
+	})
+	return events, nil
+}
+
+// parseEventMessage parses "handling event with path PATH, payload: PAYLOAD
+// and header: map[Key:[value] ...]"
+func parseEventMessage(msg string) (Event, bool) {
+	rest := strings.TrimPrefix(msg, eventMessage)
+	path, rest, ok := strings.Cut(rest, ", payload: ")
+	if !ok {
+		return Event{}, false
+	}
+	i := strings.LastIndex(rest, " and header: map[")
+	if i == -1 {
+		return Event{}, false
+	}
+	e := Event{Path: path, Payload: rest[:i], Header: http.Header{}}
+	for _, m := range headerEntry.FindAllStringSubmatch(rest[i:], -1) {
+		e.Header[http.CanonicalHeaderKey(m[1])] = strings.Fields(m[2])
+	}
+	return e, true
+}
+
+// parseTS parses the timestamp of a log line, written either as an ISO 8601
+// string or as seconds since the epoch
+func parseTS(ts json.RawMessage) time.Time {
+	var s string
+	if err := json.Unmarshal(ts, &s); err == nil {
+		t, _ := time.Parse(time.RFC3339Nano, s)
+		return t
+	}
+	var f float64
+	if err := json.Unmarshal(ts, &f); err == nil {
+		sec := int64(f)
+		return time.Unix(sec, int64((f-float64(sec))*1e9)).UTC()
+	}
+	return time.Time{}
+}
+
+// Replay sends the event again to the EventListener at url, with the same
+// payload and headers, and returns the status of the response
+func Replay(client *http.Client, url string, e Event) (string, error) {
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(url, "/")+e.Path, strings.NewReader(e.Payload))
+	if err != nil {
+		return "", err
+	}
+	for k, v := range e.Header {
+		switch k {
+		case "Content-Length", "Accept-Encoding", "User-Agent":
+			continue
+		}
+		req.Header[k] = v
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to replay event %s: %w", e.ID, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return resp.Status, fmt.Errorf("EventListener refused event %s: %s %s", e.ID, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return resp.Status, nil
+}
@@ -0,0 +1,99 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlistener
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+const elLogs = `{"level":"info","ts":"2026-10-15T10:00:00.000Z","logger":"eventlistener","caller":"sink/sink.go:90","msg":"Listen and serve on port 8080"}
+{"level":"debug","ts":"2026-10-15T10:05:00.500Z","logger":"eventlistener","caller":"sink/sink.go:177","msg":"handling event with path /, payload: {\"ref\":\"refs/heads/main\",\"note\":\"and header: map[\"} and header: map[Content-Type:[application/json] X-Github-Event:[push] X-Hub-Signature-256:[sha256=abc]]","eventlistener":"github-listener","namespace":"ns","/triggers-eventid":"event-2"}
+not json
+{"level":"debug","ts":1791972000.25,"logger":"eventlistener","caller":"sink/sink.go:177","msg":"handling event with path /hooks, payload: {} and header: map[Ce-Type:[dev.tekton.event]]","eventlistener":"github-listener","namespace":"ns","/triggers-eventid":"event-1"}
+`
+
+func TestParseEvents(t *testing.T) {
+	events, err := ParseEvents(strings.NewReader(elLogs))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Event{
+		{
+			ID:       "event-1",
+			Received: time.Date(2026, 10, 14, 10, 0, 0, 250000000, time.UTC),
+			Path:     "/hooks",
+			Header:   http.Header{"Ce-Type": {"dev.tekton.event"}},
+			Payload:  "{}",
+		},
+		{
+			ID:       "event-2",
+			Received: time.Date(2026, 10, 15, 10, 5, 0, 500000000, time.UTC),
+			Path:     "/",
+			Header: http.Header{
+				"Content-Type":        {"application/json"},
+				"X-Github-Event":      {"push"},
+				"X-Hub-Signature-256": {"sha256=abc"},
+			},
+			Payload: `{"ref":"refs/heads/main","note":"and header: map["}`,
+		},
+	}
+	test.AssertOutput(t, want, events)
+	test.AssertOutput(t, "dev.tekton.event", events[0].Type())
+	test.AssertOutput(t, "push", events[1].Type())
+}
+
+func TestReplay(t *testing.T) {
+	var gotPath, gotBody string
+	var gotHeader http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotHeader = r.Header
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+		if r.Header.Get("X-Github-Event") == "ping" {
+			http.Error(w, "no trigger matched", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	e := Event{
+		ID:      "event-1",
+		Path:    "/hooks",
+		Header:  http.Header{"X-Github-Event": {"push"}, "Content-Length": {"12"}},
+		Payload: `{"ref":"x"}`,
+	}
+	status, err := Replay(srv.Client(), srv.URL+"/", e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, "202 Accepted", status)
+	test.AssertOutput(t, "/hooks", gotPath)
+	test.AssertOutput(t, `{"ref":"x"}`, gotBody)
+	test.AssertOutput(t, "push", gotHeader.Get("X-Github-Event"))
+
+	e.Header.Set("X-Github-Event", "ping")
+	_, err = Replay(srv.Client(), srv.URL, e)
+	test.AssertOutput(t, "EventListener refused event event-1: 400 Bad Request no trigger matched", err.Error())
+}
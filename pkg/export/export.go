@@ -55,3 +55,63 @@ func RemoveFieldForExport(obj *unstructured.Unstructured) error {
 
 	return nil
 }
+
+// RemoveServerFields removes the fields which are set by the server, keeping
+// the name and namespace of the object, so that its output can be edited and
+// applied back to the same object
+func RemoveServerFields(obj *unstructured.Unstructured) {
+	content := obj.UnstructuredContent()
+
+	unstructured.RemoveNestedField(content, "status")
+
+	metadataFields := []string{
+		"managedFields",
+		"resourceVersion",
+		"uid",
+		"selfLink",
+		"generation",
+		"creationTimestamp",
+	}
+	for _, field := range metadataFields {
+		unstructured.RemoveNestedField(content, "metadata", field)
+	}
+	unstructured.RemoveNestedField(content, "metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration")
+	if annotations, found, _ := unstructured.NestedMap(content, "metadata", "annotations"); found && len(annotations) == 0 {
+		unstructured.RemoveNestedField(content, "metadata", "annotations")
+	}
+}
+
+// RemoveEmptyFields removes the empty fields the Go types of Tekton resources
+// marshal but a hand written resource would not have, i.e. the null
+// creationTimestamp, the empty computeResources of steps and the empty
+// metadata and spec of embedded taskSpecs
+func RemoveEmptyFields(content map[string]interface{}) {
+	if ts, found, _ := unstructured.NestedFieldNoCopy(content, "metadata", "creationTimestamp"); found && ts == nil {
+		unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+	}
+	removeEmptyFields(content)
+}
+
+func removeEmptyFields(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if r, ok := v["computeResources"].(map[string]interface{}); ok && len(r) == 0 {
+			delete(v, "computeResources")
+		}
+		if ts, ok := v["taskSpec"].(map[string]interface{}); ok {
+			if m, ok := ts["metadata"].(map[string]interface{}); ok && len(m) == 0 {
+				delete(ts, "metadata")
+			}
+			if spec, found := ts["spec"]; found && spec == nil {
+				delete(ts, "spec")
+			}
+		}
+		for _, child := range v {
+			removeEmptyFields(child)
+		}
+	case []interface{}:
+		for _, child := range v {
+			removeEmptyFields(child)
+		}
+	}
+}
@@ -70,3 +70,87 @@ func TestRemoveFieldForExport(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveServerFields(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"status": "some-status",
+			"metadata": map[string]interface{}{
+				"name":              "pr",
+				"namespace":         "ns",
+				"generateName":      "pr-",
+				"managedFields":     "some-managed-fields",
+				"resourceVersion":   "some-resource-version",
+				"uid":               "some-uid",
+				"generation":        int64(1),
+				"creationTimestamp": "some-timestamp",
+				"annotations": map[string]interface{}{
+					"kubectl.kubernetes.io/last-applied-configuration": "some-config",
+				},
+			},
+			"spec": map[string]interface{}{
+				"status": "PipelineRunPending",
+			},
+		},
+	}
+
+	RemoveServerFields(obj)
+	assert.DeepEqual(t, obj.Object, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":         "pr",
+			"namespace":    "ns",
+			"generateName": "pr-",
+		},
+		"spec": map[string]interface{}{
+			"status": "PipelineRunPending",
+		},
+	})
+}
+
+func TestRemoveEmptyFields(t *testing.T) {
+	content := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "build",
+			"creationTimestamp": nil,
+		},
+		"spec": map[string]interface{}{
+			"tasks": []interface{}{
+				map[string]interface{}{
+					"name": "notify",
+					"taskSpec": map[string]interface{}{
+						"metadata": map[string]interface{}{},
+						"spec":     nil,
+						"steps": []interface{}{
+							map[string]interface{}{"name": "echo", "computeResources": map[string]interface{}{}},
+						},
+					},
+				},
+			},
+			"volumes": []interface{}{
+				map[string]interface{}{"name": "cache", "emptyDir": map[string]interface{}{}},
+			},
+		},
+	}
+
+	RemoveEmptyFields(content)
+	assert.DeepEqual(t, content, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name": "build",
+		},
+		"spec": map[string]interface{}{
+			"tasks": []interface{}{
+				map[string]interface{}{
+					"name": "notify",
+					"taskSpec": map[string]interface{}{
+						"steps": []interface{}{
+							map[string]interface{}{"name": "echo"},
+						},
+					},
+				},
+			},
+			"volumes": []interface{}{
+				map[string]interface{}{"name": "cache", "emptyDir": map[string]interface{}{}},
+			},
+		},
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// kustomization is the name of the file of a kustomize directory
+const kustomization = "kustomization.yaml"
+
+// NamespaceResources are the resources exported from a namespace, in the
+// order they are listed in the kustomization, each one in its directory
+var NamespaceResources = []schema.GroupVersionResource{
+	{Group: "tekton.dev", Resource: "tasks"},
+	{Group: "tekton.dev", Resource: "pipelines"},
+	{Group: "triggers.tekton.dev", Resource: "triggerbindings"},
+	{Group: "triggers.tekton.dev", Resource: "triggertemplates"},
+	{Group: "triggers.tekton.dev", Resource: "triggers"},
+	{Group: "triggers.tekton.dev", Resource: "eventlisteners"},
+}
+
+// Namespace returns the resources of the namespace, cleaned up so that they
+// can be applied to another namespace, by resource. The resources which are
+// not installed on the cluster, e.g. those of Tekton Triggers, are skipped.
+func Namespace(c *cli.Clients, ns string) (map[string][]*unstructured.Unstructured, error) {
+	exported := map[string][]*unstructured.Unstructured{}
+	for _, gr := range NamespaceResources {
+		list, err := actions.List(gr, c.Dynamic, c.Tekton.Discovery(), ns, metav1.ListOptions{})
+		if err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list the %s of namespace %s: %w", gr.Resource, ns, err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if err := RemoveFieldForExport(obj); err != nil {
+				return nil, err
+			}
+			if annotations, found, _ := unstructured.NestedMap(obj.Object, "metadata", "annotations"); found && len(annotations) == 0 {
+				unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+			}
+			RemoveEmptyFields(obj.Object)
+			exported[gr.Resource] = append(exported[gr.Resource], obj)
+		}
+		sort.Slice(exported[gr.Resource], func(i, j int) bool {
+			return exported[gr.Resource][i].GetName() < exported[gr.Resource][j].GetName()
+		})
+	}
+	return exported, nil
+}
+
+// WriteKustomize writes the resources to dir, in a directory per resource
+// with a file per object, along with the kustomizations listing them. The
+// kustomization of dir sets the namespace the resources are applied to when
+// target is not empty.
+func WriteKustomize(dir, ns, target string, exported map[string][]*unstructured.Unstructured, out io.Writer) error {
+	var dirs []string
+	for _, gr := range NamespaceResources {
+		objs := exported[gr.Resource]
+		if len(objs) == 0 {
+			continue
+		}
+		dirs = append(dirs, gr.Resource)
+
+		var files []string
+		for _, obj := range objs {
+			b, err := yaml.Marshal(obj.Object)
+			if err != nil {
+				return err
+			}
+			file := obj.GetName() + ".yaml"
+			if err := writeFile(filepath.Join(dir, gr.Resource, file), b); err != nil {
+				return err
+			}
+			files = append(files, file)
+		}
+		if err := writeKustomization(filepath.Join(dir, gr.Resource), "", files); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "%s: %d exported\n", gr.Resource, len(objs))
+	}
+
+	if len(dirs) == 0 {
+		return fmt.Errorf("no Tekton resources found in namespace %s", ns)
+	}
+	return writeKustomization(dir, target, dirs)
+}
+
+func writeKustomization(dir, ns string, resources []string) error {
+	k := "apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\n"
+	if ns != "" {
+		k += "namespace: " + ns + "\n"
+	}
+	k += "resources:\n- " + strings.Join(resources, "\n- ") + "\n"
+	return writeFile(filepath.Join(dir, kustomization), []byte(k))
+}
+
+func writeFile(path string, b []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644) // nolint: gosec
+}
@@ -0,0 +1,173 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// streamDepth is how deep the fields of an object are written one by one,
+// enough to reach the items of status.childReferences of a PipelineRun
+const streamDepth = 3
+
+// StreamYAML writes the object as YAML field by field, the way yaml.Marshal
+// would write it whole, so that only the YAML of one field at a time is held
+// in memory, e.g. one of the child references of a PipelineRun with
+// hundreds of TaskRuns
+func StreamYAML(w io.Writer, content map[string]interface{}) error {
+	bw := bufio.NewWriter(w)
+	if err := streamMap(bw, "", content, 1); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func streamMap(w *bufio.Writer, indent string, m map[string]interface{}, depth int) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	// the keys are in the order of encoding/json, which yaml.Marshal goes
+	// through
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if depth < streamDepth {
+			switch v := m[k].(type) {
+			case map[string]interface{}:
+				if len(v) == 0 {
+					break
+				}
+				if err := writeKey(w, indent, k); err != nil {
+					return err
+				}
+				if err := streamMap(w, indent+"  ", v, depth+1); err != nil {
+					return err
+				}
+				continue
+			case []interface{}:
+				if len(v) == 0 {
+					break
+				}
+				if err := writeKey(w, indent, k); err != nil {
+					return err
+				}
+				// the items of a list are not indented from its key
+				if err := streamList(w, indent, v); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if err := writeYAML(w, indent, map[string]interface{}{k: m[k]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func streamList(w *bufio.Writer, indent string, items []interface{}) error {
+	for _, item := range items {
+		if err := writeYAML(w, indent, []interface{}{item}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeKey(w *bufio.Writer, indent, key string) error {
+	// the key is marshaled for its quotes, e.g. of a key which is a number
+	b, err := yaml.Marshal(map[string]interface{}{key: nil})
+	if err != nil {
+		return err
+	}
+	_, err = w.WriteString(indent + strings.TrimSuffix(string(b), " null\n") + "\n")
+	return err
+}
+
+// writeYAML writes the YAML of v with its lines indented
+func writeYAML(w *bufio.Writer, indent string, v interface{}) error {
+	b, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	for _, line := range bytes.SplitAfter(b, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		if _, err := w.WriteString(indent); err != nil {
+			return err
+		}
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CompactChildReferences keeps only the kind, the name and the name of the
+// pipeline task of the child references of a PipelineRun, the statuses of
+// the TaskRuns and Runs embedded by the v1beta1 API are replaced by such
+// references
+func CompactChildReferences(obj *unstructured.Unstructured) {
+	content := obj.UnstructuredContent()
+	children, _, _ := unstructured.NestedSlice(content, "status", "childReferences")
+
+	compacted := make([]interface{}, 0, len(children))
+	for _, child := range children {
+		ref, ok := child.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		compacted = append(compacted, childReference(ref["kind"], ref["name"], ref["pipelineTaskName"]))
+	}
+	for _, e := range []struct{ field, kind string }{{"taskRuns", "TaskRun"}, {"runs", "Run"}} {
+		embedded, ok, _ := unstructured.NestedFieldNoCopy(content, "status", e.field)
+		if !ok {
+			continue
+		}
+		statuses, _ := embedded.(map[string]interface{})
+		names := make([]string, 0, len(statuses))
+		for name := range statuses {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			status, _ := statuses[name].(map[string]interface{})
+			compacted = append(compacted, childReference(e.kind, name, status["pipelineTaskName"]))
+		}
+		unstructured.RemoveNestedField(content, "status", e.field)
+	}
+
+	if len(compacted) == 0 {
+		return
+	}
+	_ = unstructured.SetNestedSlice(content, compacted, "status", "childReferences")
+}
+
+func childReference(kind, name, pipelineTaskName interface{}) map[string]interface{} {
+	ref := map[string]interface{}{"kind": kind, "name": name}
+	if pipelineTaskName != nil {
+		ref["pipelineTaskName"] = pipelineTaskName
+	}
+	return ref
+}
@@ -0,0 +1,106 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+func TestStreamYAML(t *testing.T) {
+	children := []interface{}{}
+	for i := 0; i < 3; i++ {
+		children = append(children, map[string]interface{}{
+			"apiVersion":       "tekton.dev/v1",
+			"kind":             "TaskRun",
+			"name":             fmt.Sprintf("release-build-%d", i),
+			"pipelineTaskName": fmt.Sprintf("build-%d", i),
+			"whenExpressions": []interface{}{
+				map[string]interface{}{"input": "$(params.arch)", "operator": "in", "values": []interface{}{"amd64", "true"}},
+			},
+		})
+	}
+	content := map[string]interface{}{
+		"apiVersion": "tekton.dev/v1",
+		"kind":       "PipelineRun",
+		"metadata": map[string]interface{}{
+			"name":        "release",
+			"annotations": map[string]interface{}{"1": "yes", "description": "a: b\n# not a comment\n"},
+			"labels":      map[string]interface{}{},
+			"finalizers":  []interface{}{},
+		},
+		"spec": map[string]interface{}{
+			"params": []interface{}{
+				map[string]interface{}{"name": "script", "value": "#!/bin/sh\nset -e\nmake <all> & echo done\n"},
+				map[string]interface{}{"name": "versions", "value": []interface{}{"1.0", 2, nil}},
+			},
+			"timeouts": map[string]interface{}{"pipeline": "1h0m0s"},
+		},
+		"status": map[string]interface{}{
+			"childReferences": children,
+			"conditions": []interface{}{
+				map[string]interface{}{"lastTransitionTime": nil, "status": "True", "type": "Succeeded"},
+			},
+			"results": []interface{}{[]interface{}{"nested", "list"}, "- dash", ""},
+		},
+	}
+
+	want, err := yaml.Marshal(content)
+	assert.NilError(t, err)
+	got := &bytes.Buffer{}
+	assert.NilError(t, StreamYAML(got, content))
+	assert.Equal(t, got.String(), string(want))
+}
+
+func TestCompactChildReferences(t *testing.T) {
+	pr := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"childReferences": []interface{}{
+				map[string]interface{}{"apiVersion": "tekton.dev/v1", "kind": "TaskRun", "name": "release-build", "pipelineTaskName": "build", "displayName": "Build"},
+			},
+			"taskRuns": map[string]interface{}{
+				"release-test": map[string]interface{}{"pipelineTaskName": "test", "status": map[string]interface{}{"podName": "release-test-pod"}},
+				"release-lint": map[string]interface{}{"pipelineTaskName": "lint", "status": map[string]interface{}{"podName": "release-lint-pod"}},
+			},
+			"runs": map[string]interface{}{
+				"release-approve": map[string]interface{}{"pipelineTaskName": "approve"},
+			},
+			"startTime": "1984-04-04T00:00:00Z",
+		},
+	}}
+
+	CompactChildReferences(pr)
+	assert.DeepEqual(t, pr.Object, map[string]interface{}{
+		"status": map[string]interface{}{
+			"childReferences": []interface{}{
+				map[string]interface{}{"kind": "TaskRun", "name": "release-build", "pipelineTaskName": "build"},
+				map[string]interface{}{"kind": "TaskRun", "name": "release-lint", "pipelineTaskName": "lint"},
+				map[string]interface{}{"kind": "TaskRun", "name": "release-test", "pipelineTaskName": "test"},
+				map[string]interface{}{"kind": "Run", "name": "release-approve", "pipelineTaskName": "approve"},
+			},
+			"startTime": "1984-04-04T00:00:00Z",
+		},
+	})
+
+	// a PipelineRun without status is left alone
+	pending := &unstructured.Unstructured{Object: map[string]interface{}{"spec": map[string]interface{}{}}}
+	CompactChildReferences(pending)
+	assert.DeepEqual(t, pending.Object, map[string]interface{}{"spec": map[string]interface{}{}})
+}
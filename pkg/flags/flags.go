@@ -15,13 +15,18 @@
 package flags
 
 import (
+	"fmt"
 	"os"
 	"runtime"
+	"sort"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/formatted"
 	"golang.org/x/term"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 const (
@@ -30,12 +35,17 @@ const (
 	namespace  = "namespace"
 	nocolour   = "nocolour"
 	nocolor    = "no-color"
+	as         = "as"
+	asUID      = "as-uid"
+	asGroup    = "as-group"
 )
 
 // TektonOptions all global tekton options
 type TektonOptions struct {
 	KubeConfig, Context, Namespace string
 	Nocolour                       bool
+	As, AsUID                      string
+	AsGroups                       []string
 }
 
 // AddTektonOptions amends command to add flags required to initialise a cli.Param
@@ -46,7 +56,13 @@ func AddTektonOptions(cmd *cobra.Command) {
 
 	cmd.PersistentFlags().StringP(
 		context, "c", "",
-		"name of the kubeconfig context to use (default: kubectl config current-context)")
+		"name of the kubeconfig context to use (default: context of the tkn profile or kubectl config current-context)")
+	_ = cmd.RegisterFlagCompletionFunc(context,
+		func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+			kcPath, _ := cmd.Flags().GetString(kubeConfig)
+			return kubeContexts(kcPath), cobra.ShellCompDirectiveNoFileComp
+		},
+	)
 
 	cmd.PersistentFlags().StringP(
 		namespace, "n", "",
@@ -69,6 +85,18 @@ func AddTektonOptions(cmd *cobra.Command) {
 	cmd.PersistentFlags().BoolP(
 		"no-color", "C", false,
 		"disable coloring (default: false)")
+
+	cmd.PersistentFlags().String(
+		as, "",
+		"username to impersonate for the operation, can be a service account as system:serviceaccount:<namespace>:<name>")
+
+	cmd.PersistentFlags().String(
+		asUID, "",
+		"UID to impersonate for the operation")
+
+	cmd.PersistentFlags().StringArray(
+		asGroup, []string{},
+		"group to impersonate for the operation, this flag can be repeated to specify multiple groups")
 }
 
 // GetTektonOptions get the global tekton Options that are not passed to a subcommands
@@ -77,14 +105,39 @@ func GetTektonOptions(cmd *cobra.Command) TektonOptions {
 	kubeContext, _ := cmd.Flags().GetString(context)
 	ns, _ := cmd.Flags().GetString(namespace)
 	nocolourFlag, _ := cmd.Flags().GetBool(nocolor)
+	asUser, _ := cmd.Flags().GetString(as)
+	asUIDFlag, _ := cmd.Flags().GetString(asUID)
+	asGroups, _ := cmd.Flags().GetStringArray(asGroup)
 	return TektonOptions{
 		KubeConfig: kcPath,
 		Context:    kubeContext,
 		Namespace:  ns,
 		Nocolour:   nocolourFlag,
+		As:         asUser,
+		AsUID:      asUIDFlag,
+		AsGroups:   asGroups,
 	}
 }
 
+// Args returns the global flags which need to be passed to another tkn
+// invocation to target the same cluster, namespace and identity
+func (o TektonOptions) Args() string {
+	args := ""
+	if o.Context != "" {
+		args += fmt.Sprintf("--context=%s ", o.Context)
+	}
+	if o.As != "" {
+		args += fmt.Sprintf("--as=%s ", o.As)
+	}
+	if o.AsUID != "" {
+		args += fmt.Sprintf("--as-uid=%s ", o.AsUID)
+	}
+	for _, g := range o.AsGroups {
+		args += fmt.Sprintf("--as-group=%s ", g)
+	}
+	return args
+}
+
 // InitParams initialises cli.Params based on flags defined in command
 func InitParams(p cli.Params, cmd *cobra.Command) error {
 	// NOTE: breaks symmetry with AddTektonOptions as this uses Flags instead of
@@ -97,12 +150,45 @@ func InitParams(p cli.Params, cmd *cobra.Command) error {
 	}
 	p.SetKubeConfigPath(kcPath)
 
+	profile, err := p.Profile()
+	if err != nil {
+		return err
+	}
+
 	kubeContext, err := cmd.Flags().GetString(context)
 	if err != nil {
 		return err
 	}
+	// the namespace of the profile goes with its context, it is not used
+	// when --context picks another one
+	profileContext := kubeContext == "" || kubeContext == profile.Context
+	if kubeContext == "" {
+		// fallback to the context bound to the active profile, if any
+		kubeContext = profile.Context
+	}
 	p.SetKubeContext(kubeContext)
 
+	asUser, err := cmd.Flags().GetString(as)
+	if err != nil {
+		return err
+	}
+	asUIDFlag, err := cmd.Flags().GetString(asUID)
+	if err != nil {
+		return err
+	}
+	asGroups, err := cmd.Flags().GetStringArray(asGroup)
+	if err != nil {
+		return err
+	}
+	if asUser == "" && (asUIDFlag != "" || len(asGroups) != 0) {
+		return fmt.Errorf("--as-uid and --as-group require --as to be set")
+	}
+	p.SetImpersonation(rest.ImpersonationConfig{
+		UserName: asUser,
+		UID:      asUIDFlag,
+		Groups:   asGroups,
+	})
+
 	// ensure that the config is valid by creating a client but skip for bundle cmd
 	// as bundle cmd does not need k8s client and config
 	// if this annotation is available on cmd and value is false then client
@@ -117,6 +203,9 @@ func InitParams(p cli.Params, cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
+	if ns == "" && profileContext {
+		ns = profile.Namespace
+	}
 	if ns != "" {
 		p.SetNamespace(ns)
 	}
@@ -151,3 +240,33 @@ func InitParams(p cli.Params, cmd *cobra.Command) error {
 
 	return nil
 }
+
+// kubeContexts returns the names of the contexts defined in the kubeconfig
+func kubeContexts(kcPath string) []string {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if kcPath != "" {
+		rules.ExplicitPath = kcPath
+	}
+	kc, err := rules.Load()
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(kc.Contexts))
+	for name := range kc.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AddAliases makes the flags of cmd also accept other names, e.g. --label
+// for --labels, aliases map the other names to the ones of the flags
+func AddAliases(cmd *cobra.Command, aliases map[string]string) {
+	cmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		if flag, ok := aliases[name]; ok {
+			name = flag
+		}
+		return pflag.NormalizedName(name)
+	})
+}
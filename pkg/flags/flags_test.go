@@ -15,12 +15,17 @@
 package flags
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/test"
 	"gotest.tools/v3/assert"
+	"k8s.io/client-go/rest"
 )
 
 func TestFlags_colouring(t *testing.T) {
@@ -35,3 +40,100 @@ func TestFlags_colouring(t *testing.T) {
 	assert.Assert(t, color.NoColor == true)
 
 }
+
+func TestFlags_impersonation(t *testing.T) {
+	p := &test.Params{}
+	cmd := &cobra.Command{
+		Annotations: map[string]string{"kubernetes": "false"},
+		RunE:        func(_ *cobra.Command, _ []string) error { return nil },
+	}
+	AddTektonOptions(cmd)
+	assert.NilError(t, cmd.ParseFlags([]string{"--as", "system:serviceaccount:ns:ci", "--as-group", "dev", "--as-group", "ops"}))
+
+	assert.NilError(t, InitParams(p, cmd))
+	assert.DeepEqual(t, p.Impersonation(), rest.ImpersonationConfig{
+		UserName: "system:serviceaccount:ns:ci",
+		Groups:   []string{"dev", "ops"},
+	})
+	assert.Equal(t, GetTektonOptions(cmd).Args(), "--as=system:serviceaccount:ns:ci --as-group=dev --as-group=ops ")
+}
+
+func TestFlags_impersonation_requires_user(t *testing.T) {
+	cmd := &cobra.Command{
+		Annotations: map[string]string{"kubernetes": "false"},
+	}
+	AddTektonOptions(cmd)
+	assert.NilError(t, cmd.ParseFlags([]string{"--as-group", "dev"}))
+
+	err := InitParams(&test.Params{}, cmd)
+	assert.Error(t, err, "--as-uid and --as-group require --as to be set")
+}
+
+func TestFlags_profile_context(t *testing.T) {
+	profile := config.Profile{Context: "staging"}
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{
+			Annotations: map[string]string{"kubernetes": "false"},
+		}
+		AddTektonOptions(cmd)
+		return cmd
+	}
+
+	p := &test.Params{TknProfile: profile}
+	cmd := newCmd()
+	assert.NilError(t, cmd.ParseFlags([]string{}))
+	assert.NilError(t, InitParams(p, cmd))
+	assert.Equal(t, p.KubeContext(), "staging")
+
+	p = &test.Params{TknProfile: profile}
+	cmd = newCmd()
+	assert.NilError(t, cmd.ParseFlags([]string{"--context", "prod"}))
+	assert.NilError(t, InitParams(p, cmd))
+	assert.Equal(t, p.KubeContext(), "prod")
+}
+
+func TestFlags_profile_namespace(t *testing.T) {
+	profile := config.Profile{Context: "staging", Namespace: "ci"}
+	for _, tc := range []struct {
+		args []string
+		ns   string
+	}{
+		{args: []string{}, ns: "ci"},
+		{args: []string{"--context", "staging"}, ns: "ci"},
+		{args: []string{"--context", "prod"}, ns: ""},
+		{args: []string{"--context", "prod", "-n", "dev"}, ns: "dev"},
+	} {
+		cmd := &cobra.Command{
+			Annotations: map[string]string{"kubernetes": "false"},
+		}
+		AddTektonOptions(cmd)
+		assert.NilError(t, cmd.ParseFlags(tc.args))
+
+		p := &test.Params{TknProfile: profile}
+		assert.NilError(t, InitParams(p, cmd))
+		assert.Equal(t, p.Namespace(), tc.ns, "args %v", tc.args)
+	}
+}
+
+func TestFlags_context_completion(t *testing.T) {
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	err := os.WriteFile(kubeconfig, []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: c
+  cluster:
+    server: https://localhost:6443
+users:
+- name: u
+contexts:
+- name: prod
+  context: {cluster: c, user: u}
+- name: dev
+  context: {cluster: c, user: u}
+current-context: dev
+`), 0o600)
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, kubeContexts(kubeconfig), []string{"dev", "prod"})
+	assert.Assert(t, kubeContexts(filepath.Join(t.TempDir(), "missing")) == nil)
+}
@@ -0,0 +1,113 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/tektoncd/cli/pkg/cli"
+)
+
+// queryPrefix marks the arguments naming a saved query
+const queryPrefix = "@"
+
+// ExpandQueries replaces the @name arguments of the command by the flags
+// and arguments of the saved queries of the active profile. The flags
+// passed on the command line take precedence over the ones of the queries.
+func ExpandQueries(p cli.Params, cmd *cobra.Command, args []string) ([]string, error) {
+	var expanded []string
+	var queries map[string]string
+	applied := false
+	for _, arg := range args {
+		name, ok := strings.CutPrefix(arg, queryPrefix)
+		if !ok {
+			expanded = append(expanded, arg)
+			continue
+		}
+
+		if queries == nil {
+			profile, err := p.Profile()
+			if err != nil {
+				return nil, err
+			}
+			queries = profile.Queries
+			if queries == nil {
+				queries = map[string]string{}
+			}
+		}
+		query, ok := queries[name]
+		if !ok {
+			return nil, fmt.Errorf("saved query %q is not defined in the queries of the tkn profile", name)
+		}
+
+		queryArgs, err := parseQuery(cmd, query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid saved query %q: %w", name, err)
+		}
+		expanded = append(expanded, queryArgs...)
+		applied = true
+	}
+
+	// the query may have changed the namespace or the context
+	if applied && cmd.Flags().Lookup(namespace) != nil {
+		if err := InitParams(p, cmd); err != nil {
+			return nil, err
+		}
+	}
+	return expanded, nil
+}
+
+// parseQuery sets the flags of the query which were not passed on the
+// command line and returns its arguments
+func parseQuery(cmd *cobra.Command, query string) ([]string, error) {
+	words, err := shlex.Split(query)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := pflag.NewFlagSet(cmd.Name(), pflag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if !f.Changed {
+			fs.AddFlag(f)
+			return
+		}
+		// the value passed on the command line is kept
+		fs.AddFlag(&pflag.Flag{
+			Name:        f.Name,
+			Shorthand:   f.Shorthand,
+			Value:       ignoredValue{f.Value.Type()},
+			NoOptDefVal: f.NoOptDefVal,
+		})
+	})
+	if err := fs.Parse(words); err != nil {
+		return nil, err
+	}
+	return fs.Args(), nil
+}
+
+// ignoredValue is a flag value which ignores what it is set to
+type ignoredValue struct {
+	typ string
+}
+
+func (v ignoredValue) String() string     { return "" }
+func (v ignoredValue) Set(_ string) error { return nil }
+func (v ignoredValue) Type() string       { return v.typ }
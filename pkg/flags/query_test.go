@@ -0,0 +1,72 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/assert"
+)
+
+func queryCommand(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{Use: "list"}
+	cmd.Flags().String("status", "", "")
+	cmd.Flags().StringSlice("label", nil, "")
+	cmd.Flags().Bool("no-headers", false, "")
+	assert.NilError(t, cmd.ParseFlags(args))
+	return cmd
+}
+
+func queryParams(queries map[string]string) *test.Params {
+	return &test.Params{TknProfile: config.Profile{Queries: queries}}
+}
+
+func TestExpandQueries(t *testing.T) {
+	p := queryParams(map[string]string{"failed": "--status failed --label team=payments --no-headers pr-1"})
+
+	cmd := queryCommand(t, "--status", "running")
+	args, err := ExpandQueries(p, cmd, []string{"@failed", "pr-2"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, args, []string{"pr-1", "pr-2"})
+
+	status, _ := cmd.Flags().GetString("status")
+	assert.Equal(t, status, "running")
+	labels, _ := cmd.Flags().GetStringSlice("label")
+	assert.DeepEqual(t, labels, []string{"team=payments"})
+	noHeaders, _ := cmd.Flags().GetBool("no-headers")
+	assert.Equal(t, noHeaders, true)
+}
+
+func TestExpandQueries_without_queries(t *testing.T) {
+	p := queryParams(nil)
+
+	args, err := ExpandQueries(p, queryCommand(t), []string{"pr-1"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, args, []string{"pr-1"})
+
+	_, err = ExpandQueries(p, queryCommand(t), []string{"@failed"})
+	assert.Error(t, err, `saved query "failed" is not defined in the queries of the tkn profile`)
+}
+
+func TestExpandQueries_invalid(t *testing.T) {
+	p := queryParams(map[string]string{"unknown": "--unknown"})
+
+	_, err := ExpandQueries(p, queryCommand(t), []string{"@unknown"})
+	assert.ErrorContains(t, err, `invalid saved query "unknown": unknown flag: --unknown`)
+}
@@ -0,0 +1,67 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/formatted"
+)
+
+// ScriptOutput holds the -q and --porcelain flags of the commands whose
+// output is read by scripts. Unlike the tables, which are tweaked for the
+// humans reading them, the porcelain output is guaranteed not to change
+type ScriptOutput struct {
+	Quiet     bool
+	Porcelain bool
+}
+
+// AddFlags adds -q and --porcelain to the command, kind is the kind of the
+// resources it prints, e.g. PipelineRun
+func (o *ScriptOutput) AddFlags(c *cobra.Command, kind string) {
+	c.Flags().BoolVarP(&o.Quiet, "quiet", "q", false, fmt.Sprintf("only print the name of each %s, one per line", kind))
+	c.Flags().BoolVar(&o.Porcelain, "porcelain", false, fmt.Sprintf("print each %s as a line of tab separated fields, in a format which is guaranteed not to change", kind))
+}
+
+// Enabled tells whether the output is for scripts
+func (o ScriptOutput) Enabled() bool {
+	return o.Quiet || o.Porcelain
+}
+
+// Validate checks that -q and --porcelain are neither used together nor
+// with --output
+func (o ScriptOutput) Validate(output string) error {
+	if o.Quiet && o.Porcelain {
+		return errors.New("--quiet and --porcelain cannot be used together")
+	}
+	if o.Enabled() && output != "" {
+		return errors.New("--quiet and --porcelain cannot be used with --output")
+	}
+	return nil
+}
+
+// Print prints the name with -q, or the fields with --porcelain
+func (o ScriptOutput) Print(w io.Writer, name string, fields ...string) error {
+	var err error
+	if o.Quiet {
+		_, err = fmt.Fprintln(w, name)
+	} else {
+		_, err = fmt.Fprint(w, formatted.PorcelainLine(fields...))
+	}
+	return err
+}
@@ -0,0 +1,38 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flags
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/assert"
+)
+
+func TestScriptOutput_Validate(t *testing.T) {
+	assert.NilError(t, ScriptOutput{}.Validate("yaml"))
+	assert.NilError(t, ScriptOutput{Quiet: true}.Validate(""))
+	assert.Error(t, ScriptOutput{Quiet: true, Porcelain: true}.Validate(""), "--quiet and --porcelain cannot be used together")
+	assert.Error(t, ScriptOutput{Porcelain: true}.Validate("json"), "--quiet and --porcelain cannot be used with --output")
+}
+
+func TestScriptOutput_Print(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.NilError(t, ScriptOutput{Quiet: true}.Print(out, "run-1", "foo", "run-1"))
+	assert.NilError(t, ScriptOutput{Porcelain: true}.Print(out, "run-1", "foo", "run-1"))
+
+	test.AssertOutput(t, "run-1\nfoo\trun-1\n", out.String())
+}
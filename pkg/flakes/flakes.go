@@ -0,0 +1,220 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flakes finds the steps of a Pipeline which fail intermittently
+// from the logs of its recent runs
+package flakes
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// maxMarkers bounds the number of failure markers kept for a step, the
+// first lines reporting a failure are usually the most telling ones
+const maxMarkers = 5
+
+// markerPatterns match the lines reporting a failing test or step in the output of
+// common test runners and build tools
+var markerPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`--- FAIL: \S+`),
+	regexp.MustCompile(`^FAIL\s+\S+`),
+	regexp.MustCompile(`^FAILED\s+\S+`),
+	regexp.MustCompile(`\[ERROR\] (Tests run:|Failed tests:|\S+\.\S+:\d+)`),
+	regexp.MustCompile(`npm ERR!`),
+	regexp.MustCompile(`^\s*(panic|fatal|Error|ERROR|AssertionError|Exception)\b`),
+	regexp.MustCompile(`\b\w+(Error|Exception):`),
+}
+
+// volatile match the parts of a failure line which change from one run to
+// the other, they are replaced so that similar failures are clustered. Short
+// numbers are kept as they are usually line numbers or exit codes
+var volatile = []struct {
+	re   *regexp.Regexp
+	repl string
+}{
+	{regexp.MustCompile(`^\d{4}-\d{2}-\d{2}[T ][\d:.]+Z?\s*`), ""},
+	{regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`), "<uuid>"},
+	{regexp.MustCompile(`\b0x[0-9a-fA-F]+\b|\b[0-9a-f]{7,}\b`), "<hex>"},
+	{regexp.MustCompile(`\b\d+(\.\d+)?(ns|us|µs|ms|s|m|h)\b`), "<duration>"},
+	{regexp.MustCompile(`\b\d+\.\d+\b|\b\d{4,}\b`), "<n>"},
+	{regexp.MustCompile(`\s+`), " "},
+}
+
+// Step is the outcome of a step in one run
+type Step struct {
+	Task   string
+	Name   string
+	Failed bool
+	// Markers are the lines of the logs of a failed step reporting the
+	// failure, or its termination reason when no such line was found
+	Markers []string
+}
+
+// Run is the outcome of the steps of one PipelineRun
+type Run struct {
+	Name  string
+	Steps []Step
+}
+
+// Cluster groups similar failures of a step
+type Cluster struct {
+	Signature string
+	Runs      []string
+}
+
+// Report describes the failures of a step over the analysed runs
+type Report struct {
+	Task     string
+	Step     string
+	Runs     int
+	Failures int
+	Clusters []Cluster
+}
+
+// Flaky tells whether the step both failed and succeeded over the runs
+func (r Report) Flaky() bool {
+	return r.Failures > 0 && r.Failures < r.Runs
+}
+
+// Markers returns the lines of a log reporting a failure
+func Markers(r io.Reader) ([]string, error) {
+	found := []string{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() && len(found) < maxMarkers {
+		line := strings.TrimSpace(scanner.Text())
+		for _, m := range markerPatterns {
+			if m.MatchString(line) {
+				found = append(found, line)
+				break
+			}
+		}
+	}
+	return found, scanner.Err()
+}
+
+// Signature normalises a failure line so that the failures differing only
+// by durations, identifiers or timestamps have the same signature
+func Signature(line string) string {
+	for _, v := range volatile {
+		line = v.re.ReplaceAllString(line, v.repl)
+	}
+	return strings.TrimSpace(line)
+}
+
+// Analyze returns the reports of the steps which failed at least once over
+// the runs, the flaky steps first, then by number of failures. The runs are
+// expected newest first
+func Analyze(runs []Run) []Report {
+	byStep := map[string]*Report{}
+	clusters := map[string]map[string]*Cluster{}
+	for _, run := range runs {
+		for _, step := range run.Steps {
+			key := step.Task + "/" + step.Name
+			r, ok := byStep[key]
+			if !ok {
+				r = &Report{Task: step.Task, Step: step.Name}
+				byStep[key] = r
+				clusters[key] = map[string]*Cluster{}
+			}
+			r.Runs++
+			if !step.Failed {
+				continue
+			}
+			r.Failures++
+
+			seen := map[string]bool{}
+			for _, m := range step.Markers {
+				sig := Signature(m)
+				if seen[sig] {
+					continue
+				}
+				seen[sig] = true
+				c, ok := clusters[key][sig]
+				if !ok {
+					c = &Cluster{Signature: sig}
+					clusters[key][sig] = c
+				}
+				c.Runs = append(c.Runs, run.Name)
+			}
+		}
+	}
+
+	reports := []Report{}
+	for key, r := range byStep {
+		if r.Failures == 0 {
+			continue
+		}
+		for _, c := range clusters[key] {
+			r.Clusters = append(r.Clusters, *c)
+		}
+		sort.Slice(r.Clusters, func(i, j int) bool {
+			if len(r.Clusters[i].Runs) != len(r.Clusters[j].Runs) {
+				return len(r.Clusters[i].Runs) > len(r.Clusters[j].Runs)
+			}
+			return r.Clusters[i].Signature < r.Clusters[j].Signature
+		})
+		reports = append(reports, *r)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		a, b := reports[i], reports[j]
+		if a.Flaky() != b.Flaky() {
+			return a.Flaky()
+		}
+		if a.Failures != b.Failures {
+			return a.Failures > b.Failures
+		}
+		return a.Task+"/"+a.Step < b.Task+"/"+b.Step
+	})
+	return reports
+}
+
+// Print writes the reports as a table of the failing steps followed by the
+// clusters of failures of each step
+func Print(w io.Writer, reports []Report) error {
+	tw := tabwriter.NewWriter(w, 0, 5, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(tw, "TASK\tSTEP\tFAILURES\tRATE\tSTATUS")
+	for _, r := range reports {
+		status := "failing"
+		if r.Flaky() {
+			status = "flaky"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d/%d\t%d%%\t%s\n", r.Task, r.Step, r.Failures, r.Runs, r.Failures*100/r.Runs, status)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, r := range reports {
+		if len(r.Clusters) == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "\nFailures of %s/%s:\n", r.Task, r.Step)
+		tw = tabwriter.NewWriter(w, 0, 5, 3, ' ', tabwriter.TabIndent)
+		fmt.Fprintln(tw, "RUNS\tSIGNATURE\tLAST SEEN")
+		for _, c := range r.Clusters {
+			fmt.Fprintf(tw, "%d\t%s\t%s\n", len(c.Runs), c.Signature, c.Runs[0])
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flakes
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestMarkers(t *testing.T) {
+	logs := `=== RUN   TestCache
+--- FAIL: TestCache (1.02s)
+    cache_test.go:42: expected 2 entries, got 1
+FAIL	example.com/cache	1.204s
+npm ERR! code ELIFECYCLE
+Traceback (most recent call last):
+AssertionError: values differ
+ok  	example.com/other	0.3s
+`
+	got, err := Markers(strings.NewReader(logs))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, []string{
+		"--- FAIL: TestCache (1.02s)",
+		"FAIL\texample.com/cache\t1.204s",
+		"npm ERR! code ELIFECYCLE",
+		"AssertionError: values differ",
+	})
+
+	got, err = Markers(strings.NewReader(strings.Repeat("Error: boom\n", 10)))
+	assert.NilError(t, err)
+	assert.Equal(t, len(got), maxMarkers)
+}
+
+func TestSignature(t *testing.T) {
+	tests := map[string]string{
+		"--- FAIL: TestCache (1.02s)":                               "--- FAIL: TestCache (<duration>)",
+		"2026-10-15T10:00:01.123Z Error: timeout after 30s":         "Error: timeout after <duration>",
+		"Error: pod 6f1c2b7e-9a0d-4c3b-8e5f-1a2b3c4d5e6f not found": "Error: pod <uuid> not found",
+		"panic: runtime error at 0xc000123456 in   goroutine 18273": "panic: runtime error at <hex> in goroutine <n>",
+		"cache_test.go:42: expected 2 entries":                      "cache_test.go:42: expected 2 entries",
+		"FAIL\texample.com/cache\t1.204s":                           "FAIL example.com/cache <duration>",
+	}
+	for line, want := range tests {
+		assert.Equal(t, Signature(line), want, line)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	runs := []Run{
+		{Name: "build-3", Steps: []Step{
+			{Task: "unit", Name: "test", Failed: true, Markers: []string{"--- FAIL: TestCache (1.02s)", "--- FAIL: TestCache (1.02s)"}},
+			{Task: "unit", Name: "lint", Failed: true, Markers: []string{"Error: gofmt"}},
+		}},
+		{Name: "build-2", Steps: []Step{
+			{Task: "unit", Name: "test"},
+			{Task: "unit", Name: "lint", Failed: true, Markers: []string{"Error: gofmt"}},
+		}},
+		{Name: "build-1", Steps: []Step{
+			{Task: "unit", Name: "test", Failed: true, Markers: []string{"--- FAIL: TestCache (0.87s)", "--- FAIL: TestRetry (2s)"}},
+			{Task: "unit", Name: "lint", Failed: true, Markers: []string{"Error: gofmt"}},
+			{Task: "e2e", Name: "run"},
+		}},
+	}
+
+	reports := Analyze(runs)
+	assert.DeepEqual(t, reports, []Report{
+		{Task: "unit", Step: "test", Runs: 3, Failures: 2, Clusters: []Cluster{
+			{Signature: "--- FAIL: TestCache (<duration>)", Runs: []string{"build-3", "build-1"}},
+			{Signature: "--- FAIL: TestRetry (<duration>)", Runs: []string{"build-1"}},
+		}},
+		{Task: "unit", Step: "lint", Runs: 3, Failures: 3, Clusters: []Cluster{
+			{Signature: "Error: gofmt", Runs: []string{"build-3", "build-2", "build-1"}},
+		}},
+	})
+	assert.Assert(t, reports[0].Flaky())
+	assert.Assert(t, !reports[1].Flaky())
+
+	b := &bytes.Buffer{}
+	assert.NilError(t, Print(b, reports))
+	assert.Equal(t, b.String(), `TASK   STEP   FAILURES   RATE   STATUS
+unit   test   2/3        66%    flaky
+unit   lint   3/3        100%   failing
+
+Failures of unit/test:
+RUNS   SIGNATURE                          LAST SEEN
+2      --- FAIL: TestCache (<duration>)   build-3
+1      --- FAIL: TestRetry (<duration>)   build-1
+
+Failures of unit/lint:
+RUNS   SIGNATURE      LAST SEEN
+3      Error: gofmt   build-3
+`)
+}
+
+func TestMerge(t *testing.T) {
+	runs := Merge(2,
+		[]Source{{Run: Run{Name: "build-3"}, StartTime: at(3)}, {Run: Run{Name: "build-2"}, StartTime: at(2)}},
+		[]Source{{Run: Run{Name: "build-2"}, StartTime: at(2)}, {Run: Run{Name: "build-4"}, StartTime: at(4)}},
+	)
+	assert.DeepEqual(t, runs, []Run{{Name: "build-4"}, {Name: "build-3"}})
+}
+
+func at(hours int) time.Time {
+	return time.Date(2026, 10, 15, hours, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,265 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flakes
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/results"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+// reasonStepSkipped is the termination reason of the steps which did not run
+// because a former step failed
+const reasonStepSkipped = "Skipped"
+
+var (
+	pipelineRunGroupResource = schema.GroupVersionResource{Group: "tekton.dev", Resource: "pipelineruns"}
+	taskRunGroupResource     = schema.GroupVersionResource{Group: "tekton.dev", Resource: "taskruns"}
+)
+
+// logPrefix matches the prefix tkn puts in front of the lines of the logs
+// archived by Results, e.g. "[build : compile] "
+var logPrefix = regexp.MustCompile(`^\[(?:[^\]]*? : )?([^\]]+)\] ?`)
+
+// Source is a PipelineRun and the outcome of its steps
+type Source struct {
+	Run
+	StartTime time.Time
+}
+
+// FromCluster returns the last completed runs of the Pipeline found in the
+// namespace, newest first. The logs of the failed steps are read from their
+// pods, the termination reason of the steps is used when the pods are gone
+func FromCluster(c *cli.Clients, pipelineName, ns string, last int) ([]Source, error) {
+	var prs *v1.PipelineRunList
+	opts := metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", pipeline.PipelineLabelKey, pipelineName)}
+	if err := actions.ListV1(pipelineRunGroupResource, c, opts, ns, &prs); err != nil {
+		return nil, fmt.Errorf("failed to list PipelineRuns from namespace %s: %w", ns, err)
+	}
+
+	completed := completedRuns(prs.Items, last, nil)
+	sources := []Source{}
+	for _, pr := range completed {
+		run := Run{Name: pr.Name}
+		for _, child := range pr.Status.ChildReferences {
+			if child.Kind != "TaskRun" {
+				continue
+			}
+			var tr *v1.TaskRun
+			if err := actions.GetV1(taskRunGroupResource, c, child.Name, ns, metav1.GetOptions{}, &tr); err != nil {
+				// the TaskRuns may have been pruned before the PipelineRun
+				continue
+			}
+			run.Steps = append(run.Steps, steps(tr, child.PipelineTaskName, func(step v1.StepState) []string {
+				return podMarkers(c, tr, step)
+			})...)
+		}
+		sources = append(sources, Source{Run: run, StartTime: startTime(pr)})
+	}
+	return sources, nil
+}
+
+// FromResults returns the last completed runs of the Pipeline archived in
+// Tekton Results, newest first, the runs named in skip are left out
+func FromResults(client *results.Client, pipelineName, ns string, last int, skip map[string]bool) ([]Source, error) {
+	ctx := context.Background()
+	filter := fmt.Sprintf(`data_type in ["tekton.dev/v1.PipelineRun", "tekton.dev/v1beta1.PipelineRun"] && data.metadata.labels[%q] == %q`,
+		pipeline.PipelineLabelKey, pipelineName)
+	records, err := client.ListRecords(ctx, ns+"/results/-", filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the PipelineRuns archived in Results: %w", err)
+	}
+
+	prs := []v1.PipelineRun{}
+	resultOf := map[string]string{}
+	for _, record := range records {
+		var pr v1.PipelineRun
+		if err := json.Unmarshal(record.Data.Value, &pr); err != nil {
+			return nil, fmt.Errorf("failed to decode record %s: %w", record.Name, err)
+		}
+		prs = append(prs, pr)
+		resultOf[pr.Name], _, _ = strings.Cut(record.Name, "/records/")
+	}
+
+	sources := []Source{}
+	for _, pr := range completedRuns(prs, last, skip) {
+		filter := `data_type in ["tekton.dev/v1.TaskRun", "tekton.dev/v1beta1.TaskRun"]`
+		trRecords, err := client.ListRecords(ctx, resultOf[pr.Name], filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the TaskRuns of PipelineRun %s archived in Results: %w", pr.Name, err)
+		}
+
+		run := Run{Name: pr.Name}
+		for _, record := range trRecords {
+			var tr v1.TaskRun
+			if err := json.Unmarshal(record.Data.Value, &tr); err != nil {
+				return nil, fmt.Errorf("failed to decode record %s: %w", record.Name, err)
+			}
+
+			var logs map[string][]string
+			run.Steps = append(run.Steps, steps(&tr, tr.Labels[pipeline.PipelineTaskLabelKey], func(step v1.StepState) []string {
+				if logs == nil {
+					logs = resultsLogs(ctx, client, record.Name)
+				}
+				if lines, ok := logs[step.Name]; ok {
+					return lineMarkers(lines)
+				}
+				return lineMarkers(logs[""])
+			})...)
+		}
+		sources = append(sources, Source{Run: run, StartTime: startTime(pr)})
+	}
+	return sources, nil
+}
+
+// Merge returns the last runs of the sources, newest first, a run found in
+// several sources is kept once
+func Merge(last int, sources ...[]Source) []Run {
+	all := []Source{}
+	seen := map[string]bool{}
+	for _, s := range sources {
+		for _, src := range s {
+			if seen[src.Name] {
+				continue
+			}
+			seen[src.Name] = true
+			all = append(all, src)
+		}
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].StartTime.After(all[j].StartTime)
+	})
+	if len(all) > last {
+		all = all[:last]
+	}
+
+	runs := make([]Run, 0, len(all))
+	for _, src := range all {
+		runs = append(runs, src.Run)
+	}
+	return runs
+}
+
+// completedRuns returns the last PipelineRuns which are done, newest first
+func completedRuns(prs []v1.PipelineRun, last int, skip map[string]bool) []*v1.PipelineRun {
+	sort.SliceStable(prs, func(i, j int) bool {
+		return startTime(&prs[i]).After(startTime(&prs[j]))
+	})
+	completed := []*v1.PipelineRun{}
+	for i := range prs {
+		pr := &prs[i]
+		if skip[pr.Name] || !pr.IsDone() {
+			continue
+		}
+		if len(completed) == last {
+			break
+		}
+		completed = append(completed, pr)
+	}
+	return completed
+}
+
+func startTime(pr *v1.PipelineRun) time.Time {
+	if pr.Status.StartTime != nil {
+		return pr.Status.StartTime.Time
+	}
+	return pr.CreationTimestamp.Time
+}
+
+// steps returns the outcome of the steps of a TaskRun which ran, the
+// markers of the failed steps are read with logs
+func steps(tr *v1.TaskRun, task string, logs func(v1.StepState) []string) []Step {
+	if cond := tr.Status.GetCondition(apis.ConditionSucceeded); cond == nil || cond.IsUnknown() {
+		return nil
+	}
+
+	found := []Step{}
+	for _, state := range tr.Status.Steps {
+		term := state.Terminated
+		if term == nil || term.Reason == reasonStepSkipped || state.TerminationReason == reasonStepSkipped {
+			continue
+		}
+		step := Step{Task: task, Name: state.Name, Failed: term.ExitCode != 0}
+		if step.Failed {
+			step.Markers = logs(state)
+			if len(step.Markers) == 0 {
+				step.Markers = []string{fmt.Sprintf("%s with exit code %d", term.Reason, term.ExitCode)}
+			}
+		}
+		found = append(found, step)
+	}
+	return found
+}
+
+// podMarkers returns the failure markers of the logs of a step still
+// available in its pod
+func podMarkers(c *cli.Clients, tr *v1.TaskRun, step v1.StepState) []string {
+	if tr.Status.PodName == "" {
+		return nil
+	}
+	opts := &corev1.PodLogOptions{Container: step.Container}
+	logs, err := c.Kube.CoreV1().Pods(tr.Namespace).GetLogs(tr.Status.PodName, opts).Stream(context.Background())
+	if err != nil {
+		return nil
+	}
+	defer logs.Close()
+
+	found, _ := Markers(logs)
+	return found
+}
+
+// resultsLogs returns the lines of the log archived for a TaskRun record by
+// step, the lines without a step prefix are kept under ""
+func resultsLogs(ctx context.Context, client *results.Client, record string) map[string][]string {
+	lines := map[string][]string{}
+	logs, err := client.GetLog(ctx, results.LogName(record))
+	if err != nil {
+		return lines
+	}
+	defer logs.Close()
+
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		step := ""
+		if m := logPrefix.FindStringSubmatch(line); m != nil {
+			step = m[1]
+			line = line[len(m[0]):]
+		}
+		lines[step] = append(lines[step], line)
+	}
+	return lines
+}
+
+func lineMarkers(lines []string) []string {
+	found, _ := Markers(strings.NewReader(strings.Join(lines, "\n")))
+	return found
+}
@@ -76,6 +76,14 @@ func DecorateAttr(attrString, message string) string {
 		return "⏭️  "
 	case "timeouts":
 		return "⏱  "
+	case "finally":
+		return "🏁 "
+	case "provenance":
+		return "🔏 "
+	case "history":
+		return "📜 "
+	case "scheduling":
+		return "🖥  "
 	}
 
 	attr := color.Reset
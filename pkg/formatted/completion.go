@@ -1,20 +1,95 @@
 package formatted
 
 import (
+	"encoding/json"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/daemon"
+	"github.com/tektoncd/cli/pkg/state"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
+// completionCacheTTL is how long the names listed for a completion are
+// reused, completion runs on every key press and kubectl is slow to start
+const completionCacheTTL = 5 * time.Second
+
+// openStateStore returns the store completions are cached in
+var openStateStore = state.Open
+
+// listWithKubectl lists the names of the objects of a kind with kubectl
+var listWithKubectl = func(obj string) ([]string, error) {
+	out, err := exec.Command("kubectl", "get", obj, "-o=jsonpath={range .items[*]}{.metadata.name} {end}").Output()
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(out)), nil
+}
+
+// getWithKubectl returns the object of a kind as JSON with kubectl
+var getWithKubectl = func(kind, name, ns string) ([]byte, error) {
+	args := []string{"get", kind, name, "-o=json"}
+	if ns != "" {
+		args = append(args, "-n", ns)
+	}
+	return exec.Command("kubectl", args...).Output()
+}
+
+// namesFromDaemon lists the names of the objects of a kind in the namespace
+// of the current context from tkn daemon, it returns false when no daemon
+// caches them
+var namesFromDaemon = func(obj string) ([]string, bool) {
+	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{})
+	config, err := kubeConfig.ClientConfig()
+	if err != nil {
+		return nil, false
+	}
+	ns, _, err := kubeConfig.Namespace()
+	if err != nil {
+		return nil, false
+	}
+	return daemon.Names(daemon.SocketPath(config), v1.SchemeGroupVersion.WithResource(obj+"s"), ns)
+}
+
+type cachedCompletion struct {
+	Time  time.Time `json:"time"`
+	Names []string  `json:"names"`
+}
+
 // GetObjectsWithKubectl return completions with kubectl, we are doing this with
-// kubectl since we have caching and without it completion is way too slow
+// kubectl since we have caching and without it completion is way too slow.
+// The names are cached in the state store for a few seconds on top of that,
+// and are read from tkn daemon when it caches them.
 func GetObjectsWithKubectl(obj string) []string {
-	out, err := exec.Command("kubectl", "get", obj, "-o=jsonpath={range .items[*]}{.metadata.name} {end}").Output()
+	if names, ok := namesFromDaemon(obj); ok {
+		return names
+	}
+
+	key := "completion/" + obj
+	store, err := openStateStore()
+	if err == nil {
+		if b, err := store.Get(key); err == nil {
+			cached := cachedCompletion{}
+			if json.Unmarshal(b, &cached) == nil && time.Since(cached.Time) < completionCacheTTL {
+				return cached.Names
+			}
+		}
+	}
+
+	names, err := listWithKubectl(obj)
 	if err != nil {
 		return nil
 	}
-	return strings.Fields(string(out))
+	if store != nil {
+		if b, err := json.Marshal(cachedCompletion{Time: time.Now(), Names: names}); err == nil {
+			// the cache is best effort, completion works without it
+			_ = store.Put(key, b)
+		}
+	}
+	return names
 }
 
 // BaseCompletion return a completion for a kubernetes object using Kubectl
@@ -29,3 +104,92 @@ func BaseCompletion(target string, args []string) ([]string, cobra.ShellCompDire
 func ParentCompletion(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
 	return BaseCompletion(cmd.Parent().Name(), args)
 }
+
+type cachedParams struct {
+	Time   time.Time      `json:"time"`
+	Params []v1.ParamSpec `json:"params"`
+}
+
+// getParamsWithKubectl returns the params declared by the Pipeline or Task,
+// cached like the names of the objects
+func getParamsWithKubectl(kind, name, ns string) []v1.ParamSpec {
+	key := "completion/params/" + kind + "/" + ns + "/" + name
+	store, err := openStateStore()
+	if err == nil {
+		if b, err := store.Get(key); err == nil {
+			cached := cachedParams{}
+			if json.Unmarshal(b, &cached) == nil && time.Since(cached.Time) < completionCacheTTL {
+				return cached.Params
+			}
+		}
+	}
+
+	b, err := getWithKubectl(kind, name, ns)
+	if err != nil {
+		return nil
+	}
+	obj := struct {
+		Spec struct {
+			Params []v1.ParamSpec `json:"params"`
+		} `json:"spec"`
+	}{}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil
+	}
+	if store != nil {
+		if b, err := json.Marshal(cachedParams{Time: time.Now(), Params: obj.Spec.Params}); err == nil {
+			_ = store.Put(key, b)
+		}
+	}
+	return obj.Spec.Params
+}
+
+// ParamCompletion completes the --param flag of the start commands with the
+// names of the params of the Pipeline or Task being started which are not
+// set yet, and once the name is typed, with the values of its enum
+func ParamCompletion(kind string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		ns := ""
+		if f := cmd.Flags().Lookup("namespace"); f != nil {
+			ns = f.Value.String()
+		}
+		params := getParamsWithKubectl(kind, args[0], ns)
+
+		if name, _, ok := strings.Cut(toComplete, "="); ok {
+			values := []string{}
+			for _, p := range params {
+				if p.Name != name {
+					continue
+				}
+				for _, v := range p.Enum {
+					values = append(values, name+"="+v)
+				}
+			}
+			return values, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		set := map[string]bool{}
+		if values, err := cmd.Flags().GetStringArray("param"); err == nil {
+			for _, v := range values {
+				name, _, _ := strings.Cut(v, "=")
+				set[name] = true
+			}
+		}
+		names := []string{}
+		for _, p := range params {
+			if set[p.Name] {
+				continue
+			}
+			if desc := strings.TrimSpace(p.Description); desc != "" {
+				desc, _, _ = strings.Cut(desc, "\n")
+				names = append(names, p.Name+"=\t"+desc)
+				continue
+			}
+			names = append(names, p.Name+"=")
+		}
+		return names, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+}
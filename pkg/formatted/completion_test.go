@@ -0,0 +1,99 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatted
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/cli/pkg/state"
+	"gotest.tools/v3/assert"
+)
+
+func TestGetObjectsWithKubectl_cache(t *testing.T) {
+	store := state.NewMemoryStore()
+	calls := 0
+	oldOpen, oldList, oldDaemon := openStateStore, listWithKubectl, namesFromDaemon
+	openStateStore = func() (state.Store, error) { return store, nil }
+	listWithKubectl = func(string) ([]string, error) {
+		calls++
+		return []string{"pr-1", "pr-2"}, nil
+	}
+	namesFromDaemon = func(string) ([]string, bool) { return nil, false }
+	defer func() { openStateStore, listWithKubectl, namesFromDaemon = oldOpen, oldList, oldDaemon }()
+
+	assert.DeepEqual(t, GetObjectsWithKubectl("pipelinerun"), []string{"pr-1", "pr-2"})
+	assert.DeepEqual(t, GetObjectsWithKubectl("pipelinerun"), []string{"pr-1", "pr-2"})
+	assert.Equal(t, calls, 1)
+
+	// expired entries are listed again
+	b, _ := json.Marshal(cachedCompletion{Time: time.Now().Add(-time.Minute), Names: []string{"old"}})
+	assert.NilError(t, store.Put("completion/pipelinerun", b))
+	assert.DeepEqual(t, GetObjectsWithKubectl("pipelinerun"), []string{"pr-1", "pr-2"})
+	assert.Equal(t, calls, 2)
+}
+
+func TestGetObjectsWithKubectl_daemon(t *testing.T) {
+	oldList, oldDaemon := listWithKubectl, namesFromDaemon
+	listWithKubectl = func(string) ([]string, error) {
+		t.Fatal("kubectl should not be called when the daemon caches the objects")
+		return nil, nil
+	}
+	namesFromDaemon = func(obj string) ([]string, bool) {
+		assert.Equal(t, obj, "taskrun")
+		return []string{"tr-1"}, true
+	}
+	defer func() { listWithKubectl, namesFromDaemon = oldList, oldDaemon }()
+
+	assert.DeepEqual(t, GetObjectsWithKubectl("taskrun"), []string{"tr-1"})
+}
+
+func TestParamCompletion(t *testing.T) {
+	oldOpen, oldGet := openStateStore, getWithKubectl
+	openStateStore = func() (state.Store, error) { return state.NewMemoryStore(), nil }
+	getWithKubectl = func(kind, name, ns string) ([]byte, error) {
+		assert.Equal(t, kind, "pipeline")
+		assert.Equal(t, name, "build")
+		assert.Equal(t, ns, "ci")
+		return []byte(`{"spec":{"params":[
+			{"name":"env","description":"where to deploy\nthe build","enum":["dev","prod"]},
+			{"name":"revision"},
+			{"name":"url","description":"repository to build"}
+		]}}`), nil
+	}
+	defer func() { openStateStore, getWithKubectl = oldOpen, oldGet }()
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("namespace", "", "")
+	cmd.Flags().StringArray("param", nil, "")
+	assert.NilError(t, cmd.ParseFlags([]string{"--namespace", "ci", "--param", "url=https://example.com/repo.git"}))
+	complete := ParamCompletion("pipeline")
+
+	got, directive := complete(cmd, []string{"build"}, "")
+	assert.DeepEqual(t, got, []string{"env=\twhere to deploy", "revision="})
+	assert.Equal(t, directive, cobra.ShellCompDirectiveNoFileComp|cobra.ShellCompDirectiveNoSpace)
+
+	got, directive = complete(cmd, []string{"build"}, "env=")
+	assert.DeepEqual(t, got, []string{"env=dev", "env=prod"})
+	assert.Equal(t, directive, cobra.ShellCompDirectiveNoFileComp)
+
+	got, _ = complete(cmd, []string{"build"}, "revision=")
+	assert.DeepEqual(t, got, []string{})
+
+	got, _ = complete(cmd, nil, "")
+	assert.Assert(t, got == nil)
+}
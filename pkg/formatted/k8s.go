@@ -23,6 +23,9 @@ import (
 	v1 "knative.dev/pkg/apis/duck/v1"
 )
 
+// Phases of a run as returned by Phase
+var Phases = []string{"pending", "running", "succeeded", "failed", "cancelled"}
+
 var ConditionColor = map[string]color.Attribute{
 	"Failed":    color.FgHiRed,
 	"Succeeded": color.FgHiGreen,
@@ -92,3 +95,28 @@ func Condition(c v1.Conditions) string {
 	}
 	return ColorStatus(status)
 }
+
+// Phase returns the phase of a run from the status of its Condition, one of
+// Phases, e.g. to filter runs on it
+func Phase(c v1.Conditions) string {
+	if len(c) == 0 {
+		return "pending"
+	}
+
+	switch c[0].Status {
+	case corev1.ConditionTrue:
+		return "succeeded"
+	case corev1.ConditionFalse:
+		switch c[0].Reason {
+		case "PipelineRunCancelled", "TaskRunCancelled", "Cancelled":
+			return "cancelled"
+		}
+		return "failed"
+	}
+
+	switch c[0].Reason {
+	case "Pending", "PipelineRunPending", "TaskRunPending", "CreateContainerConfigError", "ExceededNodeResources", "ExceededResourceQuota":
+		return "pending"
+	}
+	return "running"
+}
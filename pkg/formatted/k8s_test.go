@@ -177,3 +177,29 @@ func TestCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestPhase(t *testing.T) {
+	tests := []struct {
+		status corev1.ConditionStatus
+		reason string
+		want   string
+	}{
+		{status: corev1.ConditionTrue, reason: "Succeeded", want: "succeeded"},
+		{status: corev1.ConditionFalse, reason: "Failed", want: "failed"},
+		{status: corev1.ConditionFalse, reason: "PipelineRunTimeout", want: "failed"},
+		{status: corev1.ConditionFalse, reason: "Cancelled", want: "cancelled"},
+		{status: corev1.ConditionFalse, reason: "TaskRunCancelled", want: "cancelled"},
+		{status: corev1.ConditionUnknown, reason: "Running", want: "running"},
+		{status: corev1.ConditionUnknown, reason: "PipelineRunPending", want: "pending"},
+		{status: corev1.ConditionUnknown, reason: "ExceededNodeResources", want: "pending"},
+	}
+	for _, tt := range tests {
+		c := []apis.Condition{{Type: apis.ConditionSucceeded, Status: tt.status, Reason: tt.reason}}
+		if got := Phase(c); got != tt.want {
+			t.Errorf("Phase(%s, %s) = %v, want %v", tt.status, tt.reason, got, tt.want)
+		}
+	}
+	if got := Phase(nil); got != "pending" {
+		t.Errorf("Phase() = %v, want pending", got)
+	}
+}
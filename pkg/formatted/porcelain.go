@@ -0,0 +1,49 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatted
+
+import (
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// porcelainEscaper escapes the characters separating the fields and lines of
+// the porcelain output
+var porcelainEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`)
+
+// PorcelainLine returns the fields as a line of porcelain output: separated
+// by tabs, with the tabs, newlines and backslashes of the fields escaped and
+// the empty fields written as -
+func PorcelainLine(fields ...string) string {
+	escaped := make([]string, len(fields))
+	for i, f := range fields {
+		if f == "" {
+			f = "-"
+		}
+		escaped[i] = porcelainEscaper.Replace(f)
+	}
+	return strings.Join(escaped, "\t") + "\n"
+}
+
+// PorcelainTime returns the time in RFC 3339 in UTC, or an empty string when
+// it is not set
+func PorcelainTime(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
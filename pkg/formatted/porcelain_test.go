@@ -0,0 +1,37 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatted
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPorcelainLine(t *testing.T) {
+	out := PorcelainLine("foo", "", "a\tb\nc\\d")
+
+	test.AssertOutput(t, "foo\t-\ta\\tb\\nc\\\\d\n", out)
+}
+
+func TestPorcelainTime(t *testing.T) {
+	start := metav1.NewTime(time.Date(2026, 10, 15, 11, 30, 0, 0, time.FixedZone("CEST", 2*60*60)))
+
+	test.AssertOutput(t, "2026-10-15T09:30:00Z", PorcelainTime(&start))
+	test.AssertOutput(t, "", PorcelainTime(nil))
+	test.AssertOutput(t, "", PorcelainTime(&metav1.Time{}))
+}
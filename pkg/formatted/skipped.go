@@ -0,0 +1,74 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatted
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// SkippedTaskReason returns why a PipelineTask was skipped. When the task was
+// skipped because of its when expressions, the ones evaluated to false are
+// appended to the reason.
+func SkippedTaskReason(st v1.SkippedTask) string {
+	if st.Reason != "" && st.Reason != v1.WhenExpressionsSkip {
+		return string(st.Reason)
+	}
+
+	falseExpressions := []string{}
+	for _, we := range st.WhenExpressions {
+		if !whenExpressionIsTrue(we) {
+			falseExpressions = append(falseExpressions, WhenExpression(we))
+		}
+	}
+	if len(falseExpressions) == 0 {
+		if st.Reason != "" {
+			return string(st.Reason)
+		}
+		return "---"
+	}
+	return fmt.Sprintf("%s: %s", v1.WhenExpressionsSkip, strings.Join(falseExpressions, ", "))
+}
+
+// WhenExpression formats a when expression as "input operator [values]"
+func WhenExpression(we v1.WhenExpression) string {
+	if we.CEL != "" {
+		return fmt.Sprintf("cel(%s)", we.CEL)
+	}
+	return fmt.Sprintf("%q %s [%s]", we.Input, we.Operator, strings.Join(we.Values, ", "))
+}
+
+// whenExpressionIsTrue evaluates the resolved when expression, CEL
+// expressions are evaluated by the controller only, consider them false
+// as they are only listed in the status when the task has been skipped
+func whenExpressionIsTrue(we v1.WhenExpression) bool {
+	if we.CEL != "" {
+		return false
+	}
+	inValues := false
+	for _, v := range we.Values {
+		if v == we.Input {
+			inValues = true
+			break
+		}
+	}
+	if we.Operator == selection.NotIn {
+		return !inValues
+	}
+	return inValues
+}
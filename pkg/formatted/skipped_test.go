@@ -0,0 +1,74 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatted
+
+import (
+	"testing"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+func TestSkippedTaskReason(t *testing.T) {
+	tt := []struct {
+		name string
+		task v1.SkippedTask
+		want string
+	}{
+		{
+			name: "Skipped because of parent tasks",
+			task: v1.SkippedTask{Name: "t", Reason: v1.ParentTasksSkip},
+			want: "Parent Tasks were skipped",
+		},
+		{
+			name: "Only the false when expressions are listed",
+			task: v1.SkippedTask{
+				Name:   "t",
+				Reason: v1.WhenExpressionsSkip,
+				WhenExpressions: []v1.WhenExpression{
+					{Input: "main", Operator: selection.In, Values: []string{"main", "release"}},
+					{Input: "docs", Operator: selection.NotIn, Values: []string{"docs"}},
+				},
+			},
+			want: `When Expressions evaluated to false: "docs" notin [docs]`,
+		},
+		{
+			name: "CEL expression",
+			task: v1.SkippedTask{
+				Name:            "t",
+				Reason:          v1.WhenExpressionsSkip,
+				WhenExpressions: []v1.WhenExpression{{CEL: "'$(params.branch)' == 'main'"}},
+			},
+			want: "When Expressions evaluated to false: cel('$(params.branch)' == 'main')",
+		},
+		{
+			name: "Reason without when expressions",
+			task: v1.SkippedTask{Name: "t", Reason: v1.WhenExpressionsSkip},
+			want: "When Expressions evaluated to false",
+		},
+		{
+			name: "No reason",
+			task: v1.SkippedTask{Name: "t"},
+			want: "---",
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := SkippedTaskReason(tc.task); got != tc.want {
+				t.Errorf("SkippedTaskReason() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package i18n translates the messages tkn shows to users. Messages are
+// looked up by their English format string in the catalog of the current
+// language, the English format is used when there is no translation. The
+// language is English until SetLanguage is called, e.g. by main with the
+// language of the environment.
+package i18n
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+var zhCN = language.MustParse("zh-CN")
+
+var (
+	// supported are the languages messages are translated to, the first
+	// one is used when none matches
+	supported = []language.Tag{language.English, zhCN}
+	matcher   = language.NewMatcher(supported)
+
+	// catalogs holds the translations of each supported language but
+	// English, keyed by the English format
+	catalogs = map[language.Tag]map[string]string{}
+
+	mu      sync.RWMutex
+	current = language.English
+)
+
+// FromEnv returns the language set in the environment, following the
+// precedence of gettext: $LC_ALL, $LC_MESSAGES then $LANG
+func FromEnv() string {
+	for _, env := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(env); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// SetLanguage sets the language messages are translated to. It accepts BCP
+// 47 tags such as zh-CN as well as POSIX locales such as zh_CN.UTF-8, an
+// empty language or the C and POSIX locales select English.
+func SetLanguage(lang string) error {
+	tag, err := parse(lang)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	current = tag
+	return nil
+}
+
+func parse(lang string) (language.Tag, error) {
+	// drop the encoding and modifier of POSIX locales, e.g. zh_CN.UTF-8@pinyin
+	if i := strings.IndexAny(lang, ".@"); i != -1 {
+		lang = lang[:i]
+	}
+	if lang == "" || lang == "C" || lang == "POSIX" {
+		return language.English, nil
+	}
+
+	tag, err := language.Parse(strings.ReplaceAll(lang, "_", "-"))
+	if err != nil {
+		return language.English, fmt.Errorf("invalid language %q: %w", lang, err)
+	}
+	_, i, confidence := matcher.Match(tag)
+	if confidence == language.No {
+		return language.English, fmt.Errorf("unsupported language %q, supported languages are %s", lang, Supported())
+	}
+	return supported[i], nil
+}
+
+// Supported returns the languages messages can be translated to
+func Supported() string {
+	tags := make([]string, 0, len(supported))
+	for _, t := range supported {
+		tags = append(tags, t.String())
+	}
+	return strings.Join(tags, ", ")
+}
+
+// Language returns the language messages are translated to
+func Language() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current.String()
+}
+
+// T returns the translation of the format in the current language
+func T(format string) string {
+	mu.RLock()
+	defer mu.RUnlock()
+	if translated, ok := catalogs[current][format]; ok {
+		return translated
+	}
+	return format
+}
+
+// Sprintf formats the translation of the format
+func Sprintf(format string, a ...interface{}) string {
+	return fmt.Sprintf(T(format), a...)
+}
+
+// Fprintf writes the translation of the format to w
+func Fprintf(w io.Writer, format string, a ...interface{}) (int, error) {
+	return fmt.Fprintf(w, T(format), a...)
+}
+
+// Errorf returns an error with the translation of the format, %w is
+// supported as with fmt.Errorf
+func Errorf(format string, a ...interface{}) error {
+	return fmt.Errorf(T(format), a...)
+}
@@ -0,0 +1,118 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package i18n
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSetLanguage(t *testing.T) {
+	defer func() { _ = SetLanguage("en") }()
+
+	for _, tc := range []struct {
+		lang    string
+		want    string
+		wantErr string
+	}{
+		{lang: "", want: "en"},
+		{lang: "C", want: "en"},
+		{lang: "POSIX", want: "en"},
+		{lang: "C.UTF-8", want: "en"},
+		{lang: "en_US.UTF-8", want: "en"},
+		{lang: "zh_CN.UTF-8", want: "zh-CN"},
+		{lang: "zh-CN", want: "zh-CN"},
+		{lang: "zh-Hans", want: "zh-CN"},
+		{lang: "fr_FR", wantErr: `unsupported language "fr_FR", supported languages are en, zh-CN`},
+		{lang: "not a language", wantErr: `invalid language "not a language"`},
+	} {
+		t.Run(tc.lang, func(t *testing.T) {
+			err := SetLanguage(tc.lang)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, Language(), tc.want)
+		})
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "")
+	t.Setenv("LANG", "en_US.UTF-8")
+	assert.Equal(t, FromEnv(), "en_US.UTF-8")
+
+	t.Setenv("LC_MESSAGES", "zh_CN.UTF-8")
+	assert.Equal(t, FromEnv(), "zh_CN.UTF-8")
+
+	t.Setenv("LC_ALL", "C")
+	assert.Equal(t, FromEnv(), "C")
+}
+
+func TestTranslate(t *testing.T) {
+	defer func() { _ = SetLanguage("en") }()
+
+	assert.NilError(t, SetLanguage("en"))
+	assert.Equal(t, Sprintf("PipelineRun started: %s\n", "build-1"), "PipelineRun started: build-1\n")
+
+	assert.NilError(t, SetLanguage("zh-CN"))
+	assert.Equal(t, Sprintf("PipelineRun started: %s\n", "build-1"), "PipelineRun 已启动：build-1\n")
+	assert.Equal(t, Sprintf("Are you sure you want to delete all %ss in namespace %q%s (y/n): ", "TaskRun", "ns", ""), `确定要删除命名空间 "ns" 中的所有 TaskRun 吗 (y/n)：`)
+
+	var b bytes.Buffer
+	_, err := Fprintf(&b, "%ss deleted: %s\n", "Task", `"build"`)
+	assert.NilError(t, err)
+	assert.Equal(t, b.String(), "已删除 Tasks：\"build\"\n")
+
+	// messages without a translation are kept in English
+	assert.Equal(t, Sprintf("no translation for %s", "this"), "no translation for this")
+
+	wrapped := fmt.Errorf("forbidden")
+	err = Errorf("failed to delete %s %q: %w", "Task", "build", wrapped)
+	assert.ErrorIs(t, err, wrapped)
+}
+
+var verb = regexp.MustCompile(`%(\[\d+\])?[a-zA-Z]`)
+
+// anyArg is formatted by any verb
+type anyArg struct{}
+
+func (anyArg) Format(f fmt.State, _ rune) {
+	fmt.Fprint(f, "x")
+}
+
+// TestCatalogs checks that translations use as many arguments as the
+// English messages
+func TestCatalogs(t *testing.T) {
+	for lang, catalog := range catalogs {
+		for format, translated := range catalog {
+			args := make([]interface{}, len(verb.FindAllString(format, -1)))
+			for i := range args {
+				args[i] = anyArg{}
+			}
+			got := fmt.Sprintf(translated, args...)
+			if strings.Contains(got, "%!") {
+				t.Errorf("translation %q of %q in %s does not match the arguments of the message: %s", translated, format, lang, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package i18n
+
+func init() {
+	catalogs[zhCN] = map[string]string{
+		// start
+		"PipelineRun started: %s\n":                                              "PipelineRun 已启动：%s\n",
+		"TaskRun started: %s\n":                                                  "TaskRun 已启动：%s\n",
+		"\nIn order to track the PipelineRun progress run:\n":                    "\n要跟踪 PipelineRun 的进度，请运行：\n",
+		"\nIn order to track the TaskRun progress run:\n":                        "\n要跟踪 TaskRun 的进度，请运行：\n",
+		"Waiting for logs to be available...\n":                                  "正在等待日志可用...\n",
+		"PipelineRun cancelled: %s\n":                                            "PipelineRun 已取消：%s\n",
+		"PipelineRun %s finished: %s\n":                                          "PipelineRun %s 已结束：%s\n",
+		"TaskRun cancelled: %s\n":                                                "TaskRun 已取消：%s\n",
+		"%ss deleted: %s\n":                                                      "已删除 %ss：%s\n",
+		"failed to delete %s %q: %s":                                             "删除 %s %q 失败：%s",
+		"Are you sure you want to delete all %ss in namespace %q%s (y/n): ":      "确定要删除命名空间 %[2]q 中的所有 %[1]s%[3]s 吗 (y/n)：",
+		"Are you sure you want to delete all %ss%s (y/n): ":                      "确定要删除所有 %s%s 吗 (y/n)：",
+		"Are you sure you want to delete all %ss related to %s %q%s (y/n): ":     "确定要删除与 %[2]s %[3]q 相关的所有 %[1]s%[4]s 吗 (y/n)：",
+		"Are you sure you want to delete %s(s) %s and related resources (y/n): ": "确定要删除 %s %s 及其相关资源吗 (y/n)：",
+		"Are you sure you want to delete %s(s) %s (y/n): ":                       "确定要删除 %s %s 吗 (y/n)：",
+		" except for ones created in last %d minutes":                            "（保留最近 %d 分钟内创建的）",
+		" keeping %d %ss":                                                        "（保留 %d 个 %s）",
+		" except for ones created in last %d minutes and keeping %d %ss":         "（保留最近 %d 分钟内创建的以及 %d 个 %s）",
+		"Please enter (y/n): ":                                                   "请输入 (y/n)：",
+		"canceled deleting %s(s) %s":                                             "已取消删除 %s %s",
+	}
+}
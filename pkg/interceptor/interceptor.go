@@ -0,0 +1,264 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+var (
+	clusterInterceptorGroupResource = schema.GroupVersionResource{Group: "triggers.tekton.dev", Resource: "clusterinterceptors"}
+	interceptorGroupResource        = schema.GroupVersionResource{Group: "triggers.tekton.dev", Resource: "interceptors"}
+)
+
+// certExpiryWarning is how long before the expiry of the caBundle a warning
+// is reported
+const certExpiryWarning = 30 * 24 * time.Hour
+
+// Interceptor is a ClusterInterceptor or a namespaced Interceptor, resolved
+// to the address EventListeners send requests to
+type Interceptor struct {
+	Kind         v1beta1.InterceptorKind
+	Name         string
+	Namespace    string
+	ClientConfig v1alpha1.ClientConfig
+	Address      *apis.URL
+}
+
+// Get returns the interceptor of the given kind, ns is only used for
+// namespaced Interceptors
+func Get(c *cli.Clients, kind v1beta1.InterceptorKind, name, ns string) (*Interceptor, error) {
+	switch kind {
+	case v1beta1.ClusterInterceptorKind:
+		u, err := actions.Get(clusterInterceptorGroupResource, c.Dynamic, c.Triggers.Discovery(), name, "", metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		var ci v1alpha1.ClusterInterceptor
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &ci); err != nil {
+			return nil, err
+		}
+		addr, err := ci.ResolveAddress()
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the address of ClusterInterceptor %s: %w", name, err)
+		}
+		return &Interceptor{Kind: kind, Name: name, ClientConfig: ci.Spec.ClientConfig, Address: addr}, nil
+	case v1beta1.NamespacedInterceptorKind:
+		u, err := actions.Get(interceptorGroupResource, c.Dynamic, c.Triggers.Discovery(), name, ns, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		var i v1alpha1.Interceptor
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &i); err != nil {
+			return nil, err
+		}
+		addr, err := i.ResolveAddress()
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve the address of Interceptor %s: %w", name, err)
+		}
+		return &Interceptor{Kind: kind, Name: name, Namespace: ns, ClientConfig: i.Spec.ClientConfig, Address: addr}, nil
+	}
+	return nil, fmt.Errorf("unknown interceptor kind %q, use %s or %s", kind, v1beta1.ClusterInterceptorKind, v1beta1.NamespacedInterceptorKind)
+}
+
+// CABundleReport describes the certificates of the caBundle of the
+// interceptor, problems are reported as warnings
+type CABundleReport struct {
+	Subjects []string
+	NotAfter time.Time
+	Warnings []string
+}
+
+// CheckCABundle parses the caBundle and checks the validity of its
+// certificates at now
+func (i *Interceptor) CheckCABundle(now time.Time) *CABundleReport {
+	report := &CABundleReport{}
+	if i.Address.Scheme == "https" && len(i.ClientConfig.CaBundle) == 0 {
+		report.Warnings = append(report.Warnings, "the address uses https but no caBundle is set, EventListeners will fail to verify the certificate")
+		return report
+	}
+
+	rest := i.ClientConfig.CaBundle
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("caBundle contains an invalid certificate: %v", err))
+			continue
+		}
+		report.Subjects = append(report.Subjects, cert.Subject.String())
+		if report.NotAfter.IsZero() || cert.NotAfter.Before(report.NotAfter) {
+			report.NotAfter = cert.NotAfter
+		}
+		switch {
+		case now.After(cert.NotAfter):
+			report.Warnings = append(report.Warnings, fmt.Sprintf("certificate %s expired on %s", cert.Subject, cert.NotAfter.Format(time.RFC3339)))
+		case now.Before(cert.NotBefore):
+			report.Warnings = append(report.Warnings, fmt.Sprintf("certificate %s is not valid before %s", cert.Subject, cert.NotBefore.Format(time.RFC3339)))
+		case cert.NotAfter.Sub(now) < certExpiryWarning:
+			report.Warnings = append(report.Warnings, fmt.Sprintf("certificate %s expires on %s", cert.Subject, cert.NotAfter.Format(time.RFC3339)))
+		}
+	}
+	if len(i.ClientConfig.CaBundle) != 0 && len(report.Subjects) == 0 && len(report.Warnings) == 0 {
+		report.Warnings = append(report.Warnings, "caBundle does not contain any PEM encoded certificate")
+	}
+	return report
+}
+
+// PingResult is the outcome of the handshake with an interceptor
+type PingResult struct {
+	StatusCode int
+	Duration   time.Duration
+	Response   *v1beta1.InterceptorResponse
+}
+
+// Ping sends req to the interceptor the way an EventListener does. Services
+// of the cluster are reached through the service proxy of the API server,
+// which does not verify the certificate of the interceptor, other addresses
+// are reached directly and verified with the caBundle.
+func (i *Interceptor) Ping(ctx context.Context, c *cli.Clients, req *v1beta1.InterceptorRequest) (*PingResult, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	var status int
+	var respBody []byte
+	if svc := i.ClientConfig.Service; svc != nil {
+		status, respBody, err = i.proxyPost(ctx, c, body)
+	} else {
+		status, respBody, err = i.post(ctx, body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PingResult{StatusCode: status, Duration: time.Since(start)}
+	if status != http.StatusOK {
+		return result, fmt.Errorf("interceptor answered with HTTP status %d: %s", status, strings.TrimSpace(string(respBody)))
+	}
+	resp := &v1beta1.InterceptorResponse{}
+	if err := json.Unmarshal(respBody, resp); err != nil {
+		return result, fmt.Errorf("interceptor answered with an invalid InterceptorResponse: %w", err)
+	}
+	result.Response = resp
+	return result, nil
+}
+
+func (i *Interceptor) proxyPost(ctx context.Context, c *cli.Clients, body []byte) (int, []byte, error) {
+	svc := i.ClientConfig.Service
+	port := i.Address.URL().Port()
+	var status int
+	raw, err := c.Kube.CoreV1().RESTClient().Post().
+		Namespace(svc.Namespace).
+		Resource("services").
+		Name(strings.Join([]string{i.Address.Scheme, svc.Name, port}, ":")).
+		SubResource("proxy").
+		Suffix(svc.Path).
+		SetHeader("Content-Type", "application/json").
+		Body(body).
+		Do(ctx).
+		StatusCode(&status).
+		Raw()
+	if status != 0 && status != http.StatusOK {
+		return status, raw, nil
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to reach service %s/%s through the API server: %w", svc.Namespace, svc.Name, err)
+	}
+	return status, raw, nil
+}
+
+func (i *Interceptor) post(ctx context.Context, body []byte) (int, []byte, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(i.ClientConfig.CaBundle) != 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(i.ClientConfig.CaBundle) {
+			return 0, nil, errors.New("caBundle does not contain any valid PEM encoded certificate")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	client := &http.Client{Transport: transport, Timeout: 30 * time.Second}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, i.Address.String(), bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		var certErr *tls.CertificateVerificationError
+		if errors.As(err, &certErr) {
+			return 0, nil, fmt.Errorf("TLS verification of %s failed: %v", i.Address, certErr.Err)
+		}
+		return 0, nil, fmt.Errorf("failed to reach %s: %w", i.Address, err)
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	return resp.StatusCode, raw, err
+}
+
+// SampleRequest returns the request sent by tkn interceptor ping, values of
+// params are decoded as JSON when possible
+func SampleRequest(ns, body string, headers, params map[string]string) *v1beta1.InterceptorRequest {
+	req := &v1beta1.InterceptorRequest{
+		Body:              body,
+		Header:            map[string][]string{"Content-Type": {"application/json"}},
+		InterceptorParams: map[string]interface{}{},
+		Context: &v1beta1.TriggerContext{
+			EventURL:  "http://tkn-interceptor-ping",
+			EventID:   "tkn-ping-" + strconv.FormatInt(time.Now().Unix(), 10),
+			TriggerID: fmt.Sprintf("namespaces/%s/triggers/tkn-ping", ns),
+		},
+	}
+	for k, v := range headers {
+		req.Header[k] = append(req.Header[k], v)
+	}
+	for k, v := range params {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(v), &decoded); err == nil {
+			req.InterceptorParams[k] = decoded
+		} else {
+			req.InterceptorParams[k] = v
+		}
+	}
+	return req
+}
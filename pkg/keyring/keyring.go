@@ -0,0 +1,139 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package keyring keeps the tokens tkn authenticates with in the keychain of
+// the operating system rather than in plaintext configuration, through the
+// docker credential helpers (osxkeychain, wincred, secretservice, pass...)
+package keyring
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/docker/docker-credential-helpers/client"
+	"github.com/docker/docker-credential-helpers/credentials"
+	"github.com/tektoncd/cli/pkg/config"
+)
+
+const (
+	// Results is the service the token of the Tekton Results API is kept under
+	Results = "results"
+	// Hub is the service the token used to contribute to the Tekton Hub
+	// catalog is kept under
+	Hub = "hub"
+
+	registryPrefix = "registry/"
+	serverPrefix   = "tkn://"
+	username       = "tkn"
+)
+
+// ErrNotFound is returned when no token is stored for a service
+var ErrNotFound = errors.New("no token stored in the keychain")
+
+// Keyring stores tokens by service
+type Keyring interface {
+	Set(service, token string) error
+	Get(service string) (string, error)
+	Delete(service string) error
+}
+
+// Open returns the keyring of profile, it is a variable so that tests can
+// replace the keychain of the machine
+var Open = func(profile config.Profile) (Keyring, error) {
+	helper := profile.CredentialsHelper
+	if helper == "" {
+		helper = DefaultHelper()
+	}
+	return New(client.NewShellProgramFunc("docker-credential-" + helper)), nil
+}
+
+// DefaultHelper is the name of the credential helper backed by the keychain
+// of the operating system
+func DefaultHelper() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "osxkeychain"
+	case "windows":
+		return "wincred"
+	default:
+		return "secretservice"
+	}
+}
+
+// Registry is the service the token of an image registry is kept under
+func Registry(host string) string {
+	return registryPrefix + host
+}
+
+// ValidateService checks that tokens can be stored for service
+func ValidateService(service string) error {
+	switch {
+	case service == Results, service == Hub:
+		return nil
+	case strings.HasPrefix(service, registryPrefix) && len(service) > len(registryPrefix):
+		return nil
+	}
+	return fmt.Errorf("invalid service %q, use %s, %s or %sHOST", service, Results, Hub, registryPrefix)
+}
+
+// Lookup returns the token stored for service in the keyring of profile, an
+// empty token is returned when there is none or the keychain can't be used
+func Lookup(profile config.Profile, service string) string {
+	kr, err := Open(profile)
+	if err != nil {
+		return ""
+	}
+	token, err := kr.Get(service)
+	if err != nil {
+		return ""
+	}
+	return token
+}
+
+type helperKeyring struct {
+	program client.ProgramFunc
+}
+
+// New returns a Keyring storing tokens through the credential helper program
+func New(program client.ProgramFunc) Keyring {
+	return &helperKeyring{program: program}
+}
+
+func (k *helperKeyring) Set(service, token string) error {
+	return client.Store(k.program, &credentials.Credentials{
+		ServerURL: serverPrefix + service,
+		Username:  username,
+		Secret:    token,
+	})
+}
+
+func (k *helperKeyring) Get(service string) (string, error) {
+	creds, err := client.Get(k.program, serverPrefix+service)
+	if err != nil {
+		if credentials.IsErrCredentialsNotFound(err) {
+			return "", ErrNotFound
+		}
+		return "", err
+	}
+	return creds.Secret, nil
+}
+
+func (k *helperKeyring) Delete(service string) error {
+	if _, err := k.Get(service); err != nil {
+		return err
+	}
+	return client.Erase(k.program, serverPrefix+service)
+}
@@ -0,0 +1,156 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keyring
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/docker/docker-credential-helpers/client"
+	"github.com/docker/docker-credential-helpers/credentials"
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+// memoryHelper is a credential helper keeping the credentials in memory
+type memoryHelper map[string]*credentials.Credentials
+
+func (h memoryHelper) Add(c *credentials.Credentials) error {
+	h[c.ServerURL] = c
+	return nil
+}
+
+func (h memoryHelper) Delete(serverURL string) error {
+	if _, ok := h[serverURL]; !ok {
+		return credentials.NewErrCredentialsNotFound()
+	}
+	delete(h, serverURL)
+	return nil
+}
+
+func (h memoryHelper) Get(serverURL string) (string, string, error) {
+	c, ok := h[serverURL]
+	if !ok {
+		return "", "", credentials.NewErrCredentialsNotFound()
+	}
+	return c.Username, c.Secret, nil
+}
+
+func (h memoryHelper) List() (map[string]string, error) {
+	list := map[string]string{}
+	for url, c := range h {
+		list[url] = c.Username
+	}
+	return list, nil
+}
+
+// helperProgram runs the actions of the helper as the binary of a
+// credential helper would
+type helperProgram struct {
+	helper credentials.Helper
+	action string
+	in     io.Reader
+}
+
+func (p *helperProgram) Input(in io.Reader) {
+	p.in = in
+}
+
+func (p *helperProgram) Output() ([]byte, error) {
+	out := &bytes.Buffer{}
+	if err := credentials.HandleCommand(p.helper, p.action, p.in, out); err != nil {
+		return []byte(err.Error()), errors.New("exit status 1")
+	}
+	return out.Bytes(), nil
+}
+
+func programFunc(helper credentials.Helper) client.ProgramFunc {
+	return func(args ...string) client.Program {
+		return &helperProgram{helper: helper, action: args[0]}
+	}
+}
+
+func TestKeyring(t *testing.T) {
+	helper := memoryHelper{}
+	kr := New(programFunc(helper))
+
+	if _, err := kr.Get(Results); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	if err := kr.Set(Results, "s3cr3t"); err != nil {
+		t.Fatal(err)
+	}
+	if err := kr.Set(Registry("ghcr.io"), "r3g"); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, "tkn", helper["tkn://results"].Username)
+
+	token, err := kr.Get(Results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, "s3cr3t", token)
+
+	if err := kr.Delete(Results); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kr.Get(Results); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if err := kr.Delete(Results); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	token, err = kr.Get(Registry("ghcr.io"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, "r3g", token)
+}
+
+func TestLookup(t *testing.T) {
+	open := Open
+	defer func() { Open = open }()
+
+	helper := memoryHelper{}
+	Open = func(config.Profile) (Keyring, error) {
+		return New(programFunc(helper)), nil
+	}
+	test.AssertOutput(t, "", Lookup(config.Profile{}, Hub))
+
+	helper["tkn://hub"] = &credentials.Credentials{ServerURL: "tkn://hub", Username: "tkn", Secret: "gh-token"}
+	test.AssertOutput(t, "gh-token", Lookup(config.Profile{}, Hub))
+
+	Open = func(config.Profile) (Keyring, error) {
+		return nil, errors.New("no keychain")
+	}
+	test.AssertOutput(t, "", Lookup(config.Profile{}, Hub))
+}
+
+func TestValidateService(t *testing.T) {
+	for _, service := range []string{"results", "hub", "registry/ghcr.io"} {
+		if err := ValidateService(service); err != nil {
+			t.Errorf("unexpected error for %s: %v", service, err)
+		}
+	}
+	for _, service := range []string{"", "github", "registry/"} {
+		if err := ValidateService(service); err == nil {
+			t.Errorf("expected an error for %q", service)
+		}
+	}
+}
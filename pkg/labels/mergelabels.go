@@ -19,10 +19,22 @@ import (
 	"strings"
 )
 
-const invalidLabel = "invalid input format for label parameter: "
+const (
+	invalidLabel      = "invalid input format for label parameter: "
+	invalidAnnotation = "invalid input format for annotation parameter: "
+)
 
 func MergeLabels(l map[string]string, optLabel []string) (map[string]string, error) {
-	labels, err := parseLabels(optLabel)
+	return merge(l, optLabel, invalidLabel)
+}
+
+// MergeAnnotations sets the annotations given as key=value on a
+func MergeAnnotations(a map[string]string, optAnnotation []string) (map[string]string, error) {
+	return merge(a, optAnnotation, invalidAnnotation)
+}
+
+func merge(l map[string]string, opt []string, invalid string) (map[string]string, error) {
+	labels, err := parse(opt, invalid)
 	if err != nil {
 		return nil, err
 	}
@@ -41,11 +53,15 @@ func MergeLabels(l map[string]string, optLabel []string) (map[string]string, err
 }
 
 func parseLabels(p []string) (map[string]string, error) {
+	return parse(p, invalidLabel)
+}
+
+func parse(p []string, invalid string) (map[string]string, error) {
 	labels := map[string]string{}
 	for _, v := range p {
 		r := strings.SplitN(v, "=", 2)
 		if len(r) != 2 {
-			return nil, errors.New(invalidLabel + v)
+			return nil, errors.New(invalid + v)
 		}
 		labels[r[0]] = r[1]
 	}
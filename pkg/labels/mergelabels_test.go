@@ -108,3 +108,23 @@ func Test_parseLabels(t *testing.T) {
 		})
 	}
 }
+
+func Test_MergeAnnotations(t *testing.T) {
+	annotations, err := MergeAnnotations(nil, []string{"cost-center=payments", "ticket=PAY-1=2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, map[string]string{"cost-center": "payments", "ticket": "PAY-1=2"}, annotations)
+
+	annotations, err = MergeAnnotations(annotations, []string{"cost-center=billing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, map[string]string{"cost-center": "billing", "ticket": "PAY-1=2"}, annotations)
+
+	_, err = MergeAnnotations(nil, []string{"ticket"})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	test.AssertOutput(t, "invalid input format for annotation parameter: ticket", err.Error())
+}
@@ -0,0 +1,454 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/pods"
+	"github.com/tektoncd/cli/pkg/trustedresources"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+// Formats of the archives logs can be written to
+const (
+	ArchiveTar = "tar"
+	ArchiveZip = "zip"
+)
+
+// metadataFile is the name of the file describing the run in the archive,
+// signatureFile the name of the file holding its signature, in base64 as
+// cosign verify-blob reads it
+const (
+	metadataFile  = "metadata.json"
+	signatureFile = "metadata.json.sig"
+)
+
+// Archive writes the logs of each step to a file of a tar or zip archive.
+// The logs of a step are kept in memory until the step ends, the archive is
+// written as it goes so that no temporary directory is needed.
+type Archive struct {
+	logType string
+	modTime time.Time
+	tw      *tar.Writer
+	zw      *zip.Writer
+	// steps holds the logs of the steps which have not ended yet
+	steps map[string]*bytes.Buffer
+	// written counts the files written for each step, a step which is
+	// retried has one file per attempt
+	written map[string]int
+	// marks finds the bookmarks of the steps which have not ended yet
+	marks map[string]*bookmarker
+	// bookmarks are the bookmarks of the files written
+	bookmarks map[string][]Bookmark
+	// digests are the SHA-256 digests of the files written
+	digests map[string]string
+	// signer signs the metadata, with the digests of the files, when the
+	// archive is signed
+	signer   signature.Signer
+	identity *trustedresources.Identity
+}
+
+// NewArchive returns an Archive of the given format written to w, the files
+// are dated modTime
+func NewArchive(format, logType string, w io.Writer, modTime time.Time) (*Archive, error) {
+	a := &Archive{
+		logType:   logType,
+		modTime:   modTime,
+		steps:     map[string]*bytes.Buffer{},
+		written:   map[string]int{},
+		marks:     map[string]*bookmarker{},
+		bookmarks: map[string][]Bookmark{},
+		digests:   map[string]string{},
+	}
+	switch format {
+	case ArchiveTar:
+		a.tw = tar.NewWriter(w)
+	case ArchiveZip:
+		a.zw = zip.NewWriter(w)
+	default:
+		return nil, fmt.Errorf("invalid archive format %q, use %s or %s", format, ArchiveTar, ArchiveZip)
+	}
+	return a, nil
+}
+
+// StepFile is the path of the file holding the logs of a step in the
+// archive
+func StepFile(logType, task, step string) string {
+	if logType == LogTypeTask {
+		return step + ".log"
+	}
+	return task + "/" + step + ".log"
+}
+
+// Write adds the logs to the archive, errors are written to the error
+// stream of s as they are received
+func (a *Archive) Write(s *cli.Stream, logC <-chan Log, errC <-chan error) error {
+	for logC != nil || errC != nil {
+		select {
+		case l, ok := <-logC:
+			if !ok {
+				logC = nil
+				continue
+			}
+			if l.Notice {
+				fmt.Fprintln(s.Err, l.Log)
+				continue
+			}
+			switch l.Log {
+			case "FINALLYLOG":
+				continue
+			case "EOFLOG":
+				if err := a.endStep(StepFile(a.logType, l.Task, l.Step)); err != nil {
+					return err
+				}
+				continue
+			}
+
+			name := StepFile(a.logType, l.Task, l.Step)
+			buf, ok := a.steps[name]
+			if !ok {
+				buf = &bytes.Buffer{}
+				a.steps[name] = buf
+				a.marks[name] = &bookmarker{}
+			}
+			a.marks[name].add(l.Log)
+			buf.WriteString(l.Log)
+			buf.WriteByte('\n')
+		case e, ok := <-errC:
+			if !ok {
+				errC = nil
+				continue
+			}
+			fmt.Fprintf(s.Err, "%s\n", e)
+		}
+	}
+	return nil
+}
+
+func (a *Archive) endStep(name string) error {
+	buf, ok := a.steps[name]
+	if !ok {
+		return nil
+	}
+	delete(a.steps, name)
+	marks := a.marks[name]
+	delete(a.marks, name)
+
+	file := name
+	if n := a.written[name]; n > 0 {
+		// later attempts of a retried step
+		file = fmt.Sprintf("%s.%d.log", name[:len(name)-len(".log")], n)
+	}
+	a.written[name]++
+	if len(marks.bookmarks) > 0 {
+		a.bookmarks[file] = marks.bookmarks
+	}
+	return a.writeFile(file, buf.Bytes())
+}
+
+// SignWith signs the archive with the private key of keyFile or with the
+// KMS key kmsKey, the identity of the key and of its certificate in certFile
+// being added to the metadata
+func (a *Archive) SignWith(keyFile, kmsKey, certFile string) error {
+	signer, err := trustedresources.LoadSigner(keyFile, kmsKey)
+	if err != nil {
+		return err
+	}
+	identity, err := trustedresources.SignerIdentity(signer, kmsKey, certFile)
+	if err != nil {
+		return err
+	}
+	a.SetSigner(signer, identity)
+	return nil
+}
+
+// SetSigner signs the archive with signer, whose identity is added to the
+// metadata
+func (a *Archive) SetSigner(signer signature.Signer, identity *trustedresources.Identity) {
+	a.signer = signer
+	a.identity = identity
+}
+
+func (a *Archive) writeFile(name string, content []byte) error {
+	a.digests[name] = fmt.Sprintf("sha256:%x", sha256.Sum256(content))
+	if a.tw != nil {
+		hdr := &tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			ModTime:  a.modTime,
+		}
+		if err := a.tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		_, err := a.tw.Write(content)
+		return err
+	}
+
+	w, err := a.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: a.modTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
+
+// Close writes the logs of the steps which did not end, then the metadata
+// as metadata.json with the bookmarks of the logs of the steps, and closes
+// the archive. A signed archive has the digests of its files in the
+// metadata, and the signature of metadata.json in metadata.json.sig.
+func (a *Archive) Close(metadata *Metadata) error {
+	names := make([]string, 0, len(a.steps))
+	for name := range a.steps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := a.endStep(name); err != nil {
+			return err
+		}
+	}
+
+	if metadata != nil {
+		for i := range metadata.Tasks {
+			for j := range metadata.Tasks[i].Steps {
+				step := &metadata.Tasks[i].Steps[j]
+				step.Bookmarks = a.bookmarks[step.File]
+			}
+		}
+		if a.signer != nil {
+			if metadata.Producer == nil {
+				metadata.Producer = &Producer{}
+			}
+			metadata.Producer.Signer = a.identity
+			metadata.Files = a.digests
+		}
+		b, err := json.MarshalIndent(metadata, "", "  ")
+		if err != nil {
+			return err
+		}
+		b = append(b, '\n')
+		if err := a.writeFile(metadataFile, b); err != nil {
+			return err
+		}
+		if a.signer != nil {
+			sig, err := a.signer.SignMessage(bytes.NewReader(b))
+			if err != nil {
+				return fmt.Errorf("failed to sign the archive: %w", err)
+			}
+			if err := a.writeFile(signatureFile, []byte(base64.StdEncoding.EncodeToString(sig))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if a.tw != nil {
+		return a.tw.Close()
+	}
+	return a.zw.Close()
+}
+
+// Metadata describes the run whose logs are archived
+type Metadata struct {
+	Kind           string         `json:"kind"`
+	Name           string         `json:"name"`
+	Namespace      string         `json:"namespace"`
+	Status         string         `json:"status,omitempty"`
+	StartTime      *metav1.Time   `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time   `json:"completionTime,omitempty"`
+	Duration       string         `json:"duration,omitempty"`
+	Tasks          []TaskMetadata `json:"tasks"`
+	// Producer is what wrote the archive
+	Producer *Producer `json:"producer,omitempty"`
+	// Files are the SHA-256 digests of the files of a signed archive, by
+	// name
+	Files map[string]string `json:"files,omitempty"`
+}
+
+// Producer is what wrote an archive, for its verifiers to audit who produced
+// it
+type Producer struct {
+	Tool    string `json:"tool,omitempty"`
+	Version string `json:"version,omitempty"`
+	// Cluster is the address of the API server the logs were read from
+	Cluster string `json:"cluster,omitempty"`
+	// Signer identifies the key which signed a signed archive
+	Signer *trustedresources.Identity `json:"signer,omitempty"`
+}
+
+// clusterParams is implemented by the Params which know the API server
+// their clients talk to
+type clusterParams interface {
+	Cluster() string
+}
+
+// NewProducer returns tkn of the given version as the producer of an
+// archive of the logs read with p
+func NewProducer(p cli.Params, version string) *Producer {
+	producer := &Producer{Tool: "tkn", Version: version}
+	if cp, ok := p.(clusterParams); ok {
+		producer.Cluster = cp.Cluster()
+	}
+	return producer
+}
+
+// TaskMetadata describes a TaskRun whose logs are archived
+type TaskMetadata struct {
+	Name           string         `json:"name"`
+	TaskRun        string         `json:"taskRun"`
+	Status         string         `json:"status,omitempty"`
+	StartTime      *metav1.Time   `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time   `json:"completionTime,omitempty"`
+	Duration       string         `json:"duration,omitempty"`
+	Steps          []StepMetadata `json:"steps"`
+}
+
+// StepMetadata describes a step whose logs are archived
+type StepMetadata struct {
+	Name           string       `json:"name"`
+	File           string       `json:"file"`
+	Status         string       `json:"status,omitempty"`
+	ExitCode       *int32       `json:"exitCode,omitempty"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	Duration       string       `json:"duration,omitempty"`
+	// Bookmarks are the groups, failures and stack traces found in the
+	// logs of the step, to jump to them in long logs
+	Bookmarks []Bookmark `json:"bookmarks,omitempty"`
+}
+
+// PipelineRunMetadata describes a PipelineRun and its TaskRuns, in the
+// order of its child references
+func PipelineRunMetadata(c *cli.Clients, pr *v1.PipelineRun) (*Metadata, error) {
+	m := &Metadata{
+		Kind:           "PipelineRun",
+		Name:           pr.Name,
+		Namespace:      pr.Namespace,
+		Status:         reason(pr.Status.GetCondition(apis.ConditionSucceeded)),
+		StartTime:      pr.Status.StartTime,
+		CompletionTime: pr.Status.CompletionTime,
+		Duration:       duration(pr.Status.StartTime, pr.Status.CompletionTime),
+		Tasks:          []TaskMetadata{},
+	}
+	for _, child := range pr.Status.ChildReferences {
+		if child.Kind != "TaskRun" {
+			continue
+		}
+		var tr *v1.TaskRun
+		err := actions.GetV1(taskrunGroupResource, c, child.Name, pr.Namespace, metav1.GetOptions{}, &tr)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		m.Tasks = append(m.Tasks, taskMetadata(LogTypePipeline, child.PipelineTaskName, tr))
+	}
+	return m, nil
+}
+
+// TaskRunMetadata describes a TaskRun
+func TaskRunMetadata(task string, tr *v1.TaskRun) *Metadata {
+	return &Metadata{
+		Kind:           "TaskRun",
+		Name:           tr.Name,
+		Namespace:      tr.Namespace,
+		Status:         reason(tr.Status.GetCondition(apis.ConditionSucceeded)),
+		StartTime:      tr.Status.StartTime,
+		CompletionTime: tr.Status.CompletionTime,
+		Duration:       duration(tr.Status.StartTime, tr.Status.CompletionTime),
+		Tasks:          []TaskMetadata{taskMetadata(LogTypeTask, task, tr)},
+	}
+}
+
+func taskMetadata(logType, task string, tr *v1.TaskRun) TaskMetadata {
+	tm := TaskMetadata{
+		Name:           task,
+		TaskRun:        tr.Name,
+		Status:         reason(tr.Status.GetCondition(apis.ConditionSucceeded)),
+		StartTime:      tr.Status.StartTime,
+		CompletionTime: tr.Status.CompletionTime,
+		Duration:       duration(tr.Status.StartTime, tr.Status.CompletionTime),
+		Steps:          []StepMetadata{},
+	}
+	for _, step := range tr.Status.Steps {
+		sm := StepMetadata{
+			Name: step.Name,
+			File: StepFile(logType, task, step.Name),
+		}
+		cs := pods.StatusOfState(step.Name, step.ContainerState, step.ImageID)
+		switch cs.State {
+		case pods.ContainerTerminated:
+			exitCode := cs.ExitCode
+			sm.Status = cs.Reason
+			sm.ExitCode = &exitCode
+			sm.StartTime = &metav1.Time{Time: cs.StartedAt}
+			sm.CompletionTime = &metav1.Time{Time: cs.FinishedAt}
+			sm.Duration = duration(sm.StartTime, sm.CompletionTime)
+		case pods.ContainerRunning:
+			sm.Status = "Running"
+			sm.StartTime = &metav1.Time{Time: cs.StartedAt}
+		case pods.ContainerWaiting:
+			sm.Status = cs.Reason
+		}
+		tm.Steps = append(tm.Steps, sm)
+	}
+	return tm
+}
+
+func reason(cond *apis.Condition) string {
+	if cond == nil {
+		return ""
+	}
+	if cond.Reason != "" {
+		return cond.Reason
+	}
+	switch cond.Status {
+	case corev1.ConditionTrue:
+		return "Succeeded"
+	case corev1.ConditionFalse:
+		return "Failed"
+	}
+	return "Running"
+}
+
+func duration(start, end *metav1.Time) string {
+	if start == nil || end == nil || start.IsZero() || end.IsZero() {
+		return ""
+	}
+	return end.Sub(start.Time).String()
+}
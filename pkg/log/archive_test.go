@@ -0,0 +1,177 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/trustedresources"
+	"gotest.tools/v3/assert"
+)
+
+func TestArchive_Write(t *testing.T) {
+	logC := make(chan Log, 10)
+	errC := make(chan error, 1)
+	logC <- Log{Task: "build", Step: "compile", Log: "attempt 0"}
+	logC <- Log{Task: "build", Step: "compile", Log: "EOFLOG"}
+	logC <- Log{Task: "build", Step: "compile", Log: "attempt 1"}
+	logC <- Log{Task: "build", Step: "compile", Log: "EOFLOG"}
+	logC <- Log{Log: "FINALLYLOG"}
+	logC <- Log{Task: "cleanup", Step: "rm", Log: "still running"}
+	errC <- errors.New("pod not found")
+	close(logC)
+	close(errC)
+
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	a, err := NewArchive(ArchiveTar, LogTypePipeline, out, time.Time{})
+	assert.NilError(t, err)
+	assert.NilError(t, a.Write(&cli.Stream{Out: out, Err: errOut}, logC, errC))
+	assert.NilError(t, a.Close(nil))
+	assert.Equal(t, "pod not found\n", errOut.String())
+
+	files := map[string]string{}
+	var names []string
+	tr := tar.NewReader(out)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NilError(t, err)
+		content, err := io.ReadAll(tr)
+		assert.NilError(t, err)
+		names = append(names, hdr.Name)
+		files[hdr.Name] = string(content)
+	}
+
+	assert.DeepEqual(t, []string{"build/compile.log", "build/compile.1.log", "cleanup/rm.log"}, names)
+	assert.Equal(t, "attempt 0\n", files["build/compile.log"])
+	assert.Equal(t, "attempt 1\n", files["build/compile.1.log"])
+	assert.Equal(t, "still running\n", files["cleanup/rm.log"])
+}
+
+func TestArchive_Close_bookmarks(t *testing.T) {
+	logC := make(chan Log, 10)
+	errC := make(chan error)
+	logC <- Log{Step: "test", Log: "##[group]Unit tests"}
+	logC <- Log{Step: "test", Log: "--- FAILED: TestParse (0.00s)"}
+	logC <- Log{Step: "test", Log: "##[endgroup]"}
+	logC <- Log{Step: "test", Log: "EOFLOG"}
+	logC <- Log{Step: "lint", Log: "no issues"}
+	close(logC)
+	close(errC)
+
+	out := &bytes.Buffer{}
+	a, err := NewArchive(ArchiveTar, LogTypeTask, out, time.Time{})
+	assert.NilError(t, err)
+	assert.NilError(t, a.Write(&cli.Stream{Out: out, Err: out}, logC, errC))
+	metadata := &Metadata{Kind: "TaskRun", Name: "tr-1", Tasks: []TaskMetadata{{
+		Name:  "unit",
+		Steps: []StepMetadata{{Name: "test", File: "test.log"}, {Name: "lint", File: "lint.log"}},
+	}}}
+	assert.NilError(t, a.Close(metadata))
+
+	assert.DeepEqual(t, metadata.Tasks[0].Steps[0].Bookmarks, []Bookmark{
+		{Line: 1, Kind: BookmarkGroup, Text: "Unit tests", EndLine: 3},
+		{Line: 2, Kind: BookmarkFailure, Text: "--- FAILED: TestParse (0.00s)"},
+	})
+	assert.Assert(t, metadata.Tasks[0].Steps[1].Bookmarks == nil)
+
+	tr := tar.NewReader(out)
+	var written string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NilError(t, err)
+		if hdr.Name == metadataFile {
+			b, err := io.ReadAll(tr)
+			assert.NilError(t, err)
+			written = string(b)
+		}
+	}
+	assert.Assert(t, strings.Contains(written, `"bookmarks": [`))
+}
+
+func TestArchive_Close_signed(t *testing.T) {
+	logC := make(chan Log, 10)
+	errC := make(chan error)
+	logC <- Log{Step: "test", Log: "ok"}
+	close(logC)
+	close(errC)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	sv, err := signature.LoadSignerVerifier(key, crypto.SHA256)
+	assert.NilError(t, err)
+	identity, err := trustedresources.SignerIdentity(sv, "awskms:///arn:aws:kms:us-east-1:111122223333:key/1234abcd", "")
+	assert.NilError(t, err)
+
+	out := &bytes.Buffer{}
+	a, err := NewArchive(ArchiveTar, LogTypeTask, out, time.Time{})
+	assert.NilError(t, err)
+	a.SetSigner(sv, identity)
+	assert.NilError(t, a.Write(&cli.Stream{Out: out, Err: out}, logC, errC))
+	metadata := &Metadata{Kind: "TaskRun", Name: "tr-1", Producer: &Producer{Tool: "tkn", Version: "v0.40.0"}}
+	assert.NilError(t, a.Close(metadata))
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(out)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NilError(t, err)
+		files[hdr.Name], err = io.ReadAll(tr)
+		assert.NilError(t, err)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(string(files[signatureFile]))
+	assert.NilError(t, err)
+	assert.NilError(t, sv.VerifySignature(bytes.NewReader(sig), bytes.NewReader(files[metadataFile])))
+
+	var written Metadata
+	assert.NilError(t, json.Unmarshal(files[metadataFile], &written))
+	assert.DeepEqual(t, written.Producer, &Producer{Tool: "tkn", Version: "v0.40.0", Signer: identity})
+	assert.DeepEqual(t, written.Files, map[string]string{
+		"test.log": "sha256:dc51b8c96c2d745df3bd5590d990230a482fd247123599548e0632fdbf97fc22",
+	})
+}
+
+func TestNewArchive_invalid_format(t *testing.T) {
+	_, err := NewArchive("rar", LogTypeTask, &bytes.Buffer{}, time.Time{})
+	assert.Error(t, err, `invalid archive format "rar", use tar or zip`)
+}
+
+func TestStepFile(t *testing.T) {
+	assert.Equal(t, "compile.log", StepFile(LogTypeTask, "build", "compile"))
+	assert.Equal(t, "build/compile.log", StepFile(LogTypePipeline, "build", "compile"))
+}
@@ -0,0 +1,104 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Kinds of the bookmarks of the logs of a step
+const (
+	// BookmarkGroup is a section opened by a CI group marker, such as
+	// ##[group] or travis_fold:start
+	BookmarkGroup = "group"
+	// BookmarkFailure is a line reporting a failure, such as FAILED
+	BookmarkFailure = "failure"
+	// BookmarkStackTrace is the first line of a stack trace
+	BookmarkStackTrace = "stacktrace"
+)
+
+// maxBookmarkText is the length after which the text of a bookmark is cut
+const maxBookmarkText = 120
+
+// Bookmark is a line of the logs of a step worth jumping to
+type Bookmark struct {
+	// Line is the number of the line in the logs of the step, from 1
+	Line int    `json:"line"`
+	Kind string `json:"kind"`
+	Text string `json:"text"`
+	// EndLine is the line closing a group, it is 0 when the group is not
+	// closed
+	EndLine int `json:"endLine,omitempty"`
+}
+
+var (
+	// the markers opening and closing groups, of Azure Pipelines, GitHub
+	// Actions and Travis CI, which tools and scripts keep printing
+	groupStart = regexp.MustCompile(`(?:##\[group\]|::group::|travis_fold:start:)(.*)`)
+	groupEnd   = regexp.MustCompile(`##\[endgroup\]|::endgroup::|travis_fold:end:`)
+	failure    = regexp.MustCompile(`\bFAILED\b|##\[error\]|::error\b`)
+	stackTrace = regexp.MustCompile(`^(?:Traceback \(most recent call last\):|panic: |goroutine \d+ \[|Exception in thread "|Caused by: )`)
+	// ansiEscape matches the escape sequences Travis CI folds are written
+	// with
+	ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+)
+
+// bookmarker finds the bookmarks of the logs of a step, line by line
+type bookmarker struct {
+	line      int
+	bookmarks []Bookmark
+	// open are the indexes of the groups not closed yet, the innermost
+	// last
+	open []int
+}
+
+// add reads the next line of the logs
+func (b *bookmarker) add(line string) {
+	b.line++
+	line = strings.ReplaceAll(ansiEscape.ReplaceAllString(line, ""), "\r", " ")
+	switch {
+	case groupEnd.MatchString(line):
+		if n := len(b.open); n > 0 {
+			b.bookmarks[b.open[n-1]].EndLine = b.line
+			b.open = b.open[:n-1]
+		}
+	case groupStart.MatchString(line):
+		title := strings.TrimSpace(groupStart.FindStringSubmatch(line)[1])
+		b.open = append(b.open, len(b.bookmarks))
+		b.mark(BookmarkGroup, title)
+	case stackTrace.MatchString(line):
+		b.mark(BookmarkStackTrace, line)
+	case failure.MatchString(line):
+		b.mark(BookmarkFailure, line)
+	}
+}
+
+func (b *bookmarker) mark(kind, text string) {
+	text = strings.TrimSpace(text)
+	if len(text) > maxBookmarkText {
+		text = text[:maxBookmarkText] + "..."
+	}
+	b.bookmarks = append(b.bookmarks, Bookmark{Line: b.line, Kind: kind, Text: text})
+}
+
+// Bookmarks returns the bookmarks of the lines of logs of a step
+func Bookmarks(lines []string) []Bookmark {
+	b := &bookmarker{}
+	for _, l := range lines {
+		b.add(l)
+	}
+	return b.bookmarks
+}
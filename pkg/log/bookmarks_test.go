@@ -0,0 +1,58 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestBookmarks(t *testing.T) {
+	lines := []string{
+		"##[group]Install dependencies",
+		"npm install",
+		"::group::Audit",
+		"found 0 vulnerabilities",
+		"::endgroup::",
+		"##[endgroup]",
+		"travis_fold:start:test\r\x1b[0KRun the tests",
+		"--- FAILED: TestParse (0.00s)",
+		"FAILED tests/test_api.py::test_get - AssertionError",
+		"Traceback (most recent call last):",
+		`  File "app.py", line 3, in <module>`,
+		"panic: runtime error: index out of range [3] with length 3",
+		"goroutine 1 [running]:",
+		"travis_fold:end:test",
+		"##[group]" + strings.Repeat("x", 130),
+	}
+
+	assert.DeepEqual(t, Bookmarks(lines), []Bookmark{
+		{Line: 1, Kind: BookmarkGroup, Text: "Install dependencies", EndLine: 6},
+		{Line: 3, Kind: BookmarkGroup, Text: "Audit", EndLine: 5},
+		{Line: 7, Kind: BookmarkGroup, Text: "test Run the tests", EndLine: 14},
+		{Line: 8, Kind: BookmarkFailure, Text: "--- FAILED: TestParse (0.00s)"},
+		{Line: 9, Kind: BookmarkFailure, Text: "FAILED tests/test_api.py::test_get - AssertionError"},
+		{Line: 10, Kind: BookmarkStackTrace, Text: "Traceback (most recent call last):"},
+		{Line: 12, Kind: BookmarkStackTrace, Text: "panic: runtime error: index out of range [3] with length 3"},
+		{Line: 13, Kind: BookmarkStackTrace, Text: "goroutine 1 [running]:"},
+		{Line: 15, Kind: BookmarkGroup, Text: strings.Repeat("x", 120) + "..."},
+	})
+}
+
+func TestBookmarks_none(t *testing.T) {
+	assert.Assert(t, Bookmarks([]string{"compiling", "a test failed", "done"}) == nil)
+}
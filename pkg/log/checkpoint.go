@@ -0,0 +1,91 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"errors"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/tektoncd/cli/pkg/state"
+)
+
+// checkpointPrefix is the prefix of the keys of the checkpoints in the
+// state store, followed by the namespace and the name of the PipelineRun
+const checkpointPrefix = "checkpoints/logs"
+
+// openStateStore returns the store the checkpoints are kept in
+var openStateStore = state.Open
+
+// checkpoint records the TaskRuns of a PipelineRun whose logs were shown to
+// the end, for the logs to be resumed after them, a nil checkpoint records
+// nothing
+type checkpoint struct {
+	store state.Store
+	key   string
+	mu    sync.Mutex
+	shown map[string]bool
+}
+
+func openCheckpoint(ns, pipelineRun string) (*checkpoint, error) {
+	store, err := openStateStore()
+	if err != nil {
+		return nil, err
+	}
+	c := &checkpoint{
+		store: store,
+		key:   path.Join(checkpointPrefix, ns, pipelineRun),
+		shown: map[string]bool{},
+	}
+	b, err := store.Get(c.key)
+	if err != nil && !errors.Is(err, state.ErrNotFound) {
+		return nil, err
+	}
+	for _, tr := range strings.Fields(string(b)) {
+		c.shown[tr] = true
+	}
+	return c, nil
+}
+
+// done tells whether the logs of the TaskRun were shown to the end
+func (c *checkpoint) done(taskRun string) bool {
+	if c == nil {
+		return false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.shown[taskRun]
+}
+
+// record adds the TaskRun to the checkpoint once its logs were shown to the
+// end, a checkpoint which cannot be written must not stop the logs from
+// being shown so errors are ignored
+func (c *checkpoint) record(taskRun string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	c.shown[taskRun] = true
+	c.mu.Unlock()
+	_ = c.store.Update(c.key, func(old []byte) ([]byte, error) {
+		for _, tr := range strings.Fields(string(old)) {
+			if tr == taskRun {
+				return old, nil
+			}
+		}
+		return append(old, taskRun+"\n"...), nil
+	})
+}
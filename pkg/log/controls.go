@@ -0,0 +1,310 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/options"
+	"golang.org/x/term"
+)
+
+// Keys of the interactive controls of followed logs
+const (
+	KeyPause      = 'p'
+	KeyTimestamps = 't'
+	KeySteps      = 's'
+	KeyVerbosity  = 'v'
+	KeyHelp       = 'h'
+	// keyInterrupt is Ctrl-C, which the terminal does not turn into a
+	// signal while it reads keys one by one
+	keyInterrupt = 0x03
+)
+
+// Levels of verbosity of the notices of followed logs
+const (
+	// VerbosityQuiet drops the notices
+	VerbosityQuiet = iota
+	// VerbosityNormal shows the notices but for the failures of the watches
+	VerbosityNormal
+	// VerbosityVerbose shows all the notices
+	VerbosityVerbose
+)
+
+var verbosityNames = []string{"quiet", "normal", "verbose"}
+
+// KeysHelp describes the keys of the interactive controls
+const KeysHelp = "--- keys: p or space to pause and resume, t to toggle the timestamps, s to toggle the step filter, v to change the verbosity, h for this help ---"
+
+// noticesSize is the number of answers to keys waiting to be shown
+const noticesSize = 16
+
+// Controls change how followed logs are shown while they stream, from keys
+// read from the terminal. The reader reads the logs of all the steps with
+// their timestamps and the notices of every verbosity, the controls choose
+// which are shown.
+type Controls struct {
+	mu         sync.Mutex
+	paused     bool
+	timestamps bool
+	// steps are the steps shown while filtering, those given with --step or
+	// the step of the last line shown when the filter was turned on
+	steps     []string
+	filtering bool
+	verbosity int
+	// last is the step of the last line shown
+	last string
+	// continued tells which steps have their last line going on in the next
+	// Log, whose timestamp is at the start of the line
+	continued map[string]bool
+	notices   chan Log
+}
+
+// NewControls returns the controls of logs read with opts, showing what opts
+// ask for until a key is pressed
+func NewControls(opts *options.LogOptions) *Controls {
+	verbosity := VerbosityNormal
+	if opts.Verbose {
+		verbosity = VerbosityVerbose
+	}
+	return &Controls{
+		timestamps: opts.Timestamps,
+		steps:      opts.Steps,
+		filtering:  len(opts.Steps) != 0,
+		verbosity:  verbosity,
+		continued:  map[string]bool{},
+		notices:    make(chan Log, noticesSize),
+	}
+}
+
+// Apply sends the logs of logC through as the controls show them, the logs
+// are held while paused
+func (c *Controls) Apply(logC <-chan Log) <-chan Log {
+	out := make(chan Log)
+
+	go func() {
+		defer close(out)
+		for logC != nil {
+			in := logC
+			if c.isPaused() {
+				in = nil
+			}
+			select {
+			case n := <-c.notices:
+				out <- n
+			case l, ok := <-in:
+				if !ok {
+					logC = nil
+					continue
+				}
+				if l, ok := c.show(l); ok {
+					out <- l
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+func (c *Controls) isPaused() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.paused
+}
+
+// show returns the log as shown, false when it is hidden
+func (c *Controls) show(l Log) (Log, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if l.Notice {
+		if c.verbosity == VerbosityQuiet || l.Verbose && c.verbosity < VerbosityVerbose {
+			return l, false
+		}
+		return l, true
+	}
+	if l.Log == "FINALLYLOG" {
+		return l, true
+	}
+	if c.filtering && !containsStep(c.steps, l.Step) {
+		return l, false
+	}
+	if l.Log == "EOFLOG" {
+		return l, true
+	}
+
+	key := l.Task + "/" + l.Step
+	if !c.timestamps && !c.continued[key] {
+		if _, line, ok := splitTimestamp(l.Log); ok {
+			l.Log = line
+		}
+	}
+	c.continued[key] = l.Continued
+	c.last = l.Step
+	return l, true
+}
+
+func containsStep(steps []string, step string) bool {
+	for _, s := range steps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
+// Press changes the controls for a key, and answers with a notice
+func (c *Controls) Press(key rune) {
+	c.mu.Lock()
+	var answer string
+	switch unicode.ToLower(key) {
+	case KeyPause, ' ':
+		c.paused = !c.paused
+		answer = "resumed"
+		if c.paused {
+			answer = "paused, press p to resume"
+		}
+	case KeyTimestamps:
+		c.timestamps = !c.timestamps
+		answer = "timestamps hidden"
+		if c.timestamps {
+			answer = "timestamps shown"
+		}
+	case KeySteps:
+		answer = c.toggleFilter()
+	case KeyVerbosity:
+		c.verbosity = (c.verbosity + 1) % len(verbosityNames)
+		answer = "verbosity " + verbosityNames[c.verbosity]
+	case KeyHelp, '?':
+		c.mu.Unlock()
+		c.notify(KeysHelp)
+		return
+	default:
+		c.mu.Unlock()
+		return
+	}
+	c.mu.Unlock()
+	c.notify(fmt.Sprintf("--- %s ---", answer))
+}
+
+func (c *Controls) toggleFilter() string {
+	if c.filtering {
+		c.filtering = false
+		return "showing all the steps"
+	}
+	if len(c.steps) == 0 {
+		if c.last == "" {
+			return "no step to filter on yet"
+		}
+		c.steps = []string{c.last}
+	}
+	c.filtering = true
+	return "showing only the steps " + strings.Join(c.steps, ", ")
+}
+
+// notify queues a notice, it is dropped when too many are waiting
+func (c *Controls) notify(notice string) {
+	select {
+	case c.notices <- Log{Notice: true, Log: notice}:
+	default:
+	}
+}
+
+// ReadKeys reads the keys pressed on the terminal in and presses them until
+// in is closed or fails, Ctrl-C calls interrupt. The terminal is put in raw
+// mode, restore must be called to put it back, and the lines written to it
+// meanwhile must go through the stream returned.
+func (c *Controls) ReadKeys(in *os.File, s *cli.Stream, interrupt func()) (restore func(), raw *cli.Stream, err error) {
+	fd := int(in.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, nil, fmt.Errorf("the keys can only be read from a terminal")
+	}
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the keys from the terminal: %w", err)
+	}
+	var once sync.Once
+	restore = func() {
+		once.Do(func() { _ = term.Restore(fd, state) })
+	}
+
+	go func() {
+		buf := make([]byte, 1)
+		for {
+			if _, err := in.Read(buf); err != nil {
+				return
+			}
+			if buf[0] == keyInterrupt {
+				restore()
+				interrupt()
+				return
+			}
+			c.Press(rune(buf[0]))
+		}
+	}()
+
+	raw = &cli.Stream{In: s.In, Out: &rawWriter{w: s.Out}, Err: &rawWriter{w: s.Err}}
+	return restore, raw, nil
+}
+
+// StartControls reads the keys pressed on the terminal of the standard input
+// to control the logs of logC, the stream of opts is replaced by one writing
+// to the terminal in raw mode until stop is called
+func StartControls(opts *options.LogOptions, logC <-chan Log) (controlled <-chan Log, stop func(), err error) {
+	c := NewControls(opts)
+	restore, raw, err := c.ReadKeys(os.Stdin, opts.Stream, interrupt)
+	if err != nil {
+		return nil, nil, err
+	}
+	s := opts.Stream
+	opts.Stream = raw
+	c.notify(KeysHelp)
+
+	stop = func() {
+		restore()
+		opts.Stream = s
+	}
+	return c.Apply(logC), stop, nil
+}
+
+// interrupt signals tkn as Ctrl-C would out of raw mode, or exits as the
+// signal would where it cannot be sent
+func interrupt() {
+	if p, err := os.FindProcess(os.Getpid()); err == nil && p.Signal(os.Interrupt) == nil {
+		return
+	}
+	os.Exit(130)
+}
+
+// rawWriter writes the lines to a terminal in raw mode, which does not
+// return to the start of the line on a new line
+type rawWriter struct {
+	w io.Writer
+}
+
+func (r *rawWriter) Write(p []byte) (int, error) {
+	if _, err := r.w.Write(bytes.ReplaceAll(p, []byte("\n"), []byte("\r\n"))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
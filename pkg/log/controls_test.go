@@ -0,0 +1,115 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/options"
+	"gotest.tools/v3/assert"
+)
+
+const stamp = "2026-10-15T10:00:00.000000000Z "
+
+// controlled returns what the controls show of the logs, pressing the keys
+// given between them
+func controlled(c *Controls, steps ...interface{}) []string {
+	var got []string
+	for _, s := range steps {
+		switch s := s.(type) {
+		case rune:
+			c.Press(s)
+			select {
+			case n := <-c.notices:
+				got = append(got, n.Log)
+			default:
+			}
+		case Log:
+			if l, ok := c.show(s); ok {
+				got = append(got, l.Log)
+			}
+		}
+	}
+	return got
+}
+
+func TestControls(t *testing.T) {
+	c := NewControls(&options.LogOptions{})
+	got := controlled(c,
+		Log{Step: "build", Log: stamp + "compiling"},
+		KeyTimestamps,
+		Log{Step: "build", Log: stamp + "linking"},
+		KeyTimestamps,
+		Log{Step: "build", Log: stamp + "a long line", Continued: true},
+		Log{Step: "build", Log: " going on"},
+		KeySteps,
+		Log{Step: "test", Log: stamp + "PASS"},
+		Log{Step: "build", Log: stamp + "done"},
+		Log{Step: "test", Log: "EOFLOG"},
+		KeySteps,
+		Log{Step: "test", Log: stamp + "ok"},
+		Log{Notice: true, Verbose: true, Log: "--- watch failed ---"},
+		KeyVerbosity,
+		Log{Notice: true, Verbose: true, Log: "--- watch failed ---"},
+		KeyVerbosity,
+		Log{Notice: true, Log: "--- waiting ---"},
+		'x',
+		KeyHelp,
+	)
+
+	assert.DeepEqual(t, got, []string{
+		"compiling",
+		"--- timestamps shown ---",
+		stamp + "linking",
+		"--- timestamps hidden ---",
+		"a long line",
+		" going on",
+		"--- showing only the steps build ---",
+		"done",
+		"--- showing all the steps ---",
+		"ok",
+		"--- verbosity verbose ---",
+		"--- watch failed ---",
+		"--- verbosity quiet ---",
+		KeysHelp,
+	})
+}
+
+func TestControls_pause(t *testing.T) {
+	c := NewControls(&options.LogOptions{Steps: []string{"test"}, Timestamps: true})
+	logC := make(chan Log, 2)
+	out := c.Apply(logC)
+
+	c.Press(' ')
+	assert.Equal(t, (<-out).Log, "--- paused, press p to resume ---")
+	logC <- Log{Step: "build", Log: stamp + "compiling"}
+	logC <- Log{Step: "test", Log: stamp + "PASS"}
+	// the logs are held until resumed
+	c.Press(KeyPause)
+	assert.Equal(t, (<-out).Log, "--- resumed ---")
+	assert.Equal(t, (<-out).Log, stamp+"PASS")
+	close(logC)
+	_, ok := <-out
+	assert.Assert(t, !ok)
+}
+
+func TestRawWriter(t *testing.T) {
+	b := &bytes.Buffer{}
+	n, err := (&rawWriter{w: b}).Write([]byte("a\nb\n"))
+	assert.NilError(t, err)
+	assert.Equal(t, n, 4)
+	assert.Equal(t, b.String(), "a\r\nb\r\n")
+}
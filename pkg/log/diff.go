@@ -0,0 +1,298 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/pods"
+	"github.com/tektoncd/cli/pkg/pods/stream"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Operations of the lines of a diff
+const (
+	DiffEqual  = ' '
+	DiffDelete = '-'
+	DiffInsert = '+'
+)
+
+// maxDiffEdits bounds the work done to align two logs, logs which differ
+// by more lines are shown as entirely replaced
+const maxDiffEdits = 2000
+
+// diffContext is the number of unchanged lines shown around changes
+const diffContext = 3
+
+// DiffLine is a line of a diff
+type DiffLine struct {
+	Op   byte
+	Text string
+}
+
+// StepLogs are the lines of logs of a step
+type StepLogs struct {
+	Step  string
+	Lines []string
+}
+
+// AttemptPod returns the name of the pod of an attempt of the TaskRun, the
+// first attempt being 1 and the last one the current attempt
+func AttemptPod(tr *v1.TaskRun, attempt int) (string, error) {
+	attempts := len(tr.Status.RetriesStatus) + 1
+	if attempt < 1 || attempt > attempts {
+		return "", fmt.Errorf("TaskRun %s has no attempt %d, it has %d", tr.Name, attempt, attempts)
+	}
+	if attempt == attempts {
+		return tr.Status.PodName, nil
+	}
+	return tr.Status.RetriesStatus[attempt-1].PodName, nil
+}
+
+// PodSteps returns the status of the steps of the attempt of the TaskRun
+// run in the pod named podName, nil when no attempt of it was
+func PodSteps(tr *v1.TaskRun, podName string) []v1.StepState {
+	if tr.Status.PodName == podName {
+		return tr.Status.Steps
+	}
+	for _, retry := range tr.Status.RetriesStatus {
+		if retry.PodName == podName {
+			return retry.Steps
+		}
+	}
+	return nil
+}
+
+// PodStepLogs reads the logs of each step of a pod, in the order of the
+// steps, the containers of the steps being named with pods.StepNames from
+// the status of the steps
+func PodStepLogs(c *cli.Clients, streamer stream.NewStreamerFunc, ns, podName string, states []v1.StepState, prefixes []string) ([]StepLogs, error) {
+	if podName == "" {
+		return nil, fmt.Errorf("the pod is not available yet")
+	}
+	p := pods.New(podName, ns, c.Kube, streamer)
+	pod, err := c.Kube.CoreV1().Pods(ns).Get(context.Background(), podName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("pod %s has been deleted, its logs are not available", podName)
+		}
+		return nil, err
+	}
+
+	names := pods.NewStepNames(pod, states, prefixes)
+	var steps []StepLogs
+	for _, container := range pod.Spec.Containers {
+		name, ok := names.Name(container.Name)
+		if !ok {
+			continue
+		}
+		logC, errC, err := p.Container(container.Name).LogReader(false, false).Read()
+		if err != nil {
+			return nil, err
+		}
+		logs := StepLogs{Step: name}
+		for logC != nil || errC != nil {
+			select {
+			case l, ok := <-logC:
+				if !ok {
+					logC = nil
+					continue
+				}
+				logs.Lines = append(logs.Lines, l.Log)
+			case e, ok := <-errC:
+				if !ok {
+					errC = nil
+					continue
+				}
+				return nil, fmt.Errorf("failed to get the logs of step %s: %v", logs.Step, e)
+			}
+		}
+		steps = append(steps, logs)
+	}
+	return steps, nil
+}
+
+// PrintStepsDiff prints the diff of the logs of the steps of two pods, step
+// by step, with the changed lines and a few lines around them
+func PrintStepsDiff(w io.Writer, oldName, newName string, old, new []StepLogs) {
+	fmt.Fprintf(w, "%s\n%s\n", formatted.DecorateAttr("red", "--- "+oldName), formatted.DecorateAttr("green", "+++ "+newName))
+
+	var names []string
+	oldLines, newLines := map[string][]string{}, map[string][]string{}
+	for _, s := range old {
+		names = append(names, s.Step)
+		oldLines[s.Step] = s.Lines
+	}
+	for _, s := range new {
+		if _, ok := oldLines[s.Step]; !ok {
+			names = append(names, s.Step)
+		}
+		newLines[s.Step] = s.Lines
+	}
+
+	for _, name := range names {
+		lines := DiffLines(oldLines[name], newLines[name])
+		changed := false
+		for _, l := range lines {
+			changed = changed || l.Op != DiffEqual
+		}
+		if !changed {
+			fmt.Fprintf(w, "\n[%s] identical\n", name)
+			continue
+		}
+
+		fmt.Fprintf(w, "\n[%s]\n", name)
+		last := -1
+		for i, l := range lines {
+			if !nearChange(lines, i) {
+				continue
+			}
+			if last != -1 && i != last+1 {
+				fmt.Fprintln(w, formatted.DecorateAttr("cyan", "..."))
+			}
+			last = i
+			switch l.Op {
+			case DiffDelete:
+				fmt.Fprintln(w, formatted.DecorateAttr("red", "- "+l.Text))
+			case DiffInsert:
+				fmt.Fprintln(w, formatted.DecorateAttr("green", "+ "+l.Text))
+			default:
+				fmt.Fprintln(w, "  "+l.Text)
+			}
+		}
+	}
+}
+
+// nearChange tells whether a line is a change or is within the context of
+// one
+func nearChange(lines []DiffLine, i int) bool {
+	for j := i - diffContext; j <= i+diffContext; j++ {
+		if j >= 0 && j < len(lines) && lines[j].Op != DiffEqual {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffLines aligns the lines of a and b with the algorithm of Myers and
+// returns the lines to delete from a and insert to get b
+func DiffLines(a, b []string) []DiffLine {
+	// the common prefix and suffix are not part of the search
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var lines []DiffLine
+	for _, l := range a[:prefix] {
+		lines = append(lines, DiffLine{DiffEqual, l})
+	}
+	lines = append(lines, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, l := range a[len(a)-suffix:] {
+		lines = append(lines, DiffLine{DiffEqual, l})
+	}
+	return lines
+}
+
+func myers(a, b []string) []DiffLine {
+	n, m := len(a), len(b)
+	max := n + m
+	off := max + 1
+	v := make([]int, 2*max+3)
+	// trace holds v over [-d, d] before each round d, for backtracking
+	var trace [][]int
+	found := false
+	for d := 0; d <= max && d <= maxDiffEdits && !found; d++ {
+		trace = append(trace, append([]int(nil), v[off-d:off+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[off+k-1] < v[off+k+1]) {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[off+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		lines := make([]DiffLine, 0, n+m)
+		for _, l := range a {
+			lines = append(lines, DiffLine{DiffDelete, l})
+		}
+		for _, l := range b {
+			lines = append(lines, DiffLine{DiffInsert, l})
+		}
+		return lines
+	}
+
+	var reversed []DiffLine
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d]
+		at := func(k int) int { return prev[k+d] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			reversed = append(reversed, DiffLine{DiffEqual, a[x-1]})
+			x--
+			y--
+		}
+		if x == prevX {
+			reversed = append(reversed, DiffLine{DiffInsert, b[y-1]})
+			y--
+		} else {
+			reversed = append(reversed, DiffLine{DiffDelete, a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		reversed = append(reversed, DiffLine{DiffEqual, a[x-1]})
+		x--
+		y--
+	}
+
+	lines := make([]DiffLine, len(reversed))
+	for i, l := range reversed {
+		lines[len(reversed)-1-i] = l
+	}
+	return lines
+}
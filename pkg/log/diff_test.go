@@ -0,0 +1,70 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+func TestDiffLines(t *testing.T) {
+	testParams := []struct {
+		name string
+		a, b []string
+		want []DiffLine
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "identical",
+			a:    []string{"a", "b"},
+			b:    []string{"a", "b"},
+			want: []DiffLine{{DiffEqual, "a"}, {DiffEqual, "b"}},
+		},
+		{
+			name: "all inserted",
+			b:    []string{"a"},
+			want: []DiffLine{{DiffInsert, "a"}},
+		},
+		{
+			name: "all deleted",
+			a:    []string{"a"},
+			want: []DiffLine{{DiffDelete, "a"}},
+		},
+		{
+			name: "changed in the middle",
+			a:    []string{"a", "b", "c", "d"},
+			b:    []string{"a", "x", "c", "d"},
+			want: []DiffLine{{DiffEqual, "a"}, {DiffDelete, "b"}, {DiffInsert, "x"}, {DiffEqual, "c"}, {DiffEqual, "d"}},
+		},
+		{
+			name: "aligned on common lines",
+			a:    []string{"a", "b", "c", "a", "b", "b", "a"},
+			b:    []string{"c", "b", "a", "b", "a", "c"},
+			want: []DiffLine{
+				{DiffDelete, "a"}, {DiffDelete, "b"}, {DiffEqual, "c"}, {DiffInsert, "b"}, {DiffEqual, "a"},
+				{DiffEqual, "b"}, {DiffDelete, "b"}, {DiffEqual, "a"}, {DiffInsert, "c"},
+			},
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			test.AssertOutput(t, tp.want, DiffLines(tp.a, tp.b))
+		})
+	}
+}
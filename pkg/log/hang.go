@@ -0,0 +1,67 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/tektoncd/cli/pkg/pods"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultHangDumpCommands are run in the container of a hung step when the
+// profile does not configure any
+var DefaultHangDumpCommands = []string{"ps -ef"}
+
+// hangDumper runs the hang dump commands in the containers of hung steps,
+// it is shared by the clones of a reader
+type hangDumper struct {
+	kube     kubernetes.Interface
+	ns       string
+	commands []string
+	exec     pods.ExecFunc
+
+	once    sync.Once
+	allowed bool
+	err     error
+}
+
+// dump returns the lines printed by the hang dump commands run in the
+// container of a pod, or why they could not be run
+func (d *hangDumper) dump(pod, container string) []string {
+	d.once.Do(func() {
+		d.allowed, d.err = pods.CanExec(d.kube, d.ns)
+	})
+	if d.err != nil {
+		return []string{fmt.Sprintf("--- no hang dump taken, failed to check if exec is allowed: %v ---", d.err)}
+	}
+	if !d.allowed {
+		return []string{fmt.Sprintf("--- no hang dump taken, exec into pods of namespace %s is not allowed ---", d.ns)}
+	}
+
+	var lines []string
+	for _, command := range d.commands {
+		lines = append(lines, fmt.Sprintf("--- hang dump: %s ---", command))
+		out, err := d.exec(d.ns, pod, container, command)
+		if err != nil {
+			lines = append(lines, fmt.Sprintf("--- failed: %v ---", err))
+			continue
+		}
+		lines = append(lines, strings.Split(strings.TrimRight(string(out), "\n"), "\n")...)
+	}
+	return append(lines, "--- end of hang dump ---")
+}
@@ -0,0 +1,231 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IndexSchema creates the tables of a database of logs: the runs, their
+// steps and the lines of the logs of the steps, searchable with the full text
+// search of SQLite, e.g. WHERE logs MATCH 'timeout'
+const IndexSchema = `CREATE TABLE IF NOT EXISTS runs (
+  namespace TEXT NOT NULL,
+  name TEXT NOT NULL,
+  kind TEXT NOT NULL,
+  status TEXT,
+  start_time TEXT,
+  completion_time TEXT,
+  duration TEXT,
+  PRIMARY KEY (namespace, name)
+);
+CREATE TABLE IF NOT EXISTS steps (
+  namespace TEXT NOT NULL,
+  run TEXT NOT NULL,
+  task TEXT NOT NULL,
+  taskrun TEXT NOT NULL,
+  task_status TEXT,
+  step TEXT NOT NULL,
+  status TEXT,
+  exit_code INTEGER,
+  start_time TEXT,
+  completion_time TEXT,
+  duration TEXT
+);
+CREATE VIRTUAL TABLE IF NOT EXISTS logs USING fts5(
+  namespace UNINDEXED,
+  run UNINDEXED,
+  task UNINDEXED,
+  step UNINDEXED,
+  line_number UNINDEXED,
+  line
+);
+`
+
+// Index writes the SQL statements adding the logs of a run and its metadata
+// to a database of logs, run by the sqlite3 CLI. The run is indexed again
+// when it was already, in a single transaction.
+type Index struct {
+	w         *bufio.Writer
+	logType   string
+	namespace string
+	run       string
+	// task is the name the logs of a TaskRun are indexed under
+	task  string
+	lines map[string]int
+	// parts holds the beginning of the lines which go on in the next Log
+	parts map[string]*strings.Builder
+}
+
+// NewIndex returns an Index of the logs of the run of logType named run,
+// writing its statements to w
+func NewIndex(w io.Writer, logType, namespace, run string) *Index {
+	x := &Index{
+		w:         bufio.NewWriter(w),
+		logType:   logType,
+		namespace: namespace,
+		run:       run,
+		lines:     map[string]int{},
+		parts:     map[string]*strings.Builder{},
+	}
+	x.printf("BEGIN;\n%s", IndexSchema)
+	for _, table := range []string{"logs", "steps"} {
+		x.printf("DELETE FROM %s WHERE namespace = %s AND run = %s;\n", table, sqlString(namespace), sqlString(run))
+	}
+	x.printf("DELETE FROM runs WHERE namespace = %s AND name = %s;\n", sqlString(namespace), sqlString(run))
+	return x
+}
+
+func (x *Index) printf(format string, a ...interface{}) {
+	// the errors of the writer are returned by Close
+	_, _ = fmt.Fprintf(x.w, format, a...)
+}
+
+// Write adds the lines of the logs to the index, errors are written to the
+// error stream of s as they are received
+func (x *Index) Write(s *cli.Stream, logC <-chan Log, errC <-chan error) {
+	for logC != nil || errC != nil {
+		select {
+		case l, ok := <-logC:
+			if !ok {
+				logC = nil
+				continue
+			}
+			if l.Notice {
+				fmt.Fprintln(s.Err, l.Log)
+				continue
+			}
+			if l.Log == "EOFLOG" || l.Log == "FINALLYLOG" {
+				continue
+			}
+			x.add(l)
+		case e, ok := <-errC:
+			if !ok {
+				errC = nil
+				continue
+			}
+			fmt.Fprintf(s.Err, "%s\n", e)
+		}
+	}
+}
+
+func (x *Index) add(l Log) {
+	if x.logType == LogTypeTask {
+		x.task = l.Task
+	}
+	key := l.Task + "/" + l.Step
+	part, ok := x.parts[key]
+	if !ok {
+		part = &strings.Builder{}
+		x.parts[key] = part
+	}
+	part.WriteString(l.Log)
+	// a line which was split is indexed as a whole
+	if l.Continued {
+		return
+	}
+	line := part.String()
+	part.Reset()
+
+	x.lines[key]++
+	x.printf("INSERT INTO logs VALUES (%s, %s, %s, %s, %d, %s);\n",
+		sqlString(x.namespace), sqlString(x.run), sqlString(l.Task), sqlString(l.Step), x.lines[key], sqlString(line))
+}
+
+// Close adds the run and its steps described by metadata to the index and
+// commits it
+func (x *Index) Close(metadata *Metadata) error {
+	x.printf("INSERT INTO runs VALUES (%s, %s, %s, %s, %s, %s, %s);\n",
+		sqlString(x.namespace), sqlString(x.run), sqlString(metadata.Kind), sqlString(metadata.Status),
+		sqlTime(metadata.StartTime), sqlTime(metadata.CompletionTime), sqlString(metadata.Duration))
+	for _, t := range metadata.Tasks {
+		task := t.Name
+		if x.logType == LogTypeTask && x.task != "" {
+			task = x.task
+		}
+		for _, s := range t.Steps {
+			exitCode := "NULL"
+			if s.ExitCode != nil {
+				exitCode = strconv.Itoa(int(*s.ExitCode))
+			}
+			x.printf("INSERT INTO steps VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s);\n",
+				sqlString(x.namespace), sqlString(x.run), sqlString(task), sqlString(t.TaskRun), sqlString(t.Status),
+				sqlString(s.Name), sqlString(s.Status), exitCode, sqlTime(s.StartTime), sqlTime(s.CompletionTime), sqlString(s.Duration))
+		}
+	}
+	x.printf("COMMIT;\n")
+	return x.w.Flush()
+}
+
+// Lines returns the number of lines indexed
+func (x *Index) Lines() int {
+	n := 0
+	for _, lines := range x.lines {
+		n += lines
+	}
+	return n
+}
+
+// sqlString quotes s as a string literal of SQL, an empty string is NULL
+func sqlString(s string) string {
+	if s == "" {
+		return "NULL"
+	}
+	// the CLI reads the statements as text, which cannot hold NUL
+	s = strings.ReplaceAll(s, "\x00", "")
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func sqlTime(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return "NULL"
+	}
+	return sqlString(t.UTC().Format("2006-01-02T15:04:05Z"))
+}
+
+// LookSQLite returns the path of the sqlite3 CLI, with an error telling to
+// install it when it is not found, replaced in tests
+var LookSQLite = func() (string, error) {
+	path, err := exec.LookPath("sqlite3")
+	if err != nil {
+		return "", fmt.Errorf("the sqlite3 CLI is needed for the database of logs, install it or add it to the PATH: %w", err)
+	}
+	return path, nil
+}
+
+// SQLite runs the sqlite3 CLI on the database db with the statements read
+// from script and returns what it prints, replaced in tests
+var SQLite = func(db string, script io.Reader, options ...string) ([]byte, error) {
+	path, err := LookSQLite()
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(path, append(append([]string{"-batch", "-bail"}, options...), db)...)
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = script, out, errOut
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("sqlite3 failed on %s: %v: %s", db, err, strings.TrimSpace(errOut.String()))
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,96 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"gotest.tools/v3/assert"
+)
+
+func indexScript(t *testing.T, logs ...Log) string {
+	logC := make(chan Log, len(logs))
+	for _, l := range logs {
+		logC <- l
+	}
+	close(logC)
+	errC := make(chan error)
+	close(errC)
+
+	script := &bytes.Buffer{}
+	x := NewIndex(script, LogTypePipeline, "ns", "release")
+	x.Write(&cli.Stream{Err: &bytes.Buffer{}}, logC, errC)
+	assert.NilError(t, x.Close(&Metadata{Kind: "PipelineRun", Name: "release", Namespace: "ns", Status: "Failed"}))
+	assert.Equal(t, x.Lines(), 3)
+	return script.String()
+}
+
+func TestIndex(t *testing.T) {
+	script := indexScript(t,
+		Log{Task: "build", Step: "compile", Log: "a long", Continued: true},
+		Log{Task: "test", Step: "unit", Log: "FAIL: it's broken"},
+		Log{Task: "build", Step: "compile", Log: " line"},
+		Log{Task: "build", Step: "compile", Log: "EOFLOG"},
+		Log{Notice: true, Log: "--- waiting ---"},
+		Log{Task: "build", Step: "compile", Log: "done"},
+	)
+
+	i := strings.Index(script, "INSERT INTO logs")
+	assert.Equal(t, script[i:], strings.Join([]string{
+		"INSERT INTO logs VALUES ('ns', 'release', 'test', 'unit', 1, 'FAIL: it''s broken');",
+		"INSERT INTO logs VALUES ('ns', 'release', 'build', 'compile', 1, 'a long line');",
+		"INSERT INTO logs VALUES ('ns', 'release', 'build', 'compile', 2, 'done');",
+		"INSERT INTO runs VALUES ('ns', 'release', 'PipelineRun', 'Failed', NULL, NULL, NULL);",
+		"COMMIT;",
+		"",
+	}, "\n"))
+}
+
+func TestSQLite_notInstalled(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	_, err := SQLite(filepath.Join(t.TempDir(), "logs.db"), strings.NewReader("SELECT 1;"))
+	if err == nil || !strings.HasPrefix(err.Error(), "the sqlite3 CLI is needed for the database of logs, install it or add it to the PATH") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestIndex_sqlite(t *testing.T) {
+	if _, err := exec.LookPath("sqlite3"); err != nil {
+		t.Skip("sqlite3 is not installed")
+	}
+	script := indexScript(t,
+		Log{Task: "test", Step: "unit", Log: "FAIL: it's broken"},
+		Log{Task: "test", Step: "unit", Log: "ok"},
+		Log{Task: "build", Step: "compile", Log: "broken pipe"},
+	)
+
+	db := filepath.Join(t.TempDir(), "runs.db")
+	// indexing a run again replaces it
+	for i := 0; i < 2; i++ {
+		_, err := SQLite(db, strings.NewReader(script))
+		assert.NilError(t, err)
+	}
+	out, err := SQLite(db, strings.NewReader("SELECT task, line_number FROM logs WHERE logs MATCH 'broken' ORDER BY task;"), "-readonly", "-csv")
+	assert.NilError(t, err)
+	assert.Equal(t, string(out), "build,1\ntest,1\n")
+
+	_, err = SQLite(db, strings.NewReader("SELECT * FROM missing;"))
+	assert.ErrorContains(t, err, "no such table: missing")
+}
@@ -0,0 +1,99 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Events of the journal which are not watch events
+const (
+	JournalFollow  = "FOLLOW"
+	JournalDone    = "DONE"
+	JournalRelist  = "RELIST"
+	JournalTimeout = "TIMEOUT"
+	JournalHang    = "HANG"
+)
+
+// JournalEntry is a line of the journal of a follow session
+type JournalEntry struct {
+	Time time.Time `json:"time"`
+	// Session tells the entries of the sessions appended to the same
+	// journal apart
+	Session string `json:"session"`
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	// Event is the type of the watch event, ADDED, MODIFIED or DELETED, or
+	// one of the events of tkn like RELIST
+	Event   string `json:"event"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// journal appends the watch events received while following logs to a file
+// as JSON lines, a nil journal records nothing
+type journal struct {
+	mu      sync.Mutex
+	f       *os.File
+	enc     *json.Encoder
+	session string
+	now     func() time.Time
+}
+
+func openJournal(path string) (*journal, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the journal: %w", err)
+	}
+	now := time.Now
+	return &journal{
+		f:       f,
+		enc:     json.NewEncoder(f),
+		session: fmt.Sprintf("%d-%d", os.Getpid(), now().UnixNano()),
+		now:     now,
+	}, nil
+}
+
+// record appends an entry, a journal which cannot be written must not stop
+// the logs from being followed so errors are ignored
+func (j *journal) record(kind, name, event, status, message string) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	_ = j.enc.Encode(JournalEntry{
+		Time:    j.now().UTC(),
+		Session: j.session,
+		Kind:    kind,
+		Name:    name,
+		Event:   event,
+		Status:  status,
+		Message: message,
+	})
+}
+
+func (j *journal) close() error {
+	if j == nil {
+		return nil
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.f.Close()
+}
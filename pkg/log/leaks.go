@@ -0,0 +1,161 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/options"
+)
+
+// credential is a kind of secret recognized in the logs
+type credential struct {
+	kind string
+	re   *regexp.Regexp
+}
+
+// credentials are the secrets most often leaked by scripts printing their
+// environment or running with set -x, the patterns favour precision as a
+// leak may fail the run
+var credentials = []credential{
+	{"AWS access key", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"AWS secret access key", regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?[A-Za-z0-9/+=]{40}\b`)},
+	{"GitHub token", regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{"GitLab token", regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20}\b`)},
+	{"Slack token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{"private key", regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )*PRIVATE KEY-----`)},
+	{"JWT", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`)},
+}
+
+// Leak is a line of the logs of a step which likely holds a secret, the
+// secret itself is not kept
+type Leak struct {
+	Task string
+	Step string
+	// Line is the number of the line in the logs of the step, from 1
+	Line int
+	Kind string
+}
+
+// LeakScanner finds the lines of the logs passing through it which likely
+// hold a secret
+type LeakScanner struct {
+	mu    sync.Mutex
+	lines map[string]int
+	// continued tells which steps have their last line going on in the next
+	// Log, which is part of the same line
+	continued map[string]bool
+	leaks     []Leak
+	found     map[Leak]bool
+}
+
+// NewLeakScanner returns a LeakScanner which has found no leak yet
+func NewLeakScanner() *LeakScanner {
+	return &LeakScanner{lines: map[string]int{}, continued: map[string]bool{}, found: map[Leak]bool{}}
+}
+
+// Tee sends the logs of logC through, scanning them for secrets
+func (s *LeakScanner) Tee(logC <-chan Log) <-chan Log {
+	out := make(chan Log)
+
+	go func() {
+		defer close(out)
+		for l := range logC {
+			if l.Log != "EOFLOG" && l.Log != "FINALLYLOG" && !l.Notice {
+				s.scan(l)
+			}
+			out <- l
+		}
+	}()
+
+	return out
+}
+
+func (s *LeakScanner) scan(l Log) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := l.Task + "/" + l.Step
+	if !s.continued[key] {
+		s.lines[key]++
+	}
+	s.continued[key] = l.Continued
+
+	for _, c := range credentials {
+		if !c.re.MatchString(l.Log) {
+			continue
+		}
+		leak := Leak{Task: l.Task, Step: l.Step, Line: s.lines[key], Kind: c.kind}
+		// the parts of a long line are reported as one line
+		if s.found[leak] {
+			continue
+		}
+		s.found[leak] = true
+		s.leaks = append(s.leaks, leak)
+	}
+}
+
+// Leaks returns the lines found to likely hold a secret, in the order they
+// were read
+func (s *LeakScanner) Leaks() []Leak {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Leak{}, s.leaks...)
+}
+
+// ReportLeaks warns on the error stream about the lines of the logs of the
+// run of logType named name which likely hold a secret, unless opts are
+// silent, and returns an error when opts ask to fail on a leak
+func ReportLeaks(opts *options.LogOptions, s *LeakScanner, logType, name string) error {
+	leaks := s.Leaks()
+	if len(leaks) == 0 {
+		return nil
+	}
+
+	if !opts.Silent {
+		out := opts.Stream.Err
+		fmt.Fprintf(out, "\n%s\n", formatted.DecorateAttr("bold", "possible secret leaks:"))
+		for _, l := range leaks {
+			if logType == LogTypePipeline {
+				fmt.Fprintf(out, "[%s : %s] line %d: %s\n", l.Task, l.Step, l.Line, l.Kind)
+				continue
+			}
+			fmt.Fprintf(out, "[%s] line %d: %s\n", l.Step, l.Line, l.Kind)
+		}
+		fmt.Fprintln(out, "Rotate the secrets which were printed and keep them out of the logs, e.g. with set +x")
+	}
+
+	if !opts.FailOnLeak {
+		return nil
+	}
+	// a line may hold several kinds of secrets
+	lines := map[Leak]bool{}
+	for _, l := range leaks {
+		l.Kind = ""
+		lines[l] = true
+	}
+	unit := "lines"
+	if len(lines) == 1 {
+		unit = "line"
+	}
+	return &cli.ExitError{
+		Code: cli.ExitCodeLeak,
+		Err:  fmt.Errorf("the logs of %s %s likely leak secrets on %d %s", runKind(logType), name, len(lines), unit),
+	}
+}
@@ -21,6 +21,20 @@ const (
 	LogTypeTask     = "task"
 )
 
+// What happens when the activity timeout is reached while following logs
+const (
+	// OnTimeoutContinue keeps following the logs after the timeout marker
+	OnTimeoutContinue = "continue"
+	// OnTimeoutFail stops following the logs and fails
+	OnTimeoutFail = "fail"
+	// OnTimeoutCancelRun stops following the logs and cancels the run
+	OnTimeoutCancelRun = "cancel-run"
+)
+
+// ContinuationMarker ends the chunks of a line which was too long to be
+// read as a whole and goes on on the next line
+const ContinuationMarker = " [...]"
+
 var taskrunGroupResource = schema.GroupVersionResource{Group: "tekton.dev", Resource: "taskruns"}
 var pipelineRunGroupResource = schema.GroupVersionResource{Group: "tekton.dev", Resource: "pipelineruns"}
 var pipelineGroupResource = schema.GroupVersionResource{Group: "tekton.dev", Resource: "pipelines"}
@@ -31,4 +45,11 @@ type Log struct {
 	Task     string
 	Step     string
 	Log      string
+	// Continued is set when the line was split and goes on in the next Log
+	Continued bool
+	// Notice is set when Log is a message of tkn about the reading of the
+	// logs rather than a line of logs, it is written to the error stream
+	Notice bool
+	// Verbose is set on the notices only shown when verbose
+	Verbose bool
 }
@@ -15,11 +15,14 @@
 package log
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/pipe"
 	pipelinepkg "github.com/tektoncd/cli/pkg/pipeline"
 	pipelinerunpkg "github.com/tektoncd/cli/pkg/pipelinerun"
 	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
@@ -28,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
@@ -36,6 +40,7 @@ func (r *Reader) readPipelineLog() (<-chan Log, <-chan error, error) {
 	if err != nil {
 		return nil, nil, err
 	}
+	r.pipelineRun = pr
 
 	if !pr.IsDone() && r.follow {
 		return r.readLivePipelineLogs(pr)
@@ -52,30 +57,77 @@ func (r *Reader) readLivePipelineLogs(pr *v1.PipelineRun) (<-chan Log, <-chan er
 		defer close(errC)
 
 		prTracker := pipelinerunpkg.NewTracker(pr.Name, r.ns, r.clients)
+		prTracker.OnRelist = func(err error) {
+			r.relisted(logC, "PipelineRun", pr.Name, err)
+		}
+		prTracker.OnEvent = func(event string, pr *v1.PipelineRun) {
+			r.recordCondition("PipelineRun", pr.Name, event, pr.Status.GetCondition(apis.ConditionSucceeded))
+		}
 		trC := prTracker.Monitor(r.tasks)
 
 		wg := sync.WaitGroup{}
 		taskIndex := 0
+		finallyStarted := false
+		var buffered *sections
+		if r.buffered {
+			buffered = newSections(logC, errC)
+		}
 
 		for trs := range trC {
-			wg.Add(len(trs))
-
 			for _, run := range trs {
+				if r.checkpoint.done(run.Name) {
+					continue
+				}
+				if run.Finally {
+					if r.skipFinally {
+						continue
+					}
+					if !finallyStarted {
+						finallyStarted = true
+						finallyLog := Log{Pipeline: pr.Name, Log: "FINALLYLOG"}
+						if buffered != nil {
+							sec := buffered.add()
+							sec.addLog(finallyLog)
+							buffered.complete(sec)
+						} else {
+							logC <- finallyLog
+						}
+					}
+				}
+
+				// the sections are added in the order the TaskRuns start
+				var sec *section
+				if buffered != nil {
+					sec = buffered.add()
+				}
+				wg.Add(1)
 				taskIndex++
 				// NOTE: passing tr, taskIdx to avoid data race
 				go func(tr taskrunpkg.Run, taskNum int) {
 					defer wg.Done()
+					release := r.acquireStream()
+					defer release()
 
 					// clone the object to keep task number and name separately
 					c := r.clone()
 					c.setUpTask(taskNum, tr)
-					c.pipeLogs(logC, errC)
+					if sec == nil {
+						c.pipeLogs(logC, errC)
+						return
+					}
+					c.drainTaskLogs(sec.addLog, sec.addErr)
+					buffered.complete(sec)
 				}(run, taskIndex)
 			}
 		}
 
 		wg.Wait()
 
+		if prTracker.Deleted() {
+			errC <- fmt.Errorf("pipelinerun %s has been deleted while streaming logs", pr.Name)
+			return
+		}
+
 		if !empty(pr.Status) && pr.Status.Conditions[0].Status == corev1.ConditionFalse {
 			errC <- fmt.Errorf("%s", pr.Status.Conditions[0].Message)
 		}
@@ -94,6 +146,10 @@ func (r *Reader) readAvailablePipelineLogs(pr *v1.PipelineRun) (<-chan Log, <-ch
 		return nil, nil, err
 	}
 
+	if r.skipFinally {
+		ordered = taskrunpkg.WithoutFinally(ordered)
+	}
+
 	taskRuns := taskrunpkg.Filter(ordered, r.tasks)
 	if len(taskRuns) == 0 && len(r.tasks) != 0 {
 		availTasks := []string{}
@@ -103,6 +159,16 @@ func (r *Reader) readAvailablePipelineLogs(pr *v1.PipelineRun) (<-chan Log, <-ch
 		return nil, nil, fmt.Errorf("passed filtered tasks: %v is not available, available tasks are: %v", r.tasks, availTasks)
 	}
 
+	if r.checkpoint != nil {
+		var left []taskrunpkg.Run
+		for _, tr := range taskRuns {
+			if !r.checkpoint.done(tr.Name) {
+				left = append(left, tr)
+			}
+		}
+		taskRuns = left
+	}
+
 	logC := make(chan Log)
 	errC := make(chan error)
 
@@ -112,7 +178,12 @@ func (r *Reader) readAvailablePipelineLogs(pr *v1.PipelineRun) (<-chan Log, <-ch
 
 		// clone the object to keep task number and name separately
 		c := r.clone()
+		finallyStarted := false
 		for i, tr := range taskRuns {
+			if tr.Finally && !finallyStarted {
+				finallyStarted = true
+				logC <- Log{Pipeline: pr.Name, Log: "FINALLYLOG"}
+			}
 			c.setUpTask(i+1, tr)
 			c.pipeLogs(logC, errC)
 		}
@@ -156,18 +227,23 @@ func (r *Reader) waitUntilAvailable() error {
 			if err != nil {
 				return err
 			}
+			r.recordCondition("PipelineRun", run.Name, string(event.Type), run.Status.GetCondition(apis.ConditionSucceeded))
 			if run.IsDone() {
 				watchRun.Stop()
 				return nil
 			}
 			if first {
 				first = false
-				fmt.Fprintln(r.stream.Out, "Pipeline still running ...")
+				if !r.silent {
+					fmt.Fprintln(r.stream.Err, "Pipeline still running ...")
+				}
 			}
 		case <-time.After(r.activityTimeout):
 			watchRun.Stop()
 			if isPipelineRunRunning(run.Status.Conditions) {
-				fmt.Fprintln(r.stream.Out, "PipelineRun is still running:", run.Status.Conditions[0].Message)
+				if !r.silent {
+					fmt.Fprintln(r.stream.Err, "PipelineRun is still running:", run.Status.Conditions[0].Message)
+				}
 				return nil
 			}
 			if err = hasPipelineRunFailed(run.Status.Conditions); err != nil {
@@ -178,29 +254,48 @@ func (r *Reader) waitUntilAvailable() error {
 	}
 }
 
+func newStreamLimit(n int) chan struct{} {
+	if n <= 0 {
+		return nil
+	}
+	return make(chan struct{}, n)
+}
+
+// acquireStream waits until the logs of one more TaskRun can be followed
+// and returns the function releasing the stream
+func (r *Reader) acquireStream() func() {
+	if r.streams == nil {
+		return func() {}
+	}
+	r.streams <- struct{}{}
+	return func() { <-r.streams }
+}
+
 func (r *Reader) pipeLogs(logC chan<- Log, errC chan<- error) {
+	r.drainTaskLogs(func(l Log) { logC <- l }, func(e error) { errC <- e })
+}
+
+// drainTaskLogs reads the logs of the TaskRun of the reader until they end
+func (r *Reader) drainTaskLogs(onLog func(Log), onErr func(error)) {
 	tlogC, terrC, err := r.readTaskLog()
 	if err != nil {
-		errC <- err
+		onErr(err)
 		return
 	}
+	// tell which revision of a remote Task produced the logs which follow
+	if r.source != nil && !r.silent {
+		onLog(Log{Task: r.task, Notice: true, Log: fmt.Sprintf("--- task %s from %s ---", r.task, pipelinerunpkg.FormatSource(r.source))})
+	}
 
-	for tlogC != nil || terrC != nil {
-		select {
-		case l, ok := <-tlogC:
-			if !ok {
-				tlogC = nil
-				continue
-			}
-			logC <- Log{Task: l.Task, Step: l.Step, Log: l.Log}
-
-		case e, ok := <-terrC:
-			if !ok {
-				terrC = nil
-				continue
-			}
-			errC <- fmt.Errorf("failed to get logs for task %s : %s", r.task, e)
-		}
+	failed := false
+	pipe.Drain(context.Background(), tlogC, terrC, onLog,
+		func(e error) {
+			failed = true
+			onErr(fmt.Errorf("failed to get logs for task %s : %s", r.task, e))
+		},
+	)
+	if !failed && !r.timedOut.Load() {
+		r.checkpoint.record(r.run)
 	}
 }
 
@@ -209,17 +304,19 @@ func (r *Reader) setUpTask(taskNumber int, tr taskrunpkg.Run) {
 	r.setRun(tr.Name)
 	r.setTask(tr.Task)
 	r.setRetries(tr.Retries)
+	r.setMatrix(tr.Matrix)
 }
 
 // getOrderedTasks get Tasks in order from Spec.PipelineRef or Spec.PipelineSpec
 // and return trh.Run after converted taskruns into trh.Run.
 func (r *Reader) getOrderedTasks(pr *v1.PipelineRun) ([]taskrunpkg.Run, error) {
-	var tasks []v1.PipelineTask
+	var tasks, finally []v1.PipelineTask
 	switch {
 	case pr.Spec.PipelineRef != nil:
 		if pr.Spec.PipelineRef.Resolver != "" {
 			if pr.Status.PipelineSpec != nil {
 				tasks = append(tasks, pr.Status.PipelineSpec.Tasks...)
+				finally = pr.Status.PipelineSpec.Finally
 			} else {
 				return nil, fmt.Errorf("pipelinerun %s does not have the PipelineRunSpec", pr.Name)
 			}
@@ -229,11 +326,11 @@ func (r *Reader) getOrderedTasks(pr *v1.PipelineRun) ([]taskrunpkg.Run, error) {
 				return nil, err
 			}
 			tasks = pl.Spec.Tasks
-			tasks = append(tasks, pl.Spec.Finally...)
+			finally = pl.Spec.Finally
 		}
 	case pr.Spec.PipelineSpec != nil:
 		tasks = pr.Spec.PipelineSpec.Tasks
-		tasks = append(tasks, pr.Spec.PipelineSpec.Finally...)
+		finally = pr.Spec.PipelineSpec.Finally
 	default:
 		return nil, fmt.Errorf("pipelinerun %s did not provide PipelineRef or PipelineSpec", pr.Name)
 	}
@@ -244,7 +341,19 @@ func (r *Reader) getOrderedTasks(pr *v1.PipelineRun) ([]taskrunpkg.Run, error) {
 	}
 
 	// Sort taskruns, to display the taskrun logs as per pipeline tasks order
-	return taskrunpkg.SortTasksBySpecOrder(tasks, trsMap), nil
+	// and keep the finally ones in a separate section at the end
+	ordered := taskrunpkg.SortTasksBySpecOrder(append(tasks, finally...), trsMap)
+	finallyTasks := map[string]bool{}
+	for _, t := range finally {
+		finallyTasks[t.Name] = true
+	}
+	for i := range ordered {
+		ordered[i].Finally = finallyTasks[ordered[i].Task]
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return !ordered[i].Finally && ordered[j].Finally
+	})
+	return ordered, nil
 }
 
 func empty(status v1.PipelineRunStatus) bool {
@@ -0,0 +1,61 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReader_acquireStream(t *testing.T) {
+	r := &Reader{streams: newStreamLimit(2)}
+	c := r.clone()
+
+	var running, max int32
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(r *Reader) {
+			defer wg.Done()
+			release := r.acquireStream()
+			defer release()
+
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&max)
+				if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+		}([]*Reader{r, c}[i%2])
+	}
+	wg.Wait()
+
+	if max != 2 {
+		t.Errorf("expected at most 2 streams at the same time, got %d", max)
+	}
+}
+
+func TestReader_acquireStream_noLimit(t *testing.T) {
+	r := &Reader{streams: newStreamLimit(0)}
+	for i := 0; i < 100; i++ {
+		// would block if the streams were limited
+		r.acquireStream()
+	}
+}
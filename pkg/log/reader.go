@@ -16,12 +16,16 @@ package log
 
 import (
 	"fmt"
+	"path"
+	"sync/atomic"
 	"time"
 
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/options"
 	"github.com/tektoncd/cli/pkg/pods"
 	"github.com/tektoncd/cli/pkg/pods/stream"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"knative.dev/pkg/apis"
 )
 
 type Reader struct {
@@ -35,17 +39,102 @@ type Reader struct {
 	timestamps      bool
 	tasks           []string
 	steps           []string
+	containers      []string
 	logType         string
 	task            string
 	number          int
 	activityTimeout time.Duration
 	retries         int
+	skipFinally     bool
+	matrix          []string
+	resync          time.Duration
+	maxLineLength   int
+	// stepTimeout is how long the logs of a followed step may stay silent,
+	// 0 when they are never given up on
+	stepTimeout time.Duration
+	onTimeout   string
+	// hangThreshold is how long a followed step may stay silent before it
+	// is reported as hung, 0 when it is never reported
+	hangThreshold time.Duration
+	// hangDumper takes a hang dump of the hung steps, nil when it is not
+	// asked for
+	hangDumper *hangDumper
+	// excludedSteps are the patterns of the steps hidden unless all the
+	// steps are shown
+	excludedSteps []string
+	// stepPrefixes are the prefixes of the containers of the steps
+	// configured in the profile, tried before step-
+	stepPrefixes []string
+	// buffered writes the logs of the followed TaskRuns of a PipelineRun a
+	// TaskRun after the other once they are done
+	buffered bool
+	// verbose tells whether the failures of the watches are reported
+	verbose bool
+	// silent drops the progress messages
+	silent bool
+	// relists is shared by the clones of the reader and counts the times a
+	// watch failed and was listed again
+	relists *atomic.Int64
+	// journal is shared by the clones of the reader and records the watch
+	// events, nil when no journal is kept
+	journal *journal
+	// timedOut is shared by the clones of the reader and set once the
+	// activity timeout made it stop following logs
+	timedOut *atomic.Bool
+	// streams limits the number of TaskRuns whose logs are followed at the
+	// same time, it is nil when there is no limit
+	streams chan struct{}
+	// attempt is the only attempt of the TaskRun whose logs are read, from
+	// 1, 0 for all of them
+	attempt int
+	// excludeRetries skips the earlier attempts of a retried TaskRun
+	excludeRetries bool
+	// stopAtFailure stops following a TaskRun once its current attempt has
+	// a pod rather than waiting for the pods of its retries
+	stopAtFailure bool
+	// results captures the results of the followed TaskRuns from the
+	// containers of their steps, nil when they are not captured
+	results *resultsCapturer
+	// source is where the Task of the TaskRun being read came from, nil
+	// when it was not resolved from a remote source
+	source *v1.RefSource
+	// pipelineRun is the PipelineRun as it was when its logs started to be
+	// read, nil for a TaskRun
+	pipelineRun *v1.PipelineRun
+	// checkpoint is shared by the clones of the reader and records the
+	// TaskRuns whose logs were shown to the end, nil unless the logs are
+	// resumed
+	checkpoint *checkpoint
 }
 
 func NewReader(logType string, opts *options.LogOptions) (*Reader, error) {
-	streamer := pods.NewStream
-	if opts.Streamer != nil {
-		streamer = opts.Streamer
+	profile, err := opts.Params.Profile()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range profile.Logs.ExcludedStepPatterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q in logs.excludedStepPatterns of the tkn profile: %w", p, err)
+		}
+	}
+
+	streamer := opts.Streamer
+	var resync time.Duration
+	var maxLineLength int
+	if streamer == nil {
+		streamOpts, err := stream.ProfileOptions(profile.Stream)
+		if err != nil {
+			return nil, err
+		}
+		streamOpts.LogStream = opts.LogStream()
+		streamer = pods.NewStreamWithOptions(streamOpts)
+		if opts.StreamFrom != "" {
+			if streamer, err = stream.Open(opts.StreamFrom); err != nil {
+				return nil, err
+			}
+		}
+		resync = streamOpts.InformerResync
+		maxLineLength = streamOpts.MaxLineLength
 	}
 
 	cs, err := opts.Params.Clients()
@@ -61,6 +150,44 @@ func NewReader(logType string, opts *options.LogOptions) (*Reader, error) {
 		run = opts.TaskrunName
 	}
 
+	exec := opts.Exec
+	if exec == nil {
+		exec = pods.NewExecutor(opts.Params).Exec
+	}
+	var dumper *hangDumper
+	if opts.HangDump {
+		commands := profile.Logs.HangDumpCommands
+		if len(commands) == 0 {
+			commands = DefaultHangDumpCommands
+		}
+		dumper = &hangDumper{kube: cs.Kube, ns: opts.Params.Namespace(), commands: commands, exec: exec}
+	}
+	var results *resultsCapturer
+	if opts.CaptureResults && opts.Follow {
+		results = newResultsCapturer(cs.Kube, opts.Params.Namespace(), exec)
+	}
+
+	var j *journal
+	if opts.Journal != "" && opts.Follow {
+		if j, err = openJournal(opts.Journal); err != nil {
+			return nil, err
+		}
+	}
+
+	var cp *checkpoint
+	if opts.Resume && logType == LogTypePipeline {
+		if cp, err = openCheckpoint(opts.Params.Namespace(), run); err != nil {
+			return nil, fmt.Errorf("failed to open the checkpoint of the logs: %w", err)
+		}
+	}
+
+	// the controls of interactive logs choose the steps, the timestamps and
+	// the notices shown out of all of them
+	timestamps, steps, verbose := opts.Timestamps, opts.Steps, opts.Verbose
+	if opts.Interactive {
+		timestamps, steps, verbose = true, nil, true
+	}
+
 	at := 10 * time.Second
 	if opts.ActivityTimeout != 0 {
 		at = opts.ActivityTimeout
@@ -73,16 +200,39 @@ func NewReader(logType string, opts *options.LogOptions) (*Reader, error) {
 		streamer:        streamer,
 		stream:          opts.Stream,
 		follow:          opts.Follow,
-		timestamps:      opts.Timestamps,
+		timestamps:      timestamps,
 		allSteps:        opts.AllSteps,
 		tasks:           opts.Tasks,
-		steps:           opts.Steps,
+		steps:           steps,
+		containers:      opts.Containers,
 		logType:         logType,
 		activityTimeout: at,
+		stepTimeout:     opts.ActivityTimeout,
+		onTimeout:       opts.OnTimeout,
+		hangThreshold:   opts.HangThreshold,
+		hangDumper:      dumper,
+		excludedSteps:   profile.Logs.ExcludedStepPatterns,
+		stepPrefixes:    profile.Logs.StepPrefixes,
+		buffered:        opts.Buffered && opts.Stream != nil && !isTerminal(opts.Stream.Out),
+		timedOut:        &atomic.Bool{},
+		verbose:         verbose,
+		silent:          opts.Silent,
+		relists:         &atomic.Int64{},
+		journal:         j,
+		skipFinally:     opts.SkipFinally,
+		attempt:         opts.Attempt,
+		excludeRetries:  opts.ExcludeRetries,
+		stopAtFailure:   opts.StopAtFailedAttempt,
+		results:         results,
+		resync:          resync,
+		maxLineLength:   maxLineLength,
+		streams:         newStreamLimit(opts.MaxConcurrentStreams),
+		checkpoint:      cp,
 	}, nil
 }
 
 func (r *Reader) Read() (<-chan Log, <-chan error, error) {
+	r.journal.record(r.logKind(), r.run, JournalFollow, "", "")
 	switch r.logType {
 	case LogTypePipeline:
 		return r.readPipelineLog()
@@ -92,6 +242,62 @@ func (r *Reader) Read() (<-chan Log, <-chan error, error) {
 	return nil, nil, fmt.Errorf("unknown log type")
 }
 
+// Close records the end of the follow session in the journal and closes it
+func (r *Reader) Close() error {
+	r.journal.record(r.logKind(), r.run, JournalDone, "", "")
+	return r.journal.close()
+}
+
+// recordCondition records a watch event of a run with its condition in the
+// journal
+func (r *Reader) recordCondition(kind, name, event string, c *apis.Condition) {
+	if c == nil {
+		r.journal.record(kind, name, event, "", "")
+		return
+	}
+	status := c.Reason
+	if status == "" {
+		status = string(c.Status)
+	}
+	r.journal.record(kind, name, event, status, c.Message)
+}
+
+func (r *Reader) logKind() string {
+	if r.logType == LogTypePipeline {
+		return "PipelineRun"
+	}
+	return "TaskRun"
+}
+
+// TimedOut tells whether the reader stopped following the logs because the
+// activity timeout was reached
+func (r *Reader) TimedOut() bool {
+	return r.timedOut.Load()
+}
+
+// PipelineRun returns the PipelineRun as it was when its logs started to be
+// read, nil until they are read or for a TaskRun
+func (r *Reader) PipelineRun() *v1.PipelineRun {
+	return r.pipelineRun
+}
+
+// Relists returns the number of times a watch failed while following the logs
+// and the informer listed the resource again
+func (r *Reader) Relists() int64 {
+	return r.relists.Load()
+}
+
+// relisted counts a failure of the watch of a resource, records it in the
+// journal and reports it on logC when verbose, logC may be nil where there is
+// no channel of logs to report it on
+func (r *Reader) relisted(logC chan<- Log, kind, name string, err error) {
+	r.relists.Add(1)
+	r.journal.record(kind, name, JournalRelist, "", err.Error())
+	if r.verbose && logC != nil {
+		logC <- Log{Notice: true, Verbose: true, Log: fmt.Sprintf("--- watch of %s %s failed, listing it again: %v ---", kind, name, err)}
+	}
+}
+
 func (r *Reader) setNumber(number int) {
 	r.number = number
 }
@@ -104,6 +310,10 @@ func (r *Reader) setRun(run string) {
 	r.run = run
 }
 
+func (r *Reader) setMatrix(matrix []string) {
+	r.matrix = matrix
+}
+
 func (r *Reader) setTask(task string) {
 	r.task = task
 }
@@ -0,0 +1,194 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/pods"
+	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
+	"github.com/tektoncd/pipeline/pkg/result"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// resultsCaptureInterval is how often the results are read from the
+// container of a followed step
+var resultsCaptureInterval = 5 * time.Second
+
+// readResultsCommand prints the name and the size of each file of the
+// results followed by its content, which may not end with a new line
+var readResultsCommand = fmt.Sprintf(`cd %s 2>/dev/null || exit 0
+for f in *; do
+  [ -f "$f" ] || continue
+  printf '%%s %%s\n' "$f" "$(wc -c < "$f")"
+  cat "$f"
+done`, taskrunpkg.ResultsDir)
+
+// resultsCapturer reads the results of the followed TaskRuns from the
+// termination messages of their steps as they end, and in full from the
+// files the steps write them to, with exec into the containers of the steps
+// while they run, as the termination messages are limited to 4KB. It keeps
+// them in the state of tkn for describe and is shared by the clones of a
+// reader.
+type resultsCapturer struct {
+	kube kubernetes.Interface
+	ns   string
+	exec pods.ExecFunc
+
+	once    sync.Once
+	allowed bool
+	err     error
+
+	mu       sync.Mutex
+	captured map[string]*taskrunpkg.CapturedResults
+}
+
+func newResultsCapturer(kube kubernetes.Interface, ns string, exec pods.ExecFunc) *resultsCapturer {
+	return &resultsCapturer{kube: kube, ns: ns, exec: exec, captured: map[string]*taskrunpkg.CapturedResults{}}
+}
+
+// capture reads the results of the TaskRun from a container of its pod and
+// saves them when they changed, it returns a notice when they could not be
+// read. Once the step of the container ended, its termination message is
+// read first and the files are read one last time, the results read from the
+// files take precedence as they are never truncated.
+func (c *resultsCapturer) capture(taskrun, pod, container string, ended bool) (notice string, verbose bool) {
+	if ended {
+		p, err := c.kube.CoreV1().Pods(c.ns).Get(context.Background(), pod, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Sprintf("--- failed to read the results of TaskRun %s: %v ---", taskrun, err), true
+		}
+		for _, cs := range p.Status.ContainerStatuses {
+			if cs.Name == container && cs.State.Terminated != nil {
+				if notice := c.save(taskrun, pod, terminationResults(cs.State.Terminated.Message), false); notice != "" {
+					return notice, false
+				}
+			}
+		}
+	}
+
+	c.once.Do(func() {
+		c.allowed, c.err = pods.CanExec(c.kube, c.ns)
+		switch {
+		case c.err != nil:
+			notice = fmt.Sprintf("--- results not fully captured, failed to check if exec is allowed: %v ---", c.err)
+		case !c.allowed:
+			notice = fmt.Sprintf("--- results not fully captured, exec into pods of namespace %s is not allowed ---", c.ns)
+		}
+	})
+	if c.err != nil || !c.allowed {
+		return notice, false
+	}
+
+	out, err := c.exec(c.ns, pod, container, readResultsCommand)
+	if err != nil {
+		if ended {
+			// the container is usually gone already
+			return "", false
+		}
+		return fmt.Sprintf("--- failed to read the results of TaskRun %s from container %s: %v ---", taskrun, container, err), true
+	}
+	results, err := parseResultFiles(out)
+	if err != nil {
+		return fmt.Sprintf("--- failed to read the results of TaskRun %s from container %s: %v ---", taskrun, container, err), true
+	}
+	return c.save(taskrun, pod, results, true), false
+}
+
+// save merges results into those captured from pod and saves them when they
+// changed, the results already captured are only replaced when override is
+// set. It returns a notice when they could not be saved.
+func (c *resultsCapturer) save(taskrun, pod string, results map[string]string, override bool) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	captured, ok := c.captured[taskrun]
+	fresh := !ok || captured.Pod != pod
+	if fresh {
+		// the results of an earlier attempt are not kept
+		captured = &taskrunpkg.CapturedResults{Pod: pod, Results: map[string]string{}}
+		c.captured[taskrun] = captured
+	}
+	merged := maps.Clone(captured.Results)
+	for k, v := range results {
+		if _, ok := merged[k]; !ok || override {
+			merged[k] = v
+		}
+	}
+	if !fresh && maps.Equal(merged, captured.Results) {
+		return ""
+	}
+	captured.Results = merged
+	if err := taskrunpkg.SaveCapturedResults(c.ns, taskrun, captured); err != nil {
+		return fmt.Sprintf("--- failed to save the results of TaskRun %s: %v ---", taskrun, err)
+	}
+	return ""
+}
+
+// terminationResults returns the results of the TaskRun written by the
+// entrypoint to the termination message of a step
+func terminationResults(message string) map[string]string {
+	results := map[string]string{}
+	var written []result.RunResult
+	if message == "" || json.Unmarshal([]byte(message), &written) != nil {
+		return results
+	}
+	for _, r := range written {
+		if r.ResultType == result.TaskRunResultType {
+			results[r.Key] = r.Value
+		}
+	}
+	return results
+}
+
+// parseResultFiles reads the files printed by readResultsCommand
+func parseResultFiles(out []byte) (map[string]string, error) {
+	results := map[string]string{}
+	for len(out) > 0 {
+		header, rest, ok := bytes.Cut(out, []byte("\n"))
+		if !ok {
+			return nil, fmt.Errorf("unexpected output %q", out)
+		}
+		fields := strings.Fields(string(header))
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("unexpected output %q", header)
+		}
+		size, err := strconv.Atoi(fields[1])
+		if err != nil || size > len(rest) {
+			return nil, fmt.Errorf("unexpected size of result %s: %s", fields[0], fields[1])
+		}
+		results[fields[0]] = string(rest[:size])
+		out = rest[size:]
+	}
+	return results, nil
+}
+
+// captureResults reads the results of the followed TaskRun from a container
+// of its pod, sending the notice of a failure
+func (r *Reader) captureResults(logC chan<- Log, pod, container string, ended bool) {
+	notice, verbose := r.results.capture(r.run, pod, container, ended)
+	if notice == "" || verbose && !r.verbose || r.silent {
+		return
+	}
+	logC <- Log{Task: r.task, Notice: true, Verbose: verbose, Log: notice}
+}
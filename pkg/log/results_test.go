@@ -0,0 +1,157 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
+	"gotest.tools/v3/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stest "k8s.io/client-go/testing"
+)
+
+func TestParseResultFiles(t *testing.T) {
+	large := strings.Repeat("x", 5000)
+	got, err := parseResultFiles([]byte("digest 15\nsha256:0123abcd" + "report    5000\n" + large + "empty 0\n"))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, map[string]string{"digest": "sha256:0123abcd", "report": large, "empty": ""})
+
+	_, err = parseResultFiles([]byte("digest 20\nsha256:0123abcd"))
+	assert.Error(t, err, "unexpected size of result digest: 20")
+	_, err = parseResultFiles([]byte("sh: cd: can't cd"))
+	assert.Error(t, err, `unexpected output "sh: cd: can't cd"`)
+}
+
+func TestTerminationResults(t *testing.T) {
+	message := `[{"key":"digest","value":"sha256:0123abcd","type":1},{"key":"StartedAt","value":"2026-10-16T10:00:00Z","type":3},{"key":"url","value":"https://tekton.dev","type":1}]`
+	assert.DeepEqual(t, terminationResults(message), map[string]string{"digest": "sha256:0123abcd", "url": "https://tekton.dev"})
+	assert.DeepEqual(t, terminationResults(""), map[string]string{})
+	assert.DeepEqual(t, terminationResults("step failed"), map[string]string{})
+}
+
+func kubeAllowingExec(allowed bool, objects ...runtime.Object) *fake.Clientset {
+	kube := fake.NewSimpleClientset(objects...)
+	kube.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stest.Action) (bool, runtime.Object, error) {
+		review := action.(k8stest.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = allowed
+		return true, review, nil
+	})
+	return kube
+}
+
+func TestResultsCapturer(t *testing.T) {
+	t.Setenv("TKN_STATE_DIR", t.TempDir())
+
+	outputs := []string{"digest 3\nabc", "", "digest 3\nabcreport 2\nok", "", "url 4\nhttp"}
+	var execs []string
+	c := newResultsCapturer(kubeAllowingExec(true), "ns", func(ns, pod, container, command string) ([]byte, error) {
+		execs = append(execs, pod+"/"+container)
+		if len(outputs) == 0 {
+			return nil, fmt.Errorf("container not found")
+		}
+		out := outputs[0]
+		outputs = outputs[1:]
+		return []byte(out), nil
+	})
+
+	for _, container := range []string{"step-build", "step-build", "step-push"} {
+		notice, _ := c.capture("release", "release-pod", container, false)
+		assert.Equal(t, notice, "")
+	}
+	got, err := taskrunpkg.GetCapturedResults("ns", "release")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &taskrunpkg.CapturedResults{Pod: "release-pod", Results: map[string]string{"digest": "abc", "report": "ok"}})
+
+	// the results of a retry replace those of the earlier attempt
+	for _, container := range []string{"step-build", "step-build"} {
+		notice, _ := c.capture("release", "release-pod-retry1", container, false)
+		assert.Equal(t, notice, "")
+	}
+	got, err = taskrunpkg.GetCapturedResults("ns", "release")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &taskrunpkg.CapturedResults{Pod: "release-pod-retry1", Results: map[string]string{"url": "http"}})
+
+	notice, verbose := c.capture("release", "release-pod-retry1", "step-push", false)
+	assert.Equal(t, notice, "--- failed to read the results of TaskRun release from container step-push: container not found ---")
+	assert.Assert(t, verbose)
+	assert.Equal(t, len(execs), 6)
+}
+
+func TestResultsCapturer_ended(t *testing.T) {
+	t.Setenv("TKN_STATE_DIR", t.TempDir())
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "release-pod", Namespace: "ns"}}
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name: "step-build",
+		State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+			Message: `[{"key":"report","value":"trunc","type":1},{"key":"digest","value":"abc","type":1}]`,
+		}},
+	}}
+	large := strings.Repeat("x", 5000)
+	outputs := []string{fmt.Sprintf("report %d\n%s", len(large), large)}
+	c := newResultsCapturer(kubeAllowingExec(true, pod), "ns", func(ns, pod, container, command string) ([]byte, error) {
+		if len(outputs) == 0 {
+			return nil, fmt.Errorf("container not found")
+		}
+		out := outputs[0]
+		outputs = outputs[1:]
+		return []byte(out), nil
+	})
+
+	notice, _ := c.capture("release", "release-pod", "step-build", false)
+	assert.Equal(t, notice, "")
+	// the termination message only adds the results which were not read in
+	// full, and the container being gone is not reported
+	notice, _ = c.capture("release", "release-pod", "step-build", true)
+	assert.Equal(t, notice, "")
+	got, err := taskrunpkg.GetCapturedResults("ns", "release")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &taskrunpkg.CapturedResults{Pod: "release-pod", Results: map[string]string{"report": large, "digest": "abc"}})
+
+	notice, verbose := c.capture("release", "release-pod-retry1", "step-build", true)
+	assert.Equal(t, notice, `--- failed to read the results of TaskRun release: pods "release-pod-retry1" not found ---`)
+	assert.Assert(t, verbose)
+}
+
+func TestResultsCapturer_notAllowed(t *testing.T) {
+	t.Setenv("TKN_STATE_DIR", t.TempDir())
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "release-pod", Namespace: "ns"}}
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+		Name:  "step-push",
+		State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: `[{"key":"digest","value":"abc","type":1}]`}},
+	}}
+	c := newResultsCapturer(kubeAllowingExec(false, pod), "ns", func(ns, pod, container, command string) ([]byte, error) {
+		t.Fatal("exec is not allowed")
+		return nil, nil
+	})
+
+	notice, verbose := c.capture("release", "release-pod", "step-build", false)
+	assert.Equal(t, notice, "--- results not fully captured, exec into pods of namespace ns is not allowed ---")
+	assert.Assert(t, !verbose)
+	// it is only reported once, the termination messages are still read
+	notice, _ = c.capture("release", "release-pod", "step-push", true)
+	assert.Equal(t, notice, "")
+	got, err := taskrunpkg.GetCapturedResults("ns", "release")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &taskrunpkg.CapturedResults{Pod: "release-pod", Results: map[string]string{"digest": "abc"}})
+}
@@ -0,0 +1,98 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"io"
+	"os"
+	"sync"
+
+	"golang.org/x/term"
+)
+
+// isTerminal tells whether w is a terminal, the logs are only buffered in
+// sections when they are written to a file or a pipe
+var isTerminal = func(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// section holds the logs and the errors of a TaskRun followed with the
+// logs buffered, in the order they were read
+type section struct {
+	entries []sectionEntry
+	done    bool
+}
+
+// sectionEntry is a line of logs, or an error when err is set
+type sectionEntry struct {
+	log Log
+	err error
+}
+
+func (s *section) addLog(l Log) {
+	s.entries = append(s.entries, sectionEntry{log: l})
+}
+
+func (s *section) addErr(e error) {
+	s.entries = append(s.entries, sectionEntry{err: e})
+}
+
+// sections writes the logs of the followed TaskRuns a TaskRun after the
+// other, in the order they were started, which follows the order of the
+// DAG of the pipeline. The logs of a TaskRun are written once it is done
+// and the TaskRuns started before it are written, so that the logs of
+// parallel TaskRuns are not interleaved.
+type sections struct {
+	mu   sync.Mutex
+	list []*section
+	// next is the index of the first section not written yet
+	next int
+	logC chan<- Log
+	errC chan<- error
+}
+
+func newSections(logC chan<- Log, errC chan<- error) *sections {
+	return &sections{logC: logC, errC: errC}
+}
+
+// add returns a new section, written after the ones added before it
+func (s *sections) add() *section {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sec := &section{}
+	s.list = append(s.list, sec)
+	return sec
+}
+
+// complete writes the section, and the ones after it which are done, once
+// the sections before it are written
+func (s *sections) complete(sec *section) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sec.done = true
+	for s.next < len(s.list) && s.list[s.next].done {
+		for _, e := range s.list[s.next].entries {
+			if e.err != nil {
+				s.errC <- e.err
+				continue
+			}
+			s.logC <- e.log
+		}
+		// release the logs written
+		s.list[s.next] = nil
+		s.next++
+	}
+}
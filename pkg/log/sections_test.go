@@ -0,0 +1,51 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"errors"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSections(t *testing.T) {
+	logC := make(chan Log, 10)
+	errC := make(chan error, 10)
+	s := newSections(logC, errC)
+
+	build, lint, test := s.add(), s.add(), s.add()
+	build.addLog(Log{Task: "build", Log: "compiling"})
+	lint.addLog(Log{Task: "lint", Log: "no issues"})
+	test.addLog(Log{Task: "test", Log: "ok"})
+	test.addErr(errors.New("failed to get logs for task test : pod deleted"))
+
+	// lint and test are done while build is still running
+	s.complete(lint)
+	s.complete(test)
+	assert.Equal(t, len(logC), 0)
+
+	build.addLog(Log{Task: "build", Log: "done"})
+	s.complete(build)
+	close(logC)
+	close(errC)
+
+	var lines []string
+	for l := range logC {
+		lines = append(lines, l.Task+": "+l.Log)
+	}
+	assert.DeepEqual(t, lines, []string{"build: compiling", "build: done", "lint: no issues", "test: ok"})
+	assert.Error(t, <-errC, "failed to get logs for task test : pod deleted")
+}
@@ -0,0 +1,315 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/options"
+	pipelinerunpkg "github.com/tektoncd/cli/pkg/pipelinerun"
+	"github.com/tektoncd/cli/pkg/results"
+	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Sources logs are read from
+const (
+	// SourceAuto probes the other sources in order
+	SourceAuto = "auto"
+	// SourcePods reads the logs of the pods of the run from the cluster
+	SourcePods = "pods"
+	// SourceResults reads the logs archived by Tekton Results
+	SourceResults = "results"
+	// SourceStorage reads the logs from the object storage of the profile
+	SourceStorage = "storage"
+)
+
+// Sources are the values accepted for the source of logs
+var Sources = []string{SourceAuto, SourcePods, SourceResults, SourceStorage}
+
+// Source is where the logs of a run are read from
+type Source struct {
+	Kind string
+	// Location is the record of the run in Tekton Results or the URL of
+	// its logs in the object storage
+	Location string
+}
+
+func (s Source) String() string {
+	switch s.Kind {
+	case SourceResults:
+		return "Tekton Results (" + s.Location + ")"
+	case SourceStorage:
+		return "the object storage (" + s.Location + ")"
+	}
+	return "the pods of the run"
+}
+
+// DetectSource returns where the logs of the run are read from. With
+// SourceAuto the pods are used while they exist, then Tekton Results and
+// then the object storage set in the profile, the pods are used when no
+// source has the logs so that the usual errors are reported.
+func DetectSource(c *cli.Clients, profile config.Profile, source, logType, ns, name string) (Source, error) {
+	switch source {
+	case SourcePods:
+		return Source{Kind: SourcePods}, nil
+	case SourceResults:
+		record, err := resultsRecord(profile, logType, ns, name)
+		if err != nil {
+			return Source{}, err
+		}
+		if record == "" {
+			return Source{}, fmt.Errorf("no logs of %s %s found in Tekton Results", runKind(logType), name)
+		}
+		return Source{Kind: SourceResults, Location: record}, nil
+	case SourceStorage:
+		u, err := storageURL(profile, logType, ns, name)
+		if err != nil {
+			return Source{}, err
+		}
+		if u == "" {
+			return Source{}, fmt.Errorf("no logs of %s %s found in the object storage", runKind(logType), name)
+		}
+		return Source{Kind: SourceStorage, Location: u}, nil
+	case SourceAuto, "":
+	default:
+		return Source{}, fmt.Errorf("invalid source %q, use one of %s", source, strings.Join(Sources, ", "))
+	}
+
+	live, err := podsExist(c, logType, ns, name)
+	if err != nil || live {
+		return Source{Kind: SourcePods}, err
+	}
+	// the sources of archived logs are best effort, the pods remain the
+	// source when they cannot be reached
+	if record, err := resultsRecord(profile, logType, ns, name); err == nil && record != "" {
+		return Source{Kind: SourceResults, Location: record}, nil
+	}
+	if u, err := storageURL(profile, logType, ns, name); err == nil && u != "" {
+		return Source{Kind: SourceStorage, Location: u}, nil
+	}
+	return Source{Kind: SourcePods}, nil
+}
+
+// FromArchive writes the logs of the run read from Tekton Results or the
+// object storage when they are the source of opts, and tells whether it did.
+// The source is reported on the error stream after the logs.
+func FromArchive(opts *options.LogOptions, logType, name string) (bool, error) {
+	// the logs read from another location are those of the pods
+	if opts.Source == SourcePods || opts.StreamFrom != "" {
+		if opts.KeepCompressed {
+			return false, fmt.Errorf("--keep-compressed can only be used with the logs read from Tekton Results or the object storage")
+		}
+		return false, nil
+	}
+	cs, err := opts.Params.Clients()
+	if err != nil {
+		return false, err
+	}
+	profile, err := opts.Params.Profile()
+	if err != nil {
+		return false, err
+	}
+	source, err := DetectSource(cs, profile, opts.Source, logType, opts.Params.Namespace(), name)
+	if err != nil {
+		return false, err
+	}
+	if source.Kind == SourcePods {
+		if opts.KeepCompressed {
+			return false, fmt.Errorf("--keep-compressed can only be used with the logs read from Tekton Results or the object storage, the logs of %s %s are read from %s", runKind(logType), name, source)
+		}
+		return false, nil
+	}
+	if opts.Archive != "" {
+		return true, fmt.Errorf("--output can only be used with the logs of pods, the logs of %s %s are read from %s", runKind(logType), name, source)
+	}
+	if opts.LogStream() != "" {
+		return true, fmt.Errorf("--stdout-only and --stderr-only can only be used with the logs of pods, the logs of %s %s are read from %s", runKind(logType), name, source)
+	}
+
+	// the progress of multi-GB transfers is shown on terminals
+	var progress io.Writer
+	if !opts.Silent && isTerminal(opts.Stream.Err) {
+		progress = opts.Stream.Err
+	}
+	encoding, err := CopyArchived(profile, source, opts.Stream.Out, progress, opts.KeepCompressed)
+	if err != nil {
+		return true, err
+	}
+	if !opts.Silent {
+		if encoding != "" {
+			fmt.Fprintf(opts.Stream.Err, "Logs of %s %s read from %s, written compressed with %s\n", runKind(logType), name, source, encoding)
+		} else {
+			fmt.Fprintf(opts.Stream.Err, "Logs of %s %s read from %s\n", runKind(logType), name, source)
+		}
+	}
+	return true, nil
+}
+
+// CopyArchived writes the logs of a source other than the pods to out, the
+// Results API is reached with the settings of profile. The
+// logs are transferred compressed when the source supports it and are
+// decompressed on the fly, unless keepCompressed is set in which case the
+// returned encoding is the compression of what was written. The progress of
+// the transfer is reported on progress when it is not nil.
+func CopyArchived(profile config.Profile, s Source, out, progress io.Writer, keepCompressed bool) (string, error) {
+	var resp *http.Response
+	switch s.Kind {
+	case SourceResults:
+		client, err := results.NewClient(results.OptionsOf(profile))
+		if err != nil {
+			return "", err
+		}
+		if resp, err = client.GetCompressedLog(context.Background(), results.LogName(s.Location), acceptEncoding); err != nil {
+			return "", fmt.Errorf("failed to get the logs of %s: %w", s.Location, err)
+		}
+	case SourceStorage:
+		req, err := http.NewRequest(http.MethodGet, s.Location, nil)
+		if err != nil {
+			return "", err
+		}
+		// setting Accept-Encoding keeps the body as transferred rather
+		// than having the transport decompress gzip
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		resp, err = http.DefaultClient.Do(req) // nolint: gosec
+		if err != nil {
+			return "", fmt.Errorf("failed to get the logs from %s: %w", s.Location, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return "", fmt.Errorf("failed to get the logs from %s: %s", s.Location, resp.Status)
+		}
+	default:
+		return "", fmt.Errorf("the logs of %s are not archived", s)
+	}
+
+	t, err := newTransfer(resp)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the logs of %s: %w", s, err)
+	}
+	if progress != nil {
+		t.reportProgress(progress)
+	}
+	return t.copyTo(out, keepCompressed)
+}
+
+// podsExist tells whether the run exists and its pods have not all been
+// deleted, a run whose pods have not been created yet has them
+func podsExist(c *cli.Clients, logType, ns, name string) (bool, error) {
+	var trNames []string
+	switch logType {
+	case LogTypeTask:
+		trNames = []string{name}
+	case LogTypePipeline:
+		pr, err := pipelinerunpkg.GetPipelineRun(pipelineRunGroupResource, c, name, ns)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		for _, child := range pr.Status.ChildReferences {
+			if child.Kind == "TaskRun" {
+				trNames = append(trNames, child.Name)
+			}
+		}
+		if len(trNames) == 0 {
+			return true, nil
+		}
+	}
+
+	for _, trName := range trNames {
+		tr, err := taskrunpkg.GetTaskRun(taskrunGroupResource, c, trName, ns)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		if tr.Status.PodName == "" {
+			return true, nil
+		}
+		_, err = c.Kube.CoreV1().Pods(ns).Get(context.Background(), tr.Status.PodName, metav1.GetOptions{})
+		if err == nil {
+			return true, nil
+		}
+		if !errors.IsNotFound(err) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// resultsRecord returns the most recent record of the run in Tekton Results,
+// or an empty string when Results is not set up in the profile or does not
+// know the run
+func resultsRecord(profile config.Profile, logType, ns, name string) (string, error) {
+	if profile.Results.Addr == "" {
+		return "", nil
+	}
+	client, err := results.NewClient(results.OptionsOf(profile))
+	if err != nil {
+		return "", err
+	}
+	filter := fmt.Sprintf("data.metadata.name == %q && data_type.endsWith(%q)", name, "."+runKind(logType))
+	records, err := client.ListRecords(context.Background(), ns+"/results/-", filter)
+	if err != nil || len(records) == 0 {
+		return "", err
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].UpdateTime.After(records[j].UpdateTime)
+	})
+	return records[0].Name, nil
+}
+
+// storageURL returns the URL of the logs of the run in the object storage,
+// or an empty string when no storage is set up in the profile or it does not
+// hold the logs of the run
+func storageURL(profile config.Profile, logType, ns, name string) (string, error) {
+	if profile.Logs.Storage == "" {
+		return "", nil
+	}
+	u := strings.NewReplacer(
+		"{namespace}", url.PathEscape(ns),
+		"{kind}", strings.ToLower(runKind(logType))+"s",
+		"{name}", url.PathEscape(name),
+	).Replace(profile.Logs.Storage)
+
+	resp, err := http.Head(u) // nolint: gosec
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", nil
+	}
+	return u, nil
+}
+
+func runKind(logType string) string {
+	if logType == LogTypePipeline {
+		return "PipelineRun"
+	}
+	return "TaskRun"
+}
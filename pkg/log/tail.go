@@ -0,0 +1,77 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tektoncd/cli/pkg/pipe"
+)
+
+// Tail keeps the last lines of the logs of each step passing through it
+type Tail struct {
+	n     int
+	mu    sync.Mutex
+	lines map[string][]string
+}
+
+// NewTail returns a Tail keeping the last n lines of each step
+func NewTail(n int) *Tail {
+	return &Tail{n: n, lines: map[string][]string{}}
+}
+
+// Tee sends the logs of logC and the errors of errC through, keeping the last
+// lines of the logs. Both are sent by the same goroutine so that an error is
+// still written after the logs read before it.
+func (t *Tail) Tee(logC <-chan Log, errC <-chan error) (<-chan Log, <-chan error) {
+	out := make(chan Log)
+	outErr := make(chan error)
+
+	go func() {
+		defer close(out)
+		defer close(outErr)
+		pipe.Drain(context.Background(), logC, errC,
+			func(l Log) {
+				if l.Log != "EOFLOG" && l.Log != "FINALLYLOG" && !l.Notice {
+					t.add(l)
+				}
+				out <- l
+			},
+			func(e error) { outErr <- e },
+		)
+	}()
+
+	return out, outErr
+}
+
+func (t *Tail) add(l Log) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := l.Task + "/" + l.Step
+	lines := append(t.lines[key], l.Log)
+	if len(lines) > t.n {
+		lines = lines[len(lines)-t.n:]
+	}
+	t.lines[key] = lines
+}
+
+// Lines returns the last lines of the logs of a step
+func (t *Tail) Lines(task, step string) []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lines[task+"/"+step]
+}
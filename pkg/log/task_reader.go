@@ -15,19 +15,24 @@
 package log
 
 import (
+	"context"
 	"fmt"
+	"path"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/pipe"
 	"github.com/tektoncd/cli/pkg/pods"
 	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"knative.dev/pkg/apis"
 )
 
 const (
@@ -37,11 +42,13 @@ const (
 type step struct {
 	name      string
 	container string
-	state     corev1.ContainerState
+	status    pods.ContainerStatus
+	// ephemeral is set for containers attached to the pod for debugging
+	ephemeral bool
 }
 
 func (s *step) hasStarted() bool {
-	return s.state.Waiting == nil
+	return s.status.Started()
 }
 
 func (r *Reader) readTaskLog() (<-chan Log, <-chan error, error) {
@@ -51,6 +58,11 @@ func (r *Reader) readTaskLog() (<-chan Log, <-chan error, error) {
 	}
 
 	r.formTaskName(tr)
+	r.task = taskrunpkg.MatrixLabel(r.task, r.matrix, tr)
+	r.source = nil
+	if tr.Status.Provenance != nil {
+		r.source = tr.Status.Provenance.RefSource
+	}
 
 	if !tr.IsDone() && r.follow {
 		return r.readLiveTaskLogs(tr)
@@ -103,16 +115,23 @@ func (r *Reader) readAvailableTaskLogs(tr *v1.TaskRun) (<-chan Log, <-chan error
 		return nil, nil, fmt.Errorf("pod for taskrun %s not available yet", tr.Name)
 	}
 
+	podNames := attemptPods(tr)
+	switch {
+	case r.attempt > 0:
+		name, err := AttemptPod(tr, r.attempt)
+		if err != nil {
+			return nil, nil, err
+		}
+		podNames = []string{name}
+	case r.excludeRetries:
+		podNames = []string{tr.Status.PodName}
+	}
+
 	podC := make(chan string)
 	go func() {
 		defer close(podC)
-		if tr.Status.PodName != "" {
-			if len(tr.Status.RetriesStatus) != 0 {
-				for _, retryStatus := range tr.Status.RetriesStatus {
-					podC <- retryStatus.PodName
-				}
-			}
-			podC <- tr.Status.PodName
+		for _, name := range podNames {
+			podC <- name
 		}
 	}()
 
@@ -120,28 +139,82 @@ func (r *Reader) readAvailableTaskLogs(tr *v1.TaskRun) (<-chan Log, <-chan error
 	return logC, errC, nil
 }
 
-func (r *Reader) readStepsLogs(logC chan<- Log, errC chan<- error, steps []*step, pod *pods.Pod, follow, timestamps bool) {
+// readStepsLogs streams the logs of the steps of a pod, when not following the
+// pod object which has already been retrieved is reused to check the status of
+// the steps rather than fetching the pod again for each of them
+func (r *Reader) readStepsLogs(logC chan<- Log, errC chan<- error, steps []*step, pod *pods.Pod, podObj *corev1.Pod, follow, timestamps bool) {
 	for _, step := range steps {
 		if !follow && !step.hasStarted() {
 			continue
 		}
 
 		container := pod.Container(step.container)
-		containerLogC, containerLogErrC, err := container.LogReader(follow, timestamps).Read()
+		ctx, cancel := context.WithCancel(context.Background())
+		containerLogC, containerLogErrC, err := container.LogReader(follow, timestamps).ReadContext(ctx)
 		if err != nil {
+			cancel()
 			errC <- fmt.Errorf("error in getting logs for step %s: %s", step.name, err)
 			continue
 		}
 
+		// the logs of a followed step are given up on when they stay silent
+		// for longer than the step timeout, unless told to continue
+		var silence *time.Timer
+		var silenceC <-chan time.Time
+		if follow && r.stepTimeout > 0 {
+			silence = time.NewTimer(r.stepTimeout)
+			silenceC = silence.C
+		}
+		// a followed step is reported as hung, once per silence, when it
+		// stays silent for longer than the hang threshold
+		var hang *time.Timer
+		var hangC <-chan time.Time
+		if follow && r.hangThreshold > 0 {
+			hang = time.NewTimer(r.hangThreshold)
+			hangC = hang.C
+		}
+		// the results are read from the container of the followed step
+		// while it runs, and once more when it ends
+		var results *time.Ticker
+		var resultsC <-chan time.Time
+		if follow && r.results != nil {
+			results = time.NewTicker(resultsCaptureInterval)
+			resultsC = results.C
+		}
+		// stop ends the reading of the logs of the step, the container reader
+		// goes away as well when the step is given up on before its logs end
+		stop := func() {
+			cancel()
+			if silence != nil {
+				silence.Stop()
+			}
+			if hang != nil {
+				hang.Stop()
+			}
+			if results != nil {
+				results.Stop()
+			}
+		}
+
 		for containerLogC != nil || containerLogErrC != nil {
 			select {
 			case l, ok := <-containerLogC:
 				if !ok {
 					containerLogC = nil
+					if results != nil {
+						r.captureResults(logC, pod.Name, step.container, true)
+					}
 					logC <- Log{Task: r.task, Step: step.name, Log: "EOFLOG"}
 					continue
 				}
-				logC <- Log{Task: r.task, Step: step.name, Log: l.Log}
+				logC <- Log{Task: r.task, Step: step.name, Log: l.Log, Continued: l.Continued}
+				if silence != nil {
+					silence.Reset(r.stepTimeout)
+				}
+				if hang != nil {
+					hang.Reset(r.hangThreshold)
+					hangC = hang.C
+				}
 
 			case e, ok := <-containerLogErrC:
 				if !ok {
@@ -150,10 +223,39 @@ func (r *Reader) readStepsLogs(logC chan<- Log, errC chan<- error, steps []*step
 				}
 
 				errC <- fmt.Errorf("failed to get logs for %s: %s", step.name, e)
+
+			case <-hangC:
+				hangC = nil
+				logC <- Log{Task: r.task, Step: step.name, Log: fmt.Sprintf("--- no logs for %s, the step may hang ---", r.hangThreshold)}
+				r.journal.record("Pod", pod.Name, JournalHang, "", fmt.Sprintf("no logs from container %s for %s", step.container, r.hangThreshold))
+				if r.hangDumper != nil {
+					for _, l := range r.hangDumper.dump(pod.Name, step.container) {
+						logC <- Log{Task: r.task, Step: step.name, Log: l}
+					}
+				}
+
+			case <-resultsC:
+				r.captureResults(logC, pod.Name, step.container, false)
+
+			case <-silenceC:
+				logC <- Log{Task: r.task, Step: step.name, Log: fmt.Sprintf("--- no logs for %s, activity timeout reached ---", r.stepTimeout)}
+				r.journal.record("Pod", pod.Name, JournalTimeout, "", fmt.Sprintf("no logs from container %s for %s", step.container, r.stepTimeout))
+				if r.onTimeout == OnTimeoutContinue {
+					silence.Reset(r.stepTimeout)
+					continue
+				}
+				stop()
+				r.timedOut.Store(true)
+				return
 			}
 		}
+		stop()
 
-		if err := container.Status(); err != nil {
+		status := container.Status
+		if !follow {
+			status = func() error { return container.StatusOf(podObj) }
+		}
+		if err := status(); err != nil {
 			errC <- err
 			return
 		}
@@ -162,33 +264,33 @@ func (r *Reader) readStepsLogs(logC chan<- Log, errC chan<- error, steps []*step
 
 func (r *Reader) readPodLogs(podC <-chan string, podErrC <-chan error, follow, timestamps bool) (<-chan Log, <-chan error) {
 	logC := make(chan Log)
-	errC := make(chan error)
-	var wg sync.WaitGroup
+	stepErrC := make(chan error)
+	// the errors of the pods and of their steps share one stream, closed
+	// once both are
+	errC := pipe.Merge(context.Background(), podErrC, stepErrC)
 
-	wg.Add(1)
 	go func() {
-		// forward pod error to error stream
-		if podErrC != nil {
-			for podErr := range podErrC {
-				errC <- podErr
-			}
-		}
-		wg.Done()
-
-		// wait for all goroutines to close before closing errC channel
-		wg.Wait()
-		close(errC)
-	}()
-
-	wg.Add(1)
-	go func() {
-		defer func() {
-			close(logC)
-			wg.Done()
-		}()
+		defer close(stepErrC)
+		defer close(logC)
 
 		for podName := range podC {
+			if r.TimedOut() {
+				// keep draining the pods so that their producer can finish
+				continue
+			}
 			p := pods.New(podName, r.ns, r.clients.Kube, r.streamer)
+			p.Resync = r.resync
+			p.MaxLineLength = r.maxLineLength
+			p.OnRelist = func(err error) {
+				r.relisted(logC, "Pod", podName, err)
+			}
+			p.OnEvent = func(event string, pod *corev1.Pod) {
+				r.journal.record("Pod", pod.Name, event, string(pod.Status.Phase), pod.Status.Message)
+			}
+			p.OnImagePull = func(err *pods.ImagePullError) {
+				logC <- Log{Task: r.task, Notice: true, Log: fmt.Sprintf("--- task %s: %s, waiting for kubelet to retry the pull ---", r.task, err)}
+				logC <- Log{Task: r.task, Notice: true, Log: fmt.Sprintf("--- hint: %s ---", err.Hint())}
+			}
 			var pod *corev1.Pod
 			var err error
 
@@ -198,10 +300,15 @@ func (r *Reader) readPodLogs(podC <-chan string, podErrC <-chan error, follow, t
 				pod, err = p.Get()
 			}
 			if err != nil {
-				errC <- fmt.Errorf("task %s failed: %s. Run tkn tr desc %s for more details", r.task, strings.TrimSpace(err.Error()), r.run)
+				stepErrC <- fmt.Errorf("task %s failed: %s. Run tkn tr desc %s for more details", r.task, strings.TrimSpace(err.Error()), r.run)
 			}
-			steps := filterSteps(pod, r.allSteps, r.steps)
-			r.readStepsLogs(logC, errC, steps, p, follow, timestamps)
+			if pod == nil {
+				// pod is gone (e.g. deleted), there are no steps to read logs from
+				continue
+			}
+			names := pods.NewStepNames(pod, r.podSteps(podName), r.stepPrefixes)
+			steps := filterSteps(pod, names, r.allSteps, r.steps, r.containers, r.excludedSteps)
+			r.readStepsLogs(logC, stepErrC, steps, p, pod, follow, timestamps)
 		}
 	}()
 
@@ -232,37 +339,85 @@ func (r *Reader) getTaskRunPodNames(run *v1.TaskRun) (<-chan string, <-chan erro
 			watchRun.Stop()
 		}()
 
+		// the attempts already retried when the logs are first read are
+		// skipped when the retries are excluded, the next ones are followed
+		first := 1
+		if r.excludeRetries {
+			first = len(run.Status.RetriesStatus) + 1
+		}
 		podMap := make(map[string]bool)
-		addPod := func(name string) {
-			if _, ok := podMap[name]; !ok {
-				podMap[name] = true
-				podC <- name
+		addPods := func(run *v1.TaskRun) {
+			for i, name := range attemptPods(run) {
+				attempt := i + 1
+				if r.attempt > 0 && attempt != r.attempt || attempt < first {
+					continue
+				}
+				if _, ok := podMap[name]; !ok {
+					podMap[name] = true
+					podC <- name
+				}
 			}
 		}
-
-		if len(run.Status.RetriesStatus) != 0 {
-			for _, retryStatus := range run.Status.RetriesStatus {
-				addPod(retryStatus.PodName)
+		// done tells whether the pods of the attempts to follow were all
+		// found, an attempt which will not happen is an error
+		done := func(run *v1.TaskRun) bool {
+			attempts := len(attemptPods(run))
+			if r.attempt > 0 && attempts >= r.attempt {
+				return true
 			}
+			if r.areRetriesScheduled(run) {
+				return false
+			}
+			if r.attempt > attempts {
+				errC <- fmt.Errorf("TaskRun %s has no attempt %d, it has %d", run.Name, r.attempt, attempts)
+			}
+			return true
 		}
-		if run.Status.PodName != "" {
-			addPod(run.Status.PodName)
-		}
+
+		addPods(run)
 
 		timeout := time.After(r.activityTimeout)
 
 		for {
 			select {
-			case event := <-watchRun.ResultChan():
+			case event, ok := <-watchRun.ResultChan():
+				if !ok {
+					errC <- fmt.Errorf("watch on taskrun %s closed unexpectedly", r.run)
+					return
+				}
+				if event.Type == watch.Deleted {
+					r.journal.record("TaskRun", r.run, string(event.Type), "", "")
+					errC <- fmt.Errorf("taskrun %s has been deleted while streaming logs", r.run)
+					return
+				}
 				var err error
-				run, err = cast2taskrun(event.Object)
-				if err != nil {
+				if event.Type == watch.Error {
+					// the watch expires when the resource version it started
+					// from is too old, the TaskRun is listed again and watched
+					// from its current version
+					err = errors.FromObject(event.Object)
+					if !errors.IsResourceExpired(err) && !errors.IsGone(err) {
+						errC <- err
+						return
+					}
+					r.relisted(nil, "TaskRun", r.run, err)
+					relisted, rewatched, err := r.rewatchTaskRun(opts)
+					if err != nil {
+						errC <- err
+						return
+					}
+					watchRun.Stop()
+					run, watchRun = relisted, rewatched
+				} else if run, err = cast2taskrun(event.Object); err != nil {
 					errC <- err
 					return
 				}
+				if event.Type != watch.Error {
+					r.recordCondition("TaskRun", run.Name, string(event.Type), run.Status.GetCondition(apis.ConditionSucceeded))
+				}
 				if run.Status.PodName != "" {
-					addPod(run.Status.PodName)
-					if !areRetriesScheduled(run, r.retries) {
+					addPods(run)
+					if done(run) {
 						return
 					}
 				}
@@ -274,11 +429,17 @@ func (r *Reader) getTaskRunPodNames(run *v1.TaskRun) (<-chan string, <-chan erro
 				}
 				// check if pod has been started and has a name
 				if run.HasStarted() && run.Status.PodName != "" {
-					if areRetriesScheduled(run, r.retries) {
-						continue
+					if done(run) {
+						return
 					}
-					return
+					continue
+				}
+				// waiting for the pod is only an activity timeout when one
+				// was given, the logs end without an error otherwise
+				if r.stepTimeout != 0 {
+					r.timedOut.Store(true)
 				}
+				r.journal.record("TaskRun", r.run, JournalTimeout, "", "no pod within the activity timeout")
 				errC <- fmt.Errorf("task %s has not started yet or pod for task not yet available", r.task)
 				return
 			}
@@ -288,16 +449,71 @@ func (r *Reader) getTaskRunPodNames(run *v1.TaskRun) (<-chan string, <-chan erro
 	return podC, errC, nil
 }
 
-func filterSteps(pod *corev1.Pod, allSteps bool, stepsGiven []string) []*step {
+// rewatchTaskRun gets the TaskRun again and watches it from its current
+// resource version
+func (r *Reader) rewatchTaskRun(opts metav1.ListOptions) (*v1.TaskRun, watch.Interface, error) {
+	run, err := taskrunpkg.GetTaskRun(taskrunGroupResource, r.clients, r.run, r.ns)
+	if errors.IsNotFound(err) {
+		r.journal.record("TaskRun", r.run, string(watch.Deleted), "", "")
+		return nil, nil, fmt.Errorf("taskrun %s has been deleted while streaming logs", r.run)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	opts.ResourceVersion = run.ResourceVersion
+	watchRun, err := actions.Watch(taskrunGroupResource, r.clients, r.ns, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return run, watchRun, nil
+}
+
+// podSteps returns the status of the steps run in the pod named podName
+// from the TaskRun as it is now, the containers of the steps of a pod only
+// being known once it is created. It is nil when the TaskRun cannot be read,
+// the steps are then found with the prefixes of their containers.
+func (r *Reader) podSteps(podName string) []v1.StepState {
+	tr, err := taskrunpkg.GetTaskRun(taskrunGroupResource, r.clients, r.run, r.ns)
+	if err != nil {
+		return nil
+	}
+	return PodSteps(tr, podName)
+}
+
+// filterSteps returns the steps logs are read from. Ephemeral containers
+// attached for debugging are only included with allSteps or when they are
+// given by name in containersGiven.
+func filterSteps(pod *corev1.Pod, names *pods.StepNames, allSteps bool, stepsGiven, containersGiven, excluded []string) []*step {
 	steps := []*step{}
-	stepsInPod := getSteps(pod)
+	stepsInPod := getSteps(pod, names)
+
+	if len(containersGiven) != 0 {
+		containersToAdd := map[string]bool{}
+		for _, c := range containersGiven {
+			containersToAdd[c] = true
+		}
+		stepsToAdd := map[string]bool{}
+		for _, s := range stepsGiven {
+			stepsToAdd[s] = true
+		}
+		for _, sp := range append(getInitSteps(pod, names), stepsInPod...) {
+			if containersToAdd[sp.container] || stepsToAdd[sp.name] {
+				steps = append(steps, sp)
+			}
+		}
+		return steps
+	}
 
 	if allSteps {
-		steps = append(steps, getInitSteps(pod)...)
+		steps = append(steps, getInitSteps(pod, names)...)
 	}
 
 	if len(stepsGiven) == 0 {
-		steps = append(steps, stepsInPod...)
+		for _, sp := range stepsInPod {
+			if allSteps || (!sp.ephemeral && !isExcluded(sp, excluded)) {
+				steps = append(steps, sp)
+			}
+		}
 		return steps
 	}
 
@@ -307,7 +523,7 @@ func filterSteps(pod *corev1.Pod, allSteps bool, stepsGiven []string) []*step {
 	}
 
 	for _, sp := range stepsInPod {
-		if stepsToAdd[sp.name] {
+		if stepsToAdd[sp.name] && !sp.ephemeral {
 			steps = append(steps, sp)
 		}
 	}
@@ -315,36 +531,63 @@ func filterSteps(pod *corev1.Pod, allSteps bool, stepsGiven []string) []*step {
 	return steps
 }
 
-func getInitSteps(pod *corev1.Pod) []*step {
-	status := map[string]corev1.ContainerState{}
-	for _, ics := range pod.Status.InitContainerStatuses {
-		status[ics.Name] = ics.State
+// isExcluded tells whether the name of the step or of its container matches
+// one of the patterns
+func isExcluded(sp *step, patterns []string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, sp.name); ok {
+			return true
+		}
+		if ok, _ := path.Match(p, sp.container); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// containerStatuses returns the status of the containers of pod by name
+func containerStatuses(pod *corev1.Pod) map[string]pods.ContainerStatus {
+	statuses := map[string]pods.ContainerStatus{}
+	for _, cs := range pods.ContainerStatuses(pod) {
+		statuses[cs.Name] = cs
 	}
+	return statuses
+}
 
+func getInitSteps(pod *corev1.Pod, names *pods.StepNames) []*step {
+	statuses := containerStatuses(pod)
 	steps := []*step{}
 	for _, ic := range pod.Spec.InitContainers {
+		name, _ := names.Name(ic.Name)
 		steps = append(steps, &step{
-			name:      strings.TrimPrefix(ic.Name, "step-"),
+			name:      name,
 			container: ic.Name,
-			state:     status[ic.Name],
+			status:    statuses[ic.Name],
 		})
 	}
 
 	return steps
 }
 
-func getSteps(pod *corev1.Pod) []*step {
-	status := map[string]corev1.ContainerState{}
-	for _, cs := range pod.Status.ContainerStatuses {
-		status[cs.Name] = cs.State
-	}
-
+// getSteps returns the containers of the pod, named after their step when
+// they have one of the prefixes
+func getSteps(pod *corev1.Pod, names *pods.StepNames) []*step {
+	statuses := containerStatuses(pod)
 	steps := []*step{}
 	for _, c := range pod.Spec.Containers {
+		name, _ := names.Name(c.Name)
 		steps = append(steps, &step{
-			name:      strings.TrimPrefix(c.Name, "step-"),
+			name:      name,
 			container: c.Name,
-			state:     status[c.Name],
+			status:    statuses[c.Name],
+		})
+	}
+	for _, ec := range pod.Spec.EphemeralContainers {
+		steps = append(steps, &step{
+			name:      ec.Name,
+			container: ec.Name,
+			status:    statuses[ec.Name],
+			ephemeral: true,
 		})
 	}
 
@@ -375,10 +618,26 @@ func isFailure(tr *v1.TaskRun) bool {
 	return len(conditions) != 0 && conditions[0].Status == corev1.ConditionFalse
 }
 
-func areRetriesScheduled(tr *v1.TaskRun, retries int) bool {
-	if tr.IsDone() {
+// areRetriesScheduled tells whether a pod of a retry of the TaskRun may
+// still be created, the retries are those of its spec or of its pipeline
+// task, and are not waited for when the reader stops at a failed attempt
+func (r *Reader) areRetriesScheduled(tr *v1.TaskRun) bool {
+	if r.stopAtFailure || tr.IsDone() {
 		return false
 	}
-	retriesDone := len(tr.Status.RetriesStatus)
-	return retriesDone < retries
+	retries := max(r.retries, tr.Spec.Retries)
+	return len(tr.Status.RetriesStatus) < retries
+}
+
+// attemptPods returns the names of the pods of the attempts of the TaskRun,
+// from the first one, the current attempt being last once it has a pod
+func attemptPods(tr *v1.TaskRun) []string {
+	names := make([]string, 0, len(tr.Status.RetriesStatus)+1)
+	for _, retryStatus := range tr.Status.RetriesStatus {
+		names = append(names, retryStatus.PodName)
+	}
+	if tr.Status.PodName != "" {
+		names = append(names, tr.Status.PodName)
+	}
+	return names
 }
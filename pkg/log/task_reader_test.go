@@ -0,0 +1,93 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/pods"
+	"github.com/tektoncd/cli/pkg/pods/stream"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// silentStream writes a log line and then stays silent until it is closed
+type silentStream struct{}
+
+func (s *silentStream) Stream() (io.ReadCloser, error) {
+	r, w := io.Pipe()
+	go fmt.Fprintln(w, "started building")
+	return r, nil
+}
+
+func TestReader_readStepsLogs_activityTimeout(t *testing.T) {
+	streamer := func(typedv1.PodInterface, string, *corev1.PodLogOptions) stream.Streamer {
+		return &silentStream{}
+	}
+	pod := pods.New("build-pod", "ns", fake.NewSimpleClientset(), streamer)
+	r := &Reader{
+		task:          "build",
+		stepTimeout:   50 * time.Millisecond,
+		hangThreshold: time.Hour,
+		timedOut:      &atomic.Bool{},
+	}
+
+	before := runtime.NumGoroutine()
+
+	logC := make(chan Log)
+	errC := make(chan error)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.readStepsLogs(logC, errC, []*step{{name: "build", container: "step-build"}}, pod, nil, true, false)
+	}()
+
+	var logs []string
+loop:
+	for {
+		select {
+		case l := <-logC:
+			logs = append(logs, l.Log)
+		case err := <-errC:
+			t.Fatalf("unexpected error: %v", err)
+		case <-done:
+			break loop
+		}
+	}
+
+	want := []string{"started building", "--- no logs for 50ms, activity timeout reached ---"}
+	if fmt.Sprint(logs) != fmt.Sprint(want) {
+		t.Errorf("got logs %q, want %q", logs, want)
+	}
+	if !r.timedOut.Load() {
+		t.Error("expected the reader to have timed out")
+	}
+
+	// the container reader ends once its stream is closed
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			buf := make([]byte, 1<<16)
+			t.Fatalf("goroutines leaked, %d running rather than %d:\n%s", runtime.NumGoroutine(), before, buf[:runtime.Stack(buf, true)])
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Encodings of the archived logs transferred compressed
+const (
+	EncodingGzip = "gzip"
+	EncodingZstd = "zstd"
+)
+
+// acceptEncoding asks the backends for the archived logs compressed, with
+// zstd rather than gzip
+const acceptEncoding = EncodingZstd + ", " + EncodingGzip
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// progressInterval is how often the progress of a transfer is reported
+var progressInterval = time.Second
+
+// transfer is the body of archived logs as it is transferred
+type transfer struct {
+	body io.ReadCloser
+	// encoding is the compression of the body, empty when it is not
+	// compressed
+	encoding string
+	// size is the size of the body as transferred, -1 when unknown
+	size int64
+}
+
+// newTransfer returns the transfer of the body of resp. The compression is
+// the Content-Encoding of the response, or is detected from the first bytes
+// of the body for the logs stored compressed, e.g. as .gz objects.
+func newTransfer(resp *http.Response) (*transfer, error) {
+	t := &transfer{body: resp.Body, size: resp.ContentLength}
+	switch encoding := strings.ToLower(resp.Header.Get("Content-Encoding")); encoding {
+	case EncodingGzip, EncodingZstd:
+		t.encoding = encoding
+		return t, nil
+	case "", "identity":
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("unsupported Content-Encoding %q", encoding)
+	}
+
+	br := bufio.NewReader(resp.Body)
+	magic, _ := br.Peek(len(zstdMagic))
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		t.encoding = EncodingGzip
+	case bytes.HasPrefix(magic, zstdMagic):
+		t.encoding = EncodingZstd
+	}
+	t.body = struct {
+		io.Reader
+		io.Closer
+	}{br, resp.Body}
+	return t, nil
+}
+
+// copyTo writes the logs to out, decompressed on the fly unless
+// keepCompressed is set. Kept compressed, logs which were not transferred
+// compressed are compressed with gzip, the returned encoding is the one of
+// what was written.
+func (t *transfer) copyTo(out io.Writer, keepCompressed bool) (string, error) {
+	defer t.body.Close()
+
+	if keepCompressed {
+		if t.encoding != "" {
+			_, err := io.Copy(out, t.body)
+			return t.encoding, err
+		}
+		zw := gzip.NewWriter(out)
+		if _, err := io.Copy(zw, t.body); err != nil {
+			return "", err
+		}
+		return EncodingGzip, zw.Close()
+	}
+
+	switch t.encoding {
+	case EncodingGzip:
+		zr, err := gzip.NewReader(t.body)
+		if err != nil {
+			return "", fmt.Errorf("failed to decompress the logs: %w", err)
+		}
+		defer zr.Close()
+		_, err = io.Copy(out, zr)
+		return "", err
+	case EncodingZstd:
+		zr, err := zstd.NewReader(t.body)
+		if err != nil {
+			return "", fmt.Errorf("failed to decompress the logs: %w", err)
+		}
+		defer zr.Close()
+		_, err = io.Copy(out, zr)
+		return "", err
+	}
+	_, err := io.Copy(out, t.body)
+	return "", err
+}
+
+// reportProgress reports on w how much of the body was transferred every
+// progressInterval, and once it is done when it was reported before, so
+// that nothing is reported for the transfers taking less than an interval
+func (t *transfer) reportProgress(w io.Writer) {
+	t.body = &progressReader{ReadCloser: t.body, w: w, size: t.size, encoding: t.encoding, reported: time.Now()}
+}
+
+// progressReader counts the bytes read from a transfer and reports them on
+// a line of a terminal which is rewritten
+type progressReader struct {
+	io.ReadCloser
+	w        io.Writer
+	size     int64
+	encoding string
+	read     int64
+	reported time.Time
+	shown    bool
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.ReadCloser.Read(b)
+	p.read += int64(n)
+	if now := time.Now(); now.Sub(p.reported) >= progressInterval {
+		p.reported = now
+		p.shown = true
+		p.report()
+	}
+	return n, err
+}
+
+func (p *progressReader) Close() error {
+	if p.shown {
+		p.report()
+		fmt.Fprintln(p.w)
+	}
+	return p.ReadCloser.Close()
+}
+
+func (p *progressReader) report() {
+	fmt.Fprintf(p.w, "\rReceived %s", formatBytes(p.read))
+	if p.size >= 0 {
+		fmt.Fprintf(p.w, " of %s", formatBytes(p.size))
+	}
+	if p.encoding != "" {
+		fmt.Fprintf(p.w, " (%s)", p.encoding)
+	}
+}
+
+// formatBytes formats a number of bytes with binary units
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
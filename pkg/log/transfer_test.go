@@ -0,0 +1,84 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"gotest.tools/v3/assert"
+)
+
+func response(encoding string, body []byte) *http.Response {
+	resp := &http.Response{
+		Header:        http.Header{},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+	}
+	if encoding != "" {
+		resp.Header.Set("Content-Encoding", encoding)
+	}
+	return resp
+}
+
+func TestTransfer_copyTo(t *testing.T) {
+	zw, err := zstd.NewWriter(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compressed := zw.EncodeAll([]byte("[build] done\n"), nil)
+
+	for _, encoding := range []string{EncodingZstd, ""} {
+		tr, err := newTransfer(response(encoding, compressed))
+		assert.NilError(t, err)
+		assert.Equal(t, tr.encoding, EncodingZstd)
+
+		var out bytes.Buffer
+		written, err := tr.copyTo(&out, false)
+		assert.NilError(t, err)
+		assert.Equal(t, written, "")
+		assert.Equal(t, out.String(), "[build] done\n")
+	}
+
+	_, err = newTransfer(response("br", compressed))
+	assert.Error(t, err, `unsupported Content-Encoding "br"`)
+}
+
+func TestTransfer_reportProgress(t *testing.T) {
+	interval := progressInterval
+	progressInterval = 0
+	defer func() { progressInterval = interval }()
+
+	tr, err := newTransfer(response("", []byte(strings.Repeat("x", 3000))))
+	assert.NilError(t, err)
+	var progress bytes.Buffer
+	tr.reportProgress(&progress)
+
+	var out bytes.Buffer
+	_, err = tr.copyTo(&out, false)
+	assert.NilError(t, err)
+	assert.Equal(t, out.Len(), 3000)
+	assert.Assert(t, strings.HasSuffix(progress.String(), "\rReceived 2.9 KiB of 2.9 KiB\n"), progress.String())
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, formatBytes(512), "512 B")
+	assert.Equal(t, formatBytes(1536), "1.5 KiB")
+	assert.Equal(t, formatBytes(3<<30), "3.0 GiB")
+}
@@ -0,0 +1,142 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Window is a time range logs are sliced to, both bounds included
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ParseWindow parses a window written START,END. Each bound is either an
+// RFC3339 time or a time of day (15:04 or 15:04:05) on the date of ref, in
+// its location. A time of day END before START is on the next day.
+func ParseWindow(s string, ref time.Time) (*Window, error) {
+	bounds := strings.Split(s, ",")
+	if len(bounds) != 2 {
+		return nil, fmt.Errorf("invalid window %q, expected START,END", s)
+	}
+
+	start, startClock, err := parseBound(strings.TrimSpace(bounds[0]), ref)
+	if err != nil {
+		return nil, err
+	}
+	end, endClock, err := parseBound(strings.TrimSpace(bounds[1]), ref)
+	if err != nil {
+		return nil, err
+	}
+	if startClock && endClock && end.Before(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("invalid window %q, the end is before the start", s)
+	}
+	return &Window{Start: start, End: end}, nil
+}
+
+// parseBound returns the time and whether it was given as a time of day
+func parseBound(s string, ref time.Time) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, false, nil
+	}
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if c, err := time.Parse(layout, s); err == nil {
+			y, m, d := ref.Date()
+			return time.Date(y, m, d, c.Hour(), c.Minute(), c.Second(), 0, ref.Location()), true, nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("invalid time %q, expected an RFC3339 time or a time of day such as 12:01:00", s)
+}
+
+// Contains tells whether t is within the window, a nil window contains any
+// time
+func (w *Window) Contains(t time.Time) bool {
+	if w == nil {
+		return true
+	}
+	return !t.Before(w.Start) && !t.After(w.End)
+}
+
+type timedLog struct {
+	time time.Time
+	log  Log
+}
+
+// MergeByTime reads all the logs, which must be prefixed with the timestamp
+// Kubernetes adds with the timestamps option, and sends them merged
+// chronologically across tasks and steps. Only the logs within w are sent
+// unless w is nil. The timestamps are stripped unless keepTimestamps is set.
+func MergeByTime(logC <-chan Log, w *Window, keepTimestamps bool) <-chan Log {
+	out := make(chan Log)
+
+	go func() {
+		defer close(out)
+
+		logs := []timedLog{}
+		// lines without a timestamp belong to the previous line of the step
+		last := map[string]time.Time{}
+		for l := range logC {
+			if l.Log == "EOFLOG" || l.Log == "FINALLYLOG" {
+				continue
+			}
+
+			key := l.Task + "/" + l.Step
+			ts, line, ok := splitTimestamp(l.Log)
+			if ok {
+				last[key] = ts
+			} else if ts, ok = last[key]; !ok {
+				continue
+			}
+			if !w.Contains(ts) {
+				continue
+			}
+			if !keepTimestamps {
+				l.Log = line
+			}
+			logs = append(logs, timedLog{ts, l})
+		}
+
+		sort.SliceStable(logs, func(i, j int) bool {
+			return logs[i].time.Before(logs[j].time)
+		})
+		for _, l := range logs {
+			out <- l.log
+		}
+	}()
+
+	return out
+}
+
+func splitTimestamp(line string) (time.Time, string, bool) {
+	i := strings.IndexByte(line, ' ')
+	if i == -1 {
+		i = len(line)
+	}
+	ts, err := time.Parse(time.RFC3339Nano, line[:i])
+	if err != nil {
+		return time.Time{}, line, false
+	}
+	if i == len(line) {
+		return ts, "", true
+	}
+	return ts, line[i+1:], true
+}
@@ -0,0 +1,132 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestParseWindow(t *testing.T) {
+	ref := time.Date(2026, 3, 4, 11, 58, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		window  string
+		want    *Window
+		wantErr string
+	}{
+		{
+			name:   "times of day",
+			window: "12:01:00,12:03:30",
+			want:   &Window{Start: time.Date(2026, 3, 4, 12, 1, 0, 0, time.UTC), End: time.Date(2026, 3, 4, 12, 3, 30, 0, time.UTC)},
+		},
+		{
+			name:   "times of day without seconds across midnight",
+			window: "23:50,00:10",
+			want:   &Window{Start: time.Date(2026, 3, 4, 23, 50, 0, 0, time.UTC), End: time.Date(2026, 3, 5, 0, 10, 0, 0, time.UTC)},
+		},
+		{
+			name:   "RFC3339",
+			window: "2026-03-04T12:01:00Z, 2026-03-04T14:03:30+02:00",
+			want:   &Window{Start: time.Date(2026, 3, 4, 12, 1, 0, 0, time.UTC), End: time.Date(2026, 3, 4, 14, 3, 30, 0, time.FixedZone("", 2*60*60))},
+		},
+		{
+			name:    "single bound",
+			window:  "12:01:00",
+			wantErr: `invalid window "12:01:00", expected START,END`,
+		},
+		{
+			name:    "invalid time",
+			window:  "noon,12:03",
+			wantErr: `invalid time "noon", expected an RFC3339 time or a time of day such as 12:01:00`,
+		},
+		{
+			name:    "end before start",
+			window:  "2026-03-04T12:01:00Z,2026-03-04T12:00:00Z",
+			wantErr: `invalid window "2026-03-04T12:01:00Z,2026-03-04T12:00:00Z", the end is before the start`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseWindow(tt.window, ref)
+			if tt.wantErr != "" {
+				assert.Error(t, err, tt.wantErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Assert(t, got.Start.Equal(tt.want.Start), "start %s", got.Start)
+			assert.Assert(t, got.End.Equal(tt.want.End), "end %s", got.End)
+		})
+	}
+}
+
+func TestMergeByTime(t *testing.T) {
+	w := &Window{
+		Start: time.Date(2026, 3, 4, 12, 1, 0, 0, time.UTC),
+		End:   time.Date(2026, 3, 4, 12, 3, 30, 0, time.UTC),
+	}
+	logC := make(chan Log)
+	go func() {
+		defer close(logC)
+		for _, l := range []Log{
+			{Task: "build", Step: "compile", Log: "2026-03-04T12:00:59.000000000Z too early"},
+			{Task: "build", Step: "compile", Log: "2026-03-04T12:01:10.000000000Z compiling"},
+			{Task: "build", Step: "compile", Log: "  continued"},
+			{Task: "build", Step: "compile", Log: "2026-03-04T12:02:30.000000000Z compiled"},
+			{Log: "EOFLOG"},
+			{Task: "test", Step: "unit", Log: "2026-03-04T12:01:05.500000000Z testing"},
+			{Task: "test", Step: "unit", Log: "2026-03-04T12:03:31.000000000Z too late"},
+		} {
+			logC <- l
+		}
+	}()
+
+	got := []Log{}
+	for l := range MergeByTime(logC, w, false) {
+		got = append(got, l)
+	}
+	assert.DeepEqual(t, got, []Log{
+		{Task: "test", Step: "unit", Log: "testing"},
+		{Task: "build", Step: "compile", Log: "compiling"},
+		{Task: "build", Step: "compile", Log: "  continued"},
+		{Task: "build", Step: "compile", Log: "compiled"},
+	})
+}
+
+func TestMergeByTime_without_window(t *testing.T) {
+	logC := make(chan Log)
+	go func() {
+		defer close(logC)
+		for _, l := range []Log{
+			{Task: "build", Step: "compile", Log: "2026-03-04T12:00:59.000000000Z compiling"},
+			{Task: "build", Step: "compile", Log: "2026-03-04T12:02:00.000000000Z compiled"},
+			{Task: "test", Step: "unit", Log: "2026-03-04T12:01:00.000000000Z testing"},
+		} {
+			logC <- l
+		}
+	}()
+
+	got := []Log{}
+	for l := range MergeByTime(logC, nil, true) {
+		got = append(got, l)
+	}
+	assert.DeepEqual(t, got, []Log{
+		{Task: "build", Step: "compile", Log: "2026-03-04T12:00:59.000000000Z compiling"},
+		{Task: "test", Step: "unit", Log: "2026-03-04T12:01:00.000000000Z testing"},
+		{Task: "build", Step: "compile", Log: "2026-03-04T12:02:00.000000000Z compiled"},
+	})
+}
@@ -15,17 +15,30 @@
 package log
 
 import (
+	"bufio"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/formatted"
 )
 
+// writeBufferSize is the size of the buffer logs are written through
+// when buffering is enabled
+const writeBufferSize = 64 * 1024
+
 // Writer helps logging pod"s log
 type Writer struct {
-	fmt       *formatted.Color
-	logType   string
-	prefixing bool
+	fmt           *formatted.Color
+	logType       string
+	prefixing     bool
+	buffered      bool
+	flushInterval time.Duration
+	// noBanner drops the headers and the separators written between the logs
+	noBanner bool
+	// silent also drops the notices
+	silent bool
 }
 
 // NewWriter returns the new instance of LogWriter
@@ -37,10 +50,48 @@ func NewWriter(logType string, prefixing bool) *Writer {
 	}
 }
 
-// Write formatted pod's logs
+// SetBuffering configures how the output is buffered: logs which are not
+// followed are written through a buffer flushed when full and at the end,
+// followed logs are written line by line unless flushInterval is set, in
+// which case they are flushed at least every flushInterval
+func (lw *Writer) SetBuffering(follow bool, flushInterval time.Duration) *Writer {
+	lw.buffered = !follow || flushInterval > 0
+	lw.flushInterval = flushInterval
+	return lw
+}
+
+// SetQuiet drops the headers and the separators written between the logs
+// when noBanner is set, and the notices as well when silent is set
+func (lw *Writer) SetQuiet(noBanner, silent bool) *Writer {
+	lw.noBanner = noBanner || silent
+	lw.silent = silent
+	return lw
+}
+
+// Write formatted pod's logs, only the logs and the separators between the
+// steps are written to the output, the banners, the notices and the errors
+// go to the error stream
 func (lw *Writer) Write(s *cli.Stream, logC <-chan Log, errC <-chan error) {
+	var out io.Writer = s.Out
+	flush := func() {}
+	var tick <-chan time.Time
+	if lw.buffered {
+		buf := bufio.NewWriterSize(s.Out, writeBufferSize)
+		out = buf
+		flush = func() { _ = buf.Flush() }
+		defer flush()
+
+		if lw.flushInterval > 0 {
+			ticker := time.NewTicker(lw.flushInterval)
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+	}
+
 	for logC != nil || errC != nil {
 		select {
+		case <-tick:
+			flush()
 		case l, ok := <-logC:
 			if !ok {
 				logC = nil
@@ -48,25 +99,50 @@ func (lw *Writer) Write(s *cli.Stream, logC <-chan Log, errC <-chan error) {
 			}
 
 			if l.Log == "EOFLOG" {
-				fmt.Fprintf(s.Out, "\n")
+				if !lw.noBanner {
+					fmt.Fprintf(out, "\n")
+				}
+				continue
+			}
+
+			if l.Notice {
+				if !lw.silent {
+					// keep notices in order with the logs written before them
+					flush()
+					fmt.Fprintln(s.Err, l.Log)
+				}
+				continue
+			}
+
+			if l.Log == "FINALLYLOG" {
+				if !lw.noBanner {
+					flush()
+					fmt.Fprintf(s.Err, "%s\n", formatted.DecorateAttr("bold", "finally:"))
+				}
 				continue
 			}
 
 			if lw.prefixing {
 				switch lw.logType {
 				case LogTypePipeline:
-					lw.fmt.Rainbow.Fprintf(l.Step, s.Out, "[%s : %s] ", l.Task, l.Step)
+					lw.fmt.Rainbow.Fprintf(l.Step, out, "[%s : %s] ", l.Task, l.Step)
 				case LogTypeTask:
-					lw.fmt.Rainbow.Fprintf(l.Step, s.Out, "[%s] ", l.Step)
+					lw.fmt.Rainbow.Fprintf(l.Step, out, "[%s] ", l.Step)
 				}
 			}
 
-			fmt.Fprintf(s.Out, "%s\n", l.Log)
+			if l.Continued {
+				fmt.Fprintf(out, "%s%s\n", l.Log, ContinuationMarker)
+				continue
+			}
+			fmt.Fprintf(out, "%s\n", l.Log)
 		case e, ok := <-errC:
 			if !ok {
 				errC = nil
 				continue
 			}
+			// keep errors in order with the logs written before them
+			flush()
 			lw.fmt.Error(s.Err, "%s\n", e)
 		}
 	}
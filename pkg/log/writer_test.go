@@ -0,0 +1,90 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"gotest.tools/v3/assert"
+)
+
+func TestWriter_notice(t *testing.T) {
+	logC := make(chan Log, 3)
+	errC := make(chan error)
+	logC <- Log{Step: "build", Log: "compiling"}
+	logC <- Log{Notice: true, Log: "--- watch of pod p failed, listing it again: EOF ---"}
+	logC <- Log{Step: "build", Log: "done"}
+	close(logC)
+	close(errC)
+
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	NewWriter(LogTypeTask, true).
+		SetBuffering(true, 0).
+		Write(&cli.Stream{Out: out, Err: errOut}, logC, errC)
+
+	assert.Equal(t, "[build] compiling\n[build] done\n", out.String())
+	assert.Equal(t, "--- watch of pod p failed, listing it again: EOF ---\n", errOut.String())
+}
+
+func TestWriter_quiet(t *testing.T) {
+	logs := []Log{
+		{Task: "build", Step: "compile", Log: "compiling"},
+		{Task: "build", Step: "compile", Log: "EOFLOG"},
+		{Notice: true, Log: "--- watch of pod p failed, listing it again: EOF ---"},
+		{Log: "FINALLYLOG"},
+		{Task: "cleanup", Step: "rm", Log: "removed"},
+	}
+	tests := []struct {
+		name             string
+		noBanner, silent bool
+		wantErr          string
+	}{{
+		name:    "default",
+		wantErr: "--- watch of pod p failed, listing it again: EOF ---\nfinally:\n",
+	}, {
+		name:     "no banner",
+		noBanner: true,
+		wantErr:  "--- watch of pod p failed, listing it again: EOF ---\n",
+	}, {
+		name:   "silent",
+		silent: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logC := make(chan Log, len(logs))
+			errC := make(chan error)
+			for _, l := range logs {
+				logC <- l
+			}
+			close(logC)
+			close(errC)
+
+			out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+			NewWriter(LogTypePipeline, true).
+				SetBuffering(false, 0).
+				SetQuiet(tt.noBanner, tt.silent).
+				Write(&cli.Stream{Out: out, Err: errOut}, logC, errC)
+
+			want := "[build : compile] compiling\n\n[cleanup : rm] removed\n"
+			if tt.noBanner || tt.silent {
+				want = "[build : compile] compiling\n[cleanup : rm] removed\n"
+			}
+			assert.Equal(t, want, out.String())
+			assert.Equal(t, tt.wantErr, errOut.String())
+		})
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// managedByLabel marks the objects created by tkn namespace init
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedBy      = "tkn"
+
+	// the ClusterRoles installed with Tekton Triggers which the service
+	// account of EventListeners needs to be bound to
+	triggersRoles        = "tekton-triggers-eventlistener-roles"
+	triggersClusterRoles = "tekton-triggers-eventlistener-clusterroles"
+)
+
+// Options describe the namespace of a CI tenant
+type Options struct {
+	Name           string
+	ServiceAccount string
+	// DockerSecrets are the docker config.json files, by name of the
+	// secret, the service account pulls images and pushes them with
+	DockerSecrets map[string][]byte
+	// Triggers binds the service account to the roles EventListeners need
+	Triggers bool
+	Quota    *Quota
+}
+
+// Quota is the ResourceQuota of the namespace and the defaults of the
+// containers which do not set their resources
+type Quota struct {
+	Hard           corev1.ResourceList
+	Default        corev1.ResourceList
+	DefaultRequest corev1.ResourceList
+}
+
+// QuotaProfiles are the quotas of the namespaces by size of the tenant
+var QuotaProfiles = map[string]Quota{
+	"small": {
+		Hard:           resources("requests.cpu=4,requests.memory=8Gi,limits.cpu=8,limits.memory=16Gi,pods=20,persistentvolumeclaims=10"),
+		Default:        resources("cpu=500m,memory=512Mi"),
+		DefaultRequest: resources("cpu=100m,memory=128Mi"),
+	},
+	"medium": {
+		Hard:           resources("requests.cpu=16,requests.memory=32Gi,limits.cpu=32,limits.memory=64Gi,pods=50,persistentvolumeclaims=25"),
+		Default:        resources("cpu=1,memory=1Gi"),
+		DefaultRequest: resources("cpu=250m,memory=256Mi"),
+	},
+	"large": {
+		Hard:           resources("requests.cpu=64,requests.memory=128Gi,limits.cpu=128,limits.memory=256Gi,pods=200,persistentvolumeclaims=100"),
+		Default:        resources("cpu=2,memory=2Gi"),
+		DefaultRequest: resources("cpu=500m,memory=512Mi"),
+	},
+}
+
+// ProfileNames returns the names of the quota profiles
+func ProfileNames() []string {
+	names := make([]string, 0, len(QuotaProfiles))
+	for name := range QuotaProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseQuota parses profile=NAME[,RESOURCE=QUANTITY...], the quantities
+// override the hard limits of the profile, e.g. profile=small,pods=50
+func ParseQuota(s string) (*Quota, error) {
+	var profile string
+	overrides := corev1.ResourceList{}
+	for _, kv := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" || v == "" {
+			return nil, fmt.Errorf("invalid quota %q, expected profile=NAME[,RESOURCE=QUANTITY...]", s)
+		}
+		if k == "profile" {
+			profile = v
+			continue
+		}
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity %q for %s: %w", v, k, err)
+		}
+		overrides[corev1.ResourceName(k)] = q
+	}
+
+	p, ok := QuotaProfiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("unknown quota profile %q, use one of %s", profile, strings.Join(ProfileNames(), ", "))
+	}
+	quota := &Quota{
+		Hard:           p.Hard.DeepCopy(),
+		Default:        p.Default.DeepCopy(),
+		DefaultRequest: p.DefaultRequest.DeepCopy(),
+	}
+	for k, v := range overrides {
+		quota.Hard[k] = v
+	}
+	return quota, nil
+}
+
+// Objects returns the objects of the namespace in the order they are created
+func Objects(opts Options) []runtime.Object {
+	meta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      name,
+			Namespace: opts.Name,
+			Labels:    map[string]string{managedByLabel: managedBy},
+		}
+	}
+
+	objs := []runtime.Object{&corev1.Namespace{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   opts.Name,
+			Labels: map[string]string{managedByLabel: managedBy},
+		},
+	}}
+
+	secrets := make([]string, 0, len(opts.DockerSecrets))
+	for name := range opts.DockerSecrets {
+		secrets = append(secrets, name)
+	}
+	sort.Strings(secrets)
+	sa := &corev1.ServiceAccount{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"},
+		ObjectMeta: meta(opts.ServiceAccount),
+	}
+	for _, name := range secrets {
+		objs = append(objs, &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: meta(name),
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: opts.DockerSecrets[name]},
+		})
+		sa.ImagePullSecrets = append(sa.ImagePullSecrets, corev1.LocalObjectReference{Name: name})
+		sa.Secrets = append(sa.Secrets, corev1.ObjectReference{Name: name})
+	}
+	objs = append(objs, sa)
+
+	if opts.Triggers {
+		subjects := []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: opts.ServiceAccount, Namespace: opts.Name}}
+		objs = append(objs,
+			&rbacv1.RoleBinding{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "RoleBinding"},
+				ObjectMeta: meta(opts.ServiceAccount + "-triggers-eventlistener"),
+				Subjects:   subjects,
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: triggersRoles},
+			},
+			&rbacv1.ClusterRoleBinding{
+				TypeMeta: metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRoleBinding"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   opts.Name + "-" + opts.ServiceAccount + "-triggers-eventlistener",
+					Labels: map[string]string{managedByLabel: managedBy},
+				},
+				Subjects: subjects,
+				RoleRef:  rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: triggersClusterRoles},
+			},
+		)
+	}
+
+	if opts.Quota != nil {
+		objs = append(objs,
+			&corev1.ResourceQuota{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"},
+				ObjectMeta: meta("tekton-ci"),
+				Spec:       corev1.ResourceQuotaSpec{Hard: opts.Quota.Hard},
+			},
+			&corev1.LimitRange{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "LimitRange"},
+				ObjectMeta: meta("tekton-ci"),
+				Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+					Type:           corev1.LimitTypeContainer,
+					Default:        opts.Quota.Default,
+					DefaultRequest: opts.Quota.DefaultRequest,
+				}}},
+			},
+		)
+	}
+	return objs
+}
+
+// Create creates the objects which do not exist yet, the secrets missing
+// from an existing service account are linked to it. What is done is
+// written to out as kind/name followed by created, configured or unchanged.
+func Create(kube k8s.Interface, objs []runtime.Object, out io.Writer) error {
+	ctx := context.Background()
+	for _, obj := range objs {
+		var err error
+		switch o := obj.(type) {
+		case *corev1.Namespace:
+			_, err = kube.CoreV1().Namespaces().Create(ctx, o, metav1.CreateOptions{})
+		case *corev1.Secret:
+			_, err = kube.CoreV1().Secrets(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+		case *corev1.ServiceAccount:
+			_, err = kube.CoreV1().ServiceAccounts(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+			if errors.IsAlreadyExists(err) {
+				linked, lerr := linkSecrets(ctx, kube, o)
+				if lerr != nil {
+					return fmt.Errorf("failed to link the secrets to serviceaccount/%s: %v", o.Name, lerr)
+				}
+				if linked {
+					fmt.Fprintf(out, "serviceaccount/%s configured\n", o.Name)
+					continue
+				}
+			}
+		case *rbacv1.RoleBinding:
+			_, err = kube.RbacV1().RoleBindings(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+		case *rbacv1.ClusterRoleBinding:
+			_, err = kube.RbacV1().ClusterRoleBindings().Create(ctx, o, metav1.CreateOptions{})
+		case *corev1.ResourceQuota:
+			_, err = kube.CoreV1().ResourceQuotas(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+		case *corev1.LimitRange:
+			_, err = kube.CoreV1().LimitRanges(o.Namespace).Create(ctx, o, metav1.CreateOptions{})
+		default:
+			return fmt.Errorf("unsupported object %T", obj)
+		}
+
+		ref := objectRef(obj)
+		switch {
+		case err == nil:
+			fmt.Fprintf(out, "%s created\n", ref)
+		case errors.IsAlreadyExists(err):
+			fmt.Fprintf(out, "%s unchanged\n", ref)
+		default:
+			return fmt.Errorf("failed to create %s: %w", ref, err)
+		}
+	}
+	return nil
+}
+
+// linkSecrets adds the secrets of sa missing from the existing service
+// account, it tells whether the service account was updated
+func linkSecrets(ctx context.Context, kube k8s.Interface, sa *corev1.ServiceAccount) (bool, error) {
+	existing, err := kube.CoreV1().ServiceAccounts(sa.Namespace).Get(ctx, sa.Name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	linked := false
+	for _, s := range sa.ImagePullSecrets {
+		if !hasPullSecret(existing, s.Name) {
+			existing.ImagePullSecrets = append(existing.ImagePullSecrets, s)
+			linked = true
+		}
+	}
+	for _, s := range sa.Secrets {
+		if !hasSecret(existing, s.Name) {
+			existing.Secrets = append(existing.Secrets, s)
+			linked = true
+		}
+	}
+	if !linked {
+		return false, nil
+	}
+	_, err = kube.CoreV1().ServiceAccounts(sa.Namespace).Update(ctx, existing, metav1.UpdateOptions{})
+	return err == nil, err
+}
+
+func hasPullSecret(sa *corev1.ServiceAccount, name string) bool {
+	for _, s := range sa.ImagePullSecrets {
+		if s.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasSecret(sa *corev1.ServiceAccount, name string) bool {
+	for _, s := range sa.Secrets {
+		if s.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func objectRef(obj runtime.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	name := ""
+	if o, ok := obj.(metav1.Object); ok {
+		name = o.GetName()
+	}
+	return strings.ToLower(kind) + "/" + name
+}
+
+// resources parses the quantities of name=quantity pairs, it is only used
+// with the constant quantities of the profiles
+func resources(s string) corev1.ResourceList {
+	list := corev1.ResourceList{}
+	for _, kv := range strings.Split(s, ",") {
+		k, v, _ := strings.Cut(kv, "=")
+		list[corev1.ResourceName(k)] = resource.MustParse(v)
+	}
+	return list
+}
@@ -0,0 +1,140 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseQuota(t *testing.T) {
+	quota, err := ParseQuota("profile=small,pods=50")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pods := quota.Hard[corev1.ResourcePods]
+	test.AssertOutput(t, "50", pods.String())
+	cpu := quota.Hard[corev1.ResourceRequestsCPU]
+	test.AssertOutput(t, "4", cpu.String())
+	// the profile itself is left untouched
+	pods = QuotaProfiles["small"].Hard[corev1.ResourcePods]
+	test.AssertOutput(t, "20", pods.String())
+
+	for s, want := range map[string]string{
+		"profile=huge":          "unknown quota profile \"huge\", use one of large, medium, small",
+		"pods=50":               "unknown quota profile \"\", use one of large, medium, small",
+		"profile=small,pods":    "invalid quota \"profile=small,pods\", expected profile=NAME[,RESOURCE=QUANTITY...]",
+		"profile=small,pods=ab": "invalid quantity \"ab\" for pods: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+	} {
+		_, err := ParseQuota(s)
+		if err == nil {
+			t.Errorf("expected an error for %s", s)
+			continue
+		}
+		test.AssertOutput(t, want, err.Error())
+	}
+}
+
+func TestCreate(t *testing.T) {
+	quota, err := ParseQuota("profile=small")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := Options{
+		Name:           "team-a",
+		ServiceAccount: "ci",
+		DockerSecrets:  map[string][]byte{"registry": []byte(`{"auths":{}}`)},
+		Triggers:       true,
+		Quota:          quota,
+	}
+	kube := fake.NewSimpleClientset()
+
+	out := &bytes.Buffer{}
+	if err := Create(kube, Objects(opts), out); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, `namespace/team-a created
+secret/registry created
+serviceaccount/ci created
+rolebinding/ci-triggers-eventlistener created
+clusterrolebinding/team-a-ci-triggers-eventlistener created
+resourcequota/tekton-ci created
+limitrange/tekton-ci created
+`, out.String())
+
+	sa, err := kube.CoreV1().ServiceAccounts("team-a").Get(context.Background(), "ci", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, []corev1.LocalObjectReference{{Name: "registry"}}, sa.ImagePullSecrets)
+
+	lr, err := kube.CoreV1().LimitRanges("team-a").Get(context.Background(), "tekton-ci", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, resource.MustParse("512Mi"), lr.Spec.Limits[0].Default[corev1.ResourceMemory])
+
+	// running it again changes nothing
+	out.Reset()
+	if err := Create(kube, Objects(opts), out); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, `namespace/team-a unchanged
+secret/registry unchanged
+serviceaccount/ci unchanged
+rolebinding/ci-triggers-eventlistener unchanged
+clusterrolebinding/team-a-ci-triggers-eventlistener unchanged
+resourcequota/tekton-ci unchanged
+limitrange/tekton-ci unchanged
+`, out.String())
+}
+
+func TestCreate_link_secrets(t *testing.T) {
+	kube := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&corev1.ServiceAccount{
+			ObjectMeta:       metav1.ObjectMeta{Name: "ci", Namespace: "team-a"},
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "existing"}},
+		},
+	)
+	opts := Options{
+		Name:           "team-a",
+		ServiceAccount: "ci",
+		DockerSecrets:  map[string][]byte{"registry": []byte(`{"auths":{}}`)},
+	}
+
+	out := &bytes.Buffer{}
+	if err := Create(kube, Objects(opts), out); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, `namespace/team-a unchanged
+secret/registry created
+serviceaccount/ci configured
+`, out.String())
+
+	sa, err := kube.CoreV1().ServiceAccounts("team-a").Get(context.Background(), "ci", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertOutput(t, []corev1.LocalObjectReference{{Name: "existing"}, {Name: "registry"}}, sa.ImagePullSecrets)
+	test.AssertOutput(t, []corev1.ObjectReference{{Name: "registry"}}, sa.Secrets)
+}
@@ -0,0 +1,119 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notify tells the user the state of a followed run through the
+// terminal, so it can be noticed from another window.
+package notify
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+
+	"golang.org/x/term"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+)
+
+// isTerminal tells whether w is a terminal, the escape sequences are not
+// written to files and pipes
+var isTerminal = func(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// desktop shows a desktop notification, with notify-send on Linux and
+// osascript on macOS, it fails elsewhere or when the tool is missing
+var desktop = func(title, message string) error {
+	switch runtime.GOOS {
+	case "linux", "freebsd", "openbsd":
+		return exec.Command("notify-send", "--app-name", "tkn", title, message).Run()
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", strconv.Quote(message), strconv.Quote(title))
+		return exec.Command("osascript", "-e", script).Run()
+	}
+	return fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+}
+
+// Terminal sets the title of the terminal to the state of a run and rings
+// its bell along with a desktop notification once the run is done. Inside
+// tmux the name of the window is set as well.
+type Terminal struct {
+	w    io.Writer
+	tmux bool
+}
+
+// NewTerminal returns a Terminal writing to w, nothing is written when w is
+// not a terminal
+func NewTerminal(w io.Writer) *Terminal {
+	if !isTerminal(w) {
+		w = io.Discard
+	}
+	return &Terminal{w: w, tmux: os.Getenv("TMUX") != ""}
+}
+
+// Title sets the title of the terminal
+func (t *Terminal) Title(title string) {
+	fmt.Fprintf(t.w, "\x1b]0;%s\x07", title)
+	if t.tmux {
+		fmt.Fprintf(t.w, "\x1bk%s\x1b\\", title)
+	}
+}
+
+// Done sets the title of the terminal, rings its bell and shows a desktop
+// notification where supported. The notification is best effort, a
+// terminal without a desktop still gets the title and the bell.
+func (t *Terminal) Done(title, message string) {
+	t.Title(title)
+	fmt.Fprint(t.w, "\a")
+	_ = desktop(title, message)
+}
+
+// Running sets the title of the terminal to tell that the logs of the run of
+// kind, PipelineRun or TaskRun, named name are followed
+func (t *Terminal) Running(kind, name string) {
+	t.Title(fmt.Sprintf("tkn: %s %s running", kind, name))
+}
+
+// Finished tells that the run of kind named name finished with the
+// Succeeded condition c
+func (t *Terminal) Finished(kind, name string, c *apis.Condition) {
+	title := fmt.Sprintf("tkn: %s %s %s", kind, name, status(c))
+	message := fmt.Sprintf("%s %s %s", kind, name, status(c))
+	if c != nil && c.Message != "" {
+		message = c.Message
+	}
+	t.Done(title, message)
+}
+
+// status returns the state of a run without the colours of the other
+// outputs, which would end up in the title
+func status(c *apis.Condition) string {
+	if c == nil {
+		return "running"
+	}
+	switch c.Status {
+	case corev1.ConditionTrue:
+		return "succeeded"
+	case corev1.ConditionFalse:
+		if c.Reason != "" && c.Reason != "Failed" {
+			return "failed (" + c.Reason + ")"
+		}
+		return "failed"
+	}
+	return "running"
+}
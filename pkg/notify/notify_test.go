@@ -0,0 +1,84 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+)
+
+func stub(t *testing.T, terminal bool) *[]string {
+	t.Helper()
+	notified := []string{}
+	oldTerminal, oldDesktop := isTerminal, desktop
+	isTerminal = func(io.Writer) bool { return terminal }
+	desktop = func(title, message string) error {
+		notified = append(notified, title+": "+message)
+		return nil
+	}
+	t.Cleanup(func() { isTerminal, desktop = oldTerminal, oldDesktop })
+	return &notified
+}
+
+func TestTerminal(t *testing.T) {
+	tests := []struct {
+		name     string
+		tmux     string
+		cond     *apis.Condition
+		want     string
+		notified []string
+	}{{
+		name:     "succeeded",
+		cond:     &apis.Condition{Type: apis.ConditionSucceeded, Status: corev1.ConditionTrue, Reason: "Succeeded", Message: "Tasks Completed: 2 (Failed: 0, Cancelled 0), Skipped: 0"},
+		want:     "\x1b]0;tkn: PipelineRun pr-1 running\x07\x1b]0;tkn: PipelineRun pr-1 succeeded\x07\a",
+		notified: []string{"tkn: PipelineRun pr-1 succeeded: Tasks Completed: 2 (Failed: 0, Cancelled 0), Skipped: 0"},
+	}, {
+		name:     "cancelled in tmux",
+		tmux:     "/tmp/tmux-1000/default,1234,0",
+		cond:     &apis.Condition{Type: apis.ConditionSucceeded, Status: corev1.ConditionFalse, Reason: "Cancelled"},
+		want:     "\x1b]0;tkn: PipelineRun pr-1 running\x07\x1bktkn: PipelineRun pr-1 running\x1b\\\x1b]0;tkn: PipelineRun pr-1 failed (Cancelled)\x07\x1bktkn: PipelineRun pr-1 failed (Cancelled)\x1b\\\a",
+		notified: []string{"tkn: PipelineRun pr-1 failed (Cancelled): PipelineRun pr-1 failed (Cancelled)"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notified := stub(t, true)
+			t.Setenv("TMUX", tt.tmux)
+			out := &bytes.Buffer{}
+
+			term := NewTerminal(out)
+			term.Running("PipelineRun", "pr-1")
+			term.Finished("PipelineRun", "pr-1", tt.cond)
+			assert.Equal(t, out.String(), tt.want)
+			assert.DeepEqual(t, *notified, tt.notified)
+		})
+	}
+}
+
+func TestTerminal_notATerminal(t *testing.T) {
+	notified := stub(t, false)
+	out := &bytes.Buffer{}
+
+	term := NewTerminal(out)
+	term.Running("TaskRun", "tr-1")
+	term.Finished("TaskRun", "tr-1", &apis.Condition{Type: apis.ConditionSucceeded, Status: corev1.ConditionFalse, Reason: "Failed"})
+	assert.Equal(t, out.String(), "")
+	// the desktop notification does not depend on the terminal
+	assert.DeepEqual(t, *notified, []string{"tkn: TaskRun tr-1 failed: TaskRun tr-1 failed"})
+}
@@ -18,9 +18,12 @@ import (
 	"bufio"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/i18n"
 	"github.com/tektoncd/cli/pkg/names"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type DeleteOptions struct {
@@ -36,6 +39,36 @@ type DeleteOptions struct {
 	IgnoreRunning            bool
 	IgnoreRunningPipelinerun bool
 	LabelSelector            string
+	Cascade                  string
+	Wait                     bool
+	WaitTimeout              time.Duration
+}
+
+// Values of --cascade
+const (
+	CascadeBackground = "background"
+	CascadeForeground = "foreground"
+	CascadeOrphan     = "orphan"
+)
+
+// DeleteOptions returns the options of the deletion of the runs, the
+// --cascade policy tells the garbage collector what to do with the
+// TaskRuns, pods and PVCs of volumeClaimTemplates the runs own
+func (o *DeleteOptions) DeleteOptions() (metav1.DeleteOptions, error) {
+	var policy metav1.DeletionPropagation
+	switch o.Cascade {
+	case "":
+		return metav1.DeleteOptions{}, nil
+	case CascadeBackground:
+		policy = metav1.DeletePropagationBackground
+	case CascadeForeground:
+		policy = metav1.DeletePropagationForeground
+	case CascadeOrphan:
+		policy = metav1.DeletePropagationOrphan
+	default:
+		return metav1.DeleteOptions{}, fmt.Errorf("invalid value %q for --cascade, use %s, %s or %s", o.Cascade, CascadeBackground, CascadeForeground, CascadeOrphan)
+	}
+	return metav1.DeleteOptions{PropagationPolicy: &policy}, nil
 }
 
 func (o *DeleteOptions) CheckOptions(s *cli.Stream, resourceNames []string, ns string) error {
@@ -71,25 +104,25 @@ func (o *DeleteOptions) CheckOptions(s *cli.Stream, resourceNames []string, ns s
 
 	keepStr := ""
 	if o.Keep > 0 {
-		keepStr = fmt.Sprintf(" keeping %d %ss", o.Keep, o.Resource)
+		keepStr = i18n.Sprintf(" keeping %d %ss", o.Keep, o.Resource)
 	}
 	if o.KeepSince > 0 {
-		keepStr = fmt.Sprintf(" except for ones created in last %d minutes", o.KeepSince)
+		keepStr = i18n.Sprintf(" except for ones created in last %d minutes", o.KeepSince)
 	}
 	if o.Keep > 0 && o.KeepSince > 0 {
-		keepStr = fmt.Sprintf(" except for ones created in last %d minutes and keeping %d %ss", o.KeepSince, o.Keep, o.Resource)
+		keepStr = i18n.Sprintf(" except for ones created in last %d minutes and keeping %d %ss", o.KeepSince, o.Keep, o.Resource)
 	}
 	switch {
 	case o.DeleteAllNs:
-		fmt.Fprintf(s.Out, "Are you sure you want to delete all %ss in namespace %q%s (y/n): ", o.Resource, ns, keepStr)
+		i18n.Fprintf(s.Out, "Are you sure you want to delete all %ss in namespace %q%s (y/n): ", o.Resource, ns, keepStr)
 	case o.DeleteAll:
-		fmt.Fprintf(s.Out, "Are you sure you want to delete all %ss%s (y/n): ", o.Resource, keepStr)
+		i18n.Fprintf(s.Out, "Are you sure you want to delete all %ss%s (y/n): ", o.Resource, keepStr)
 	case o.ParentResource != "" && o.ParentResourceName != "":
-		fmt.Fprintf(s.Out, "Are you sure you want to delete all %ss related to %s %q%s (y/n): ", o.Resource, o.ParentResource, o.ParentResourceName, keepStr)
+		i18n.Fprintf(s.Out, "Are you sure you want to delete all %ss related to %s %q%s (y/n): ", o.Resource, o.ParentResource, o.ParentResourceName, keepStr)
 	case o.DeleteRelated:
-		fmt.Fprintf(s.Out, "Are you sure you want to delete %s(s) %s and related resources (y/n): ", o.Resource, formattedNames)
+		i18n.Fprintf(s.Out, "Are you sure you want to delete %s(s) %s and related resources (y/n): ", o.Resource, formattedNames)
 	default:
-		fmt.Fprintf(s.Out, "Are you sure you want to delete %s(s) %s (y/n): ", o.Resource, formattedNames)
+		i18n.Fprintf(s.Out, "Are you sure you want to delete %s(s) %s (y/n): ", o.Resource, formattedNames)
 	}
 
 	return o.TakeInput(s, formattedNames)
@@ -102,9 +135,9 @@ func (o *DeleteOptions) TakeInput(s *cli.Stream, formattedNames string) error {
 		if t == "y" {
 			return nil
 		} else if t == "n" {
-			return fmt.Errorf("canceled deleting %s(s) %s", o.Resource, formattedNames)
+			return i18n.Errorf("canceled deleting %s(s) %s", o.Resource, formattedNames)
 		}
-		fmt.Fprint(s.Out, "Please enter (y/n): ")
+		fmt.Fprint(s.Out, i18n.T("Please enter (y/n): "))
 	}
 	return nil
 }
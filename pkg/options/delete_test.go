@@ -21,6 +21,7 @@ import (
 
 	"github.com/tektoncd/cli/pkg/cli"
 	"github.com/tektoncd/cli/pkg/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestDeleteOptions(t *testing.T) {
@@ -191,3 +192,30 @@ func TestDeleteOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteOptions_Cascade(t *testing.T) {
+	for cascade, want := range map[string]metav1.DeletionPropagation{
+		CascadeBackground: metav1.DeletePropagationBackground,
+		CascadeForeground: metav1.DeletePropagationForeground,
+		CascadeOrphan:     metav1.DeletePropagationOrphan,
+	} {
+		o := &DeleteOptions{Cascade: cascade}
+		got, err := o.DeleteOptions()
+		if err != nil {
+			t.Fatalf("unexpected Error: %v", err)
+		}
+		test.AssertOutput(t, want, *got.PropagationPolicy)
+	}
+
+	got, err := (&DeleteOptions{}).DeleteOptions()
+	if err != nil {
+		t.Fatalf("unexpected Error: %v", err)
+	}
+	test.AssertOutput(t, metav1.DeleteOptions{}, got)
+
+	_, err = (&DeleteOptions{Cascade: "all"}).DeleteOptions()
+	if err == nil {
+		t.Fatal("error expected here")
+	}
+	test.AssertOutput(t, `invalid value "all" for --cascade, use background, foreground or orphan`, err.Error())
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/AlecAivazis/survey/v2/terminal"
@@ -45,6 +46,26 @@ type DescribeOptions struct {
 	AskOpts                   survey.AskOpt
 	Fzf                       bool
 	Last                      bool
+	// Clean strips the fields set by the server from the output of -o
+	Clean bool
+	// SkipStatusTaskRuns compacts the child references of a PipelineRun in
+	// the output of -o to their kind, name and pipeline task
+	SkipStatusTaskRuns bool
+	// Link prints the links to the Tekton Dashboard instead of describing
+	Link bool
+	// History adds the transitions of the condition of a run to its
+	// description
+	History bool
+	// Scheduling adds the nodes the pods of the TaskRuns were scheduled on
+	// to the description of a run
+	Scheduling bool
+	// Wide adds what backs the workspaces of a TaskRun and the steps they
+	// are mounted in to its description
+	Wide bool
+	// All describes all the runs of the namespace at once
+	All bool
+	// Since limits All to the runs created within this duration
+	Since time.Duration
 }
 
 func NewDescribeOptions(p cli.Params) *DescribeOptions {
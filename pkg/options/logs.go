@@ -27,6 +27,7 @@ import (
 	"github.com/ktr0731/go-fuzzyfinder"
 	"github.com/tektoncd/cli/pkg/cli"
 	pipelinerunpkg "github.com/tektoncd/cli/pkg/pipelinerun"
+	"github.com/tektoncd/cli/pkg/pods"
 	"github.com/tektoncd/cli/pkg/pods/stream"
 	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
 )
@@ -44,6 +45,9 @@ type LogOptions struct {
 	Streamer        stream.NewStreamerFunc
 	Tasks           []string
 	Steps           []string
+	// Containers are the containers of the pods logs are shown for,
+	// including ephemeral containers attached for debugging
+	Containers      []string
 	Last            bool
 	Limit           int
 	AskOpts         survey.AskOpt
@@ -52,9 +56,108 @@ type LogOptions struct {
 	Timestamps      bool
 	Prefixing       bool
 	ExitWithPrError bool
+	SkipFinally     bool
+	// Resume skips the TaskRuns of the PipelineRun whose logs were shown to
+	// the end by the earlier commands run with Resume, and records the
+	// TaskRuns shown in the state store
+	Resume bool
 	// ActivityTimeout is the amount of time to wait for some activity
-	// (e.g. Pod ready) before giving up.
+	// (e.g. Pod ready) before giving up. When set it also bounds how long
+	// the logs of a followed step may stay silent.
 	ActivityTimeout time.Duration
+	// OnTimeout is what happens when the activity timeout is reached while
+	// following logs: continue, fail or cancel-run
+	OnTimeout string
+	// FlushInterval is the maximum amount of time logs are kept in the
+	// write buffer before being written out
+	FlushInterval time.Duration
+	// Between is the time window, written START,END, logs are sliced to
+	Between string
+	// Sort is the order of the logs, by task or by time
+	Sort string
+	// SummaryLines is the number of log lines of each failed step printed
+	// in the failure summary, no summary is printed when it is 0
+	SummaryLines int
+	// MaxConcurrentStreams is the maximum number of TaskRuns whose logs are
+	// followed at the same time, 0 for no limit
+	MaxConcurrentStreams int
+	// Archive is the format of the archive, tar or zip, the logs are
+	// written to instead of being printed
+	Archive string
+	// Source is where the logs are read from: auto, pods, results or
+	// storage
+	Source string
+	// KeepCompressed writes the logs read from Tekton Results or the object
+	// storage compressed, as they were transferred
+	KeepCompressed bool
+	// StreamFrom is the location the logs of the containers are read from
+	// instead of the cluster, such as file:///tmp/logs or s3://bucket/logs,
+	// see stream.Open
+	StreamFrom string
+	// HangThreshold is how long a followed step may stay silent before it
+	// is reported as hung, 0 to never report it
+	HangThreshold time.Duration
+	// HangDump runs the hang dump commands of the profile in the container
+	// of a hung step and adds what they print to the logs
+	HangDump bool
+	// Exec runs the hang dump commands, through the API server when not set
+	Exec pods.ExecFunc
+	// WholePipeline shows the logs of the PipelineRun the TaskRun is part of
+	// instead of the logs of the TaskRun
+	WholePipeline bool
+	// Verbose reports the failures of the watches of followed runs
+	Verbose bool
+	// Journal is the file the watch events received while following the
+	// logs are appended to, as JSON lines
+	Journal string
+	// StdoutOnly and StderrOnly limit the logs of the pods to one stream of
+	// the containers
+	StdoutOnly bool
+	StderrOnly bool
+	// NotifyTerminal sets the title of the terminal to the state of the
+	// followed run, and rings its bell with a desktop notification once it
+	// is done
+	NotifyTerminal bool
+	// NoBanner drops the decorative headers and separators written between
+	// the logs
+	NoBanner bool
+	// Silent drops the banners and the progress and diagnostic messages,
+	// only the logs and the errors are written
+	Silent bool
+	// ScanLeaks looks for secrets in the logs and warns about the lines
+	// likely holding one
+	ScanLeaks bool
+	// FailOnLeak scans the logs for secrets and fails when one is found
+	FailOnLeak bool
+	// ExcludeRetries only shows the logs of the last attempt of the
+	// TaskRuns which were retried, when following the attempts made since
+	ExcludeRetries bool
+	// Attempt only shows the logs of this attempt of the TaskRun, from 1, 0
+	// for all of them
+	Attempt int
+	// StopAtFailedAttempt stops following a TaskRun after its current
+	// attempt rather than waiting for the pods of its retries
+	StopAtFailedAttempt bool
+	// Interactive reads keys from the terminal to change how the followed
+	// logs are shown while they stream
+	Interactive bool
+	// CaptureResults reads the results of the followed TaskRuns from the
+	// containers of their steps, for describe to show them in full
+	CaptureResults bool
+	// RunLabels scope the runs the one to show is selected from, with
+	// --last or by asking, to those with these labels, e.g.
+	// tekton.dev/pipeline=build
+	RunLabels map[string]string
+	// Buffered writes the logs of the followed TaskRuns of a PipelineRun a
+	// TaskRun after the other, once each one is done, when they are not
+	// written to a terminal
+	Buffered bool
+	// SignKey and SignKMSKey are the private key file or the KMS key the
+	// archive of the logs is signed with, SignCert the certificate of the
+	// key whose identity is recorded in the archive
+	SignKey    string
+	SignKMSKey string
+	SignCert   string
 }
 
 func NewLogOptions(p cli.Params) *LogOptions {
@@ -70,6 +173,18 @@ func NewLogOptions(p cli.Params) *LogOptions {
 	}
 }
 
+// LogStream returns the stream of the containers the logs are limited to,
+// empty when both are shown
+func (opts *LogOptions) LogStream() string {
+	switch {
+	case opts.StdoutOnly:
+		return stream.LogStreamStdout
+	case opts.StderrOnly:
+		return stream.LogStreamStderr
+	}
+	return ""
+}
+
 func (opts *LogOptions) ValidateOpts() error {
 	if opts.Limit <= 0 {
 		return fmt.Errorf("limit was %d but must be a positive number", opts.Limit)
@@ -77,6 +192,23 @@ func (opts *LogOptions) ValidateOpts() error {
 	return nil
 }
 
+// ValidateSigning checks the options signing the archive of the logs
+func (opts *LogOptions) ValidateSigning() error {
+	if opts.SignKey == "" && opts.SignKMSKey == "" && opts.SignCert == "" {
+		return nil
+	}
+	if opts.Archive == "" {
+		return fmt.Errorf("--sign-key, --sign-kms-key and --sign-cert can only be used with --output")
+	}
+	if opts.SignKey != "" && opts.SignKMSKey != "" {
+		return fmt.Errorf("only one of --sign-key and --sign-kms-key can be used")
+	}
+	if opts.SignKey == "" && opts.SignKMSKey == "" {
+		return fmt.Errorf("--sign-cert needs --sign-key or --sign-kms-key")
+	}
+	return nil
+}
+
 func (opts *LogOptions) Ask(resource string, options []string) error {
 	var ans string
 	var qs = []*survey.Question{
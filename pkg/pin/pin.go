@@ -0,0 +1,172 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pin pins the images of the steps of Tekton resources to their
+// digests, so that the runs of the resources are reproducible
+package pin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	cosignsignature "github.com/sigstore/cosign/v2/pkg/signature"
+	"gopkg.in/yaml.v3"
+)
+
+// containerKeys are the keys of the lists or mappings of containers whose
+// images are pinned
+var containerKeys = map[string]bool{
+	"steps":        true,
+	"sidecars":     true,
+	"stepTemplate": true,
+}
+
+// Image is an image of the resources and the reference it was pinned to, it
+// is left as it is when it is already pinned or references a variable
+type Image struct {
+	Ref    string
+	Pinned string
+}
+
+// Resolver returns the digest of the image ref
+type Resolver func(ref string) (string, error)
+
+// RemoteResolver returns a Resolver reading the digests from the registries
+func RemoteResolver(opts ...remote.Option) Resolver {
+	return func(ref string) (string, error) {
+		r, err := name.ParseReference(ref)
+		if err != nil {
+			return "", err
+		}
+		desc, err := remote.Head(r, opts...)
+		if err != nil {
+			return "", err
+		}
+		return desc.Digest.String(), nil
+	}
+}
+
+// Pin replaces the images of the steps, sidecars and step templates of the
+// YAML documents of b by their references pinned to the digest returned by
+// resolve, it returns the documents and the images found in them
+func Pin(b []byte, resolve Resolver) ([]byte, []Image, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	docs := []*yaml.Node{}
+	for {
+		doc := &yaml.Node{}
+		err := dec.Decode(doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	images := []Image{}
+	pinned := map[string]string{}
+	var pinErr error
+	for _, doc := range docs {
+		walk(doc, false, func(node *yaml.Node) {
+			if pinErr != nil {
+				return
+			}
+			ref := node.Value
+			if p, ok := pinned[ref]; ok {
+				node.Value = p
+				return
+			}
+			img := Image{Ref: ref, Pinned: ref}
+			if !strings.Contains(ref, "@") && !strings.Contains(ref, "$(") {
+				digest, err := resolve(ref)
+				if err != nil {
+					pinErr = fmt.Errorf("failed to resolve the digest of image %s: %w", ref, err)
+					return
+				}
+				img.Pinned = ref + "@" + digest
+			}
+			pinned[ref] = img.Pinned
+			images = append(images, img)
+			node.Value = img.Pinned
+		})
+	}
+	if pinErr != nil {
+		return nil, nil, pinErr
+	}
+
+	out := &bytes.Buffer{}
+	enc := yaml.NewEncoder(out)
+	enc.SetIndent(2)
+	for _, doc := range docs {
+		if err := enc.Encode(doc); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return nil, nil, err
+	}
+	return out.Bytes(), images, nil
+}
+
+// walk calls f with the scalar images of the containers below node,
+// inContainers is set when node is in a list or mapping of containers
+func walk(node *yaml.Node, inContainers bool, f func(*yaml.Node)) {
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, n := range node.Content {
+			walk(n, inContainers, f)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			if inContainers && key == "image" && value.Kind == yaml.ScalarNode {
+				f(value)
+				continue
+			}
+			walk(value, containerKeys[key], f)
+		}
+	}
+}
+
+// Verify verifies that the pinned image is signed with the key of the
+// public key file, the transparency log is not checked
+func Verify(ctx context.Context, pinned, keyfile string, opts ...remote.Option) error {
+	ref, err := name.ParseReference(pinned)
+	if err != nil {
+		return err
+	}
+	verifier, err := cosignsignature.LoadPublicKey(ctx, keyfile)
+	if err != nil {
+		return fmt.Errorf("error getting verifier from key file: %w", err)
+	}
+	co := &cosign.CheckOpts{
+		SigVerifier:        verifier,
+		RegistryClientOpts: []ociremote.Option{ociremote.WithRemoteOptions(opts...)},
+		IgnoreTlog:         true,
+		IgnoreSCT:          true,
+	}
+	if _, _, err := cosign.VerifyImageSignatures(ctx, ref, co); err != nil {
+		return fmt.Errorf("failed to verify the signature of image %s: %w", pinned, err)
+	}
+	return nil
+}
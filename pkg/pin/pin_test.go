@@ -0,0 +1,115 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pin
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestPin(t *testing.T) {
+	in := `# the build Task
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: build
+spec:
+  stepTemplate:
+    image: alpine:3.20
+  steps:
+    - name: test
+      image: golang:1.23 # the toolchain
+    - name: lint
+      image: golangci/golangci-lint@sha256:0123
+    - name: custom
+      image: $(params.image)
+  sidecars:
+    - name: docker
+      image: docker:dind
+---
+apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: ci
+spec:
+  tasks:
+    - name: build
+      params:
+        - name: image
+          value: golang:1.23
+      taskSpec:
+        steps:
+          - image: golang:1.23
+`
+	resolved := []string{}
+	resolve := func(ref string) (string, error) {
+		resolved = append(resolved, ref)
+		return fmt.Sprintf("sha256:%d", len(resolved)), nil
+	}
+
+	out, images, err := Pin([]byte(in), resolve)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, resolved, []string{"alpine:3.20", "golang:1.23", "docker:dind"})
+	assert.DeepEqual(t, images, []Image{
+		{Ref: "alpine:3.20", Pinned: "alpine:3.20@sha256:1"},
+		{Ref: "golang:1.23", Pinned: "golang:1.23@sha256:2"},
+		{Ref: "golangci/golangci-lint@sha256:0123", Pinned: "golangci/golangci-lint@sha256:0123"},
+		{Ref: "$(params.image)", Pinned: "$(params.image)"},
+		{Ref: "docker:dind", Pinned: "docker:dind@sha256:3"},
+	})
+	assert.Equal(t, string(out), `# the build Task
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: build
+spec:
+  stepTemplate:
+    image: alpine:3.20@sha256:1
+  steps:
+    - name: test
+      image: golang:1.23@sha256:2 # the toolchain
+    - name: lint
+      image: golangci/golangci-lint@sha256:0123
+    - name: custom
+      image: $(params.image)
+  sidecars:
+    - name: docker
+      image: docker:dind@sha256:3
+---
+apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: ci
+spec:
+  tasks:
+    - name: build
+      params:
+        - name: image
+          value: golang:1.23
+      taskSpec:
+        steps:
+          - image: golang:1.23@sha256:2
+`)
+}
+
+func TestPin_resolveError(t *testing.T) {
+	in := "kind: Task\nspec:\n  steps:\n    - image: private/image:1.0\n"
+	_, _, err := Pin([]byte(in), func(string) (string, error) {
+		return "", fmt.Errorf("UNAUTHORIZED")
+	})
+	assert.Error(t, err, "failed to resolve the digest of image private/image:1.0: UNAUTHORIZED")
+}
@@ -0,0 +1,112 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pipe joins the channels the logs and their errors are streamed
+// through. A channel returned by a function of this package is closed once
+// all its inputs are closed or the context is done, so a reader ranging
+// over it never blocks forever. The inputs are not drained after the
+// context is done, their producers should watch the context as well.
+package pipe
+
+import (
+	"context"
+	"sync"
+)
+
+// Forward sends the values of in to out until in is closed, it returns false
+// when ctx is done first
+func Forward[T any](ctx context.Context, out chan<- T, in <-chan T) bool {
+	if in == nil {
+		return true
+	}
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok {
+				return true
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return false
+			}
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// Merge returns a channel receiving the values of all the inputs, as soon as
+// they are received. The values of an input keep their order, the values of
+// different inputs are interleaved. Nil inputs are skipped.
+func Merge[T any](ctx context.Context, inputs ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, in := range inputs {
+		if in == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(in <-chan T) {
+			defer wg.Done()
+			Forward(ctx, out, in)
+		}(in)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Concat returns a channel receiving all the values of each input before the
+// values of the next one. An input is not read before the previous ones are
+// closed. Nil inputs are skipped.
+func Concat[T any](ctx context.Context, inputs ...<-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, in := range inputs {
+			if !Forward(ctx, out, in) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Drain reads a and b until both are closed, handing their values to onA and
+// onB, it returns false when ctx is done first. Nil channels count as
+// closed.
+func Drain[A, B any](ctx context.Context, a <-chan A, b <-chan B, onA func(A), onB func(B)) bool {
+	for a != nil || b != nil {
+		select {
+		case v, ok := <-a:
+			if !ok {
+				a = nil
+				continue
+			}
+			onA(v)
+		case v, ok := <-b:
+			if !ok {
+				b = nil
+				continue
+			}
+			onB(v)
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
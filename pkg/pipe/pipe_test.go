@@ -0,0 +1,167 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipe
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+// send returns a channel receiving values, closed once they are sent
+func send[T any](values ...T) <-chan T {
+	c := make(chan T)
+	go func() {
+		defer close(c)
+		for _, v := range values {
+			c <- v
+		}
+	}()
+	return c
+}
+
+func collect[T any](t *testing.T, c <-chan T) []T {
+	t.Helper()
+	got := []T{}
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case v, ok := <-c:
+			if !ok {
+				return got
+			}
+			got = append(got, v)
+		case <-timeout:
+			t.Fatalf("channel not closed, received %v", got)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	got := collect(t, Merge(context.Background(), send(1, 2, 3), nil, send(4, 5)))
+
+	// the values of an input keep their order
+	var first, second []int
+	for _, v := range got {
+		if v <= 3 {
+			first = append(first, v)
+		} else {
+			second = append(second, v)
+		}
+	}
+	assert.DeepEqual(t, first, []int{1, 2, 3})
+	assert.DeepEqual(t, second, []int{4, 5})
+
+	sort.Ints(got)
+	assert.DeepEqual(t, got, []int{1, 2, 3, 4, 5})
+}
+
+func TestMerge_noInputs(t *testing.T) {
+	assert.DeepEqual(t, collect(t, Merge[int](context.Background())), []int{})
+	assert.DeepEqual(t, collect(t, Merge[int](context.Background(), nil)), []int{})
+}
+
+func TestMerge_closedOnceAllInputsAre(t *testing.T) {
+	open := make(chan int)
+	out := Merge(context.Background(), send(1), open)
+
+	assert.Equal(t, <-out, 1)
+	select {
+	case v, ok := <-out:
+		t.Fatalf("received %d, %t before all the inputs were closed", v, ok)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	open <- 2
+	close(open)
+	assert.DeepEqual(t, collect(t, out), []int{2})
+}
+
+func TestMerge_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	// an input which is never closed
+	out := Merge(ctx, make(chan int), send(1))
+
+	assert.Equal(t, <-out, 1)
+	cancel()
+	assert.DeepEqual(t, collect(t, out), []int{})
+}
+
+func TestConcat(t *testing.T) {
+	a := make(chan string)
+	b := send("b1", "b2")
+	out := Concat(context.Background(), a, nil, b)
+
+	// b is not read before a is closed, whatever is ready first
+	go func() {
+		a <- "a1"
+		time.Sleep(20 * time.Millisecond)
+		a <- "a2"
+		close(a)
+	}()
+	assert.DeepEqual(t, collect(t, out), []string{"a1", "a2", "b1", "b2"})
+}
+
+func TestConcat_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	out := Concat(ctx, make(chan int), send(1))
+	cancel()
+	assert.DeepEqual(t, collect(t, out), []int{})
+}
+
+func TestForward(t *testing.T) {
+	out := make(chan int, 3)
+	assert.Assert(t, Forward(context.Background(), out, send(1, 2, 3)))
+	close(out)
+	assert.DeepEqual(t, collect(t, out), []int{1, 2, 3})
+
+	// nothing to forward from a nil input
+	assert.Assert(t, Forward(context.Background(), make(chan int), nil))
+}
+
+func TestForward_cancelledWhileSending(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan bool)
+	// nobody reads the output
+	go func() { done <- Forward(ctx, make(chan int), send(1)) }()
+	cancel()
+	assert.Assert(t, !<-done)
+}
+
+func TestDrain(t *testing.T) {
+	var lines []string
+	var errs []error
+	ok := Drain(context.Background(), send("a", "b"), send(errors.New("failed")),
+		func(l string) { lines = append(lines, l) },
+		func(e error) { errs = append(errs, e) },
+	)
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, lines, []string{"a", "b"})
+	assert.Equal(t, len(errs), 1)
+
+	// nil channels count as closed
+	assert.Assert(t, Drain[string, error](context.Background(), nil, nil, nil, nil))
+}
+
+func TestDrain_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ok := Drain(ctx, make(chan int), (<-chan error)(nil), func(int) {}, func(error) {})
+	assert.Assert(t, !ok)
+}
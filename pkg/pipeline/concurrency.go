@@ -0,0 +1,82 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Concurrency is how many runs of a Pipeline are not done yet
+type Concurrency struct {
+	// Running are the PipelineRuns executing, oldest first
+	Running []v1.PipelineRun
+	// Pending are the PipelineRuns created as pending, which do not run
+	// until their pending status is cleared
+	Pending []v1.PipelineRun
+}
+
+// GetConcurrency returns the runs of the Pipeline which are not done, found
+// with the tekton.dev/pipeline label of the PipelineRuns
+func GetConcurrency(cs *cli.Clients, pipeline, ns string) (*Concurrency, error) {
+	options := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("tekton.dev/pipeline=%s", pipeline),
+	}
+
+	var runs *v1.PipelineRunList
+	if err := actions.ListV1(pipelineRunGroupResource, cs, options, ns, &runs); err != nil {
+		return nil, err
+	}
+
+	c := &Concurrency{}
+	for _, run := range runs.Items {
+		if run.IsDone() {
+			continue
+		}
+		if run.IsPending() {
+			c.Pending = append(c.Pending, run)
+			continue
+		}
+		c.Running = append(c.Running, run)
+	}
+	for _, prs := range [][]v1.PipelineRun{c.Running, c.Pending} {
+		sort.Slice(prs, func(i, j int) bool {
+			return prs[i].CreationTimestamp.Before(&prs[j].CreationTimestamp)
+		})
+	}
+	return c, nil
+}
+
+// CheckConcurrency returns an error when the Pipeline has max runs or more
+// executing, a soft limit checked before starting another one. A max of 0
+// is no limit.
+func CheckConcurrency(cs *cli.Clients, pipeline, ns string, max int) error {
+	if max <= 0 {
+		return nil
+	}
+	c, err := GetConcurrency(cs, pipeline, ns)
+	if err != nil {
+		return fmt.Errorf("failed to count the running PipelineRuns of Pipeline %s: %w", pipeline, err)
+	}
+	if len(c.Running) >= max {
+		return fmt.Errorf("Pipeline %s has %d PipelineRuns running in namespace %s, the limit is %d", pipeline, len(c.Running), ns, max)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/tektoncd/cli/pkg/apply"
+	"github.com/tektoncd/cli/pkg/formatted"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// ParseGitSource parses the location of the definition of a Pipeline in
+// git, either a git reference as accepted by ParseGitRef or the scp-like
+// address of a repository followed by the path in it, as
+// git@host:org/repo//path/to/pipeline.yaml@revision
+func ParseGitSource(s string) (*GitRef, error) {
+	if IsGitRef(s) {
+		return ParseGitRef(s)
+	}
+	invalid := fmt.Errorf("invalid git source %s, it must be like git@host:org/repo//path/to/pipeline.yaml@revision", s)
+	if strings.Contains(s, "://") {
+		return nil, invalid
+	}
+
+	repo, pathInRepo, ok := strings.Cut(s, "//")
+	if !ok || !strings.Contains(repo, ":") {
+		return nil, invalid
+	}
+	// the revision is taken from the path, the user of the repository is
+	// part of the address
+	ref, err := ParseGitRef(gitRefPrefix + "ssh://" + strings.Replace(repo, ":", "/", 1) + "//" + pathInRepo)
+	if err != nil {
+		return nil, invalid
+	}
+	ref.URL = repo
+	return ref, nil
+}
+
+// String returns the reference as written with git+, or with the scp-like
+// address of its repository
+func (r GitRef) String() string {
+	s := r.URL + "//" + r.PathInRepo
+	if strings.Contains(r.URL, "://") {
+		s = gitRefPrefix + s
+	}
+	if r.Revision != "" {
+		s += "@" + r.Revision
+	}
+	return s
+}
+
+// FetchGitFile reads the file of the reference at its revision, or at the
+// head of the default branch, with a shallow fetch of the git CLI, replaced
+// in tests
+var FetchGitFile = func(ref GitRef) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "tkn-git-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	revision := ref.Revision
+	if revision == "" {
+		revision = "HEAD"
+	}
+	if _, err := git(dir, "init", "--quiet"); err != nil {
+		return nil, err
+	}
+	if _, err := git(dir, "fetch", "--quiet", "--depth", "1", ref.URL, revision); err != nil {
+		return nil, err
+	}
+	return git(dir, "show", "FETCH_HEAD:"+ref.PathInRepo)
+}
+
+func git(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+	cmd.Stdout, cmd.Stderr = out, errOut
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("git %s failed: %v: %s", strings.Join(args, " "), err, strings.TrimSpace(errOut.String()))
+	}
+	return out.Bytes(), nil
+}
+
+// DecodePipeline reads a Pipeline of the v1 or v1beta1 API from its YAML
+// or JSON definition
+func DecodePipeline(b []byte) (*v1.Pipeline, error) {
+	var meta struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+	}
+	if err := yaml.Unmarshal(b, &meta); err != nil {
+		return nil, fmt.Errorf("failed to parse the Pipeline: %w", err)
+	}
+	if meta.Kind != "Pipeline" {
+		return nil, fmt.Errorf("the definition is a %s, not a Pipeline", meta.Kind)
+	}
+
+	var p v1.Pipeline
+	switch meta.APIVersion {
+	case "tekton.dev/v1":
+		if err := yaml.Unmarshal(b, &p); err != nil {
+			return nil, fmt.Errorf("failed to parse the Pipeline: %w", err)
+		}
+	case "tekton.dev/v1beta1":
+		var pv1beta1 v1beta1.Pipeline
+		if err := yaml.Unmarshal(b, &pv1beta1); err != nil {
+			return nil, fmt.Errorf("failed to parse the Pipeline: %w", err)
+		}
+		if err := pv1beta1.ConvertTo(context.Background(), &p); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported version %s of the Pipeline", meta.APIVersion)
+	}
+	return &p, nil
+}
+
+// Drift returns the changes made to the Pipeline in the cluster since its
+// definition, the spec, the labels and the annotations are compared once
+// both are defaulted as the API server would
+func Drift(definition, live *v1.Pipeline) ([]apply.Change, error) {
+	from, err := driftContent(definition)
+	if err != nil {
+		return nil, err
+	}
+	to, err := driftContent(live)
+	if err != nil {
+		return nil, err
+	}
+	return apply.Diff(from, to), nil
+}
+
+func driftContent(p *v1.Pipeline) (*unstructured.Unstructured, error) {
+	p = p.DeepCopy()
+	p.SetDefaults(context.Background())
+
+	spec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&p.Spec)
+	if err != nil {
+		return nil, err
+	}
+	metadata := map[string]interface{}{}
+	if len(p.Labels) != 0 {
+		metadata["labels"] = stringMap(p.Labels)
+	}
+	if annotations := formatted.RemoveLastAppliedConfig(p.Annotations); len(annotations) != 0 {
+		metadata["annotations"] = stringMap(annotations)
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{"metadata": metadata, "spec": spec}}, nil
+}
+
+func stringMap(m map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
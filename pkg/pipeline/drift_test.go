@@ -0,0 +1,113 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/apply"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseGitSource(t *testing.T) {
+	tests := []struct {
+		source  string
+		want    *GitRef
+		wantErr bool
+	}{
+		{
+			source: "git@github.com:org/repo//tekton/pipeline.yaml@main",
+			want:   &GitRef{URL: "git@github.com:org/repo", PathInRepo: "tekton/pipeline.yaml", Revision: "main"},
+		},
+		{
+			source: "git@gitlab.com:group/sub/repo.git//pipeline.yaml",
+			want:   &GitRef{URL: "git@gitlab.com:group/sub/repo.git", PathInRepo: "pipeline.yaml"},
+		},
+		{
+			source: "git+https://github.com/org/repo//pipeline.yaml@a1b2c3d",
+			want:   &GitRef{URL: "https://github.com/org/repo", PathInRepo: "pipeline.yaml", Revision: "a1b2c3d"},
+		},
+		{source: "git@github.com:org/repo", wantErr: true},
+		{source: "git@github.com:org/repo//", wantErr: true},
+		{source: "github.com/org/repo//pipeline.yaml", wantErr: true},
+		{source: "https://github.com/org/repo//pipeline.yaml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			got, err := ParseGitSource(tt.source)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "invalid git")
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.want)
+			assert.Equal(t, got.String(), tt.source)
+		})
+	}
+}
+
+func TestDecodePipeline(t *testing.T) {
+	p, err := DecodePipeline([]byte(`apiVersion: tekton.dev/v1beta1
+kind: Pipeline
+metadata:
+  name: build
+spec:
+  tasks:
+  - name: compile
+    taskRef:
+      name: golang-build
+`))
+	assert.NilError(t, err)
+	assert.Equal(t, p.Name, "build")
+	assert.Equal(t, p.Spec.Tasks[0].TaskRef.Name, "golang-build")
+
+	_, err = DecodePipeline([]byte("apiVersion: tekton.dev/v1\nkind: Task\n"))
+	assert.Error(t, err, "the definition is a Task, not a Pipeline")
+
+	_, err = DecodePipeline([]byte("apiVersion: tekton.dev/v1alpha1\nkind: Pipeline\n"))
+	assert.Error(t, err, "unsupported version tekton.dev/v1alpha1 of the Pipeline")
+}
+
+func TestDrift(t *testing.T) {
+	definition := &v1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "build", Labels: map[string]string{"team": "ci"}},
+		Spec: v1.PipelineSpec{
+			Params: v1.ParamSpecs{{Name: "flags"}},
+			Tasks:  []v1.PipelineTask{{Name: "compile", TaskRef: &v1.TaskRef{Name: "golang-build"}}},
+		},
+	}
+
+	// the live Pipeline is defaulted by the API server and kept by the
+	// cluster with its metadata
+	live := definition.DeepCopy()
+	live.Namespace, live.UID, live.ResourceVersion = "ns", "b4c1", "42"
+	live.Annotations = map[string]string{"kubectl.kubernetes.io/last-applied-configuration": "{}"}
+	live.SetDefaults(context.Background())
+	changes, err := Drift(definition, live)
+	assert.NilError(t, err)
+	assert.Equal(t, len(changes), 0)
+
+	live.Labels["team"] = "release"
+	live.Spec.Tasks = append(live.Spec.Tasks, v1.PipelineTask{Name: "test", TaskRef: &v1.TaskRef{Name: "golang-test"}})
+	changes, err = Drift(definition, live)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, changes, []apply.Change{
+		{Op: apply.Changed, Path: "metadata.labels.team", Old: "ci", New: "release"},
+		{Op: apply.Added, Path: "spec.tasks[test]", New: map[string]interface{}{"name": "test", "taskRef": map[string]interface{}{"kind": "Task", "name": "golang-test"}}},
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
+const gitRefPrefix = "git+"
+
+// GitRef is a Pipeline definition in a git repository, written as
+// git+https://host/org/repo//path/to/pipeline.yaml@revision
+type GitRef struct {
+	URL        string
+	PathInRepo string
+	// Revision is empty to use the default branch of the repository
+	Revision string
+}
+
+// IsGitRef tells whether the argument of tkn pipeline start is a git reference
+// rather than the name of a Pipeline
+func IsGitRef(s string) bool {
+	return strings.HasPrefix(s, gitRefPrefix)
+}
+
+// ParseGitRef parses a git reference, the path in the repository follows the
+// first // after the scheme of the URL and the revision its last @
+func ParseGitRef(s string) (*GitRef, error) {
+	invalid := fmt.Errorf("invalid git reference %s, it must be like git+https://host/org/repo//path/to/pipeline.yaml@revision", s)
+	if !IsGitRef(s) {
+		return nil, invalid
+	}
+	url := strings.TrimPrefix(s, gitRefPrefix)
+
+	scheme, rest, ok := strings.Cut(url, "://")
+	if !ok {
+		return nil, invalid
+	}
+	repo, pathInRepo, ok := strings.Cut(rest, "//")
+	if !ok || repo == "" {
+		return nil, invalid
+	}
+
+	ref := &GitRef{URL: scheme + "://" + repo}
+	if i := strings.LastIndex(pathInRepo, "@"); i >= 0 {
+		ref.Revision = pathInRepo[i+1:]
+		pathInRepo = pathInRepo[:i]
+		if ref.Revision == "" {
+			return nil, invalid
+		}
+	}
+	if pathInRepo == "" {
+		return nil, invalid
+	}
+	ref.PathInRepo = pathInRepo
+	return ref, nil
+}
+
+// Name returns a name for the runs of the Pipeline, the base name of its file
+func (r GitRef) Name() string {
+	base := path.Base(r.PathInRepo)
+	return strings.TrimSuffix(base, path.Ext(base))
+}
+
+// PipelineRef returns the reference resolving the Pipeline with the git
+// resolver, resolverParams are additional params of the resolver as
+// key=value, e.g. token=my-secret
+func (r GitRef) PipelineRef(resolverParams []string) (*v1beta1.PipelineRef, error) {
+	params := v1beta1.Params{
+		{Name: "url", Value: *v1beta1.NewStructuredValues(r.URL)},
+		{Name: "pathInRepo", Value: *v1beta1.NewStructuredValues(r.PathInRepo)},
+	}
+	if r.Revision != "" {
+		params = append(params, v1beta1.Param{Name: "revision", Value: *v1beta1.NewStructuredValues(r.Revision)})
+	}
+	for _, p := range resolverParams {
+		key, value, ok := strings.Cut(p, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid resolver param %s, it must be key=value", p)
+		}
+		params = setParam(params, key, value)
+	}
+
+	return &v1beta1.PipelineRef{
+		ResolverRef: v1beta1.ResolverRef{
+			Resolver: "git",
+			Params:   params,
+		},
+	}, nil
+}
+
+// setParam sets the param, replacing the one of the same name if any
+func setParam(params v1beta1.Params, name, value string) v1beta1.Params {
+	for i := range params {
+		if params[i].Name == name {
+			params[i].Value = *v1beta1.NewStructuredValues(value)
+			return params
+		}
+	}
+	return append(params, v1beta1.Param{Name: name, Value: *v1beta1.NewStructuredValues(value)})
+}
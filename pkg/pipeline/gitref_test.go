@@ -0,0 +1,81 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"gotest.tools/v3/assert"
+)
+
+func TestParseGitRef(t *testing.T) {
+	tests := []struct {
+		ref     string
+		want    *GitRef
+		wantErr bool
+	}{
+		{
+			ref:  "git+https://github.com/org/repo//tekton/pipeline.yaml@a1b2c3d",
+			want: &GitRef{URL: "https://github.com/org/repo", PathInRepo: "tekton/pipeline.yaml", Revision: "a1b2c3d"},
+		},
+		{
+			ref:  "git+https://github.com/org/repo//pipeline.yaml",
+			want: &GitRef{URL: "https://github.com/org/repo", PathInRepo: "pipeline.yaml"},
+		},
+		{
+			ref:  "git+ssh://git@github.com/org/repo//pipeline.yaml@release/v1",
+			want: &GitRef{URL: "ssh://git@github.com/org/repo", PathInRepo: "pipeline.yaml", Revision: "release/v1"},
+		},
+		{ref: "git+https://github.com/org/repo/pipeline.yaml", wantErr: true},
+		{ref: "git+https://github.com/org/repo//@main", wantErr: true},
+		{ref: "git+https://github.com/org/repo//pipeline.yaml@", wantErr: true},
+		{ref: "https://github.com/org/repo//pipeline.yaml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got, err := ParseGitRef(tt.ref)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "invalid git reference")
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
+
+func TestGitRef_PipelineRef(t *testing.T) {
+	ref := GitRef{URL: "https://github.com/org/repo", PathInRepo: "tekton/build.yaml"}
+	assert.Equal(t, ref.Name(), "build")
+
+	got, err := ref.PipelineRef([]string{"revision=main", "token=git-token"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, &v1beta1.PipelineRef{
+		ResolverRef: v1beta1.ResolverRef{
+			Resolver: "git",
+			Params: v1beta1.Params{
+				{Name: "url", Value: *v1beta1.NewStructuredValues("https://github.com/org/repo")},
+				{Name: "pathInRepo", Value: *v1beta1.NewStructuredValues("tekton/build.yaml")},
+				{Name: "revision", Value: *v1beta1.NewStructuredValues("main")},
+				{Name: "token", Value: *v1beta1.NewStructuredValues("git-token")},
+			},
+		},
+	})
+
+	_, err = ref.PipelineRef([]string{"token"})
+	assert.Error(t, err, "invalid resolver param token, it must be key=value")
+}
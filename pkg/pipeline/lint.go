@@ -0,0 +1,220 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/cli/pkg/sarif"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+// LintRule is a check of tkn pipeline lint
+type LintRule struct {
+	ID          string
+	Name        string
+	Description string
+	Level       string
+}
+
+// Rules checked by Lint
+var (
+	RuleUnknownRunAfter = LintRule{
+		ID:          "TKN001",
+		Name:        "unknown-run-after",
+		Description: "runAfter references a task which is not part of the Pipeline",
+		Level:       sarif.LevelError,
+	}
+	RuleUndeclaredParam = LintRule{
+		ID:          "TKN002",
+		Name:        "undeclared-param",
+		Description: "a task references a param which is not declared by the Pipeline",
+		Level:       sarif.LevelError,
+	}
+	RuleUnusedParam = LintRule{
+		ID:          "TKN003",
+		Name:        "unused-param",
+		Description: "a param of the Pipeline is not used by any task",
+		Level:       sarif.LevelWarning,
+	}
+	RuleUndeclaredWorkspace = LintRule{
+		ID:          "TKN004",
+		Name:        "undeclared-workspace",
+		Description: "a task binds a workspace which is not declared by the Pipeline",
+		Level:       sarif.LevelError,
+	}
+	RuleUnpinnedImage = LintRule{
+		ID:          "TKN005",
+		Name:        "unpinned-image",
+		Description: "a step uses an image without a tag or with the latest tag",
+		Level:       sarif.LevelWarning,
+	}
+
+	LintRules = []LintRule{
+		RuleUnknownRunAfter,
+		RuleUndeclaredParam,
+		RuleUnusedParam,
+		RuleUndeclaredWorkspace,
+		RuleUnpinnedImage,
+	}
+)
+
+// Finding is a problem found by Lint, Task is empty for findings about the
+// Pipeline itself
+type Finding struct {
+	Rule    LintRule
+	Task    string
+	Message string
+}
+
+var paramRef = regexp.MustCompile(`\$\(params\.([A-Za-z0-9_-]+)`)
+
+// Lint checks a Pipeline for common mistakes
+func Lint(p *v1.Pipeline) []Finding {
+	findings := []Finding{}
+	tasks := append(append([]v1.PipelineTask{}, p.Spec.Tasks...), p.Spec.Finally...)
+
+	names := map[string]bool{}
+	for _, t := range tasks {
+		names[t.Name] = true
+	}
+	declaredParams := map[string]bool{}
+	for _, param := range p.Spec.Params {
+		declaredParams[param.Name] = true
+	}
+	declaredWorkspaces := map[string]bool{}
+	for _, ws := range p.Spec.Workspaces {
+		declaredWorkspaces[ws.Name] = true
+	}
+
+	usedParams := map[string]bool{}
+	for _, t := range tasks {
+		for _, after := range t.RunAfter {
+			if !names[after] {
+				findings = append(findings, Finding{RuleUnknownRunAfter, t.Name, fmt.Sprintf("task %s runs after %s which is not a task of the Pipeline", t.Name, after)})
+			}
+		}
+
+		// params of an embedded taskSpec may refer to its own params so only
+		// the references outside of it have to be declared by the Pipeline
+		withoutSpec := t.DeepCopy()
+		withoutSpec.TaskSpec = nil
+		for _, name := range paramRefs(withoutSpec) {
+			if !declaredParams[name] {
+				findings = append(findings, Finding{RuleUndeclaredParam, t.Name, fmt.Sprintf("task %s references param %s which is not declared by the Pipeline", t.Name, name)})
+			}
+		}
+		for _, name := range paramRefs(t) {
+			usedParams[name] = true
+		}
+
+		for _, ws := range t.Workspaces {
+			if ws.Workspace != "" && !declaredWorkspaces[ws.Workspace] {
+				findings = append(findings, Finding{RuleUndeclaredWorkspace, t.Name, fmt.Sprintf("task %s binds workspace %s which is not declared by the Pipeline", t.Name, ws.Workspace)})
+			}
+		}
+
+		if t.TaskSpec != nil {
+			for _, step := range t.TaskSpec.Steps {
+				if step.Image != "" && !pinned(step.Image) {
+					findings = append(findings, Finding{RuleUnpinnedImage, t.Name, fmt.Sprintf("step %s of task %s uses image %s which is not pinned to a tag or digest", step.Name, t.Name, step.Image)})
+				}
+			}
+		}
+	}
+
+	for _, param := range p.Spec.Params {
+		if !usedParams[param.Name] {
+			findings = append(findings, Finding{RuleUnusedParam, "", fmt.Sprintf("param %s is not used by any task", param.Name)})
+		}
+	}
+	return findings
+}
+
+func paramRefs(t interface{}) []string {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	refs := []string{}
+	for _, m := range paramRef.FindAllStringSubmatch(string(b), -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			refs = append(refs, m[1])
+		}
+	}
+	sort.Strings(refs)
+	return refs
+}
+
+func pinned(image string) bool {
+	if strings.Contains(image, "@") {
+		return true
+	}
+	// the tag is after the last colon which is not part of the registry host
+	i := strings.LastIndex(image, ":")
+	if i == -1 || strings.Contains(image[i:], "/") {
+		return false
+	}
+	return image[i+1:] != "latest"
+}
+
+// SARIF returns the findings of the rules of a tool as a SARIF log. object
+// is the path of the linted object, e.g. pipelines/build, its tasks are
+// below it, and filename is the file it was read from, if any.
+func SARIF(tool string, rules []LintRule, findings []Finding, object, filename string) *sarif.Log {
+	sarifRules := make([]sarif.Rule, 0, len(rules))
+	for _, r := range rules {
+		sarifRules = append(sarifRules, sarif.Rule{
+			ID:                   r.ID,
+			Name:                 r.Name,
+			ShortDescription:     sarif.Message{Text: r.Description},
+			DefaultConfiguration: sarif.Configuration{Level: r.Level},
+		})
+	}
+
+	name := path.Base(object)
+	results := make([]sarif.Result, 0, len(findings))
+	for _, f := range findings {
+		location := sarif.Location{}
+		if filename != "" {
+			location.PhysicalLocation = &sarif.PhysicalLocation{ArtifactLocation: sarif.ArtifactLocation{URI: filename}}
+		}
+		fqn := object
+		if f.Task != "" {
+			fqn += "/tasks/" + f.Task
+		}
+		location.LogicalLocations = []sarif.LogicalLocation{{Name: name, FullyQualifiedName: fqn, Kind: "object"}}
+
+		results = append(results, sarif.Result{
+			RuleID:    f.Rule.ID,
+			Level:     f.Rule.Level,
+			Message:   sarif.Message{Text: f.Message},
+			Locations: []sarif.Location{location},
+		})
+	}
+
+	return sarif.NewLog(sarif.Driver{
+		Name:           tool,
+		InformationURI: "https://github.com/tektoncd/cli",
+		Rules:          sarifRules,
+	}, results)
+}
@@ -0,0 +1,147 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"testing"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"gotest.tools/v3/assert"
+)
+
+func TestLint(t *testing.T) {
+	step := func(image string) v1.Step {
+		return v1.Step{Name: "run", Image: image}
+	}
+	embedded := func(name string, steps ...v1.Step) v1.PipelineTask {
+		return v1.PipelineTask{Name: name, TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{Steps: steps}}}
+	}
+	param := func(name, value string) v1.Param {
+		return v1.Param{Name: name, Value: *v1.NewStructuredValues(value)}
+	}
+
+	tests := []struct {
+		name string
+		spec v1.PipelineSpec
+		want []Finding
+	}{
+		{
+			name: "clean",
+			spec: v1.PipelineSpec{
+				Params:     v1.ParamSpecs{{Name: "revision"}},
+				Workspaces: []v1.PipelineWorkspaceDeclaration{{Name: "source"}},
+				Tasks: []v1.PipelineTask{
+					{
+						Name:       "clone",
+						TaskRef:    &v1.TaskRef{Name: "git-clone"},
+						Params:     v1.Params{param("revision", "$(params.revision)")},
+						Workspaces: []v1.WorkspacePipelineTaskBinding{{Name: "output", Workspace: "source"}},
+					},
+					embedded("build", step("golang:1.23")),
+				},
+				Finally: []v1.PipelineTask{
+					{Name: "notify", TaskRef: &v1.TaskRef{Name: "notify"}},
+				},
+			},
+			want: []Finding{},
+		},
+		{
+			name: "unknown runAfter",
+			spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{
+					{Name: "test", TaskRef: &v1.TaskRef{Name: "test"}, RunAfter: []string{"build"}},
+				},
+			},
+			want: []Finding{
+				{RuleUnknownRunAfter, "test", "task test runs after build which is not a task of the Pipeline"},
+			},
+		},
+		{
+			name: "undeclared param",
+			spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{
+					{Name: "clone", TaskRef: &v1.TaskRef{Name: "git-clone"}, Params: v1.Params{param("revision", "$(params.revision)")}},
+				},
+			},
+			want: []Finding{
+				{RuleUndeclaredParam, "clone", "task clone references param revision which is not declared by the Pipeline"},
+			},
+		},
+		{
+			name: "params of an embedded task",
+			spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{
+					{
+						Name: "build",
+						TaskSpec: &v1.EmbeddedTask{TaskSpec: v1.TaskSpec{
+							Params: v1.ParamSpecs{{Name: "target"}},
+							Steps:  []v1.Step{{Name: "run", Image: "golang:1.23", Script: "go build $(params.target)"}},
+						}},
+					},
+				},
+			},
+			want: []Finding{},
+		},
+		{
+			name: "unused param",
+			spec: v1.PipelineSpec{
+				Params: v1.ParamSpecs{{Name: "revision"}},
+				Tasks:  []v1.PipelineTask{{Name: "clone", TaskRef: &v1.TaskRef{Name: "git-clone"}}},
+			},
+			want: []Finding{
+				{RuleUnusedParam, "", "param revision is not used by any task"},
+			},
+		},
+		{
+			name: "undeclared workspace",
+			spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{
+					{
+						Name:       "clone",
+						TaskRef:    &v1.TaskRef{Name: "git-clone"},
+						Workspaces: []v1.WorkspacePipelineTaskBinding{{Name: "output", Workspace: "source"}},
+					},
+				},
+			},
+			want: []Finding{
+				{RuleUndeclaredWorkspace, "clone", "task clone binds workspace source which is not declared by the Pipeline"},
+			},
+		},
+		{
+			name: "unpinned images",
+			spec: v1.PipelineSpec{
+				Tasks: []v1.PipelineTask{
+					embedded("untagged", step("golang")),
+					embedded("latest", step("golang:latest")),
+					embedded("registry port", step("localhost:5000/golang")),
+					embedded("tagged", step("localhost:5000/golang:1.23")),
+					embedded("digest", step("golang@sha256:0123456789abcdef")),
+				},
+			},
+			want: []Finding{
+				{RuleUnpinnedImage, "untagged", "step run of task untagged uses image golang which is not pinned to a tag or digest"},
+				{RuleUnpinnedImage, "latest", "step run of task latest uses image golang:latest which is not pinned to a tag or digest"},
+				{RuleUnpinnedImage, "registry port", "step run of task registry port uses image localhost:5000/golang which is not pinned to a tag or digest"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Lint(&v1.Pipeline{Spec: tt.spec})
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/tektoncd/cli/pkg/cli"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
 )
 
 // LastRun returns the name of last pipelinerun for a given pipeline
@@ -60,3 +61,30 @@ func LastRun(cs *cli.Clients, pipeline string, ns string) (*v1.PipelineRun, erro
 
 	return &latest, nil
 }
+
+// LastSuccessfulRun returns the last PipelineRun of a pipeline which succeeded
+func LastSuccessfulRun(cs *cli.Clients, pipeline string, ns string) (*v1.PipelineRun, error) {
+	options := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("tekton.dev/pipeline=%s", pipeline),
+	}
+
+	var runs *v1.PipelineRunList
+	err := actions.ListV1(pipelineRunGroupResource, cs, options, ns, &runs)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *v1.PipelineRun
+	for i, run := range runs.Items {
+		if !run.Status.GetCondition(apis.ConditionSucceeded).IsTrue() {
+			continue
+		}
+		if latest == nil || run.CreationTimestamp.Time.After(latest.CreationTimestamp.Time) {
+			latest = &runs.Items[i]
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("no successful pipelineruns related to pipeline %s found in namespace %s", pipeline, ns)
+	}
+	return latest, nil
+}
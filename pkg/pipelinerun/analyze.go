@@ -0,0 +1,116 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"fmt"
+
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	pipelinepkg "github.com/tektoncd/cli/pkg/pipeline"
+	"github.com/tektoncd/cli/pkg/sarif"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+// Rules checked by Analyze besides the lint rules of the Pipeline run
+var (
+	RuleFailedTask = pipelinepkg.LintRule{
+		ID:          "TKN101",
+		Name:        "failed-task",
+		Description: "a task of the PipelineRun failed",
+		Level:       sarif.LevelError,
+	}
+	RuleTimedOutTask = pipelinepkg.LintRule{
+		ID:          "TKN102",
+		Name:        "timed-out-task",
+		Description: "a task of the PipelineRun did not finish within its timeout",
+		Level:       sarif.LevelError,
+	}
+	RuleRetriedTask = pipelinepkg.LintRule{
+		ID:          "TKN103",
+		Name:        "retried-task",
+		Description: "a task of the PipelineRun only succeeded once retried, it may be flaky",
+		Level:       sarif.LevelWarning,
+	}
+	RuleSkippedTask = pipelinepkg.LintRule{
+		ID:          "TKN104",
+		Name:        "skipped-task",
+		Description: "a task of the PipelineRun was skipped",
+		Level:       sarif.LevelNote,
+	}
+
+	AnalyzeRules = append(append([]pipelinepkg.LintRule{}, pipelinepkg.LintRules...),
+		RuleFailedTask,
+		RuleTimedOutTask,
+		RuleRetriedTask,
+		RuleSkippedTask,
+	)
+)
+
+// Analyze checks a PipelineRun for the problems of its tasks, and the
+// Pipeline it ran for the mistakes found by tkn pipeline lint
+func Analyze(c *cli.Clients, pr *v1.PipelineRun) ([]pipelinepkg.Finding, error) {
+	trs := map[string]*v1.TaskRun{}
+	for _, child := range pr.Status.ChildReferences {
+		if child.Kind != "TaskRun" {
+			continue
+		}
+		var tr *v1.TaskRun
+		err := actions.GetV1(taskrunGroupResource, c, child.Name, pr.Namespace, metav1.GetOptions{}, &tr)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		trs[child.Name] = tr
+	}
+	return analyze(pr, trs), nil
+}
+
+// analyze checks the PipelineRun with its TaskRuns by name
+func analyze(pr *v1.PipelineRun, trs map[string]*v1.TaskRun) []pipelinepkg.Finding {
+	findings := []pipelinepkg.Finding{}
+	if pr.Status.PipelineSpec != nil {
+		findings = append(findings, pipelinepkg.Lint(&v1.Pipeline{Spec: *pr.Status.PipelineSpec})...)
+	}
+
+	for _, child := range pr.Status.ChildReferences {
+		tr, ok := trs[child.Name]
+		if !ok {
+			continue
+		}
+		task := child.PipelineTaskName
+		cond := tr.Status.GetCondition(apis.ConditionSucceeded)
+		switch {
+		case cond == nil:
+		case cond.Status == corev1.ConditionFalse && cond.Reason == v1.TaskRunReasonTimedOut.String():
+			findings = append(findings, pipelinepkg.Finding{Rule: RuleTimedOutTask, Task: task, Message: fmt.Sprintf("task %s timed out: %s", task, cond.Message)})
+		case cond.Status == corev1.ConditionFalse && cond.Reason != v1.TaskRunReasonCancelled.String():
+			findings = append(findings, pipelinepkg.Finding{Rule: RuleFailedTask, Task: task, Message: fmt.Sprintf("task %s failed: %s", task, message(cond.Reason, cond.Message))})
+		case cond.Status == corev1.ConditionTrue && len(tr.Status.RetriesStatus) != 0:
+			findings = append(findings, pipelinepkg.Finding{Rule: RuleRetriedTask, Task: task, Message: fmt.Sprintf("task %s succeeded after %d retries", task, len(tr.Status.RetriesStatus))})
+		}
+	}
+
+	for _, skipped := range pr.Status.SkippedTasks {
+		findings = append(findings, pipelinepkg.Finding{Rule: RuleSkippedTask, Task: skipped.Name, Message: fmt.Sprintf("task %s was skipped: %s", skipped.Name, skipped.Reason)})
+	}
+	return findings
+}
@@ -0,0 +1,97 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Described is a PipelineRun along with its TaskRuns, as stored
+type Described struct {
+	PipelineRun *unstructured.Unstructured   `json:"pipelineRun"`
+	TaskRuns    []*unstructured.Unstructured `json:"taskRuns"`
+}
+
+// DescribeAll returns the PipelineRuns of the namespace created since the
+// given time, the most recent first, along with their TaskRuns in the order
+// of their child references. The PipelineRuns and the TaskRuns are each read
+// with a single list, only the TaskRuns missing from it, e.g. whose labels
+// were changed, are read one by one.
+func DescribeAll(c *cli.Clients, ns string, since time.Time) ([]Described, error) {
+	prs, err := actions.List(pipelineRunGroupResource, c.Dynamic, c.Tekton.Discovery(), ns, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PipelineRuns from namespace %s: %w", ns, err)
+	}
+	runs := []*unstructured.Unstructured{}
+	for i := range prs.Items {
+		if prs.Items[i].GetCreationTimestamp().Time.Before(since) {
+			continue
+		}
+		runs = append(runs, &prs.Items[i])
+	}
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].GetCreationTimestamp().After(runs[j].GetCreationTimestamp().Time)
+	})
+
+	described := []Described{}
+	if len(runs) == 0 {
+		return described, nil
+	}
+
+	trs, err := actions.List(taskrunGroupResource, c.Dynamic, c.Tekton.Discovery(), ns, metav1.ListOptions{
+		LabelSelector: pipeline.PipelineRunLabelKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list TaskRuns from namespace %s: %w", ns, err)
+	}
+	listed := map[string]*unstructured.Unstructured{}
+	for i := range trs.Items {
+		listed[trs.Items[i].GetName()] = &trs.Items[i]
+	}
+
+	for _, pr := range runs {
+		d := Described{PipelineRun: pr, TaskRuns: []*unstructured.Unstructured{}}
+		children, _, _ := unstructured.NestedSlice(pr.Object, "status", "childReferences")
+		for _, child := range children {
+			ref, ok := child.(map[string]interface{})
+			if !ok || ref["kind"] != "TaskRun" {
+				continue
+			}
+			name, _ := ref["name"].(string)
+			tr, ok := listed[name]
+			if !ok {
+				tr, err = actions.GetUnstructured(taskrunGroupResource, c, name, ns, metav1.GetOptions{})
+				if err != nil {
+					if errors.IsNotFound(err) {
+						continue
+					}
+					return nil, fmt.Errorf("failed to get TaskRun %s of PipelineRun %s: %v", name, pr.GetName(), err)
+				}
+			}
+			d.TaskRuns = append(d.TaskRuns, tr)
+		}
+		described = append(described, d)
+	}
+	return described, nil
+}
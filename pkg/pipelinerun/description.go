@@ -26,12 +26,16 @@ import (
 	"github.com/jonboulle/clockwork"
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/conditions"
+	"github.com/tektoncd/cli/pkg/dashboard"
 	"github.com/tektoncd/cli/pkg/formatted"
+	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
 )
 
 const describeTemplate = `{{decorate "bold" "Name"}}:	{{ .PipelineRun.Name }}
@@ -50,7 +54,7 @@ const describeTemplate = `{{decorate "bold" "Name"}}:	{{ .PipelineRun.Name }}
  {{ $k }}={{ $v }}
 {{- end }}
 {{- end }}
-{{- $annotations := removeLastAppliedConfig .PipelineRun.Annotations -}}
+{{- $annotations := runAnnotations .PipelineRun.Annotations -}}
 {{- if $annotations }}
 {{decorate "bold" "Annotations"}}:
 {{- range $k, $v := $annotations }}
@@ -131,7 +135,17 @@ STARTED	DURATION	STATUS
 
 {{decorate "taskruns" ""}}{{decorate "underline bold" "Taskruns\n"}}
  NAME	TASK NAME	STARTED	DURATION	STATUS
-{{- range $taskrun := .TaskrunList }}{{ if checkTRStatus $taskrun }}
+{{- range $taskrun := .TaskrunList }}{{ if and (checkTRStatus $taskrun) (not $taskrun.Finally) }}
+ {{decorate "bullet" $taskrun.TaskRunName }}	{{ $taskrun.PipelineTaskName }}	{{ formatAge $taskrun.Status.StartTime $.Time }}	{{ formatDuration $taskrun.Status.StartTime $taskrun.Status.CompletionTime }}	{{ formatCondition $taskrun.Status.Conditions }}
+{{- end }}
+{{- end }}
+{{- end }}
+
+{{- if hasFinally .TaskrunList }}
+
+{{decorate "finally" ""}}{{decorate "underline bold" "Finally Taskruns\n"}}
+ NAME	TASK NAME	STARTED	DURATION	STATUS
+{{- range $taskrun := .TaskrunList }}{{ if and (checkTRStatus $taskrun) $taskrun.Finally }}
  {{decorate "bullet" $taskrun.TaskRunName }}	{{ $taskrun.PipelineTaskName }}	{{ formatAge $taskrun.Status.StartTime $.Time }}	{{ formatDuration $taskrun.Status.StartTime $taskrun.Status.CompletionTime }}	{{ formatCondition $taskrun.Status.Conditions }}
 {{- end }}
 {{- end }}
@@ -140,9 +154,43 @@ STARTED	DURATION	STATUS
 {{- if ne (len .PipelineRun.Status.SkippedTasks) 0 }}
 
 {{decorate "skippedtasks" ""}}{{decorate "underline bold" "Skipped Tasks\n"}}
- NAME
+ NAME	REASON
 {{- range $skippedTask := .PipelineRun.Status.SkippedTasks }}
- {{decorate "bullet" $skippedTask.Name }}
+ {{decorate "bullet" $skippedTask.Name }}	{{ formatSkippedReason $skippedTask }}
+{{- end }}
+{{- end }}
+
+{{- with .Provenance }}
+
+{{decorate "provenance" ""}}{{decorate "underline bold" "Provenance\n"}}
+{{- if .Resolver }}
+ {{decorate "bold" "Resolver"}}:	{{ .Resolver }}
+{{- end }}
+{{- with .RefSource }}
+ {{decorate "bold" "Source"}}:	{{ .URI }}
+{{- if .EntryPoint }}
+ {{decorate "bold" "Entry Point"}}:	{{ .EntryPoint }}
+{{- end }}
+ {{decorate "bold" "Digest"}}:	{{ formatDigest . }}
+{{- end }}
+{{- if .Builder }}
+ {{decorate "bold" "Builder"}}:	{{ .Builder }}
+{{- end }}
+{{- if .Artifacts }}
+ {{decorate "bold" "Signed Artifacts"}}:	{{ join .Artifacts ", " }}
+{{- end }}
+{{- if .Chains }}
+ {{decorate "bold" "Chains"}}:
+{{- range $k, $v := .Chains }}
+  {{ $k }}={{ $v }}
+{{- end }}
+{{- end }}
+{{- if .TaskRuns }}
+
+ TASKRUN	TASK NAME	RESOLVER	SOURCE	DIGEST
+{{- range $tr := .TaskRuns }}
+ {{decorate "bullet" $tr.TaskRunName }}	{{ $tr.PipelineTaskName }}	{{ or $tr.Resolver "---" }}	{{ if $tr.RefSource }}{{ $tr.RefSource.URI }}{{ else }}---{{ end }}	{{ formatDigest $tr.RefSource }}
+{{- end }}
 {{- end }}
 {{- end }}
 `
@@ -151,6 +199,8 @@ type TaskRunWithStatus struct {
 	TaskRunName      string
 	PipelineTaskName string
 	Status           *v1.TaskRunStatus
+	// Finally is true when the TaskRun belongs to a finally task
+	Finally bool
 }
 
 type TaskRunWithStatusList []TaskRunWithStatus
@@ -172,9 +222,12 @@ func (trs TaskRunWithStatusList) Less(i, j int) bool {
 func PrintPipelineRunDescription(out io.Writer, c *cli.Clients, ns string, prName string, time clockwork.Clock) error {
 	pr, err := GetPipelineRun(pipelineRunGroupResource, c, prName, ns)
 	if err != nil {
-		return fmt.Errorf("failed to find pipelinerun %q", prName)
+		return cli.WithCause(err, "failed to find pipelinerun %q", prName)
 	}
 
+	var trs []*v1.TaskRun
+	finallyTasks := FinallyTaskNames(pr)
+	matrixParams := MatrixParamNames(pr)
 	var taskRunList TaskRunWithStatusList
 	for _, child := range pr.Status.ChildReferences {
 		if child.Kind == "TaskRun" {
@@ -183,10 +236,12 @@ func PrintPipelineRunDescription(out io.Writer, c *cli.Clients, ns string, prNam
 			if err != nil {
 				return fmt.Errorf("failed to find get taskruns of the pipelineruns")
 			}
+			trs = append(trs, tr)
 			taskRunList = append(taskRunList, TaskRunWithStatus{
 				tr.Name,
-				child.PipelineTaskName,
+				taskrunpkg.MatrixLabel(child.PipelineTaskName, matrixParams[child.PipelineTaskName], tr),
 				&tr.Status,
+				finallyTasks[child.PipelineTaskName],
 			})
 		}
 	}
@@ -199,22 +254,28 @@ func PrintPipelineRunDescription(out io.Writer, c *cli.Clients, ns string, prNam
 		PipelineRun *v1.PipelineRun
 		Time        clockwork.Clock
 		TaskrunList TaskRunWithStatusList
+		Provenance  *Provenance
 	}{
 		PipelineRun: pr,
 		Time:        time,
 		TaskrunList: taskRunList,
+		Provenance:  PipelineRunProvenance(pr, trs),
 	}
 
 	funcMap := template.FuncMap{
-		"formatAge":               formatted.Age,
-		"formatDuration":          formatted.Duration,
-		"formatCondition":         formatted.Condition,
-		"formatWorkspace":         formatted.Workspace,
-		"hasFailed":               hasFailed,
-		"pipelineRefExists":       formatted.PipelineRefExists,
-		"decorate":                formatted.DecorateAttr,
-		"checkTRStatus":           checkTaskRunStatus,
-		"removeLastAppliedConfig": formatted.RemoveLastAppliedConfig,
+		"formatAge":           formatted.Age,
+		"formatDuration":      formatted.Duration,
+		"formatCondition":     formatted.Condition,
+		"formatWorkspace":     formatted.Workspace,
+		"hasFailed":           hasFailed,
+		"pipelineRefExists":   formatted.PipelineRefExists,
+		"decorate":            formatted.DecorateAttr,
+		"checkTRStatus":       checkTaskRunStatus,
+		"hasFinally":          hasFinally,
+		"formatSkippedReason": formatted.SkippedTaskReason,
+		"runAnnotations":      runAnnotations,
+		"formatDigest":        FormatDigest,
+		"join":                strings.Join,
 	}
 
 	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
@@ -226,6 +287,63 @@ func PrintPipelineRunDescription(out io.Writer, c *cli.Clients, ns string, prNam
 	return w.Flush()
 }
 
+// PrintPipelineRunLinks prints the links to the PipelineRun and to its
+// TaskRuns in the Tekton Dashboard
+func PrintPipelineRunLinks(out io.Writer, c *cli.Clients, ns string, prName string, links *dashboard.Links) error {
+	pr, err := GetPipelineRun(pipelineRunGroupResource, c, prName, ns)
+	if err != nil {
+		return cli.WithCause(err, "failed to find pipelinerun %q", prName)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(w, "NAME\tTASK NAME\tLINK")
+	fmt.Fprintf(w, "%s\t---\t%s\n", pr.Name, links.Link(dashboard.KindPipelineRun, ns, pr.Name))
+	for _, child := range pr.Status.ChildReferences {
+		if child.Kind != "TaskRun" {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", child.Name, child.PipelineTaskName, links.Link(dashboard.KindTaskRun, ns, child.Name))
+	}
+	return w.Flush()
+}
+
+// PrintPipelineRunConditionHistory prints the transitions of the condition
+// of the PipelineRun
+func PrintPipelineRunConditionHistory(out io.Writer, c *cli.Clients, ns string, prName string, clock clockwork.Clock) error {
+	pr, err := GetPipelineRun(pipelineRunGroupResource, c, prName, ns)
+	if err != nil {
+		return cli.WithCause(err, "failed to find pipelinerun %q", prName)
+	}
+	history, err := conditions.History(c, ns, "PipelineRun", pr.Name, pr.Status.GetCondition(apis.ConditionSucceeded))
+	if err != nil {
+		return fmt.Errorf("failed to get the condition history of PipelineRun %s: %w", pr.Name, err)
+	}
+	conditions.PrintHistory(out, history, clock)
+	return nil
+}
+
+// PrintPipelineRunScheduling prints the nodes the pods of the TaskRuns of
+// the PipelineRun were scheduled on and how they got there
+func PrintPipelineRunScheduling(out io.Writer, c *cli.Clients, ns string, prName string) error {
+	pr, err := GetPipelineRun(pipelineRunGroupResource, c, prName, ns)
+	if err != nil {
+		return cli.WithCause(err, "failed to find pipelinerun %q", prName)
+	}
+
+	var trs []*v1.TaskRun
+	for _, child := range pr.Status.ChildReferences {
+		if child.Kind != "TaskRun" {
+			continue
+		}
+		var tr *v1.TaskRun
+		if err := actions.GetV1(taskrunGroupResource, c, child.Name, ns, metav1.GetOptions{}, &tr); err != nil {
+			return fmt.Errorf("failed to get TaskRun %s of the PipelineRun: %w", child.Name, err)
+		}
+		trs = append(trs, tr)
+	}
+	return taskrunpkg.PrintScheduling(out, c, ns, trs)
+}
+
 func GetPipelineRun(gr schema.GroupVersionResource, c *cli.Clients, prName, ns string) (*v1.PipelineRun, error) {
 	var pipelinerun v1.PipelineRun
 	gvr, err := actions.GetGroupVersionResource(gr, c.Tekton.Discovery())
@@ -282,6 +400,65 @@ func hasFailed(pr *v1.PipelineRun, taskruns TaskRunWithStatusList) string {
 	return ""
 }
 
+// runAnnotations are the annotations shown with the labels of the run, the
+// ones of Chains are shown with the provenance
+func runAnnotations(annotations map[string]string) map[string]string {
+	shown := formatted.RemoveLastAppliedConfig(annotations)
+	for k := range shown {
+		if IsChainsAnnotation(k) {
+			delete(shown, k)
+		}
+	}
+	return shown
+}
+
 func checkTaskRunStatus(taskRun TaskRunWithStatus) bool {
 	return taskRun.Status != nil
 }
+
+func hasFinally(taskRuns TaskRunWithStatusList) bool {
+	for _, tr := range taskRuns {
+		if tr.Finally && checkTaskRunStatus(tr) {
+			return true
+		}
+	}
+	return false
+}
+
+// FinallyTaskNames returns the names of the finally tasks of the resolved
+// pipeline spec of the PipelineRun
+func FinallyTaskNames(pr *v1.PipelineRun) map[string]bool {
+	names := map[string]bool{}
+	spec := pr.Status.PipelineSpec
+	if spec == nil {
+		spec = pr.Spec.PipelineSpec
+	}
+	if spec == nil {
+		return names
+	}
+	for _, t := range spec.Finally {
+		names[t.Name] = true
+	}
+	return names
+}
+
+// MatrixParamNames returns the matrix param names of the Pipeline tasks of a
+// PipelineRun which fan out to several TaskRuns, keyed by Pipeline task name
+func MatrixParamNames(pr *v1.PipelineRun) map[string][]string {
+	names := map[string][]string{}
+	spec := pr.Status.PipelineSpec
+	if spec == nil {
+		spec = pr.Spec.PipelineSpec
+	}
+	if spec == nil {
+		return names
+	}
+	for _, tasks := range [][]v1.PipelineTask{spec.Tasks, spec.Finally} {
+		for _, t := range tasks {
+			if matrix := taskrunpkg.MatrixParamNames(t); len(matrix) != 0 {
+				names[t.Name] = matrix
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,145 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"fmt"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExtractedSpecs are the resources rebuilt from the status of a PipelineRun
+type ExtractedSpecs struct {
+	Pipeline *v1.Pipeline
+	Tasks    []*v1.Task
+}
+
+// ExtractSpecs rebuilds the Pipeline a PipelineRun ran from the resolved
+// pipelineSpec of its status. With withTasks, the Tasks referenced by the
+// Pipeline are rebuilt from the resolved taskSpec of the TaskRuns and the
+// Pipeline is changed to reference them by name, so the result does not
+// depend on resolvers or bundles anymore.
+func ExtractSpecs(c *cli.Clients, pr *v1.PipelineRun, name string, withTasks bool) (*ExtractedSpecs, error) {
+	if pr.Status.PipelineSpec == nil {
+		return nil, fmt.Errorf("PipelineRun %s has no resolved pipelineSpec in its status yet", pr.Name)
+	}
+
+	if name == "" {
+		name = extractedPipelineName(pr)
+	}
+	p := &v1.Pipeline{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "Pipeline"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       *pr.Status.PipelineSpec.DeepCopy(),
+	}
+	specs := &ExtractedSpecs{Pipeline: p}
+	if !withTasks {
+		return specs, nil
+	}
+
+	taskNames := map[string]string{}
+	for _, cr := range pr.Status.ChildReferences {
+		if cr.Kind != "TaskRun" {
+			continue
+		}
+		if _, ok := taskNames[cr.PipelineTaskName]; ok {
+			// matrix TaskRuns share the spec of their pipeline task
+			continue
+		}
+		pt := pipelineTask(p, cr.PipelineTaskName)
+		if pt == nil || pt.TaskRef == nil {
+			// embedded taskSpecs are already part of the Pipeline
+			continue
+		}
+
+		tr, err := taskrunpkg.GetTaskRun(taskrunGroupResource, c, cr.Name, pr.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if tr.Status.TaskSpec == nil {
+			return nil, fmt.Errorf("TaskRun %s has no resolved taskSpec in its status yet", tr.Name)
+		}
+
+		taskName := pt.TaskRef.Name
+		if taskName == "" {
+			taskName = tr.Labels[pipeline.TaskLabelKey]
+		}
+		if taskName == "" {
+			taskName = cr.PipelineTaskName
+		}
+		if !addTask(specs, taskName, tr.Status.TaskSpec) {
+			// a different Task with the same name was already extracted
+			taskName = fmt.Sprintf("%s-%s", taskName, cr.PipelineTaskName)
+			addTask(specs, taskName, tr.Status.TaskSpec)
+		}
+		taskNames[cr.PipelineTaskName] = taskName
+	}
+
+	for i := range p.Spec.Tasks {
+		rewriteTaskRef(&p.Spec.Tasks[i], taskNames)
+	}
+	for i := range p.Spec.Finally {
+		rewriteTaskRef(&p.Spec.Finally[i], taskNames)
+	}
+	return specs, nil
+}
+
+func extractedPipelineName(pr *v1.PipelineRun) string {
+	if pr.Spec.PipelineRef != nil && pr.Spec.PipelineRef.Name != "" {
+		return pr.Spec.PipelineRef.Name
+	}
+	if name := pr.Labels[pipeline.PipelineLabelKey]; name != "" && name != pr.Name {
+		return name
+	}
+	return pr.Name
+}
+
+func pipelineTask(p *v1.Pipeline, name string) *v1.PipelineTask {
+	for _, tasks := range [][]v1.PipelineTask{p.Spec.Tasks, p.Spec.Finally} {
+		for i := range tasks {
+			if tasks[i].Name == name {
+				return &tasks[i]
+			}
+		}
+	}
+	return nil
+}
+
+// addTask adds a Task unless one with the same name exists, it returns false
+// if the existing Task has a different spec
+func addTask(specs *ExtractedSpecs, name string, spec *v1.TaskSpec) bool {
+	for _, t := range specs.Tasks {
+		if t.Name == name {
+			return equality.Semantic.DeepEqual(t.Spec, *spec)
+		}
+	}
+	specs.Tasks = append(specs.Tasks, &v1.Task{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "tekton.dev/v1", Kind: "Task"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       *spec.DeepCopy(),
+	})
+	return true
+}
+
+func rewriteTaskRef(pt *v1.PipelineTask, taskNames map[string]string) {
+	if name, ok := taskNames[pt.Name]; ok {
+		pt.TaskRef = &v1.TaskRef{Name: name, Kind: v1.NamespacedTaskKind}
+	}
+}
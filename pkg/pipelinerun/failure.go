@@ -0,0 +1,85 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/pods"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+// reasonStepSkipped is the termination reason of the steps which did not run
+// because a former step failed
+const reasonStepSkipped = "Skipped"
+
+// Failure describes a task of a PipelineRun which failed
+type Failure struct {
+	Task string
+	// Step is the step which failed, empty when the TaskRun failed without
+	// a failing step, e.g. when it timed out or its pod could not be created
+	Step     string
+	ExitCode int32
+	// Message is the termination reason of the failed step, or the reason
+	// and message of the condition of the TaskRun
+	Message string
+}
+
+// Failures returns the failed steps of the TaskRuns of a PipelineRun, in the
+// order of its child references
+func Failures(c *cli.Clients, pr *v1.PipelineRun) ([]Failure, error) {
+	failures := []Failure{}
+	for _, child := range pr.Status.ChildReferences {
+		if child.Kind != "TaskRun" {
+			continue
+		}
+		var tr *v1.TaskRun
+		err := actions.GetV1(taskrunGroupResource, c, child.Name, pr.Namespace, metav1.GetOptions{}, &tr)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		cond := tr.Status.GetCondition(apis.ConditionSucceeded)
+		if cond == nil || cond.Status != corev1.ConditionFalse {
+			continue
+		}
+
+		found := false
+		for _, step := range tr.Status.Steps {
+			cs := pods.StatusOfState(step.Name, step.ContainerState, step.ImageID)
+			if !cs.Failed() || cs.Reason == reasonStepSkipped || step.TerminationReason == reasonStepSkipped {
+				continue
+			}
+			found = true
+			failures = append(failures, Failure{
+				Task:     child.PipelineTaskName,
+				Step:     step.Name,
+				ExitCode: cs.ExitCode,
+				Message:  cs.Reason,
+			})
+		}
+		if !found {
+			failures = append(failures, Failure{Task: child.PipelineTaskName, Message: message(cond.Reason, cond.Message)})
+		}
+	}
+	return failures, nil
+}
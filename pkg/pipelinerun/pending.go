@@ -0,0 +1,197 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// BlockedOnPending is used for PipelineRuns held with spec.status PipelineRunPending
+	BlockedOnPending = "Pending"
+	// BlockedOnController is used for PipelineRuns not yet picked up by the controller
+	BlockedOnController = "Controller"
+	// BlockedOnResolution is used while a Pipeline or Task reference is being resolved
+	BlockedOnResolution = "Resolution"
+	// BlockedOnAdmission is used when the pod of a TaskRun could not be created
+	BlockedOnAdmission = "Admission"
+	// BlockedOnScheduling is used when the pod of a TaskRun could not be scheduled
+	BlockedOnScheduling = "Scheduling"
+)
+
+// reasons set by the TaskRun reconciler when a pod can't be created or scheduled
+const (
+	reasonExceededResourceQuota = "ExceededResourceQuota"
+	reasonExceededNodeResources = "ExceededNodeResources"
+)
+
+// Blocker describes why a PipelineRun, or one of its tasks, is not progressing
+type Blocker struct {
+	// Task is the Pipeline task which is blocked, empty when the whole
+	// PipelineRun is blocked
+	Task   string
+	Kind   string
+	Reason string
+}
+
+// PendingBlockers returns the reasons for which a PipelineRun which has not
+// completed is not making progress, extracted from the conditions of the
+// PipelineRun, its TaskRuns and their pods, and from their warning events
+func PendingBlockers(c *cli.Clients, pr *v1.PipelineRun) ([]Blocker, error) {
+	if pr.IsDone() {
+		return nil, nil
+	}
+
+	if pr.IsPending() {
+		return []Blocker{{Kind: BlockedOnPending, Reason: "PipelineRun is held with status " + v1.PipelineRunSpecStatusPending}}, nil
+	}
+
+	cond := pr.Status.GetCondition(apis.ConditionSucceeded)
+	if cond == nil {
+		return []Blocker{{Kind: BlockedOnController, Reason: "PipelineRun has not been reconciled yet"}}, nil
+	}
+	if cond.Reason == v1.PipelineRunReasonResolvingPipelineRef.String() {
+		return []Blocker{{Kind: BlockedOnResolution, Reason: message(cond.Reason, cond.Message)}}, nil
+	}
+
+	blockers := []Blocker{}
+	for _, child := range pr.Status.ChildReferences {
+		if child.Kind != "TaskRun" {
+			continue
+		}
+		var tr *v1.TaskRun
+		err := actions.GetV1(taskrunGroupResource, c, child.Name, pr.Namespace, metav1.GetOptions{}, &tr)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		blocker, err := taskRunBlocker(c, tr)
+		if err != nil {
+			return nil, err
+		}
+		if blocker != nil {
+			blocker.Task = child.PipelineTaskName
+			blockers = append(blockers, *blocker)
+		}
+	}
+
+	return blockers, nil
+}
+
+func taskRunBlocker(c *cli.Clients, tr *v1.TaskRun) (*Blocker, error) {
+	if tr.IsDone() {
+		return nil, nil
+	}
+
+	cond := tr.Status.GetCondition(apis.ConditionSucceeded)
+	if cond != nil {
+		switch cond.Reason {
+		case v1.TaskRunReasonResolvingTaskRef, v1.TaskRunReasonResolvingStepActionRef:
+			return &Blocker{Kind: BlockedOnResolution, Reason: message(cond.Reason, cond.Message)}, nil
+		case reasonExceededResourceQuota:
+			return &Blocker{Kind: BlockedOnAdmission, Reason: message(cond.Reason, cond.Message)}, nil
+		case reasonExceededNodeResources:
+			return &Blocker{Kind: BlockedOnScheduling, Reason: message(cond.Reason, cond.Message)}, nil
+		}
+	}
+
+	if tr.Status.PodName == "" {
+		// the pod couldn't be created, the reconciler reports why through events
+		event, err := lastWarningEvent(c, tr.Namespace, "TaskRun", tr.Name)
+		if err != nil || event == nil {
+			return nil, err
+		}
+		return &Blocker{Kind: BlockedOnAdmission, Reason: message(event.Reason, event.Message)}, nil
+	}
+
+	pod, err := c.Kube.CoreV1().Pods(tr.Namespace).Get(context.Background(), tr.Status.PodName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if pod.Status.Phase != corev1.PodPending {
+		return nil, nil
+	}
+
+	for _, pc := range pod.Status.Conditions {
+		if pc.Type != corev1.PodScheduled || pc.Status != corev1.ConditionFalse {
+			continue
+		}
+		if pc.Message != "" {
+			return &Blocker{Kind: BlockedOnScheduling, Reason: message(pc.Reason, pc.Message)}, nil
+		}
+		event, err := lastWarningEvent(c, pod.Namespace, "Pod", pod.Name)
+		if err != nil {
+			return nil, err
+		}
+		if event != nil {
+			return &Blocker{Kind: BlockedOnScheduling, Reason: message(event.Reason, event.Message)}, nil
+		}
+		return &Blocker{Kind: BlockedOnScheduling, Reason: pc.Reason}, nil
+	}
+
+	return nil, nil
+}
+
+func lastWarningEvent(c *cli.Clients, ns, kind, name string) (*corev1.Event, error) {
+	events, err := c.Kube.CoreV1().Events(ns).List(context.Background(), metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=%s,involvedObject.name=%s", kind, name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := []corev1.Event{}
+	for _, e := range events.Items {
+		if e.Type == corev1.EventTypeWarning && e.InvolvedObject.Kind == kind && e.InvolvedObject.Name == name {
+			warnings = append(warnings, e)
+		}
+	}
+	if len(warnings) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(warnings, func(i, j int) bool {
+		return warnings[i].LastTimestamp.Before(&warnings[j].LastTimestamp)
+	})
+	return &warnings[len(warnings)-1], nil
+}
+
+func message(reason, msg string) string {
+	msg = strings.TrimSpace(msg)
+	switch {
+	case reason == "":
+		return msg
+	case msg == "":
+		return reason
+	}
+	return fmt.Sprintf("%s: %s", reason, msg)
+}
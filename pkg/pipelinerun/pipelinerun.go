@@ -16,8 +16,8 @@ package pipelinerun
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/tektoncd/cli/pkg/actions"
@@ -28,8 +28,10 @@ import (
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
 )
 
 var pipelineRunGroupResource = schema.GroupVersionResource{Group: "tekton.dev", Resource: "pipelineruns"}
@@ -39,7 +41,7 @@ var taskrunGroupResource = schema.GroupVersionResource{Group: "tekton.dev", Reso
 func GetAllPipelineRuns(gr schema.GroupVersionResource, opts metav1.ListOptions, c *cli.Clients, ns string, limit int, time clockwork.Clock) ([]string, error) {
 	var pipelineruns *v1.PipelineRunList
 	if err := actions.ListV1(gr, c, opts, ns, &pipelineruns); err != nil {
-		return nil, fmt.Errorf("failed to list PipelineRuns from namespace %s: %v", ns, err)
+		return nil, fmt.Errorf("failed to list PipelineRuns from namespace %s: %w", ns, err)
 	}
 
 	runslen := len(pipelineruns.Items)
@@ -59,23 +61,16 @@ func GetAllPipelineRuns(gr schema.GroupVersionResource, opts metav1.ListOptions,
 	return ret, nil
 }
 
-type patchStringValue struct {
-	Op    string `json:"op"`
-	Path  string `json:"path"`
-	Value string `json:"value"`
-}
-
 func Cancel(c *cli.Clients, prname string, opts metav1.PatchOptions, cancelStatus, ns string) (*v1.PipelineRun, error) {
-	payload := []patchStringValue{{
+	ops := []actions.JSONPatchOperation{{
 		Op:    "replace",
 		Path:  "/spec/status",
 		Value: cancelStatus,
 	}}
 
-	data, _ := json.Marshal(payload)
 	prGroupResource := schema.GroupVersionResource{Group: "tekton.dev", Resource: "pipelineruns"}
 	var pipelinerun *v1.PipelineRun
-	err := actions.Patch(prGroupResource, c, prname, data, opts, ns, &pipelinerun)
+	err := actions.JSONPatch(prGroupResource, c, prname, ops, opts, ns, &pipelinerun)
 	if err != nil {
 		return nil, err
 	}
@@ -139,3 +134,62 @@ func Create(c *cli.Clients, pr *v1beta1.PipelineRun, opts metav1.CreateOptions,
 
 	return pipelinerun, nil
 }
+
+// WaitForCompletion watches the PipelineRun until it reaches a terminal
+// state, e.g. once it is cancelled and its finally tasks are done, and
+// returns it. It gives up after timeout.
+func WaitForCompletion(c *cli.Clients, prname, ns string, timeout time.Duration) (*v1.PipelineRun, error) {
+	return WatchTransitions(c, prname, ns, timeout, nil)
+}
+
+// WatchTransitions watches the PipelineRun until it reaches a terminal
+// state and returns it. transition, when set, is called with the
+// PipelineRun every time the status or the reason of its Succeeded
+// condition changes, starting with the one it has when the watch
+// starts. A zero timeout watches until the PipelineRun has finished.
+func WatchTransitions(c *cli.Clients, prname, ns string, timeout time.Duration, transition func(*v1.PipelineRun) error) (*v1.PipelineRun, error) {
+	opts := metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", prname).String(),
+	}
+	w, err := actions.Watch(pipelineRunGroupResource, c, ns, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer w.Stop()
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	var last apis.Condition
+	for {
+		// the run may have finished before the watch started, its state
+		// is read again on every event
+		pr, err := GetPipelineRun(pipelineRunGroupResource, c, prname, ns)
+		if err != nil {
+			return nil, err
+		}
+		if cond := pr.Status.GetCondition(apis.ConditionSucceeded); transition != nil && cond != nil &&
+			(cond.Status != last.Status || cond.Reason != last.Reason) {
+			last = *cond
+			if err := transition(pr); err != nil {
+				return nil, err
+			}
+		}
+		if pr.IsDone() {
+			return pr, nil
+		}
+
+		select {
+		case _, ok := <-w.ResultChan():
+			if !ok {
+				return nil, fmt.Errorf("watch of PipelineRun %s closed before it finished", prname)
+			}
+		case <-expired:
+			return nil, fmt.Errorf("timed out after %s waiting for PipelineRun %s to finish", timeout, prname)
+		}
+	}
+}
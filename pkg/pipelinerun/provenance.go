@@ -0,0 +1,177 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+const (
+	chainsPrefix = "chains.tekton.dev/"
+	// the annotations Tekton Chains stores the signed payload of the run and
+	// its signature in, suffixed with the kind and the uid of the run
+	chainsPayload = chainsPrefix + "payload-"
+)
+
+// chainsArtifacts are the annotations holding what Tekton Chains stored on
+// the run, only their presence is shown
+var chainsArtifacts = []string{"payload", "signature", "cert", "chain"}
+
+// Provenance is where the definitions executed by a PipelineRun came from,
+// as recorded by Tekton Pipelines and Tekton Chains
+type Provenance struct {
+	// Resolver is the resolver the Pipeline was fetched with
+	Resolver  string
+	RefSource *v1.RefSource
+	// Builder is the id of the builder of the provenance signed by Chains
+	Builder string
+	// Chains are the annotations of Chains other than its artifacts
+	Chains map[string]string
+	// Artifacts are the kinds of artifacts Chains stored on the run
+	Artifacts []string
+	TaskRuns  []TaskRunProvenance
+}
+
+// TaskRunProvenance is where the Task executed by a TaskRun came from
+type TaskRunProvenance struct {
+	TaskRunName      string
+	PipelineTaskName string
+	Resolver         string
+	RefSource        *v1.RefSource
+}
+
+// PipelineRunProvenance returns the provenance of the PipelineRun and of its
+// TaskRuns, nil is returned when nothing is known about it
+func PipelineRunProvenance(pr *v1.PipelineRun, trs []*v1.TaskRun) *Provenance {
+	p := &Provenance{Chains: map[string]string{}}
+	if pr.Spec.PipelineRef != nil {
+		p.Resolver = string(pr.Spec.PipelineRef.Resolver)
+	}
+	if pr.Status.Provenance != nil {
+		p.RefSource = pr.Status.Provenance.RefSource
+	}
+
+	for k, v := range pr.Annotations {
+		if !strings.HasPrefix(k, chainsPrefix) {
+			continue
+		}
+		if artifact := chainsArtifact(k); artifact != "" {
+			p.Artifacts = append(p.Artifacts, artifact)
+			if strings.HasPrefix(k, chainsPayload) {
+				p.Builder = builderID(v)
+			}
+			continue
+		}
+		p.Chains[strings.TrimPrefix(k, chainsPrefix)] = v
+	}
+	sort.Strings(p.Artifacts)
+
+	for _, tr := range trs {
+		tp := TaskRunProvenance{
+			TaskRunName:      tr.Name,
+			PipelineTaskName: tr.Labels["tekton.dev/pipelineTask"],
+		}
+		if tr.Spec.TaskRef != nil {
+			tp.Resolver = string(tr.Spec.TaskRef.Resolver)
+		}
+		if tr.Status.Provenance != nil {
+			tp.RefSource = tr.Status.Provenance.RefSource
+		}
+		if tp.Resolver != "" || tp.RefSource != nil {
+			p.TaskRuns = append(p.TaskRuns, tp)
+		}
+	}
+
+	if p.Resolver == "" && p.RefSource == nil && len(p.Chains) == 0 && len(p.Artifacts) == 0 && len(p.TaskRuns) == 0 {
+		return nil
+	}
+	return p
+}
+
+// IsChainsAnnotation tells whether an annotation was set by Tekton Chains
+func IsChainsAnnotation(key string) bool {
+	return strings.HasPrefix(key, chainsPrefix)
+}
+
+// FormatDigest formats the digests of a source as algorithm:digest pairs
+func FormatDigest(source *v1.RefSource) string {
+	if source == nil || len(source.Digest) == 0 {
+		return "---"
+	}
+	digests := make([]string, 0, len(source.Digest))
+	for alg, d := range source.Digest {
+		digests = append(digests, fmt.Sprintf("%s:%s", alg, d))
+	}
+	sort.Strings(digests)
+	return strings.Join(digests, ", ")
+}
+
+// FormatSource formats where a definition came from as its URI at its
+// digests, followed by its entry point in the source when it has one
+func FormatSource(source *v1.RefSource) string {
+	s := source.URI
+	if len(source.Digest) > 0 {
+		s += "@" + FormatDigest(source)
+	}
+	if source.EntryPoint != "" {
+		s += " (" + source.EntryPoint + ")"
+	}
+	return s
+}
+
+// chainsArtifact returns the kind of artifact stored in the annotation, or
+// an empty string when it is not an artifact
+func chainsArtifact(key string) string {
+	for _, artifact := range chainsArtifacts {
+		if strings.HasPrefix(key, chainsPrefix+artifact+"-") {
+			return artifact
+		}
+	}
+	return ""
+}
+
+// builderID reads the id of the builder from the in-toto statement signed by
+// Chains, for both SLSA v0.2 and v1 predicates
+func builderID(payload string) string {
+	b, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return ""
+	}
+	var statement struct {
+		Predicate struct {
+			Builder struct {
+				ID string `json:"id"`
+			} `json:"builder"`
+			RunDetails struct {
+				Builder struct {
+					ID string `json:"id"`
+				} `json:"builder"`
+			} `json:"runDetails"`
+		} `json:"predicate"`
+	}
+	if err := json.Unmarshal(b, &statement); err != nil {
+		return ""
+	}
+	if id := statement.Predicate.RunDetails.Builder.ID; id != "" {
+		return id
+	}
+	return statement.Predicate.Builder.ID
+}
@@ -0,0 +1,96 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPipelineRunProvenance(t *testing.T) {
+	// {"predicate":{"builder":{"id":"https://tekton.dev/chains/v2"}}}
+	payload := "eyJwcmVkaWNhdGUiOnsiYnVpbGRlciI6eyJpZCI6Imh0dHBzOi8vdGVrdG9uLmRldi9jaGFpbnMvdjIifX19"
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pr",
+			Annotations: map[string]string{
+				"tekton.dev/tags":                            "testing",
+				"chains.tekton.dev/signed":                   "true",
+				"chains.tekton.dev/payload-pipelinerun-0a":   payload,
+				"chains.tekton.dev/signature-pipelinerun-0a": "MEUCIQ",
+			},
+		},
+		Spec: v1.PipelineRunSpec{
+			PipelineRef: &v1.PipelineRef{ResolverRef: v1.ResolverRef{Resolver: "git"}},
+		},
+		Status: v1.PipelineRunStatus{
+			PipelineRunStatusFields: v1.PipelineRunStatusFields{
+				Provenance: &v1.Provenance{
+					RefSource: &v1.RefSource{URI: "git+https://github.com/tektoncd/catalog.git", Digest: map[string]string{"sha1": "3c5e0b5d"}},
+				},
+			},
+		},
+	}
+	trs := []*v1.TaskRun{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr-1", Labels: map[string]string{"tekton.dev/pipelineTask": "t-1"}},
+			Spec:       v1.TaskRunSpec{TaskRef: &v1.TaskRef{ResolverRef: v1.ResolverRef{Resolver: "bundles"}}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr-2"},
+			Spec:       v1.TaskRunSpec{TaskRef: &v1.TaskRef{Name: "task"}},
+		},
+	}
+
+	p := PipelineRunProvenance(pr, trs)
+	if p == nil {
+		t.Fatal("expected a provenance")
+	}
+	test.AssertOutput(t, "git", p.Resolver)
+	test.AssertOutput(t, "sha1:3c5e0b5d", FormatDigest(p.RefSource))
+	test.AssertOutput(t, "https://tekton.dev/chains/v2", p.Builder)
+	test.AssertOutput(t, map[string]string{"signed": "true"}, p.Chains)
+	test.AssertOutput(t, []string{"payload", "signature"}, p.Artifacts)
+	test.AssertOutput(t, []TaskRunProvenance{{TaskRunName: "tr-1", PipelineTaskName: "t-1", Resolver: "bundles"}}, p.TaskRuns)
+}
+
+func TestPipelineRunProvenance_unknown(t *testing.T) {
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "pr"},
+		Spec:       v1.PipelineRunSpec{PipelineRef: &v1.PipelineRef{Name: "pipeline"}},
+	}
+	if p := PipelineRunProvenance(pr, nil); p != nil {
+		t.Errorf("expected no provenance, got %+v", p)
+	}
+	test.AssertOutput(t, "---", FormatDigest(nil))
+}
+
+func TestFormatSource(t *testing.T) {
+	test.AssertOutput(t, "git+https://github.com/tektoncd/catalog.git@sha1:3c5e0b5d (task/git-clone/0.9/git-clone.yaml)", FormatSource(&v1.RefSource{
+		URI:        "git+https://github.com/tektoncd/catalog.git",
+		Digest:     map[string]string{"sha1": "3c5e0b5d"},
+		EntryPoint: "task/git-clone/0.9/git-clone.yaml",
+	}))
+	test.AssertOutput(t, "gcr.io/tekton-releases/catalog/upstream/golang-build:0.4@sha256:a1b2", FormatSource(&v1.RefSource{
+		URI:    "gcr.io/tekton-releases/catalog/upstream/golang-build:0.4",
+		Digest: map[string]string{"sha256": "a1b2"},
+	}))
+	test.AssertOutput(t, "https://artifacthub.io/api/v1/packages/tekton-task/tekton-catalog-tasks/git-clone/0.9.0", FormatSource(&v1.RefSource{
+		URI: "https://artifacthub.io/api/v1/packages/tekton-task/tekton-catalog-tasks/git-clone/0.9.0",
+	}))
+}
@@ -0,0 +1,69 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"context"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	taskrunpkg "github.com/tektoncd/cli/pkg/taskrun"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// readinessPollInterval is how often WaitForChildReferences reads the
+// PipelineRun and its TaskRuns again
+var readinessPollInterval = 500 * time.Millisecond
+
+// WaitForChildReferences waits until the PipelineRun can be read and
+// references TaskRuns which can all be read, or has finished, so that the
+// log readers built right after its creation do not fail on objects which
+// are not visible yet. A PipelineRun which still has no TaskRun after
+// timeout, e.g. a pending one, is left to the log readers, which wait for
+// its TaskRuns to start.
+func WaitForChildReferences(c *cli.Clients, prname, ns string, timeout time.Duration) error {
+	err := wait.PollUntilContextTimeout(context.Background(), readinessPollInterval, timeout, true, func(context.Context) (bool, error) {
+		pr, err := GetPipelineRun(pipelineRunGroupResource, c, prname, ns)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		if pr.IsDone() {
+			return true, nil
+		}
+		if len(pr.Status.ChildReferences) == 0 {
+			return false, nil
+		}
+		for _, child := range pr.Status.ChildReferences {
+			if child.Kind != "TaskRun" {
+				continue
+			}
+			if _, err := taskrunpkg.GetTaskRun(taskrunGroupResource, c, child.Name, ns); err != nil {
+				if apierrors.IsNotFound(err) {
+					return false, nil
+				}
+				return false, err
+			}
+		}
+		return true, nil
+	})
+	if wait.Interrupted(err) {
+		return nil
+	}
+	return err
+}
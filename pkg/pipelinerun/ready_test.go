@@ -0,0 +1,139 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipelinerun
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stest "k8s.io/client-go/testing"
+)
+
+func TestWaitForChildReferences(t *testing.T) {
+	readinessPollInterval = time.Millisecond
+	t.Cleanup(func() { readinessPollInterval = 500 * time.Millisecond })
+
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "pr-1", Namespace: "ns"},
+	}
+	started := pr.DeepCopy()
+	started.Status.ChildReferences = []v1.ChildStatusReference{{
+		TypeMeta:         runtime.TypeMeta{Kind: "TaskRun"},
+		Name:             "pr-1-build",
+		PipelineTaskName: "build",
+	}}
+	tr := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "pr-1-build", Namespace: "ns"},
+	}
+	notFound := func(resource, name string) error {
+		return apierrors.NewNotFound(schema.GroupResource{Group: "tekton.dev", Resource: resource}, name)
+	}
+
+	scenarios := []struct {
+		name    string
+		timeout time.Duration
+		// reads are the answers to the reads of the PipelineRun and of its
+		// TaskRun, in order
+		reads     []func() (runtime.Object, error)
+		wantReads int
+		wantErr   string
+	}{
+		{
+			name:    "not visible yet",
+			timeout: time.Minute,
+			reads: []func() (runtime.Object, error){
+				func() (runtime.Object, error) { return nil, notFound("pipelineruns", "pr-1") },
+				func() (runtime.Object, error) { return cb.UnstructuredPR(pr, "v1"), nil },
+				func() (runtime.Object, error) { return cb.UnstructuredPR(started, "v1"), nil },
+				func() (runtime.Object, error) { return nil, notFound("taskruns", "pr-1-build") },
+				func() (runtime.Object, error) { return cb.UnstructuredPR(started, "v1"), nil },
+				func() (runtime.Object, error) { return cb.UnstructuredTR(tr, "v1"), nil },
+			},
+			wantReads: 6,
+		},
+		{
+			name:    "no TaskRun before the timeout",
+			timeout: 20 * time.Millisecond,
+			reads: []func() (runtime.Object, error){
+				func() (runtime.Object, error) { return cb.UnstructuredPR(pr, "v1"), nil },
+			},
+		},
+		{
+			name:    "error",
+			timeout: time.Minute,
+			reads: []func() (runtime.Object, error){
+				func() (runtime.Object, error) { return nil, errors.New("connection refused") },
+			},
+			wantReads: 1,
+			wantErr:   "connection refused",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			reads := 0
+			answer := func(_ k8stest.Action) (bool, runtime.Object, error) {
+				// the last answer is repeated
+				read := s.reads[len(s.reads)-1]
+				if reads < len(s.reads) {
+					read = s.reads[reads]
+				}
+				reads++
+				obj, err := read()
+				return true, obj, err
+			}
+			tdc := testDynamic.Options{
+				PrependReactors: []testDynamic.PrependOpt{
+					{Verb: "get", Resource: "pipelineruns", Action: answer},
+					{Verb: "get", Resource: "taskruns", Action: answer},
+				},
+			}
+			dc, err := tdc.Client()
+			if err != nil {
+				t.Fatalf("unable to create dynamic client: %v", err)
+			}
+			cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+			cs.Pipeline.Resources = cb.APIResourceList("v1", []string{"pipelinerun", "taskrun"})
+			c := &cli.Clients{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+			if err := actions.InitializeAPIGroupRes(c.Tekton.Discovery()); err != nil {
+				t.Fatalf("failed to initialize APIGroup Resource: %v", err)
+			}
+
+			err = WaitForChildReferences(c, "pr-1", "ns", s.timeout)
+			if s.wantErr != "" {
+				if err == nil || err.Error() != s.wantErr {
+					t.Fatalf("expected error %q, got %v", s.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if s.wantReads != 0 && reads != s.wantReads {
+				t.Errorf("expected %d reads, got %d", s.wantReads, reads)
+			}
+		})
+	}
+}
@@ -37,6 +37,13 @@ type Tracker struct {
 	Ns           string
 	Client       *cli.Clients
 	ongoingTasks map[string]bool
+	deleted      bool
+	// OnRelist is called when the watch of Monitor fails and the informer
+	// is about to list the PipelineRun again, it may be nil
+	OnRelist func(err error)
+	// OnEvent is called by Monitor with the type of each event of the watch
+	// of the PipelineRun, ADDED, MODIFIED or DELETED, it may be nil
+	OnEvent func(event string, pr *v1.PipelineRun)
 }
 
 // NewTracker returns a new instance of Tracker
@@ -76,7 +83,7 @@ func (t *Tracker) Monitor(allowed []string) <-chan []taskrunpkg.Run {
 		close(trC)
 	}()
 
-	eventHandler := func(obj interface{}) {
+	eventHandler := func(event string, obj interface{}) {
 		var pipelinerunConverted v1.PipelineRun
 		pr, ok := obj.(*v1.PipelineRun)
 		if !ok || pr == nil {
@@ -92,6 +99,10 @@ func (t *Tracker) Monitor(allowed []string) <-chan []taskrunpkg.Run {
 			pr = &prv1
 		}
 
+		if t.OnEvent != nil {
+			t.OnEvent(event, pr)
+		}
+
 		trsMap, err := GetTaskRunsWithStatus(pr, t.Client, t.Ns)
 		if err != nil {
 			return
@@ -115,7 +126,7 @@ func (t *Tracker) Monitor(allowed []string) <-chan []taskrunpkg.Run {
 				case <-stopC:
 					return
 				default:
-					eventHandler(obj)
+					eventHandler("ADDED", obj)
 				}
 			},
 			UpdateFunc: func(_, newObj interface{}) {
@@ -125,17 +136,23 @@ func (t *Tracker) Monitor(allowed []string) <-chan []taskrunpkg.Run {
 				case <-stopC:
 					return
 				default:
-					eventHandler(newObj)
+					eventHandler("MODIFIED", newObj)
 				}
 			},
-			DeleteFunc: func(obj interface{}) {
+			DeleteFunc: func(_ interface{}) {
+				// PipelineRun has been deleted while being monitored, there
+				// will be no more TaskRuns to report so stop right away
 				mu.Lock()
 				defer mu.Unlock()
 				select {
 				case <-stopC:
 					return
 				default:
-					eventHandler(obj)
+					if t.OnEvent != nil {
+						t.OnEvent("DELETED", &v1.PipelineRun{ObjectMeta: metav1.ObjectMeta{Name: t.Name, Namespace: t.Ns}})
+					}
+					t.deleted = true
+					close(stopC) // should close trC
 				}
 			},
 		},
@@ -144,6 +161,22 @@ func (t *Tracker) Monitor(allowed []string) <-chan []taskrunpkg.Run {
 		return nil
 	}
 
+	err = informer.SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+		cache.DefaultWatchErrorHandler(r, err)
+		mu.Lock()
+		defer mu.Unlock()
+		select {
+		case <-stopC:
+		default:
+			if t.OnRelist != nil {
+				t.OnRelist(err)
+			}
+		}
+	})
+	if err != nil {
+		return nil
+	}
+
 	factory.Start(stopC)
 	factory.WaitForCacheSync(stopC)
 
@@ -162,16 +195,25 @@ func pipelinerunOpts(name string) func(opts *metav1.ListOptions) {
 // returns true if the pipelinerun has finished
 func (t *Tracker) findNewTaskruns(pr *v1.PipelineRun, allowed []string, trStatuses map[string]*v1.PipelineRunTaskRunStatus) []taskrunpkg.Run {
 	ret := []taskrunpkg.Run{}
+	finallyTasks := FinallyTaskNames(pr)
 	for tr, trs := range trStatuses {
 		retries := 0
+		var matrix []string
 		if pr.Status.PipelineSpec != nil {
 			for _, pipelineTask := range pr.Status.PipelineSpec.Tasks {
 				if trs.PipelineTaskName == pipelineTask.Name {
 					retries = pipelineTask.Retries
+					matrix = taskrunpkg.MatrixParamNames(pipelineTask)
+				}
+			}
+			for _, pipelineTask := range pr.Status.PipelineSpec.Finally {
+				if trs.PipelineTaskName == pipelineTask.Name {
+					retries = pipelineTask.Retries
+					matrix = taskrunpkg.MatrixParamNames(pipelineTask)
 				}
 			}
 		}
-		run := taskrunpkg.Run{Name: tr, Task: trs.PipelineTaskName, Retries: retries}
+		run := taskrunpkg.Run{Name: tr, Task: trs.PipelineTaskName, Retries: retries, Finally: finallyTasks[trs.PipelineTaskName], Matrix: matrix}
 
 		if t.loggingInProgress(tr) ||
 			!taskrunpkg.HasScheduled(trs) ||
@@ -193,6 +235,12 @@ func hasCompleted(pr *v1.PipelineRun) bool {
 	return pr.Status.Conditions[0].Status != corev1.ConditionUnknown
 }
 
+// Deleted returns true if the PipelineRun was deleted while being monitored.
+// It is only meaningful once the channel returned by Monitor has been closed.
+func (t *Tracker) Deleted() bool {
+	return t.deleted
+}
+
 func (t *Tracker) loggingInProgress(tr string) bool {
 	_, ok := t.ongoingTasks[tr]
 	return ok
@@ -199,6 +199,40 @@ func TestTracker_pipelinerun_complete(t *testing.T) {
 	}
 }
 
+func TestTracker_pipelinerun_deleted(t *testing.T) {
+	pr := &v1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "output-pipeline-1", Namespace: "namespace"},
+		Status: v1.PipelineRunStatus{
+			Status: duckv1.Status{
+				Conditions: duckv1.Conditions{{Status: corev1.ConditionUnknown, Reason: v1.PipelineRunReasonRunning.String()}},
+			},
+		},
+	}
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{PipelineRuns: []*v1.PipelineRun{pr}})
+	watcher := watch.NewFake()
+	cs.Pipeline.PrependWatchReactor("pipelineruns", k8stest.DefaultWatchReactor(watcher, nil))
+	cs.Pipeline.Resources = cb.APIResourceList("v1", []string{"pipelinerun"})
+	tc := &cli.Clients{Tekton: cs.Pipeline, Kube: cs.Kube}
+
+	go func() {
+		time.Sleep(time.Second)
+		watcher.Delete(pr)
+	}()
+
+	tracker := NewTracker(pr.Name, pr.Namespace, tc)
+	done := make(chan []trh.Run)
+	go func() { done <- taskRunsFor(nil, tracker) }()
+	select {
+	case runs := <-done:
+		test.AssertOutput(t, []trh.Run{}, runs)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the tracker kept monitoring the deleted PipelineRun")
+	}
+	if !tracker.Deleted() {
+		t.Error("expected the tracker to report the deletion of the PipelineRun")
+	}
+}
+
 func taskRunsFor(onlyTasks []string, tracker *Tracker) []trh.Run {
 	output := []trh.Run{}
 	for ts := range tracker.Monitor(onlyTasks) {
@@ -15,12 +15,13 @@
 package pods
 
 import (
-	"bufio"
+	"context"
 	"fmt"
 	"io"
 
 	"github.com/tektoncd/cli/pkg/pods/stream"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 )
 
 type Container struct {
@@ -29,40 +30,41 @@ type Container struct {
 	pod         *Pod
 }
 
+// Status fetches the pod and returns an error if the container has failed
 func (c *Container) Status() error {
 	pod, err := c.pod.Get()
 	if err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("pod %s has been deleted while streaming logs of container %s", c.pod.Name, c.name)
+		}
 		return err
 	}
 
-	container := c.name
-	for _, cs := range pod.Status.ContainerStatuses {
-		if cs.Name != container {
+	return c.StatusOf(pod)
+}
+
+// StatusOf returns an error if the container has failed according to a pod
+// which has already been retrieved, e.g. a completed pod which won't change
+func (c *Container) StatusOf(pod *corev1.Pod) error {
+	for _, cs := range ContainerStatuses(pod) {
+		if cs.Name != c.name || cs.State != ContainerTerminated {
 			continue
 		}
 
-		if cs.State.Terminated != nil && cs.State.Terminated.ExitCode == 1 {
+		switch {
+		case cs.Kind == KindEphemeral && cs.ExitCode != 0:
+			return fmt.Errorf("ephemeral container %s has failed: %s", c.name, cs.Reason)
+		case cs.Kind == KindInit && cs.ExitCode == 1:
+			return fmt.Errorf("container %s has failed: %s", c.name, cs.Reason)
+		case cs.Kind == KindContainer && cs.ExitCode == 1:
 			msg := ""
-
-			if cs.State.Terminated.Reason != "" && cs.State.Terminated.Reason != "Error" {
-				msg = msg + " : " + cs.State.Terminated.Reason
+			if cs.Reason != "" && cs.Reason != "Error" {
+				msg = msg + " : " + cs.Reason
 			}
-
-			if cs.State.Terminated.Message != "" && cs.State.Terminated.Message != "Error" {
-				msg = msg + " : " + cs.State.Terminated.Message
+			if cs.Message != "" && cs.Message != "Error" {
+				msg = msg + " : " + cs.Message
 			}
-
-			return fmt.Errorf("container %s has failed %s", container, msg)
-		}
-	}
-
-	for _, cs := range pod.Status.InitContainerStatuses {
-		if cs.Name != container {
-			continue
-		}
-
-		if cs.State.Terminated != nil && cs.State.Terminated.ExitCode == 1 {
-			return fmt.Errorf("container %s has failed: %s", container, cs.State.Terminated.Reason)
+			return fmt.Errorf("container %s has failed %s", c.name, msg)
 		}
 	}
 
@@ -74,6 +76,9 @@ type Log struct {
 	PodName       string
 	ContainerName string
 	Log           string
+	// Continued is set when the line was longer than the maximum length of
+	// a line and goes on in the next Log
+	Continued bool
 }
 type LogReader struct {
 	containerName string
@@ -87,6 +92,12 @@ func (c *Container) LogReader(follow, timestamps bool) *LogReader {
 }
 
 func (lr *LogReader) Read() (<-chan Log, <-chan error, error) {
+	return lr.ReadContext(context.Background())
+}
+
+// ReadContext reads the logs like Read until ctx is done, the stream is then
+// closed and the channels are closed without waiting for them to be drained
+func (lr *LogReader) ReadContext(ctx context.Context) (<-chan Log, <-chan error, error) {
 	pod := lr.pod
 	opts := &corev1.PodLogOptions{
 		Follow:     lr.follow,
@@ -105,23 +116,37 @@ func (lr *LogReader) Read() (<-chan Log, <-chan error, error) {
 	go func() {
 		defer close(logC)
 		defer close(errC)
-		defer stream.Close()
+		// closing the stream unblocks the line being read once ctx is done
+		stop := context.AfterFunc(ctx, func() { stream.Close() })
+		defer func() {
+			if stop() {
+				stream.Close()
+			}
+		}()
 
-		r := bufio.NewReader(stream)
+		r := newLineReader(stream, pod.MaxLineLength)
 		for {
-			line, _, err := r.ReadLine()
+			line, continued, err := r.ReadLine()
 
 			if err != nil {
-				if err != io.EOF {
-					errC <- err
+				if err != io.EOF && ctx.Err() == nil {
+					select {
+					case errC <- err:
+					case <-ctx.Done():
+					}
 				}
 				return
 			}
 
-			logC <- Log{
+			select {
+			case logC <- Log{
 				PodName:       pod.Name,
 				ContainerName: lr.containerName,
-				Log:           string(line),
+				Log:           line,
+				Continued:     continued,
+			}:
+			case <-ctx.Done():
+				return
 			}
 		}
 	}()
@@ -101,6 +101,52 @@ func TestContainer_fetch_logs(t *testing.T) {
 	}
 }
 
+func TestContainer_fetch_logs_max_line_length(t *testing.T) {
+	podName := "build-and-push-xyz"
+	ns := "test"
+	container := "step-build-app"
+
+	ps := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      podName,
+				Namespace: ns,
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  container,
+						Image: "step-build-app:latest",
+					},
+				},
+			},
+		},
+	}
+
+	logs := fake.Logs(
+		fake.PodLog(podName,
+			fake.NewContainer(container, `{"layers":["sha256:7be8c1df"]}`, "done"),
+		),
+	)
+
+	cs, _ := test.SeedV1beta1TestData(t, test.Data{Pods: ps})
+
+	pod := New(podName, ns, cs.Kube, fake.Streamer(logs))
+	pod.MaxLineLength = 16
+
+	output, err := containerLogs(pod.Container(container).LogReader(false, false))
+	if err != nil {
+		t.Errorf("error occurred %v", err)
+	}
+
+	expected := []Log{
+		{PodName: podName, ContainerName: container, Log: `{"layers":["sha2`, Continued: true},
+		{PodName: podName, ContainerName: container, Log: `56:7be8c1df"]}`},
+		{PodName: podName, ContainerName: container, Log: "done"},
+	}
+	test.AssertOutput(t, expected, output)
+}
+
 func containerLogs(lr *LogReader) ([]Log, error) {
 	logC, errC, err := lr.Read()
 
@@ -124,3 +170,53 @@ func containerLogs(lr *LogReader) ([]Log, error) {
 		}
 	}
 }
+
+func TestContainer_StatusOf(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "build-pod",
+			Namespace: "test",
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "step-build",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error", Message: "build failed"},
+					},
+				},
+				{
+					Name: "step-push",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: 0},
+					},
+				},
+			},
+			EphemeralContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "debugger",
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"},
+					},
+				},
+			},
+		},
+	}
+
+	cs, _ := test.SeedV1beta1TestData(t, test.Data{Pods: []*corev1.Pod{pod}})
+	cs.Kube.ClearActions()
+	p := New(pod.Name, pod.Namespace, cs.Kube, nil)
+
+	err := p.Container("step-build").StatusOf(pod)
+	test.AssertOutput(t, "container step-build has failed  : build failed", err.Error())
+	if err := p.Container("step-push").StatusOf(pod); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	err = p.Container("debugger").StatusOf(pod)
+	test.AssertOutput(t, "ephemeral container debugger has failed: OOMKilled", err.Error())
+
+	// the status is read from the pod which was passed, not fetched again
+	if actions := cs.Kube.Actions(); len(actions) != 0 {
+		t.Errorf("expected no request to the API server, got %d", len(actions))
+	}
+}
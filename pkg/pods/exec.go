@@ -0,0 +1,132 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// ExecTimeout bounds how long a command run in a container may take
+const ExecTimeout = 30 * time.Second
+
+// ExecFunc runs a shell command in a container of a pod and returns what it
+// printed
+type ExecFunc func(ns, pod, container, command string) ([]byte, error)
+
+// Executor runs commands in the containers of pods through the API server
+// the clients of tkn talk to, so with the kubeconfig, the context and the
+// impersonation tkn was given
+type Executor struct {
+	params cli.Params
+}
+
+// NewExecutor returns an Executor running the commands with the clients of p
+func NewExecutor(p cli.Params) *Executor {
+	return &Executor{params: p}
+}
+
+// Exec runs a shell command in a container of a pod, it is bounded by
+// ExecTimeout
+func (e *Executor) Exec(ns, pod, container, command string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ExecTimeout)
+	defer cancel()
+	return e.run(ctx, ns, pod, container, []string{"sh", "-c", command}, nil)
+}
+
+// ExecInput runs a shell command reading in in a container of a pod, it is
+// not bounded by ExecTimeout as in may be large
+func (e *Executor) ExecInput(ns, pod, container, command string, in io.Reader) ([]byte, error) {
+	return e.run(context.Background(), ns, pod, container, []string{"sh", "-c", command}, in)
+}
+
+func (e *Executor) run(ctx context.Context, ns, pod, container string, command []string, in io.Reader) ([]byte, error) {
+	// the configuration is known once the clients are created
+	if _, err := e.params.Clients(); err != nil {
+		return nil, err
+	}
+	cp, ok := e.params.(cli.ConfigParams)
+	if !ok || cp.RESTConfig() == nil {
+		return nil, fmt.Errorf("cannot exec into pod %s: the configuration of the cluster is unknown", pod)
+	}
+	config := cp.RESTConfig()
+	kube, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	req := kube.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     in != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  in,
+		Stdout: &out,
+		Stderr: &out,
+	})
+	if err != nil {
+		return out.Bytes(), fmt.Errorf("%s: %v", strings.TrimSpace(out.String()), err)
+	}
+	return out.Bytes(), nil
+}
+
+// CanExec tells whether the user is allowed to exec into the pods of the
+// namespace
+func CanExec(kube kubernetes.Interface, ns string) (bool, error) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   ns,
+				Verb:        "create",
+				Resource:    "pods",
+				Subresource: "exec",
+			},
+		},
+	}
+	review, err := kube.AuthorizationV1().SelfSubjectAccessReviews().Create(context.Background(), review, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
+
+// ExecInputFunc runs a shell command reading in in a container of a pod
+type ExecInputFunc func(ns, pod, container, command string, in io.Reader) ([]byte, error)
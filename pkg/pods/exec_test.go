@@ -0,0 +1,66 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	"gotest.tools/v3/assert"
+	"k8s.io/client-go/rest"
+)
+
+// configuredParams are test Params knowing the configuration of their
+// clients
+type configuredParams struct {
+	*test.Params
+	config *rest.Config
+}
+
+func (p *configuredParams) RESTConfig() *rest.Config {
+	return p.config
+}
+
+func TestExecutor_Exec(t *testing.T) {
+	var requested *url.URL
+	var impersonated string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL
+		impersonated = r.Header.Get("Impersonate-User")
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	p := &configuredParams{
+		Params: &test.Params{},
+		config: &rest.Config{Host: server.URL, Impersonate: rest.ImpersonationConfig{UserName: "ci-bot"}},
+	}
+	_, err := NewExecutor(p).Exec("ns", "build-pod", "step-compile", "jstack 1")
+	assert.ErrorContains(t, err, "")
+
+	// the command goes to the API server of the clients, as their user
+	assert.Equal(t, requested.Path, "/api/v1/namespaces/ns/pods/build-pod/exec")
+	assert.DeepEqual(t, requested.Query()["command"], []string{"sh", "-c", "jstack 1"})
+	assert.Equal(t, requested.Query().Get("container"), "step-compile")
+	assert.Equal(t, impersonated, "ci-bot")
+}
+
+func TestExecutor_unknownConfig(t *testing.T) {
+	_, err := NewExecutor(&test.Params{}).Exec("ns", "build-pod", "step-compile", "jstack 1")
+	assert.Error(t, err, "cannot exec into pod build-pod: the configuration of the cluster is unknown")
+}
@@ -17,6 +17,7 @@ package fake
 import (
 	"fmt"
 	"io"
+	"net/url"
 	"strings"
 
 	"github.com/tektoncd/cli/pkg/pods/stream"
@@ -58,3 +59,11 @@ func Streamer(l []Log) stream.NewStreamerFunc {
 		}
 	}
 }
+
+// Opener returns a stream.Opener serving l whatever the location, for tests
+// registering a scheme of fixtures with stream.Register
+func Opener(l []Log) stream.Opener {
+	return func(*url.URL) (stream.NewStreamerFunc, error) {
+		return Streamer(l), nil
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ImagePullError describes a container of a pod whose image cannot be
+// pulled, kubelet keeps retrying the pull with a backoff
+type ImagePullError struct {
+	Container string
+	Image     string
+	Registry  string
+	// Reason is ErrImagePull or ImagePullBackOff, which kubelet retries, or
+	// InvalidImageName or ErrImageNeverPull, which it does not
+	Reason         string
+	Message        string
+	ServiceAccount string
+	PullSecrets    []string
+}
+
+func (e *ImagePullError) Error() string {
+	msg := fmt.Sprintf("container %s cannot pull image %s from registry %s (%s)", e.Container, e.Image, e.Registry, e.Reason)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Retryable tells whether kubelet retries the pull of the image
+func (e *ImagePullError) Retryable() bool {
+	return e.Reason == "ErrImagePull" || e.Reason == "ImagePullBackOff"
+}
+
+// Hint returns what the user can check to get the image pulled
+func (e *ImagePullError) Hint() string {
+	switch e.Reason {
+	case "InvalidImageName":
+		return fmt.Sprintf("fix the reference of the image %s in the step of the Task", e.Image)
+	case "ErrImageNeverPull":
+		return fmt.Sprintf("the image pull policy of the step is Never, load the image %s on the node or change the policy", e.Image)
+	}
+	sa := e.ServiceAccount
+	if sa == "" {
+		sa = "default"
+	}
+	if len(e.PullSecrets) == 0 {
+		return fmt.Sprintf("check that the image exists, if %s is a private registry add an image pull secret for it to the service account %s", e.Registry, sa)
+	}
+	return fmt.Sprintf("check that the image exists and that the image pull secrets %s of the pod, taken from the service account %s, hold credentials for %s", strings.Join(e.PullSecrets, ", "), sa, e.Registry)
+}
+
+var imagePullReasons = map[string]bool{
+	"ErrImagePull":      true,
+	"ImagePullBackOff":  true,
+	"InvalidImageName":  true,
+	"ErrImageNeverPull": true,
+}
+
+// ImagePullErrors returns the containers of pod waiting on an image which
+// cannot be pulled
+func ImagePullErrors(pod *corev1.Pod) []*ImagePullError {
+	var secrets []string
+	for _, s := range pod.Spec.ImagePullSecrets {
+		secrets = append(secrets, s.Name)
+	}
+
+	var errs []*ImagePullError
+	for _, s := range ContainerStatuses(pod) {
+		if s.Kind == KindEphemeral || s.State != ContainerWaiting || !imagePullReasons[s.Reason] {
+			continue
+		}
+		errs = append(errs, &ImagePullError{
+			Container:      s.Name,
+			Image:          s.Image,
+			Registry:       imageRegistry(s.Image),
+			Reason:         s.Reason,
+			Message:        s.Message,
+			ServiceAccount: pod.Spec.ServiceAccountName,
+			PullSecrets:    secrets,
+		})
+	}
+	return errs
+}
+
+// imageRegistry returns the registry of an image reference, as resolved by
+// the container runtimes
+func imageRegistry(image string) string {
+	i := strings.IndexRune(image, '/')
+	if i == -1 {
+		return "docker.io"
+	}
+	host := image[:i]
+	if !strings.ContainsAny(host, ".:") && host != "localhost" {
+		return "docker.io"
+	}
+	return host
+}
@@ -0,0 +1,75 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestImagePullErrors(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			ServiceAccountName: "builder",
+			ImagePullSecrets:   []corev1.LocalObjectReference{{Name: "regcred"}},
+			InitContainers:     []corev1.Container{{Name: "prepare", Image: "busybox"}},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  "prepare",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+				},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  "step-build",
+					Image: "golang",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"}},
+				},
+			},
+		},
+	}
+
+	errs := ImagePullErrors(pod)
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 image pull error, got %d", len(errs))
+	}
+	test.AssertOutput(t, "container prepare cannot pull image busybox from registry docker.io (ErrImagePull)", errs[0].Error())
+	test.AssertOutput(t, "check that the image exists and that the image pull secrets regcred of the pod, taken from the service account builder, hold credentials for docker.io", errs[0].Hint())
+
+	errs[0].PullSecrets = nil
+	errs[0].ServiceAccount = ""
+	test.AssertOutput(t, "check that the image exists, if docker.io is a private registry add an image pull secret for it to the service account default", errs[0].Hint())
+	test.AssertOutput(t, true, errs[0].Retryable())
+
+	errs[0].Reason = "InvalidImageName"
+	test.AssertOutput(t, false, errs[0].Retryable())
+	test.AssertOutput(t, "fix the reference of the image busybox in the step of the Task", errs[0].Hint())
+}
+
+func Test_imageRegistry(t *testing.T) {
+	for image, registry := range map[string]string{
+		"busybox":                          "docker.io",
+		"library/busybox:1.36":             "docker.io",
+		"gcr.io/tekton-releases/git-init":  "gcr.io",
+		"localhost/builder":                "localhost",
+		"registry.local:5000/team/builder": "registry.local:5000",
+	} {
+		test.AssertOutput(t, registry, imageRegistry(image))
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode/utf8"
+)
+
+// DefaultMaxLineLength is the length in bytes after which a line of logs is
+// split when no other length is configured
+const DefaultMaxLineLength = 64 * 1024
+
+// lineReader reads lines of logs without ever holding more than max bytes of
+// a line in memory, longer lines are returned in chunks
+type lineReader struct {
+	r *bufio.Reader
+	// carry is the start of a rune cut at the end of the previous chunk
+	carry []byte
+}
+
+func newLineReader(r io.Reader, max int) *lineReader {
+	if max <= 0 {
+		max = DefaultMaxLineLength
+	}
+	// bufio never uses a buffer smaller than 16 bytes
+	return &lineReader{r: bufio.NewReaderSize(r, max)}
+}
+
+// ReadLine returns the next line, or chunk of a line, without its end of
+// line. continued is set when the line goes on in the next chunk.
+func (lr *lineReader) ReadLine() (line string, continued bool, err error) {
+	b, err := lr.r.ReadSlice('\n')
+	switch err {
+	case nil:
+		b = bytes.TrimSuffix(b[:len(b)-1], []byte{'\r'})
+	case bufio.ErrBufferFull:
+		continued, err = true, nil
+	case io.EOF:
+		if len(b) == 0 && len(lr.carry) == 0 {
+			return "", false, io.EOF
+		}
+		// the last line is returned, EOF is returned by the next call
+		err = nil
+	default:
+		return "", false, err
+	}
+
+	var cut []byte
+	if continued {
+		b, cut = splitIncompleteRune(b)
+	}
+	line = string(lr.carry) + string(b)
+	lr.carry = append(lr.carry[:0], cut...)
+	return line, continued, nil
+}
+
+// splitIncompleteRune splits b before a rune which is cut at its end, so that
+// chunks of a line remain valid UTF-8
+func splitIncompleteRune(b []byte) ([]byte, []byte) {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			if !utf8.FullRune(b[i:]) {
+				return b[:i], b[i:]
+			}
+			break
+		}
+	}
+	return b, nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+type chunk struct {
+	Line      string
+	Continued bool
+}
+
+func TestLineReader(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		max      int
+		expected []chunk
+	}{
+		{
+			name:     "short lines",
+			input:    "first\r\nsecond\nlast",
+			max:      16,
+			expected: []chunk{{"first", false}, {"second", false}, {"last", false}},
+		},
+		{
+			name:  "long line",
+			input: strings.Repeat("a", 40) + "\nnext\n",
+			max:   16,
+			expected: []chunk{
+				{strings.Repeat("a", 16), true},
+				{strings.Repeat("a", 16), true},
+				{strings.Repeat("a", 8), false},
+				{"next", false},
+			},
+		},
+		{
+			name:  "rune cut at the end of a chunk",
+			input: strings.Repeat("a", 15) + "é" + "\n",
+			max:   16,
+			expected: []chunk{
+				{strings.Repeat("a", 15), true},
+				{"é", false},
+			},
+		},
+		{
+			name:  "long last line",
+			input: strings.Repeat("b", 20),
+			max:   16,
+			expected: []chunk{
+				{strings.Repeat("b", 16), true},
+				{strings.Repeat("b", 4), false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newLineReader(strings.NewReader(tt.input), tt.max)
+			got := []chunk{}
+			for {
+				line, continued, err := r.ReadLine()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got = append(got, chunk{line, continued})
+			}
+			test.AssertOutput(t, tt.expected, got)
+		})
+	}
+}
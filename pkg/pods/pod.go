@@ -15,6 +15,7 @@
 package pods
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
@@ -25,6 +26,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	k8s "k8s.io/client-go/kubernetes"
 	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
@@ -35,22 +37,141 @@ type Stream struct {
 	name string
 	pods typedv1.PodInterface
 	opts *corev1.PodLogOptions
+	// logStream is the stream of the container the logs are limited to,
+	// both streams are read when empty
+	logStream string
 }
 
 func NewStream(pods typedv1.PodInterface, name string, opts *corev1.PodLogOptions) stream.Streamer {
-	return &Stream{name, pods, opts}
+	return &Stream{name: name, pods: pods, opts: opts}
 }
 
 // Stream Creates a stream object which allows reading the logs
 func (s *Stream) Stream() (io.ReadCloser, error) {
-	return s.pods.GetLogs(s.name, s.opts).Stream(context.Background())
+	req := s.pods.GetLogs(s.name, s.opts)
+	// the stream field of PodLogOptions is not known to the vendored API,
+	// it is passed as the query parameter it is decoded from
+	if s.logStream != "" {
+		req = req.Param("stream", s.logStream)
+	}
+	return req.Stream(context.Background())
+}
+
+// NewStreamWithOptions returns a NewStreamerFunc creating streams tuned
+// with the read buffer size and the idle timeout of opts
+func NewStreamWithOptions(opts stream.Options) stream.NewStreamerFunc {
+	return func(pods typedv1.PodInterface, name string, o *corev1.PodLogOptions) stream.Streamer {
+		return &tunedStream{Streamer: &Stream{name: name, pods: pods, opts: o, logStream: opts.LogStream}, opts: opts}
+	}
+}
+
+type tunedStream struct {
+	stream.Streamer
+	opts stream.Options
+}
+
+func (s *tunedStream) Stream() (io.ReadCloser, error) {
+	rc, err := s.Streamer.Stream()
+	if err != nil {
+		return nil, err
+	}
+	if s.opts.IdleTimeout != 0 {
+		rc = newIdleReader(rc, s.opts.IdleTimeout)
+	}
+	if s.opts.ReadBufferSize != 0 {
+		rc = &bufferedReadCloser{Reader: bufio.NewReaderSize(rc, s.opts.ReadBufferSize), Closer: rc}
+	}
+	return rc, nil
 }
 
+type bufferedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// idleReader closes the underlying stream when no data has been read from
+// it for the idle timeout, which unblocks a read on a dead connection
+type idleReader struct {
+	rc      io.ReadCloser
+	timeout time.Duration
+	timer   *time.Timer
+
+	mu       sync.Mutex
+	timedOut bool
+}
+
+func newIdleReader(rc io.ReadCloser, timeout time.Duration) *idleReader {
+	r := &idleReader{rc: rc, timeout: timeout}
+	r.timer = time.AfterFunc(timeout, func() {
+		r.mu.Lock()
+		r.timedOut = true
+		r.mu.Unlock()
+		r.rc.Close()
+	})
+	return r
+}
+
+func (r *idleReader) Read(p []byte) (int, error) {
+	n, err := r.rc.Read(p)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.timedOut {
+		return n, fmt.Errorf("no logs received for %s, the connection to the cluster may have been lost", r.timeout)
+	}
+	if n > 0 {
+		r.timer.Reset(r.timeout)
+	}
+	return n, err
+}
+
+func (r *idleReader) Close() error {
+	r.timer.Stop()
+	return r.rc.Close()
+}
+
+// DefaultImagePullTimeout is how long Wait keeps waiting while kubelet
+// retries the pulls of images when ImagePullTimeout is not set
+const DefaultImagePullTimeout = 5 * time.Minute
+
+// DefaultResync is the resync period of the informer Wait watches the pod
+// through when Resync is not set
+const DefaultResync = 10 * time.Second
+
+const maxWatchBackoff = 30 * time.Second
+
+var (
+	// maxWatchFailures is the number of consecutive failures of the
+	// informer after which Wait gives up
+	maxWatchFailures = 5
+	// watchBackoff is the delay before the first re-list after a failure
+	// of the informer, it doubles with each consecutive failure
+	watchBackoff = time.Second
+)
+
 type Pod struct {
 	Name     string
 	Ns       string
 	Kc       k8s.Interface
 	Streamer stream.NewStreamerFunc
+	// Resync is the resync period of the informer used by Wait, 0 uses
+	// DefaultResync
+	Resync time.Duration
+	// MaxLineLength is the length in bytes after which the lines of logs
+	// are split, 0 uses DefaultMaxLineLength
+	MaxLineLength int
+	// OnRelist is called when the watch of Wait fails and the informer is
+	// about to list the pod again, it may be nil
+	OnRelist func(err error)
+	// OnImagePull is called by Wait the first time a container of the pod
+	// cannot pull its image, Wait keeps waiting while kubelet retries the
+	// pull, it may be nil
+	OnImagePull func(err *ImagePullError)
+	// ImagePullTimeout is how long Wait keeps waiting while kubelet retries
+	// the pulls of images before failing, 0 uses DefaultImagePullTimeout
+	ImagePullTimeout time.Duration
+	// OnEvent is called by Wait with the type of each event of the watch of
+	// the pod, ADDED, MODIFIED or DELETED, it may be nil
+	OnEvent func(event string, pod *corev1.Pod)
 }
 
 func New(name, ns string, client k8s.Interface, streamer stream.NewStreamerFunc) *Pod {
@@ -78,74 +199,191 @@ func (p *Pod) Wait() (*corev1.Pod, error) {
 
 	stopC := make(chan struct{})
 	eventC := make(chan interface{}, 10)
+	errC := make(chan error, 1)
 	mu := sync.Mutex{}
 	defer func() {
 		mu.Lock()
 		close(stopC)
 		close(eventC)
+		close(errC)
 		mu.Unlock()
 	}()
 
-	p.watcher(stopC, eventC, &mu)
+	if err := p.watcher(stopC, eventC, errC, &mu); err != nil {
+		return nil, err
+	}
+
+	pullTimeout := p.ImagePullTimeout
+	if pullTimeout == 0 {
+		pullTimeout = DefaultImagePullTimeout
+	}
+	// pullC fires once the images of the pod could not be pulled for the
+	// image pull timeout, it is reset when the pulls succeed
+	var pullC <-chan time.Time
+	var pullErr *ImagePullError
 
-	var pod *corev1.Pod
-	var err error
-	for e := range eventC {
-		pod, err = checkPodStatus(e)
-		if pod != nil || err != nil {
-			break
+	reported := map[string]bool{}
+	for {
+		select {
+		case e := <-eventC:
+			p.reportImagePulls(e, reported)
+			pod, err := checkPodStatus(e)
+			if pod != nil || err != nil {
+				return pod, err
+			}
+			if pulls := imagePullErrors(e); len(pulls) != 0 {
+				pullErr = pulls[0]
+				if pullC == nil {
+					pullC = time.After(pullTimeout)
+				}
+			} else {
+				pullC, pullErr = nil, nil
+			}
+		case <-pullC:
+			return nil, fmt.Errorf("gave up waiting for pod %s after %s: %w", p.Name, pullTimeout, pullErr)
+		case err := <-errC:
+			return nil, err
 		}
 	}
+}
 
-	return pod, err
+// reportImagePulls calls OnImagePull once per container and image which
+// cannot be pulled while kubelet retries the pull
+func (p *Pod) reportImagePulls(obj interface{}, reported map[string]bool) {
+	if p.OnImagePull == nil {
+		return
+	}
+	for _, e := range imagePullErrors(obj) {
+		key := e.Container + "/" + e.Image
+		if !reported[key] {
+			reported[key] = true
+			p.OnImagePull(e)
+		}
+	}
 }
 
-func (p *Pod) watcher(stopC <-chan struct{}, eventC chan<- interface{}, mu *sync.Mutex) {
+// imagePullErrors returns the image pull errors of the pod of an event
+// which kubelet retries
+func imagePullErrors(obj interface{}) []*ImagePullError {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil
+	}
+	var errs []*ImagePullError
+	for _, e := range ImagePullErrors(pod) {
+		if e.Retryable() {
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
+
+func (p *Pod) notifyEvent(event string, obj interface{}) {
+	if p.OnEvent == nil {
+		return
+	}
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	if pod, ok := obj.(*corev1.Pod); ok {
+		p.OnEvent(event, pod)
+	}
+}
+
+func (p *Pod) watcher(stopC <-chan struct{}, eventC chan<- interface{}, errC chan<- error, mu *sync.Mutex) error {
+	resync := p.Resync
+	if resync == 0 {
+		resync = DefaultResync
+	}
 	factory := informers.NewSharedInformerFactoryWithOptions(
-		p.Kc, time.Second*10,
+		p.Kc, resync,
 		informers.WithNamespace(p.Ns),
 		informers.WithTweakListOptions(podOpts(p.Name)))
+	informer := factory.Core().V1().Pods().Informer()
+
+	// failures counts the consecutive failures of the informer, it is reset
+	// whenever an event is received
+	failures := 0
+	send := func(event string, obj interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+		select {
+		case <-stopC:
+			return
+		default:
+			// default is used to avoid pseudo-random selection of multiple matching cases
+			failures = 0
+			p.notifyEvent(event, obj)
+			eventC <- obj
+		}
+	}
 
-	_, err := factory.Core().V1().Pods().Informer().AddEventHandler(
+	_, err := informer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
-				mu.Lock()
-				defer mu.Unlock()
-				select {
-				case <-stopC:
-					return
-				default:
-					// default is used to avoid pseudo-random selection of multiple matching cases
-					eventC <- obj
-				}
+				send("ADDED", obj)
 			},
 			UpdateFunc: func(_, newObj interface{}) {
-				mu.Lock()
-				defer mu.Unlock()
-				select {
-				case <-stopC:
-					return
-				default:
-					eventC <- newObj
-				}
+				send("MODIFIED", newObj)
 			},
 			DeleteFunc: func(obj interface{}) {
-				mu.Lock()
-				defer mu.Unlock()
+				send("DELETED", deletedPod(obj))
+			},
+		})
+	if err != nil {
+		return fmt.Errorf("failed to watch pod %s: %w", p.Name, err)
+	}
+
+	// the error handler is called before the informer lists the pod again,
+	// waiting there spreads the re-lists of concurrent watchers out so that
+	// they do not all hit a loaded API server at once
+	err = informer.SetWatchErrorHandler(func(_ *cache.Reflector, err error) {
+		mu.Lock()
+		failures++
+		n := failures
+		if n >= maxWatchFailures {
+			select {
+			case <-stopC:
+			default:
+				// errC is buffered, a failure already reported is enough
 				select {
-				case <-stopC:
-					return
+				case errC <- fmt.Errorf("failed to watch pod %s after %d attempts: %w", p.Name, n, err):
 				default:
-					eventC <- obj
 				}
-			},
-		})
+			}
+			mu.Unlock()
+			return
+		}
+		if p.OnRelist != nil {
+			select {
+			case <-stopC:
+			default:
+				p.OnRelist(err)
+			}
+		}
+		mu.Unlock()
+
+		select {
+		case <-stopC:
+		case <-time.After(backoff(n)):
+		}
+	})
 	if err != nil {
-		return
+		return fmt.Errorf("failed to watch pod %s: %w", p.Name, err)
 	}
 
 	factory.Start(stopC)
-	factory.WaitForCacheSync(stopC)
+	return nil
+}
+
+// backoff returns the jittered delay before the re-list following the nth
+// consecutive failure of the informer
+func backoff(n int) time.Duration {
+	d := watchBackoff << (n - 1)
+	if d <= 0 || d > maxWatchBackoff {
+		d = maxWatchBackoff
+	}
+	return wait.Jitter(d, 0.5)
 }
 
 func podOpts(name string) func(opts *metav1.ListOptions) {
@@ -154,14 +392,35 @@ func podOpts(name string) func(opts *metav1.ListOptions) {
 	}
 }
 
+// deletedPod returns the pod of a delete event with a deletion timestamp, the
+// pods removed at once, e.g. force deleted, do not have one and would
+// otherwise be waited for forever
+func deletedPod(obj interface{}) interface{} {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.DeletionTimestamp != nil {
+		return obj
+	}
+	pod = pod.DeepCopy()
+	now := metav1.Now()
+	pod.DeletionTimestamp = &now
+	return pod
+}
+
 func checkPodStatus(obj interface{}) (*corev1.Pod, error) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
 		return nil, fmt.Errorf("failed to cast to pod object")
 	}
 
 	if pod.DeletionTimestamp != nil {
-		return pod, fmt.Errorf("failed to run the pod %s ", pod.Name)
+		return pod, fmt.Errorf("pod %s has been deleted", pod.Name)
 	}
 
 	if pod.Status.Phase == corev1.PodSucceeded ||
@@ -170,6 +429,18 @@ func checkPodStatus(obj interface{}) (*corev1.Pod, error) {
 		return pod, nil
 	}
 
+	// kubelet retries the pulls of images with a backoff, keep waiting
+	// for them, Wait reports them through OnImagePull and bounds the wait,
+	// the images which cannot ever be pulled fail at once
+	if errs := ImagePullErrors(pod); len(errs) != 0 {
+		for _, e := range errs {
+			if !e.Retryable() {
+				return pod, e
+			}
+		}
+		return nil, nil
+	}
+
 	// Handle any issues with pulling images that may fail
 	for _, c := range pod.Status.Conditions {
 		if c.Type == corev1.PodInitialized || c.Type == corev1.ContainersReady {
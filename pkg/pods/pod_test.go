@@ -15,15 +15,26 @@
 package pods
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/tektoncd/cli/pkg/pods/stream"
 	"github.com/tektoncd/cli/pkg/test"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	k8stest "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 )
 
 func Test_wait_pod_initialized(t *testing.T) {
@@ -179,6 +190,212 @@ func Test_wait_pod_imagepull_error(t *testing.T) {
 	}
 }
 
+func Test_wait_pod_deleted(t *testing.T) {
+	initial := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+		Status:     corev1.PodStatus{Phase: corev1.PodPending},
+	}
+
+	kc := simulateDeleteWatch(t, &initial, initial.DeepCopy())
+	_, err := NewWithDefaults("test", "ns", kc).Wait()
+	if err == nil {
+		t.Fatal("expected the deletion of the pod to be reported")
+	}
+	test.AssertOutput(t, "pod test has been deleted", err.Error())
+}
+
+func Test_checkPodStatus_deleted(t *testing.T) {
+	deletionTime := metav1.Now()
+	deleting := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns", DeletionTimestamp: &deletionTime},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	tests := []struct {
+		name string
+		obj  interface{}
+	}{
+		{name: "pod being deleted", obj: deleting},
+		{name: "final state unknown", obj: cache.DeletedFinalStateUnknown{Key: "ns/test", Obj: deleting}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod, err := checkPodStatus(tt.obj)
+			if err == nil {
+				t.Fatal("expected the deletion of the pod to be reported")
+			}
+			test.AssertOutput(t, "pod test has been deleted", err.Error())
+			test.AssertOutput(t, "test", pod.Name)
+		})
+	}
+
+	if _, err := checkPodStatus(cache.DeletedFinalStateUnknown{Key: "ns/test"}); err == nil {
+		t.Error("expected a tombstone without pod to be an error")
+	}
+}
+
+func Test_wait_pod_watch_failure(t *testing.T) {
+	defer func(n int, d time.Duration) { maxWatchFailures, watchBackoff = n, d }(maxWatchFailures, watchBackoff)
+	maxWatchFailures, watchBackoff = 2, time.Millisecond
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+		Status:     corev1.PodStatus{Phase: corev1.PodPending},
+	}
+	clients, _ := test.SeedV1beta1TestData(t, test.Data{Pods: []*corev1.Pod{pod}})
+	clients.Kube.PrependReactor("list", "pods", func(k8stest.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("the server is currently unable to handle the request")
+	})
+
+	p := New("test", "ns", clients.Kube, NewStream)
+	p.Resync = time.Minute
+	_, err := p.Wait()
+	if err == nil {
+		t.Fatal("expected the failures of the informer to be reported")
+	}
+	test.AssertOutput(t, "failed to watch pod test after 2 attempts: failed to list *v1.Pod: the server is currently unable to handle the request", err.Error())
+}
+
+func Test_wait_pod_relist(t *testing.T) {
+	defer func(d time.Duration) { watchBackoff = d }(watchBackoff)
+	watchBackoff = time.Millisecond
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	clients, _ := test.SeedV1beta1TestData(t, test.Data{Pods: []*corev1.Pod{pod}})
+	failed := false
+	clients.Kube.PrependReactor("list", "pods", func(k8stest.Action) (bool, runtime.Object, error) {
+		if failed {
+			return false, nil, nil
+		}
+		failed = true
+		return true, nil, errors.New("connection reset by peer")
+	})
+
+	var relists []string
+	p := New("test", "ns", clients.Kube, NewStream)
+	p.Resync = time.Minute
+	p.OnRelist = func(err error) {
+		relists = append(relists, err.Error())
+	}
+	if _, err := p.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, []string{"failed to list *v1.Pod: connection reset by peer"}, relists)
+}
+
+func Test_wait_pod_image_pull_backoff(t *testing.T) {
+	podname := "test"
+	ns := "ns"
+
+	initial := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podname,
+			Namespace: ns,
+		},
+		Spec: corev1.PodSpec{
+			ServiceAccountName: "builder",
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:    corev1.ContainersReady,
+					Status:  corev1.ConditionUnknown,
+					Message: "containers with unready status: [step-build]",
+				},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:  "step-build",
+					Image: "registry.example.com/team/builder:1.0",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{
+							Reason:  "ImagePullBackOff",
+							Message: "Back-off pulling image",
+						},
+					},
+				},
+			},
+		},
+	}
+	later := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podname,
+			Namespace: ns,
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+		},
+	}
+	kc := simulateAddWatch(t, &initial, &later)
+
+	var pulls []string
+	pod := NewWithDefaults(podname, ns, kc)
+	pod.OnImagePull = func(err *ImagePullError) {
+		pulls = append(pulls, err.Error())
+	}
+	p, err := pod.Wait()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.Status.Phase != corev1.PodRunning {
+		t.Errorf("expected to wait for the pod to run, got %s", p.Status.Phase)
+	}
+	test.AssertOutput(t, []string{"container step-build cannot pull image registry.example.com/team/builder:1.0 from registry registry.example.com (ImagePullBackOff): Back-off pulling image"}, pulls)
+}
+
+func Test_wait_pod_image_pull_timeout(t *testing.T) {
+	podname := "test"
+	ns := "ns"
+
+	pulling := func(reason string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      podname,
+				Namespace: ns,
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:  "step-build",
+						Image: "registry.example.com/team/builder:1.0",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{Reason: reason},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	pod := NewWithDefaults(podname, ns, simulateNoWatch(t, pulling("ErrImagePull")))
+	pod.ImagePullTimeout = time.Second
+	_, err := pod.Wait()
+	test.AssertOutput(t, "gave up waiting for pod test after 1s: container step-build cannot pull image registry.example.com/team/builder:1.0 from registry registry.example.com (ErrImagePull)", fmt.Sprint(err))
+
+	pod = NewWithDefaults(podname, ns, simulateNoWatch(t, pulling("InvalidImageName")))
+	_, err = pod.Wait()
+	test.AssertOutput(t, "container step-build cannot pull image registry.example.com/team/builder:1.0 from registry registry.example.com (InvalidImageName)", fmt.Sprint(err))
+}
+
+func Test_backoff(t *testing.T) {
+	for n, base := range map[int]time.Duration{
+		1:  time.Second,
+		3:  4 * time.Second,
+		10: maxWatchBackoff,
+		70: maxWatchBackoff,
+	} {
+		d := backoff(n)
+		if d < base || d > base+base/2 {
+			t.Errorf("backoff(%d) = %s, expected between %s and %s", n, d, base, base+base/2)
+		}
+	}
+}
+
 func simulateAddWatch(t *testing.T, initial *corev1.Pod, later *corev1.Pod) k8s.Interface {
 	ps := []*corev1.Pod{
 		initial,
@@ -196,6 +413,15 @@ func simulateAddWatch(t *testing.T, initial *corev1.Pod, later *corev1.Pod) k8s.
 	return clients.Kube
 }
 
+// simulateNoWatch serves the initial pod with a watch never sending events,
+// for the tests where Wait returns before any event and stops the watch
+func simulateNoWatch(t *testing.T, initial *corev1.Pod) k8s.Interface {
+	clients, _ := test.SeedV1beta1TestData(t, test.Data{Pods: []*corev1.Pod{initial}})
+	clients.Kube.PrependWatchReactor("pods", k8stest.DefaultWatchReactor(watch.NewFake(), nil))
+
+	return clients.Kube
+}
+
 func simulateDeleteWatch(t *testing.T, initial *corev1.Pod, later *corev1.Pod) k8s.Interface {
 	ps := []*corev1.Pod{
 		initial,
@@ -212,3 +438,74 @@ func simulateDeleteWatch(t *testing.T, initial *corev1.Pod, later *corev1.Pod) k
 
 	return clients.Kube
 }
+
+type blockingStream struct {
+	closed chan struct{}
+}
+
+func (s *blockingStream) Read(_ []byte) (int, error) {
+	<-s.closed
+	return 0, io.ErrClosedPipe
+}
+
+func (s *blockingStream) Close() error {
+	select {
+	case <-s.closed:
+	default:
+		close(s.closed)
+	}
+	return nil
+}
+
+func Test_idle_reader_timeout(t *testing.T) {
+	r := newIdleReader(&blockingStream{closed: make(chan struct{})}, 50*time.Millisecond)
+	defer r.Close()
+
+	_, err := r.Read(make([]byte, 10))
+	if err == nil {
+		t.Fatal("expected the read on an idle stream to fail")
+	}
+	test.AssertOutput(t, "no logs received for 50ms, the connection to the cluster may have been lost", err.Error())
+}
+
+func Test_idle_reader_active(t *testing.T) {
+	r := newIdleReader(io.NopCloser(strings.NewReader("step output\n")), time.Minute)
+	defer r.Close()
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, "step output\n", string(b))
+}
+
+func Test_stream_log_stream(t *testing.T) {
+	for _, logStream := range []string{"", stream.LogStreamStdout, stream.LogStreamStderr} {
+		t.Run(logStream, func(t *testing.T) {
+			var query url.Values
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				_, _ = w.Write([]byte("step output\n"))
+			}))
+			defer srv.Close()
+
+			kube, err := k8s.NewForConfig(&rest.Config{Host: srv.URL})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			streamer := NewStreamWithOptions(stream.Options{LogStream: logStream})
+			rc, err := streamer(kube.CoreV1().Pods("ns"), "pod", &corev1.PodLogOptions{Container: "step-build"}).Stream()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer rc.Close()
+			b, err := io.ReadAll(rc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			test.AssertOutput(t, "step output\n", string(b))
+			test.AssertOutput(t, "step-build", query.Get("container"))
+			test.AssertOutput(t, logStream, query.Get("stream"))
+		})
+	}
+}
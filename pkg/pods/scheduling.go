@@ -0,0 +1,126 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+)
+
+// Scheduling describes how a pod was placed on a node
+type Scheduling struct {
+	Pod  string
+	Node string
+	// Scheduled is set once the pod has been bound to Node
+	Scheduled bool
+	// Latency is the time between the creation of the pod and its binding
+	// to a node
+	Latency      time.Duration
+	NodeSelector map[string]string
+	Tolerations  []corev1.Toleration
+	// Delays are the causes of the failed attempts to schedule the pod:
+	// taint, affinity or resources
+	Delays []string
+	// Message is the message of the last failed attempt
+	Message string
+}
+
+// GetScheduling gets the pod and the events of its failed attempts to be
+// scheduled to describe its scheduling
+func GetScheduling(kc k8s.Interface, ns, name string) (*Scheduling, error) {
+	pod, err := kc.CoreV1().Pods(ns).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	events, err := kc.CoreV1().Events(ns).List(context.Background(), metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=Pod,involvedObject.name=%s", name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return SchedulingOf(pod, events.Items), nil
+}
+
+// SchedulingOf describes the scheduling of pod from its status and from
+// its events
+func SchedulingOf(pod *corev1.Pod, events []corev1.Event) *Scheduling {
+	s := &Scheduling{
+		Pod:          pod.Name,
+		Node:         pod.Spec.NodeName,
+		NodeSelector: pod.Spec.NodeSelector,
+		Tolerations:  pod.Spec.Tolerations,
+	}
+
+	var messages []string
+	for _, c := range pod.Status.Conditions {
+		if c.Type != corev1.PodScheduled {
+			continue
+		}
+		if c.Status == corev1.ConditionTrue {
+			s.Scheduled = true
+			s.Latency = c.LastTransitionTime.Sub(pod.CreationTimestamp.Time)
+		} else if c.Message != "" {
+			messages = append(messages, c.Message)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(&events[j].LastTimestamp)
+	})
+	for _, e := range events {
+		if e.InvolvedObject.Kind != "Pod" || e.InvolvedObject.Name != pod.Name || e.Reason != "FailedScheduling" {
+			continue
+		}
+		messages = append(messages, e.Message)
+	}
+
+	delays := map[string]bool{}
+	for _, m := range messages {
+		for _, d := range schedulingDelays(m) {
+			if !delays[d] {
+				delays[d] = true
+				s.Delays = append(s.Delays, d)
+			}
+		}
+		s.Message = m
+	}
+	sort.Strings(s.Delays)
+	return s
+}
+
+// schedulingDelays returns the causes of a failed attempt to schedule a pod
+// from the message of the scheduler, like "0/3 nodes are available: 1 node(s)
+// had untolerated taint {dedicated: gpu}, 2 node(s) didn't match Pod's node
+// affinity/selector."
+func schedulingDelays(message string) []string {
+	var delays []string
+	if strings.Contains(message, "taint") {
+		delays = append(delays, "taint")
+	}
+	if strings.Contains(message, "affinity") || strings.Contains(message, "selector") {
+		delays = append(delays, "affinity")
+	}
+	if strings.Contains(message, "Insufficient") {
+		delays = append(delays, "resources")
+	}
+	return delays
+}
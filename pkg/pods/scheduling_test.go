@@ -0,0 +1,54 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSchedulingOf_pending(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "build-pod"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Reason:  "Unschedulable",
+					Message: "0/2 nodes are available: 2 Insufficient cpu.",
+				},
+			},
+		},
+	}
+	events := []corev1.Event{
+		{
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "other-pod"},
+			Reason:         "FailedScheduling",
+			Message:        "0/2 nodes are available: 2 node(s) had untolerated taint {gpu: true}.",
+		},
+	}
+
+	s := SchedulingOf(pod, events)
+	if s.Scheduled {
+		t.Error("expected the pod not to be scheduled")
+	}
+	test.AssertOutput(t, []string{"resources"}, s.Delays)
+	test.AssertOutput(t, "0/2 nodes are available: 2 Insufficient cpu.", s.Message)
+}
@@ -0,0 +1,183 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ContainerState is the state a container is in
+type ContainerState string
+
+const (
+	// ContainerUnknown is the state of a container without a status yet
+	ContainerUnknown ContainerState = ""
+	// ContainerWaiting is the state of a container which has not started,
+	// or is waiting to be restarted
+	ContainerWaiting ContainerState = "Waiting"
+	// ContainerRunning is the state of a running container
+	ContainerRunning ContainerState = "Running"
+	// ContainerTerminated is the state of a container which has exited
+	ContainerTerminated ContainerState = "Terminated"
+)
+
+// Kinds of the containers of a pod
+const (
+	KindContainer = "container"
+	KindInit      = "init"
+	KindEphemeral = "ephemeral"
+)
+
+// ContainerStatus summarizes the status of a container of a pod
+type ContainerStatus struct {
+	Name string
+	// Kind is KindContainer, KindInit or KindEphemeral
+	Kind  string
+	State ContainerState
+	// Reason and Message are those of the waiting or terminated state
+	Reason  string
+	Message string
+	// ExitCode is only set once the container has terminated
+	ExitCode int32
+	// StartedAt and FinishedAt are zero when not known yet
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Image      string
+	// ImageDigest is the digest of the image which was run, e.g.
+	// sha256:..., empty until the image has been pulled
+	ImageDigest  string
+	RestartCount int32
+}
+
+// Started tells whether the container has started, a container without a
+// status yet counts as started as its logs can be asked for
+func (s ContainerStatus) Started() bool {
+	return s.State != ContainerWaiting
+}
+
+// Failed tells whether the container has terminated with an exit code other
+// than 0
+func (s ContainerStatus) Failed() bool {
+	return s.State == ContainerTerminated && s.ExitCode != 0
+}
+
+// Duration returns how long the container ran, 0 until it has terminated
+func (s ContainerStatus) Duration() time.Duration {
+	if s.State != ContainerTerminated || s.StartedAt.IsZero() || s.FinishedAt.IsZero() {
+		return 0
+	}
+	return s.FinishedAt.Sub(s.StartedAt)
+}
+
+// StatusOfState returns the summary of the state of a container, e.g. of a
+// step of a TaskRun, which has no kind, image or restart count
+func StatusOfState(name string, state corev1.ContainerState, imageID string) ContainerStatus {
+	s := ContainerStatus{Name: name, ImageDigest: imageDigest(imageID)}
+	switch {
+	case state.Terminated != nil:
+		s.State = ContainerTerminated
+		s.Reason = state.Terminated.Reason
+		s.Message = state.Terminated.Message
+		s.ExitCode = state.Terminated.ExitCode
+		s.StartedAt = state.Terminated.StartedAt.Time
+		s.FinishedAt = state.Terminated.FinishedAt.Time
+	case state.Running != nil:
+		s.State = ContainerRunning
+		s.StartedAt = state.Running.StartedAt.Time
+	case state.Waiting != nil:
+		s.State = ContainerWaiting
+		s.Reason = state.Waiting.Reason
+		s.Message = state.Waiting.Message
+	}
+	return s
+}
+
+// ContainerStatuses returns the status of the containers of pod, the init
+// containers first, then the containers and the ephemeral containers, in
+// the order of the spec. The containers without a status yet are in the
+// ContainerUnknown state.
+func ContainerStatuses(pod *corev1.Pod) []ContainerStatus {
+	statuses := []ContainerStatus{}
+	add := func(kind string, names, images []string, cs []corev1.ContainerStatus) {
+		byName := map[string]corev1.ContainerStatus{}
+		for _, s := range cs {
+			byName[s.Name] = s
+			// a status without a container in the spec is kept last
+			if !slices.Contains(names, s.Name) {
+				names = append(names, s.Name)
+				images = append(images, "")
+			}
+		}
+		for i, name := range names {
+			s := StatusOfState(name, byName[name].State, byName[name].ImageID)
+			s.Kind = kind
+			s.Image = images[i]
+			if byName[name].Image != "" {
+				s.Image = byName[name].Image
+			}
+			s.RestartCount = byName[name].RestartCount
+			statuses = append(statuses, s)
+		}
+	}
+
+	names, images := containerNames(pod.Spec.InitContainers)
+	add(KindInit, names, images, pod.Status.InitContainerStatuses)
+	names, images = containerNames(pod.Spec.Containers)
+	add(KindContainer, names, images, pod.Status.ContainerStatuses)
+	names, images = []string{}, []string{}
+	for _, c := range pod.Spec.EphemeralContainers {
+		names = append(names, c.Name)
+		images = append(images, c.Image)
+	}
+	add(KindEphemeral, names, images, pod.Status.EphemeralContainerStatuses)
+	return statuses
+}
+
+// ContainerStatuses fetches the pod and returns the status of its
+// containers
+func (p *Pod) ContainerStatuses() ([]ContainerStatus, error) {
+	pod, err := p.Get()
+	if err != nil {
+		return nil, err
+	}
+	return ContainerStatuses(pod), nil
+}
+
+func containerNames(containers []corev1.Container) ([]string, []string) {
+	names := make([]string, 0, len(containers))
+	images := make([]string, 0, len(containers))
+	for _, c := range containers {
+		names = append(names, c.Name)
+		images = append(images, c.Image)
+	}
+	return names, images
+}
+
+// imageDigest returns the digest of the ID of an image, which the container
+// runtimes prefix with the name of the image or with a scheme such as
+// docker-pullable://
+func imageDigest(imageID string) string {
+	if i := strings.LastIndex(imageID, "@"); i != -1 {
+		return imageID[i+1:]
+	}
+	if i := strings.Index(imageID, "sha256:"); i != -1 {
+		return imageID[i:]
+	}
+	return ""
+}
@@ -0,0 +1,171 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakekube "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestContainerStatuses(t *testing.T) {
+	started := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	finished := started.Add(90 * time.Second)
+
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "prepare", Image: "busybox"}},
+			Containers: []corev1.Container{
+				{Name: "step-build", Image: "golang"},
+				{Name: "step-push", Image: "crane"},
+				{Name: "step-notify", Image: "curl"},
+			},
+			EphemeralContainers: []corev1.EphemeralContainer{
+				{EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "debugger", Image: "busybox"}},
+			},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{
+				Name:    "prepare",
+				Image:   "docker.io/library/busybox:latest",
+				ImageID: "docker.io/library/busybox@sha256:abc",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+					Reason:     "Completed",
+					StartedAt:  metav1.NewTime(started),
+					FinishedAt: metav1.NewTime(started),
+				}},
+			}},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         "step-push",
+					RestartCount: 2,
+					State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}},
+				},
+				{
+					Name:    "step-build",
+					ImageID: "docker-pullable://golang@sha256:def",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+						ExitCode:   2,
+						Reason:     "Error",
+						Message:    "build failed",
+						StartedAt:  metav1.NewTime(started),
+						FinishedAt: metav1.NewTime(finished),
+					}},
+				},
+			},
+			EphemeralContainerStatuses: []corev1.ContainerStatus{{
+				Name:    "debugger",
+				ImageID: "sha256:123",
+				State:   corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(finished)}},
+			}},
+		},
+	}
+
+	got := ContainerStatuses(pod)
+	want := []ContainerStatus{
+		{
+			Name: "prepare", Kind: KindInit, State: ContainerTerminated, Reason: "Completed",
+			StartedAt: started, FinishedAt: started,
+			Image: "docker.io/library/busybox:latest", ImageDigest: "sha256:abc",
+		},
+		{
+			Name: "step-build", Kind: KindContainer, State: ContainerTerminated, Reason: "Error", Message: "build failed", ExitCode: 2,
+			StartedAt: started, FinishedAt: finished,
+			Image: "golang", ImageDigest: "sha256:def",
+		},
+		{
+			Name: "step-push", Kind: KindContainer, State: ContainerWaiting, Reason: "ImagePullBackOff", Message: "Back-off pulling image",
+			Image: "crane", RestartCount: 2,
+		},
+		{Name: "step-notify", Kind: KindContainer, State: ContainerUnknown, Image: "curl"},
+		{
+			Name: "debugger", Kind: KindEphemeral, State: ContainerRunning,
+			StartedAt: finished, Image: "busybox", ImageDigest: "sha256:123",
+		},
+	}
+	test.AssertOutput(t, want, got)
+
+	assert := func(what string, got, want interface{}) {
+		t.Helper()
+		if got != want {
+			t.Errorf("%s: got %v, want %v", what, got, want)
+		}
+	}
+	assert("build failed", got[1].Failed(), true)
+	assert("build duration", got[1].Duration(), 90*time.Second)
+	assert("prepare failed", got[0].Failed(), false)
+	assert("push started", got[2].Started(), false)
+	assert("notify started", got[3].Started(), true)
+	assert("debugger duration", got[4].Duration(), time.Duration(0))
+}
+
+func TestContainerStatuses_statusWithoutSpec(t *testing.T) {
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "step-build",
+				Image: "golang",
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	}
+
+	test.AssertOutput(t, []ContainerStatus{
+		{Name: "step-build", Kind: KindContainer, State: ContainerRunning, Image: "golang"},
+	}, ContainerStatuses(pod))
+}
+
+func TestPod_ContainerStatuses(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "step-build", Image: "golang"}}},
+	}
+	p := New("pod", "ns", fakekube.NewSimpleClientset(pod), nil)
+
+	got, err := p.ContainerStatuses()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertOutput(t, []ContainerStatus{{Name: "step-build", Kind: KindContainer, Image: "golang"}}, got)
+
+	if _, err := New("missing", "ns", fakekube.NewSimpleClientset(), nil).ContainerStatuses(); err == nil {
+		t.Error("expected an error for a missing pod")
+	}
+}
+
+func TestStatusOfState(t *testing.T) {
+	for _, tt := range []struct {
+		imageID string
+		digest  string
+	}{
+		{"", ""},
+		{"busybox@sha256:abc", "sha256:abc"},
+		{"docker-pullable://gcr.io/p/img@sha256:abc", "sha256:abc"},
+		{"sha256:abc", "sha256:abc"},
+		{"docker://sha256:abc", "sha256:abc"},
+	} {
+		got := StatusOfState("step", corev1.ContainerState{}, tt.imageID)
+		if got.ImageDigest != tt.digest {
+			t.Errorf("digest of %q: got %q, want %q", tt.imageID, got.ImageDigest, tt.digest)
+		}
+		if got.State != ContainerUnknown || !got.Started() {
+			t.Errorf("a state without a status should be unknown and started, got %+v", got)
+		}
+	}
+}
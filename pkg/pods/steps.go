@@ -0,0 +1,88 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// StepPrefix is the prefix Tekton gives the names of the containers of
+	// the steps
+	StepPrefix = "step-"
+	// StepPrefixAnnotation is the annotation of a pod giving the prefix of
+	// the names of the containers of its steps, it overrides the containers
+	// the TaskRun gives for its steps
+	StepPrefixAnnotation = "tekton.dev/step-container-prefix"
+)
+
+// StepNames names the steps run in the containers of a pod
+type StepNames struct {
+	// override is the prefix of the annotation of the pod
+	override string
+	// containers are the names of the steps by container, as given in the
+	// status of the TaskRun
+	containers map[string]string
+	// prefixes are the configured prefixes followed by step-
+	prefixes []string
+}
+
+// NewStepNames returns the names of the steps of pod. The container of a
+// step is the one the TaskRun gives in the status of the step, steps, unless
+// the annotation of the pod gives another prefix. The containers of the
+// steps missing from steps, e.g. before the TaskRun reports them, are those
+// with one of the configured prefixes, then step-.
+func NewStepNames(pod *corev1.Pod, steps []v1.StepState, configured []string) *StepNames {
+	n := &StepNames{
+		containers: map[string]string{},
+		prefixes:   append(append([]string{}, configured...), StepPrefix),
+	}
+	if pod != nil {
+		n.override = pod.Annotations[StepPrefixAnnotation]
+	}
+	for _, s := range steps {
+		if s.Container != "" {
+			n.containers[s.Container] = s.Name
+		}
+	}
+	return n
+}
+
+// Name returns the name of the step run in a container, and whether the
+// container runs a step. A container which does not is named after itself.
+func (n *StepNames) Name(container string) (string, bool) {
+	if n.override != "" && strings.HasPrefix(container, n.override) {
+		return strings.TrimPrefix(container, n.override), true
+	}
+	if name, ok := n.containers[container]; ok {
+		return name, true
+	}
+	return StepName(container, n.prefixes)
+}
+
+// StepName returns the name of the step run in a container, the name of the
+// container without the first of the prefixes it has, and whether it has
+// one
+func StepName(container string, prefixes []string) (string, bool) {
+	for _, p := range prefixes {
+		if p != "" && strings.HasPrefix(container, p) {
+			return strings.TrimPrefix(container, p), true
+		}
+	}
+	return container, false
+}
@@ -0,0 +1,80 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pods
+
+import (
+	"testing"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStepNames(t *testing.T) {
+	steps := []v1.StepState{
+		{Name: "build", Container: "step-build"},
+		{Name: "push", Container: "push-1"},
+		{Name: "test"},
+	}
+	annotated := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{StepPrefixAnnotation: "tekton-step-"},
+	}}
+
+	tests := []struct {
+		name      string
+		pod       *corev1.Pod
+		steps     []v1.StepState
+		container string
+		want      string
+		wantOk    bool
+	}{
+		{"no status", nil, nil, "step-build", "build", true},
+		{"not a step", nil, nil, "istio-proxy", "istio-proxy", false},
+		{"status", &corev1.Pod{}, steps, "push-1", "push", true},
+		{"status and prefix", &corev1.Pod{}, steps, "step-build", "build", true},
+		{"configured prefix", &corev1.Pod{}, steps, "s-lint", "lint", true},
+		{"annotation overrides status", annotated, []v1.StepState{{Name: "push", Container: "tekton-step-build"}}, "tekton-step-build", "build", true},
+		{"annotation", annotated, steps, "push-1", "push", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := NewStepNames(tt.pod, tt.steps, []string{"s-"}).Name(tt.container)
+			assert.Equal(t, name, tt.want)
+			assert.Equal(t, ok, tt.wantOk)
+		})
+	}
+}
+
+func TestStepName(t *testing.T) {
+	prefixes := []string{"tekton-step-", "step-"}
+	tests := []struct {
+		container string
+		want      string
+		wantOk    bool
+	}{
+		{"step-build", "build", true},
+		{"tekton-step-build", "build", true},
+		{"tekton-step-step-build", "step-build", true},
+		{"istio-proxy", "istio-proxy", false},
+	}
+	for _, tt := range tests {
+		name, ok := StepName(tt.container, prefixes)
+		assert.Equal(t, name, tt.want, tt.container)
+		assert.Equal(t, ok, tt.wantOk, tt.container)
+	}
+	_, ok := StepName("build", []string{""})
+	assert.Assert(t, !ok)
+}
@@ -0,0 +1,53 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stream
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// fileStream reads the logs of a container from a file, the logs are read
+// as they are when followed
+type fileStream struct {
+	path string
+}
+
+func (s *fileStream) Stream() (io.ReadCloser, error) {
+	return os.Open(s.path)
+}
+
+// openFile returns the streamers reading the logs from a directory, given
+// as file:///dir or as file:dir relative to the current directory
+func openFile(u *url.URL) (NewStreamerFunc, error) {
+	dir := u.Opaque
+	if dir == "" {
+		dir = u.Host + u.Path
+	}
+	dir = filepath.FromSlash(dir)
+	if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+		return nil, fmt.Errorf("the location of logs %s is not a directory", u)
+	}
+
+	return func(_ typedv1.PodInterface, name string, o *corev1.PodLogOptions) Streamer {
+		return &fileStream{path: filepath.Join(dir, filepath.FromSlash(containerFile(name, o.Container)))}
+	}, nil
+}
@@ -0,0 +1,80 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stream
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Opener returns the NewStreamerFunc reading the logs of the containers of
+// the pods from location, whose scheme it is registered for
+type Opener func(location *url.URL) (NewStreamerFunc, error)
+
+var (
+	openersMu sync.RWMutex
+	openers   = map[string]Opener{
+		"file": openFile,
+		"s3":   openS3,
+	}
+)
+
+// Register makes the streamers of scheme available to Open, it replaces the
+// opener registered for scheme before
+func Register(scheme string, open Opener) {
+	openersMu.Lock()
+	defer openersMu.Unlock()
+	openers[strings.ToLower(scheme)] = open
+}
+
+// Schemes returns the schemes Open knows, sorted
+func Schemes() []string {
+	openersMu.RLock()
+	defer openersMu.RUnlock()
+	schemes := make([]string, 0, len(openers))
+	for s := range openers {
+		schemes = append(schemes, s)
+	}
+	sort.Strings(schemes)
+	return schemes
+}
+
+// Open returns the NewStreamerFunc reading the logs of the pods from
+// location, a URL whose scheme picks where the logs come from, such as
+// file:///tmp/logs or s3://bucket/prefix. The logs of a container are found
+// at <pod>/<container>.log under the location.
+func Open(location string) (NewStreamerFunc, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location of logs %q: %w", location, err)
+	}
+
+	openersMu.RLock()
+	open, ok := openers[strings.ToLower(u.Scheme)]
+	openersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown scheme %q of the location of logs %s, use one of %s", u.Scheme, location, strings.Join(Schemes(), ", "))
+	}
+	return open(u)
+}
+
+// containerFile returns the path of the logs of a container under a
+// location
+func containerFile(pod, container string) string {
+	return pod + "/" + container + ".log"
+}
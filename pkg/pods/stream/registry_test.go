@@ -0,0 +1,133 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stream
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func read(t *testing.T, newStreamer NewStreamerFunc, pod, container string) (string, error) {
+	t.Helper()
+	rc, err := newStreamer(nil, pod, &corev1.PodLogOptions{Container: container}).Stream()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+	b, err := io.ReadAll(rc)
+	assert.NilError(t, err)
+	return string(b), nil
+}
+
+func TestOpen_unknownScheme(t *testing.T) {
+	_, err := Open("ftp://host/logs")
+	assert.Error(t, err, `unknown scheme "ftp" of the location of logs ftp://host/logs, use one of file, s3`)
+}
+
+func TestRegister(t *testing.T) {
+	var opened *url.URL
+	Register("fixture", func(u *url.URL) (NewStreamerFunc, error) {
+		opened = u
+		return openFile(&url.URL{Scheme: "file", Path: t.TempDir()})
+	})
+	t.Cleanup(func() {
+		openersMu.Lock()
+		delete(openers, "fixture")
+		openersMu.Unlock()
+	})
+
+	assert.DeepEqual(t, Schemes(), []string{"file", "fixture", "s3"})
+	_, err := Open("fixture://build")
+	assert.NilError(t, err)
+	assert.Equal(t, opened.Host, "build")
+}
+
+func TestOpen_file(t *testing.T) {
+	dir := t.TempDir()
+	assert.NilError(t, os.MkdirAll(filepath.Join(dir, "build-pod"), 0o755))
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "build-pod", "step-compile.log"), []byte("compiling\ndone\n"), 0o600))
+
+	newStreamer, err := Open("file://" + filepath.ToSlash(dir))
+	assert.NilError(t, err)
+
+	logs, err := read(t, newStreamer, "build-pod", "step-compile")
+	assert.NilError(t, err)
+	assert.Equal(t, logs, "compiling\ndone\n")
+
+	_, err = read(t, newStreamer, "build-pod", "step-test")
+	assert.Assert(t, os.IsNotExist(err))
+
+	_, err = Open("file://" + filepath.ToSlash(filepath.Join(dir, "missing")))
+	assert.ErrorContains(t, err, "is not a directory")
+}
+
+func TestOpen_s3(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		if r.URL.Path != "/ci-logs/runs/build-pod/step-compile.log" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.WriteString(w, "compiling\n")
+	}))
+	defer server.Close()
+
+	defaultConfig := awsConfig
+	t.Cleanup(func() { awsConfig = defaultConfig })
+	awsConfig = func(context.Context) (aws.Config, error) {
+		return aws.Config{
+			Region:       "eu-west-1",
+			BaseEndpoint: aws.String(server.URL),
+			Credentials:  credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+		}, nil
+	}
+
+	newStreamer, err := Open("s3://ci-logs/runs/")
+	assert.NilError(t, err)
+
+	logs, err := read(t, newStreamer, "build-pod", "step-compile")
+	assert.NilError(t, err)
+	assert.Equal(t, logs, "compiling\n")
+	assert.Assert(t, strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKID/"), authorization)
+	assert.Assert(t, strings.Contains(authorization, "/eu-west-1/s3/aws4_request"), authorization)
+
+	_, err = read(t, newStreamer, "build-pod", "step-test")
+	assert.Error(t, err, "failed to get s3://ci-logs/runs/build-pod/step-test.log: 404 Not Found")
+}
+
+func TestOpen_s3_noRegion(t *testing.T) {
+	defaultConfig := awsConfig
+	t.Cleanup(func() { awsConfig = defaultConfig })
+	awsConfig = func(context.Context) (aws.Config, error) { return aws.Config{}, nil }
+
+	_, err := Open("s3://ci-logs")
+	assert.Error(t, err, "no AWS region configured, set AWS_REGION or the region of the AWS profile")
+
+	_, err = Open("s3:///logs")
+	assert.Error(t, err, "the bucket is missing from the location of logs s3:///logs")
+}
@@ -0,0 +1,119 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stream
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	corev1 "k8s.io/api/core/v1"
+	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// unsignedPayload is the hash of the payload of the requests to S3 which
+// do not sign their body, the GETs have none
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// awsConfig loads the AWS configuration of the default credential chain,
+// replaced in tests
+var awsConfig = func(ctx context.Context) (aws.Config, error) {
+	return awsconfig.LoadDefaultConfig(ctx)
+}
+
+// s3Stream reads the logs of a container from an object of an S3 bucket.
+// The objects are fetched with signed GETs, from the regional endpoint of
+// AWS or from the endpoint of AWS_ENDPOINT_URL, such as a MinIO server,
+// addressed by path.
+type s3Stream struct {
+	cfg    aws.Config
+	bucket string
+	key    string
+}
+
+func (s *s3Stream) Stream() (io.ReadCloser, error) {
+	ctx := context.Background()
+
+	u := &url.URL{Scheme: "https", Host: fmt.Sprintf("%s.s3.%s.amazonaws.com", s.bucket, s.cfg.Region), Path: "/" + s.key}
+	if s.cfg.BaseEndpoint != nil {
+		endpoint, err := url.Parse(*s.cfg.BaseEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AWS endpoint %s: %w", *s.cfg.BaseEndpoint, err)
+		}
+		u = endpoint.JoinPath(s.bucket, s.key)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// anonymous requests are left unsigned, for public buckets
+	if s.cfg.Credentials != nil {
+		creds, err := s.cfg.Credentials.Retrieve(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the AWS credentials: %w", err)
+		}
+		req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+		region := s.cfg.Region
+		if region == "" {
+			region = "us-east-1"
+		}
+		if err := v4.NewSigner().SignHTTP(ctx, creds, req, unsignedPayload, "s3", region, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	var client aws.HTTPClient = http.DefaultClient
+	if s.cfg.HTTPClient != nil {
+		client = s.cfg.HTTPClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get s3://%s/%s: %w", s.bucket, s.key, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to get s3://%s/%s: %s", s.bucket, s.key, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// openS3 returns the streamers reading the logs from s3://bucket/prefix with
+// the default AWS credentials
+func openS3(u *url.URL) (NewStreamerFunc, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("the bucket is missing from the location of logs %s", u)
+	}
+	cfg, err := awsConfig(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the AWS configuration: %w", err)
+	}
+	if cfg.Region == "" && cfg.BaseEndpoint == nil {
+		return nil, fmt.Errorf("no AWS region configured, set AWS_REGION or the region of the AWS profile")
+	}
+
+	bucket, prefix := u.Host, strings.Trim(u.Path, "/")
+	return func(_ typedv1.PodInterface, name string, o *corev1.PodLogOptions) Streamer {
+		return &s3Stream{cfg: cfg, bucket: bucket, key: path.Join(prefix, containerFile(name, o.Container))}
+	}, nil
+}
@@ -15,10 +15,17 @@
 package stream
 
 import (
+	"fmt"
 	"io"
+	"net/http"
+	"sync"
+	"time"
 
+	"github.com/tektoncd/cli/pkg/config"
+	"golang.org/x/net/http2"
 	corev1 "k8s.io/api/core/v1"
 	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/transport"
 )
 
 // Streamer provides Stream method
@@ -28,3 +35,119 @@ type Streamer interface {
 
 // NewStreamerFunc must return and Streamer given the pod details
 type NewStreamerFunc func(p typedv1.PodInterface, name string, o *corev1.PodLogOptions) Streamer
+
+const (
+	// LogStreamStdout limits the logs of a container to its standard output
+	LogStreamStdout = "Stdout"
+	// LogStreamStderr limits the logs of a container to its standard error
+	LogStreamStderr = "Stderr"
+)
+
+// Options tunes how logs are streamed from the cluster
+type Options struct {
+	// ReadBufferSize is the size of the buffer logs are read through,
+	// 0 uses the default size of bufio
+	ReadBufferSize int
+	// MaxLineLength is the length in bytes after which the lines of logs
+	// are split so that a long line is never held in memory as a whole,
+	// 0 uses the default of 64KiB
+	MaxLineLength int
+	// PingInterval is the interval after which an HTTP/2 ping is sent on a
+	// connection which has not received any frame, so that a connection which
+	// died without a RST is detected and closed, 0 keeps the client-go default
+	PingInterval time.Duration
+	// PingTimeout is the time to wait for the answer to a ping before the
+	// connection is closed, 0 keeps the client-go default
+	PingTimeout time.Duration
+	// IdleTimeout closes a stream which has not received any data for that
+	// long, 0 disables it
+	IdleTimeout time.Duration
+	// InformerResync is the resync period of the informer watching pods
+	// until they start, 0 uses the default of 10s
+	InformerResync time.Duration
+	// LogStream limits the logs to one stream of the containers,
+	// LogStreamStdout or LogStreamStderr, both are read when empty. It
+	// needs the SplitStdoutAndStderr feature gate of Kubernetes 1.32, older
+	// clusters ignore it and return both streams.
+	LogStream string
+}
+
+// ProfileOptions returns the options for the stream settings of a profile
+func ProfileOptions(s config.Stream) (Options, error) {
+	opts := Options{ReadBufferSize: s.ReadBufferSize, MaxLineLength: s.MaxLineLength}
+	if s.ReadBufferSize < 0 {
+		return opts, fmt.Errorf("invalid value %d for stream.readBufferSize: must not be negative", s.ReadBufferSize)
+	}
+	if s.MaxLineLength < 0 {
+		return opts, fmt.Errorf("invalid value %d for stream.maxLineLength: must not be negative", s.MaxLineLength)
+	}
+
+	durations := []struct {
+		name  string
+		value string
+		into  *time.Duration
+	}{
+		{"pingInterval", s.PingInterval, &opts.PingInterval},
+		{"pingTimeout", s.PingTimeout, &opts.PingTimeout},
+		{"idleTimeout", s.IdleTimeout, &opts.IdleTimeout},
+		{"informerResync", s.InformerResync, &opts.InformerResync},
+	}
+	for _, d := range durations {
+		if d.value == "" {
+			continue
+		}
+		v, err := time.ParseDuration(d.value)
+		if err != nil {
+			return opts, fmt.Errorf("invalid value %q for stream.%s: %w", d.value, d.name, err)
+		}
+		if v < 0 {
+			return opts, fmt.Errorf("invalid value %q for stream.%s: must not be negative", d.value, d.name)
+		}
+		*d.into = v
+	}
+	return opts, nil
+}
+
+// WrapTransport returns a wrapper of the transports client-go builds which
+// sends HTTP/2 pings with the keepalive settings of the options, it is nil
+// when they keep the defaults of client-go. The transport is cloned rather
+// than rebuilt so that its dialer, TLS settings and certificate rotation are
+// kept, transports without HTTP/2 are left untouched.
+func (o Options) WrapTransport() transport.WrapperFunc {
+	if o.PingInterval == 0 && o.PingTimeout == 0 {
+		return nil
+	}
+
+	var mu sync.Mutex
+	tuned := map[*http.Transport]*http.Transport{}
+	return func(rt http.RoundTripper) http.RoundTripper {
+		t, ok := rt.(*http.Transport)
+		if !ok || t.TLSNextProto[http2.NextProtoTLS] == nil {
+			return rt
+		}
+
+		// the transports are shared by the clients of a config
+		mu.Lock()
+		defer mu.Unlock()
+		if c, ok := tuned[t]; ok {
+			return c
+		}
+		c := t.Clone()
+		c.TLSNextProto = nil
+		if o.ReadBufferSize > 0 {
+			c.ReadBufferSize = o.ReadBufferSize
+		}
+		h2, err := http2.ConfigureTransports(c)
+		if err != nil {
+			return rt
+		}
+		if o.PingInterval != 0 {
+			h2.ReadIdleTimeout = o.PingInterval
+		}
+		if o.PingTimeout != 0 {
+			h2.PingTimeout = o.PingTimeout
+		}
+		tuned[t] = c
+		return c
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stream
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/config"
+	"gotest.tools/v3/assert"
+	"k8s.io/client-go/rest"
+)
+
+func TestProfileOptions(t *testing.T) {
+	opts, err := ProfileOptions(config.Stream{ReadBufferSize: 65536, MaxLineLength: 1048576, PingInterval: "15s", IdleTimeout: "10m", InformerResync: "1m"})
+	assert.NilError(t, err)
+	assert.Equal(t, opts.ReadBufferSize, 65536)
+	assert.Equal(t, opts.MaxLineLength, 1048576)
+	assert.Equal(t, opts.PingInterval, 15*time.Second)
+	assert.Equal(t, opts.PingTimeout, time.Duration(0))
+	assert.Equal(t, opts.IdleTimeout, 10*time.Minute)
+	assert.Equal(t, opts.InformerResync, time.Minute)
+
+	_, err = ProfileOptions(config.Stream{PingInterval: "often"})
+	assert.Error(t, err, `invalid value "often" for stream.pingInterval: time: invalid duration "often"`)
+
+	_, err = ProfileOptions(config.Stream{MaxLineLength: -1})
+	assert.Error(t, err, "invalid value -1 for stream.maxLineLength: must not be negative")
+
+	_, err = ProfileOptions(config.Stream{IdleTimeout: "-1m"})
+	assert.Error(t, err, `invalid value "-1m" for stream.idleTimeout: must not be negative`)
+}
+
+func TestOptions_WrapTransport(t *testing.T) {
+	config := &rest.Config{
+		Host:            "https://localhost:6443",
+		TLSClientConfig: rest.TLSClientConfig{Insecure: true},
+	}
+	config.Wrap(Options{PingInterval: 15 * time.Second, ReadBufferSize: 1 << 16}.WrapTransport())
+
+	rt, err := rest.TransportFor(config)
+	assert.NilError(t, err)
+	transport, ok := rt.(*http.Transport)
+	assert.Assert(t, ok)
+	// the TLS settings of the config are kept on the transport
+	assert.Assert(t, transport.TLSClientConfig.InsecureSkipVerify)
+	assert.Equal(t, transport.ReadBufferSize, 1<<16)
+	assert.Assert(t, transport.TLSNextProto["h2"] != nil)
+	assert.DeepEqual(t, config.TLSClientConfig, rest.TLSClientConfig{Insecure: true})
+
+	// the clients of the config share the tuned transport
+	other, err := rest.TransportFor(config)
+	assert.NilError(t, err)
+	assert.Assert(t, other == rt)
+}
+
+func TestOptions_WrapTransport_defaults(t *testing.T) {
+	assert.Assert(t, Options{IdleTimeout: time.Minute}.WrapTransport() == nil)
+}
@@ -0,0 +1,167 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package policy checks resources against local rego and CUE policies, with
+// the opa and cue command line tools
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// Package is the rego package of the policies, its deny and warn rules are
+// sets of messages
+const Package = "tkn"
+
+// Result is the outcome of the policies for a resource
+type Result struct {
+	// Denials are the messages of the violated policies
+	Denials []string
+	// Warnings are the messages of the policies which only warn
+	Warnings []string
+}
+
+// Check evaluates the policies of files against the resource, the .rego
+// files are evaluated together with opa eval and the .cue files each with
+// cue vet, whose errors are denials
+func Check(files []string, resource interface{}) (*Result, error) {
+	input, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	rego := []string{}
+	for _, f := range files {
+		if f, err = homedir.Expand(f); err != nil {
+			return nil, err
+		}
+		switch filepath.Ext(f) {
+		case ".rego":
+			rego = append(rego, f)
+		case ".cue":
+			denials, err := vetCUE(f, input)
+			if err != nil {
+				return nil, err
+			}
+			result.Denials = append(result.Denials, denials...)
+		default:
+			return nil, fmt.Errorf("unsupported policy %s, policies are .rego or .cue files", f)
+		}
+	}
+	if len(rego) > 0 {
+		if err := evalRego(rego, input, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// evalRego evaluates the deny and warn rules of the Package of the rego files
+func evalRego(files []string, input []byte, result *Result) error {
+	args := []string{"eval", "--format", "json", "--stdin-input"}
+	for _, f := range files {
+		args = append(args, "--data", f)
+	}
+	args = append(args, "data."+Package)
+
+	cmd := exec.Command("opa", args...)
+	cmd.Stdin = bytes.NewReader(input)
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("failed to evaluate the policies %s with opa: %v: %s", strings.Join(files, ", "), err, strings.TrimSpace(stderr.String()))
+	}
+
+	var eval struct {
+		Result []struct {
+			Expressions []struct {
+				Value struct {
+					Deny []interface{} `json:"deny"`
+					Warn []interface{} `json:"warn"`
+				} `json:"value"`
+			} `json:"expressions"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(out, &eval); err != nil {
+		return fmt.Errorf("failed to read the output of opa: %w", err)
+	}
+	for _, r := range eval.Result {
+		for _, e := range r.Expressions {
+			result.Denials = append(result.Denials, messages(e.Value.Deny)...)
+			result.Warnings = append(result.Warnings, messages(e.Value.Warn)...)
+		}
+	}
+	return nil
+}
+
+// vetCUE returns the errors of cue vet unifying the input with the policy
+func vetCUE(file string, input []byte) ([]string, error) {
+	dir, err := os.MkdirTemp("", "tkn-policy-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	inputFile := filepath.Join(dir, "input.json")
+	if err := os.WriteFile(inputFile, input, 0o600); err != nil {
+		return nil, err
+	}
+
+	out, err := exec.Command("cue", "vet", "-c", file, inputFile).CombinedOutput()
+	if err == nil {
+		return nil, nil
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return nil, fmt.Errorf("failed to check the policy %s with cue: %w", file, err)
+	}
+
+	// an error starts on a line of its own, followed by the indented
+	// positions it was found at
+	denials := []string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if line == "" || strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			continue
+		}
+		denials = append(denials, strings.TrimSuffix(line, ":"))
+	}
+	return denials, nil
+}
+
+// messages returns the messages of a rule, the ones which are not strings
+// are written as JSON
+func messages(values []interface{}) []string {
+	msgs := []string{}
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			msgs = append(msgs, s)
+			continue
+		}
+		b, _ := json.Marshal(v)
+		msgs = append(msgs, string(b))
+	}
+	sort.Strings(msgs)
+	return msgs
+}
@@ -0,0 +1,78 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+// fakeTools puts an opa on the PATH denying the resources with a latest
+// image and recording its arguments in dir, and a cue failing for any of
+// them
+func fakeTools(t *testing.T, dir string) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake policy tools are shell scripts")
+	}
+	opa := `#!/bin/sh
+echo "$@" > ` + filepath.Join(dir, "opa-args") + `
+case "$(cat)" in
+*:latest*) echo '{"result":[{"expressions":[{"value":{"deny":["image alpine:latest uses the latest tag"],"warn":["no resource limits"]}}]}]}';;
+*) echo '{"result":[{"expressions":[{"value":{"warn":["no resource limits"]}}]}]}';;
+esac
+`
+	cue := `#!/bin/sh
+echo 'spec.timeouts.pipeline: invalid value "3h" (out of bound <=2h):'
+echo '    ./policy.cue:3:15'
+exit 1
+`
+	for name, script := range map[string]string{"opa": opa, "cue": cue} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(script), 0o755); err != nil { // nolint: gosec
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestCheck(t *testing.T) {
+	dir := t.TempDir()
+	fakeTools(t, dir)
+
+	run := map[string]interface{}{"spec": map[string]string{"image": "alpine:latest"}}
+	result, err := Check([]string{"images.rego", "limits.rego"}, run)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result, &Result{
+		Denials:  []string{"image alpine:latest uses the latest tag"},
+		Warnings: []string{"no resource limits"},
+	})
+	args, err := os.ReadFile(filepath.Join(dir, "opa-args"))
+	assert.NilError(t, err)
+	assert.Equal(t, strings.TrimSpace(string(args)), "eval --format json --stdin-input --data images.rego --data limits.rego data.tkn")
+
+	result, err = Check([]string{"timeouts.cue", "images.rego"}, map[string]string{"image": "alpine:3.20"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, result, &Result{
+		Denials:  []string{`spec.timeouts.pipeline: invalid value "3h" (out of bound <=2h)`},
+		Warnings: []string{"no resource limits"},
+	})
+
+	_, err = Check([]string{"policy.json"}, run)
+	assert.Error(t, err, "unsupported policy policy.json, policies are .rego or .cue files")
+}
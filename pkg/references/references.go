@@ -0,0 +1,324 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package references finds the resources of a namespace which reference a
+// Task or a Pipeline, so that deleting it does not break them unnoticed.
+package references
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/names"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	pipelineGroupResource        = schema.GroupVersionResource{Group: "tekton.dev", Resource: "pipelines"}
+	taskrunGroupResource         = schema.GroupVersionResource{Group: "tekton.dev", Resource: "taskruns"}
+	pipelinerunGroupResource     = schema.GroupVersionResource{Group: "tekton.dev", Resource: "pipelineruns"}
+	triggertemplateGroupResource = schema.GroupVersionResource{Group: "triggers.tekton.dev", Resource: "triggertemplates"}
+)
+
+// RecentRuns is how old the runs referencing a Task or a Pipeline can be to
+// be reported
+const RecentRuns = 24 * time.Hour
+
+// maxListed is how many references of a kind are listed, the others are
+// counted
+const maxListed = 5
+
+// Reference is a resource referencing a Task or a Pipeline
+type Reference struct {
+	Kind string
+	Name string
+	// Detail tells how the resource references it, e.g. the pipeline
+	// tasks of a Pipeline running the Task
+	Detail string
+}
+
+// Options tells which references are looked up
+type Options struct {
+	// Since is when the oldest run reported was created
+	Since time.Time
+	// SkipRuns does not report the runs, e.g. as they are deleted along
+	SkipRuns bool
+}
+
+// UncheckedError tells the resources which could not be checked for
+// references as the user is not allowed to list them, the references found
+// in the others are returned along
+type UncheckedError struct {
+	Resources []string
+}
+
+func (e *UncheckedError) Error() string {
+	return fmt.Sprintf("not allowed to list the %s", strings.Join(e.Resources, ", "))
+}
+
+// add records the resources left unchecked by err, it returns the errors
+// which are not an UncheckedError
+func (e *UncheckedError) add(err error) error {
+	var unchecked *UncheckedError
+	if !errors.As(err, &unchecked) {
+		return err
+	}
+	e.Resources = append(e.Resources, unchecked.Resources...)
+	return nil
+}
+
+// result returns the references found, and the resources left unchecked
+// if any
+func (e *UncheckedError) result(refs []Reference) ([]Reference, error) {
+	if len(e.Resources) > 0 {
+		return refs, e
+	}
+	return refs, nil
+}
+
+// ToTask returns the Pipelines, TriggerTemplates and recent TaskRuns of
+// namespace ns referencing the Task name, with an UncheckedError when some
+// of them could not be listed
+func ToTask(c *cli.Clients, ns, name string, opts Options) ([]Reference, error) {
+	var refs []Reference
+	unchecked := &UncheckedError{}
+
+	pipelines, err := list(c, pipelineGroupResource, ns, metav1.ListOptions{})
+	if err := unchecked.add(err); err != nil {
+		return nil, err
+	}
+	for _, p := range pipelines {
+		spec, _, _ := unstructured.NestedMap(p.Object, "spec")
+		if tasks := pipelineTasksRunning(spec, name); len(tasks) > 0 {
+			refs = append(refs, Reference{Kind: "Pipeline", Name: p.GetName(), Detail: "pipeline tasks " + strings.Join(tasks, ", ")})
+		}
+	}
+
+	templates, err := list(c, triggertemplateGroupResource, ns, metav1.ListOptions{})
+	if err := unchecked.add(err); err != nil {
+		return nil, err
+	}
+	for _, tt := range templates {
+		if templateCreates(tt, func(kind string, run map[string]interface{}) bool {
+			switch kind {
+			case "TaskRun":
+				ref, _, _ := unstructured.NestedMap(run, "spec", "taskRef")
+				return refersToTask(ref, name)
+			case "PipelineRun":
+				spec, _, _ := unstructured.NestedMap(run, "spec", "pipelineSpec")
+				return len(pipelineTasksRunning(spec, name)) > 0
+			}
+			return false
+		}) {
+			refs = append(refs, Reference{Kind: "TriggerTemplate", Name: tt.GetName()})
+		}
+	}
+
+	if opts.SkipRuns {
+		return unchecked.result(refs)
+	}
+	runs, err := list(c, taskrunGroupResource, ns, metav1.ListOptions{LabelSelector: "tekton.dev/task=" + name})
+	if err := unchecked.add(err); err != nil {
+		return nil, err
+	}
+	// the label is the same for the TaskRuns of a ClusterTask
+	var taskRuns []*unstructured.Unstructured
+	for _, tr := range runs {
+		if kind, _, _ := unstructured.NestedString(tr.Object, "spec", "taskRef", "kind"); kind == "" || kind == "Task" {
+			taskRuns = append(taskRuns, tr)
+		}
+	}
+	return unchecked.result(append(refs, recentRuns("TaskRun", taskRuns, opts.Since)...))
+}
+
+// ToPipeline returns the TriggerTemplates and recent PipelineRuns of
+// namespace ns referencing the Pipeline name, with an UncheckedError when
+// some of them could not be listed
+func ToPipeline(c *cli.Clients, ns, name string, opts Options) ([]Reference, error) {
+	var refs []Reference
+	unchecked := &UncheckedError{}
+
+	templates, err := list(c, triggertemplateGroupResource, ns, metav1.ListOptions{})
+	if err := unchecked.add(err); err != nil {
+		return nil, err
+	}
+	for _, tt := range templates {
+		if templateCreates(tt, func(kind string, run map[string]interface{}) bool {
+			ref, _, _ := unstructured.NestedString(run, "spec", "pipelineRef", "name")
+			return kind == "PipelineRun" && ref == name
+		}) {
+			refs = append(refs, Reference{Kind: "TriggerTemplate", Name: tt.GetName()})
+		}
+	}
+
+	if opts.SkipRuns {
+		return unchecked.result(refs)
+	}
+	runs, err := list(c, pipelinerunGroupResource, ns, metav1.ListOptions{LabelSelector: "tekton.dev/pipeline=" + name})
+	if err := unchecked.add(err); err != nil {
+		return nil, err
+	}
+	return unchecked.result(append(refs, recentRuns("PipelineRun", runs, opts.Since)...))
+}
+
+// Print lists the references to the resource of kind and name, at most
+// maxListed of each kind
+func Print(w io.Writer, kind, name string, refs []Reference) {
+	fmt.Fprintf(w, "%s %q is referenced by:\n", kind, name)
+	listed := map[string]int{}
+	for _, r := range refs {
+		listed[r.Kind]++
+		if listed[r.Kind] > maxListed {
+			continue
+		}
+		fmt.Fprintf(w, "  - %s %s", r.Kind, r.Name)
+		if r.Detail != "" {
+			fmt.Fprintf(w, " (%s)", r.Detail)
+		}
+		fmt.Fprintln(w)
+	}
+	var kinds []string
+	for k := range listed {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	for _, k := range kinds {
+		if more := listed[k] - maxListed; more > 0 {
+			fmt.Fprintf(w, "  - and %d more %ss\n", more, k)
+		}
+	}
+}
+
+// list returns the objects of a resource, none when the resource is not
+// installed on the cluster, e.g. when Tekton Triggers is not, and an
+// UncheckedError when the user is not allowed to list them
+func list(c *cli.Clients, gr schema.GroupVersionResource, ns string, opts metav1.ListOptions) ([]*unstructured.Unstructured, error) {
+	l, err := actions.List(gr, c.Dynamic, c.Tekton.Discovery(), ns, opts)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		if apierrors.IsForbidden(err) {
+			return nil, &UncheckedError{Resources: []string{gr.Resource}}
+		}
+		return nil, fmt.Errorf("failed to list the %s of namespace %s: %w", gr.Resource, ns, err)
+	}
+	objs := make([]*unstructured.Unstructured, len(l.Items))
+	for i := range l.Items {
+		objs[i] = &l.Items[i]
+	}
+	sort.Slice(objs, func(i, j int) bool { return objs[i].GetName() < objs[j].GetName() })
+	return objs, nil
+}
+
+// pipelineTasksRunning returns the names of the tasks and finally tasks of
+// a Pipeline spec which run the Task name
+func pipelineTasksRunning(spec map[string]interface{}, name string) []string {
+	var names []string
+	for _, field := range []string{"tasks", "finally"} {
+		tasks, _, _ := unstructured.NestedSlice(spec, field)
+		for _, t := range tasks {
+			pt, ok := t.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if ref, _, _ := unstructured.NestedMap(pt, "taskRef"); !refersToTask(ref, name) {
+				continue
+			}
+			ptName, _, _ := unstructured.NestedString(pt, "name")
+			names = append(names, ptName)
+		}
+	}
+	return names
+}
+
+// refersToTask tells whether a taskRef references the Task name
+func refersToTask(ref map[string]interface{}, name string) bool {
+	refName, _, _ := unstructured.NestedString(ref, "name")
+	kind, _, _ := unstructured.NestedString(ref, "kind")
+	return refName == name && (kind == "" || kind == "Task")
+}
+
+// templateCreates tells whether any of the Tekton resources templated by a
+// TriggerTemplate satisfies match
+func templateCreates(tt *unstructured.Unstructured, match func(kind string, obj map[string]interface{}) bool) bool {
+	templates, _, _ := unstructured.NestedSlice(tt.Object, "spec", "resourcetemplates")
+	for _, t := range templates {
+		obj, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		apiVersion, _, _ := unstructured.NestedString(obj, "apiVersion")
+		kind, _, _ := unstructured.NestedString(obj, "kind")
+		if strings.HasPrefix(apiVersion, "tekton.dev/") && match(kind, obj) {
+			return true
+		}
+	}
+	return false
+}
+
+// recentRuns returns the references of the runs created since, the most
+// recent first
+func recentRuns(kind string, runs []*unstructured.Unstructured, since time.Time) []Reference {
+	var recent []*unstructured.Unstructured
+	for _, r := range runs {
+		if !r.GetCreationTimestamp().Time.Before(since) {
+			recent = append(recent, r)
+		}
+	}
+	sort.SliceStable(recent, func(i, j int) bool {
+		return recent[i].GetCreationTimestamp().Time.After(recent[j].GetCreationTimestamp().Time)
+	})
+	refs := make([]Reference, len(recent))
+	for i, r := range recent {
+		refs[i] = Reference{Kind: kind, Name: r.GetName()}
+	}
+	return refs
+}
+
+// Check prints the references found by find to each of the resources of
+// kind and resourceNames on w, and refuses to go on with deleting them unless force
+// is set when any of them is referenced. The references which could not be
+// checked are warned about, they do not prevent the deletion.
+func Check(w io.Writer, kind string, resourceNames []string, force bool, find func(name string) ([]Reference, error)) error {
+	var referenced []string
+	for _, name := range resourceNames {
+		refs, err := find(name)
+		var unchecked *UncheckedError
+		if errors.As(err, &unchecked) {
+			fmt.Fprintf(w, "Warning: the %s referencing %s %q could not be checked, you are not allowed to list them\n", strings.Join(unchecked.Resources, ", "), kind, name)
+		} else if err != nil {
+			return err
+		}
+		if len(refs) == 0 {
+			continue
+		}
+		Print(w, kind, name, refs)
+		referenced = append(referenced, name)
+	}
+	if len(referenced) > 0 && !force {
+		return fmt.Errorf("refusing to delete the referenced %s(s) %s, use --force to delete them anyway", kind, names.QuotedList(referenced))
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package references
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/test"
+	cb "github.com/tektoncd/cli/pkg/test/builder"
+	testDynamic "github.com/tektoncd/cli/pkg/test/dynamic"
+	pipelinetest "github.com/tektoncd/pipeline/test"
+	"gotest.tools/v3/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stest "k8s.io/client-go/testing"
+)
+
+func object(apiVersion, kind, name string, created time.Time, labels map[string]string, spec map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetName(name)
+	u.SetNamespace("ns")
+	u.SetLabels(labels)
+	u.SetCreationTimestamp(metav1.Time{Time: created})
+	return u
+}
+
+func pipelineTask(name, task, kind string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":    name,
+		"taskRef": map[string]interface{}{"name": task, "kind": kind},
+	}
+}
+
+func clients(t *testing.T, withTriggers bool, objs ...runtime.Object) *cli.Clients {
+	t.Helper()
+	return clientsWith(t, withTriggers, testDynamic.Options{}, objs...)
+}
+
+func clientsWith(t *testing.T, withTriggers bool, tdc testDynamic.Options, objs ...runtime.Object) *cli.Clients {
+	t.Helper()
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{})
+	cs.Pipeline.Resources = cb.APIResourceList("v1", []string{"pipeline", "taskrun", "pipelinerun"})
+	if withTriggers {
+		cs.Pipeline.Resources = append(cs.Pipeline.Resources, cb.TriggersAPIResourceList("v1beta1", []string{"triggertemplate"})...)
+	}
+	dc, err := tdc.Client(objs...)
+	assert.NilError(t, err)
+	p := &test.Params{Tekton: cs.Pipeline, Kube: cs.Kube, Dynamic: dc}
+	c, err := p.Clients()
+	assert.NilError(t, err)
+	return c
+}
+
+func fixtures(now time.Time) []runtime.Object {
+	return []runtime.Object{
+		object("tekton.dev/v1", "Pipeline", "ci", now, nil, map[string]interface{}{
+			"tasks": []interface{}{
+				pipelineTask("compile", "build", "Task"),
+				pipelineTask("lint", "lint", "Task"),
+			},
+			"finally": []interface{}{
+				pipelineTask("package", "build", ""),
+			},
+		}),
+		object("tekton.dev/v1", "Pipeline", "release", now, nil, map[string]interface{}{
+			"tasks": []interface{}{pipelineTask("compile", "build", "ClusterTask")},
+		}),
+		object("triggers.tekton.dev/v1beta1", "TriggerTemplate", "on-push", now, nil, map[string]interface{}{
+			"resourcetemplates": []interface{}{
+				map[string]interface{}{
+					"apiVersion": "tekton.dev/v1",
+					"kind":       "PipelineRun",
+					"spec":       map[string]interface{}{"pipelineRef": map[string]interface{}{"name": "ci"}},
+				},
+			},
+		}),
+		object("triggers.tekton.dev/v1beta1", "TriggerTemplate", "on-tag", now, nil, map[string]interface{}{
+			"resourcetemplates": []interface{}{
+				map[string]interface{}{
+					"apiVersion": "tekton.dev/v1beta1",
+					"kind":       "TaskRun",
+					"spec":       map[string]interface{}{"taskRef": map[string]interface{}{"name": "build"}},
+				},
+			},
+		}),
+		object("triggers.tekton.dev/v1beta1", "TriggerTemplate", "on-comment", now, nil, map[string]interface{}{
+			"resourcetemplates": []interface{}{
+				map[string]interface{}{
+					"apiVersion": "tekton.dev/v1",
+					"kind":       "PipelineRun",
+					"spec": map[string]interface{}{"pipelineSpec": map[string]interface{}{
+						"tasks": []interface{}{pipelineTask("compile", "build", "")},
+					}},
+				},
+			},
+		}),
+		object("tekton.dev/v1", "TaskRun", "build-run-1", now.Add(-2*time.Hour), map[string]string{"tekton.dev/task": "build"},
+			map[string]interface{}{"taskRef": map[string]interface{}{"name": "build", "kind": "Task"}}),
+		object("tekton.dev/v1", "TaskRun", "build-run-2", now.Add(-time.Hour), map[string]string{"tekton.dev/task": "build"},
+			map[string]interface{}{"taskRef": map[string]interface{}{"name": "build", "kind": "Task"}}),
+		object("tekton.dev/v1", "TaskRun", "build-run-old", now.Add(-48*time.Hour), map[string]string{"tekton.dev/task": "build"},
+			map[string]interface{}{"taskRef": map[string]interface{}{"name": "build", "kind": "Task"}}),
+		object("tekton.dev/v1", "TaskRun", "cluster-build-run", now, map[string]string{"tekton.dev/task": "build"},
+			map[string]interface{}{"taskRef": map[string]interface{}{"name": "build", "kind": "ClusterTask"}}),
+		object("tekton.dev/v1", "PipelineRun", "ci-run-1", now, map[string]string{"tekton.dev/pipeline": "ci"},
+			map[string]interface{}{"pipelineRef": map[string]interface{}{"name": "ci"}}),
+	}
+}
+
+func TestToTask(t *testing.T) {
+	now := test.FakeClock().Now()
+	since := now.Add(-RecentRuns)
+
+	c := clients(t, true, fixtures(now)...)
+	refs, err := ToTask(c, "ns", "build", Options{Since: since})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, refs, []Reference{
+		{Kind: "Pipeline", Name: "ci", Detail: "pipeline tasks compile, package"},
+		{Kind: "TriggerTemplate", Name: "on-comment"},
+		{Kind: "TriggerTemplate", Name: "on-tag"},
+		{Kind: "TaskRun", Name: "build-run-2"},
+		{Kind: "TaskRun", Name: "build-run-1"},
+	})
+
+	refs, err = ToTask(c, "ns", "build", Options{Since: since, SkipRuns: true})
+	assert.NilError(t, err)
+	assert.Equal(t, len(refs), 3)
+
+	refs, err = ToTask(c, "ns", "test", Options{Since: since})
+	assert.NilError(t, err)
+	assert.Equal(t, len(refs), 0)
+}
+
+func TestToTask_without_triggers(t *testing.T) {
+	now := test.FakeClock().Now()
+
+	c := clients(t, false, fixtures(now)...)
+	refs, err := ToTask(c, "ns", "build", Options{Since: now.Add(-RecentRuns), SkipRuns: true})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, refs, []Reference{
+		{Kind: "Pipeline", Name: "ci", Detail: "pipeline tasks compile, package"},
+	})
+}
+
+func TestToTask_forbidden(t *testing.T) {
+	now := test.FakeClock().Now()
+
+	forbidden := func(resource string) testDynamic.PrependOpt {
+		return testDynamic.PrependOpt{
+			Resource: resource,
+			Verb:     "list",
+			Action: func(action k8stest.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewForbidden(schema.GroupResource{Group: action.GetResource().Group, Resource: resource}, "", errors.New("denied"))
+			},
+		}
+	}
+	tdc := testDynamic.Options{PrependReactors: []testDynamic.PrependOpt{forbidden("triggertemplates"), forbidden("taskruns")}}
+	c := clientsWith(t, true, tdc, fixtures(now)...)
+
+	refs, err := ToTask(c, "ns", "build", Options{Since: now.Add(-RecentRuns)})
+	assert.Error(t, err, "not allowed to list the triggertemplates, taskruns")
+	assert.DeepEqual(t, refs, []Reference{
+		{Kind: "Pipeline", Name: "ci", Detail: "pipeline tasks compile, package"},
+	})
+}
+
+func TestToPipeline(t *testing.T) {
+	now := test.FakeClock().Now()
+
+	c := clients(t, true, fixtures(now)...)
+	refs, err := ToPipeline(c, "ns", "ci", Options{Since: now.Add(-RecentRuns)})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, refs, []Reference{
+		{Kind: "TriggerTemplate", Name: "on-push"},
+		{Kind: "PipelineRun", Name: "ci-run-1"},
+	})
+
+	refs, err = ToPipeline(c, "ns", "release", Options{Since: now.Add(-RecentRuns)})
+	assert.NilError(t, err)
+	assert.Equal(t, len(refs), 0)
+}
+
+func TestCheck(t *testing.T) {
+	runs := []Reference{{Kind: "Pipeline", Name: "ci", Detail: "pipeline tasks compile"}}
+	for i := 1; i <= 7; i++ {
+		runs = append(runs, Reference{Kind: "TaskRun", Name: fmt.Sprintf("build-run-%d", i)})
+	}
+	find := func(name string) ([]Reference, error) {
+		if name == "build" {
+			return runs, nil
+		}
+		return nil, nil
+	}
+	expected := `Task "build" is referenced by:
+  - Pipeline ci (pipeline tasks compile)
+  - TaskRun build-run-1
+  - TaskRun build-run-2
+  - TaskRun build-run-3
+  - TaskRun build-run-4
+  - TaskRun build-run-5
+  - and 2 more TaskRuns
+`
+
+	out := &bytes.Buffer{}
+	err := Check(out, "Task", []string{"build", "lint"}, false, find)
+	assert.Error(t, err, `refusing to delete the referenced Task(s) "build", use --force to delete them anyway`)
+	test.AssertOutput(t, expected, out.String())
+
+	out.Reset()
+	assert.NilError(t, Check(out, "Task", []string{"build", "lint"}, true, find))
+	test.AssertOutput(t, expected, out.String())
+
+	out.Reset()
+	assert.NilError(t, Check(out, "Task", []string{"lint"}, false, find))
+	test.AssertOutput(t, "", out.String())
+
+	// the references which could not be checked do not prevent deleting
+	unchecked := func(name string) ([]Reference, error) {
+		if name == "build" {
+			return runs[:1], &UncheckedError{Resources: []string{"taskruns"}}
+		}
+		return nil, &UncheckedError{Resources: []string{"pipelines", "triggertemplates"}}
+	}
+	out.Reset()
+	assert.NilError(t, Check(out, "Task", []string{"lint"}, false, unchecked))
+	test.AssertOutput(t, "Warning: the pipelines, triggertemplates referencing Task \"lint\" could not be checked, you are not allowed to list them\n", out.String())
+
+	out.Reset()
+	err = Check(out, "Task", []string{"build"}, false, unchecked)
+	assert.Error(t, err, `refusing to delete the referenced Task(s) "build", use --force to delete them anyway`)
+	test.AssertOutput(t, `Warning: the taskruns referencing Task "build" could not be checked, you are not allowed to list them
+Task "build" is referenced by:
+  - Pipeline ci (pipeline tasks compile)
+`, out.String())
+
+	out.Reset()
+	assert.NilError(t, Check(out, "Task", []string{"build"}, true, unchecked))
+}
@@ -0,0 +1,106 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package render renders Go templates of Tekton manifests with values, in
+// the spirit of helm templates
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Values are the values available to a template as .Values
+type Values map[string]interface{}
+
+// Merge merges src into v, nested maps are merged and any other value of
+// src replaces the one of v
+func (v Values) Merge(src Values) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := v[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			Values(dstMap).Merge(srcMap)
+			continue
+		}
+		v[key] = value
+	}
+}
+
+// Set sets the value of a dotted key, e.g. git.revision=main, the value is
+// converted to a bool or a number when it looks like one
+func (v Values) Set(assignment string) error {
+	key, raw, ok := strings.Cut(assignment, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("invalid value %q, expected key=value", assignment)
+	}
+
+	var value interface{} = raw
+	if b, err := strconv.ParseBool(raw); err == nil {
+		value = b
+	} else if i, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		value = i
+	} else if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		value = f
+	}
+
+	parts := strings.Split(key, ".")
+	current := v
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[part] = next
+		}
+		current = next
+	}
+	current[parts[len(parts)-1]] = value
+	return nil
+}
+
+// ParseValues parses a values file
+func ParseValues(b []byte) (Values, error) {
+	values := Values{}
+	if err := yaml.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// Render renders the template tmpl with the values, the result has to be
+// valid YAML
+func Render(name string, tmpl []byte, values Values) ([]byte, error) {
+	t, err := template.New(name).Option("missingkey=zero").Funcs(FuncMap()).Parse(string(tmpl))
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	if err := t.Execute(&out, map[string]interface{}{"Values": values}); err != nil {
+		return nil, err
+	}
+
+	for i, doc := range bytes.Split(out.Bytes(), []byte("\n---")) {
+		var obj interface{}
+		if err := yaml.Unmarshal(doc, &obj); err != nil {
+			return nil, fmt.Errorf("document %d of the rendered %s is not valid YAML: %w", i+1, name, err)
+		}
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValues_SetAndMerge(t *testing.T) {
+	values := Values{}
+	values.Merge(Values{
+		"git":   map[string]interface{}{"url": "https://example.com/repo", "revision": "v1"},
+		"cache": true,
+	})
+	for _, s := range []string{"git.revision=main", "replicas=3", "ratio=0.5", "cache=false", "a.b.c=d"} {
+		if err := values.Set(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := Values{
+		"git":      map[string]interface{}{"url": "https://example.com/repo", "revision": "main"},
+		"cache":    false,
+		"replicas": int64(3),
+		"ratio":    0.5,
+		"a":        map[string]interface{}{"b": map[string]interface{}{"c": "d"}},
+	}
+	if d := cmp.Diff(want, values); d != "" {
+		t.Errorf("unexpected values (-want +got): %s", d)
+	}
+}
+
+func TestRender_funcs(t *testing.T) {
+	tmpl := `name: {{ .Values.name | default "x" | upper | quote }}
+args: {{ list "a" "b" | join "," | squote }}
+short: {{ trunc 3 "abcdef" }}
+empty: {{ coalesce .Values.missing "" "fallback" }}
+env: {{ ternary "yes" "no" (empty .Values.missing) }}
+repo: {{ (split "/" .Values.image)._1 }}
+parts: {{ splitList "/" .Values.image | join " " }}
+title: {{ title "élan vital ǆungla" }}
+`
+	got, err := Render("test", []byte(tmpl), Values{"name": "build", "image": "ghcr.io/tektoncd/cli"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `name: "BUILD"
+args: 'a,b'
+short: abc
+empty: fallback
+env: yes
+repo: tektoncd
+parts: ghcr.io tektoncd cli
+title: Élan Vital ǅungla
+`
+	if d := cmp.Diff(want, string(got)); d != "" {
+		t.Errorf("unexpected render (-want +got): %s", d)
+	}
+}
+
+func TestRender_noEnv(t *testing.T) {
+	t.Setenv("TKN_RENDER_SECRET", "s3cr3t")
+	_, err := Render("test", []byte(`token: {{ env "TKN_RENDER_SECRET" }}`), Values{})
+	if err == nil || !strings.Contains(err.Error(), `function "env" not defined`) {
+		t.Errorf("expected env to be undefined, got %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolverref
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+)
+
+const (
+	bundlePrefix = "bundle://"
+	hubPrefix    = "hub://"
+)
+
+// Ref is a Task or a Pipeline out of the cluster, resolved by a resolver of
+// Tekton when the run starts, written as
+// bundle://registry/repo:tag#name for the bundles resolver or
+// hub://catalog/name@version for the hub resolver
+type Ref struct {
+	// Resolver is the resolver of Tekton resolving the definition
+	Resolver string
+	// Name is the name of the Task or Pipeline
+	Name   string
+	Params v1beta1.Params
+}
+
+// Parse parses a reference to a definition of kind, task or pipeline
+func Parse(s, kind string) (*Ref, error) {
+	switch {
+	case strings.HasPrefix(s, bundlePrefix):
+		invalid := fmt.Errorf("invalid bundle reference %s, it must be like bundle://registry/repo:tag#name", s)
+		image, name, ok := strings.Cut(strings.TrimPrefix(s, bundlePrefix), "#")
+		if !ok || image == "" || name == "" {
+			return nil, invalid
+		}
+		return &Ref{Resolver: "bundles", Name: name, Params: v1beta1.Params{
+			{Name: "bundle", Value: *v1beta1.NewStructuredValues(image)},
+			{Name: "name", Value: *v1beta1.NewStructuredValues(name)},
+			{Name: "kind", Value: *v1beta1.NewStructuredValues(kind)},
+		}}, nil
+	case strings.HasPrefix(s, hubPrefix):
+		invalid := fmt.Errorf("invalid hub reference %s, it must be like hub://catalog/name@version", s)
+		path, version, ok := strings.Cut(strings.TrimPrefix(s, hubPrefix), "@")
+		if !ok || path == "" || version == "" {
+			return nil, invalid
+		}
+		// the catalog may be left to the default of the resolver
+		catalog, name, ok := strings.Cut(path, "/")
+		if !ok {
+			catalog, name = "", path
+		}
+		if name == "" || strings.Contains(name, "/") {
+			return nil, invalid
+		}
+		params := v1beta1.Params{}
+		if catalog != "" {
+			params = append(params, v1beta1.Param{Name: "catalog", Value: *v1beta1.NewStructuredValues(catalog)})
+		}
+		params = append(params,
+			v1beta1.Param{Name: "kind", Value: *v1beta1.NewStructuredValues(kind)},
+			v1beta1.Param{Name: "name", Value: *v1beta1.NewStructuredValues(name)},
+			v1beta1.Param{Name: "version", Value: *v1beta1.NewStructuredValues(version)},
+		)
+		return &Ref{Resolver: "hub", Name: name, Params: params}, nil
+	}
+	return nil, fmt.Errorf("invalid reference %s, it must be like bundle://registry/repo:tag#name or hub://catalog/name@version", s)
+}
+
+// SetParams sets additional params of the resolver given as key=value, e.g.
+// serviceAccount=puller, replacing those of the same name
+func (r *Ref) SetParams(params []string) error {
+	for _, p := range params {
+		key, value, ok := strings.Cut(p, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("invalid resolver param %s, it must be key=value", p)
+		}
+		r.Params = setParam(r.Params, key, value)
+	}
+	return nil
+}
+
+func setParam(params v1beta1.Params, name, value string) v1beta1.Params {
+	for i := range params {
+		if params[i].Name == name {
+			params[i].Value = *v1beta1.NewStructuredValues(value)
+			return params
+		}
+	}
+	return append(params, v1beta1.Param{Name: name, Value: *v1beta1.NewStructuredValues(value)})
+}
+
+// ResolverRef returns the reference of the resolver for a TaskRef or a
+// PipelineRef
+func (r Ref) ResolverRef() v1beta1.ResolverRef {
+	return v1beta1.ResolverRef{Resolver: v1beta1.ResolverName(r.Resolver), Params: r.Params}
+}
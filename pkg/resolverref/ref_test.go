@@ -0,0 +1,80 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolverref
+
+import (
+	"testing"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"gotest.tools/v3/assert"
+)
+
+func param(name, value string) v1beta1.Param {
+	return v1beta1.Param{Name: name, Value: *v1beta1.NewStructuredValues(value)}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		ref     string
+		want    *Ref
+		wantErr bool
+	}{
+		{
+			ref: "bundle://gcr.io/org/tasks:v1#build",
+			want: &Ref{Resolver: "bundles", Name: "build", Params: v1beta1.Params{
+				param("bundle", "gcr.io/org/tasks:v1"), param("name", "build"), param("kind", "task"),
+			}},
+		},
+		{
+			ref: "hub://tekton/git-clone@0.9",
+			want: &Ref{Resolver: "hub", Name: "git-clone", Params: v1beta1.Params{
+				param("catalog", "tekton"), param("kind", "task"), param("name", "git-clone"), param("version", "0.9"),
+			}},
+		},
+		{
+			ref: "hub://git-clone@0.9",
+			want: &Ref{Resolver: "hub", Name: "git-clone", Params: v1beta1.Params{
+				param("kind", "task"), param("name", "git-clone"), param("version", "0.9"),
+			}},
+		},
+		{ref: "bundle://gcr.io/org/tasks:v1", wantErr: true},
+		{ref: "bundle://#build", wantErr: true},
+		{ref: "hub://tekton/git-clone", wantErr: true},
+		{ref: "hub://tekton/git/clone@0.9", wantErr: true},
+		{ref: "oci://gcr.io/org/tasks:v1#build", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got, err := Parse(tt.ref, "task")
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "invalid")
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
+
+func TestRef_SetParams(t *testing.T) {
+	ref, err := Parse("hub://tekton/git-clone@0.9", "task")
+	assert.NilError(t, err)
+	assert.NilError(t, ref.SetParams([]string{"version=0.10", "type=artifact"}))
+	assert.DeepEqual(t, ref.Params, v1beta1.Params{
+		param("catalog", "tekton"), param("kind", "task"), param("name", "git-clone"), param("version", "0.10"), param("type", "artifact"),
+	})
+	assert.ErrorContains(t, ref.SetParams([]string{"version"}), "invalid resolver param version")
+}
@@ -0,0 +1,284 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package results is a client for the REST API of Tekton Results
+package results
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/config"
+	"github.com/tektoncd/cli/pkg/keyring"
+)
+
+const (
+	recordsAPIPath = "/apis/results.tekton.dev/v1alpha2"
+	logsAPIPath    = "/apis/results.tekton.dev/v1alpha3"
+	defaultTimeout = 30 * time.Second
+)
+
+// Options configures how to reach the Results API
+type Options struct {
+	// Addr is the base URL of the REST endpoint of the Results API
+	Addr string
+	// Token is sent as a bearer token with every request
+	Token string
+	// InsecureSkipTLSVerify disables the verification of the certificate
+	// of the Results API
+	InsecureSkipTLSVerify bool
+}
+
+// TokenEnv is the environment variable the token can be passed in
+const TokenEnv = "TKN_RESULTS_TOKEN"
+
+// OptionsOf returns the Options of profile, the token is read from
+// $TKN_RESULTS_TOKEN or else from the keychain
+func OptionsOf(profile config.Profile) Options {
+	opts := Options{
+		Addr:                  profile.Results.Addr,
+		InsecureSkipTLSVerify: profile.Results.InsecureSkipTLSVerify,
+		Token:                 os.Getenv(TokenEnv),
+	}
+	if opts.Token == "" {
+		opts.Token = keyring.Lookup(profile, keyring.Results)
+	}
+	return opts
+}
+
+// Client talks to the REST endpoint of the Results API
+type Client struct {
+	http  *http.Client
+	base  *url.URL
+	token string
+}
+
+// NewClient returns a Client for the Results API described by opts
+func NewClient(opts Options) (*Client, error) {
+	if opts.Addr == "" {
+		return nil, fmt.Errorf("the address of the Results API is not set, use --addr or the results.addr setting of the tkn config")
+	}
+	base, err := url.Parse(opts.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q of the Results API: %w", opts.Addr, err)
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return nil, fmt.Errorf("invalid address %q of the Results API: only the REST endpoint is supported, over http or https", opts.Addr)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.InsecureSkipTLSVerify {
+		// nolint: gosec
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return &Client{
+		http:  &http.Client{Transport: transport},
+		base:  base,
+		token: opts.Token,
+	}, nil
+}
+
+// Summary is the summary of the run a Result is about
+type Summary struct {
+	Record    string    `json:"record"`
+	Type      string    `json:"type"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	Status    string    `json:"status"`
+}
+
+// Result groups the records of a run and of the runs it created
+type Result struct {
+	Name        string            `json:"name"`
+	UID         string            `json:"uid"`
+	CreateTime  time.Time         `json:"createTime"`
+	UpdateTime  time.Time         `json:"updateTime"`
+	Annotations map[string]string `json:"annotations"`
+	Summary     *Summary          `json:"summary"`
+}
+
+// RecordData is the content of a Record, Value holds the stored object
+type RecordData struct {
+	Type  string `json:"type"`
+	Value []byte `json:"value"`
+}
+
+// Record is an object stored by Results, e.g. a PipelineRun or a Log
+type Record struct {
+	Name       string     `json:"name"`
+	UID        string     `json:"uid"`
+	Data       RecordData `json:"data"`
+	CreateTime time.Time  `json:"createTime"`
+	UpdateTime time.Time  `json:"updateTime"`
+}
+
+// ListResults returns the results of parent, usually a namespace, matching
+// the CEL filter
+func (c *Client) ListResults(ctx context.Context, parent, filter string) ([]Result, error) {
+	results := []Result{}
+	err := c.list(ctx, fmt.Sprintf("%s/parents/%s/results", recordsAPIPath, parent), filter, func(b []byte) (string, error) {
+		var page struct {
+			Results       []Result `json:"results"`
+			NextPageToken string   `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(b, &page); err != nil {
+			return "", err
+		}
+		results = append(results, page.Results...)
+		return page.NextPageToken, nil
+	})
+	return results, err
+}
+
+// ListRecords returns the records of a result, named parent/results/result,
+// matching the CEL filter, "-" can be used as result to list the records of
+// all the results of parent
+func (c *Client) ListRecords(ctx context.Context, result, filter string) ([]Record, error) {
+	records := []Record{}
+	err := c.list(ctx, fmt.Sprintf("%s/parents/%s/records", recordsAPIPath, result), filter, func(b []byte) (string, error) {
+		var page struct {
+			Records       []Record `json:"records"`
+			NextPageToken string   `json:"nextPageToken"`
+		}
+		if err := json.Unmarshal(b, &page); err != nil {
+			return "", err
+		}
+		records = append(records, page.Records...)
+		return page.NextPageToken, nil
+	})
+	return records, err
+}
+
+// GetRecord returns the record with the given name, i.e.
+// parent/results/result/records/record
+func (c *Client) GetRecord(ctx context.Context, name string) (*Record, error) {
+	b, err := c.get(ctx, fmt.Sprintf("%s/parents/%s", recordsAPIPath, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	record := &Record{}
+	if err := json.Unmarshal(b, record); err != nil {
+		return nil, fmt.Errorf("failed to decode record %s: %w", name, err)
+	}
+	return record, nil
+}
+
+// GetLog returns the content of the log stored for the record with the
+// given name, i.e. parent/results/result/logs/log, the log is streamed
+// until the returned reader is closed
+func (c *Client) GetLog(ctx context.Context, name string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, fmt.Sprintf("%s/parents/%s", logsAPIPath, name), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// GetCompressedLog asks for the content of a log record compressed with one
+// of the encodings of acceptEncoding, the body of the response is left as
+// transferred, compressed when its Content-Encoding is set
+func (c *Client) GetCompressedLog(ctx context.Context, name, acceptEncoding string) (*http.Response, error) {
+	return c.do(ctx, fmt.Sprintf("%s/parents/%s", logsAPIPath, name), nil, http.Header{"Accept-Encoding": {acceptEncoding}})
+}
+
+// LogName returns the name of the log record of a run record
+func LogName(record string) string {
+	return strings.Replace(record, "/records/", "/logs/", 1)
+}
+
+func (c *Client) list(ctx context.Context, path, filter string, page func([]byte) (string, error)) error {
+	token := ""
+	for {
+		query := url.Values{}
+		query.Set("page_size", strconv.Itoa(100))
+		if filter != "" {
+			query.Set("filter", filter)
+		}
+		if token != "" {
+			query.Set("page_token", token)
+		}
+
+		b, err := c.get(ctx, path, query)
+		if err != nil {
+			return err
+		}
+		if token, err = page(b); err != nil {
+			return fmt.Errorf("failed to decode response of the Results API: %w", err)
+		}
+		if token == "" {
+			return nil
+		}
+	}
+}
+
+func (c *Client) get(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	resp, err := c.do(ctx, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+func (c *Client) do(ctx context.Context, path string, query url.Values, header http.Header) (*http.Response, error) {
+	u := c.base.JoinPath(path)
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach the Results API: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, apiError(resp)
+	}
+	return resp, nil
+}
+
+// apiError turns the status of the gateway of the Results API into an error
+func apiError(resp *http.Response) error {
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	var status struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(b, &status); err == nil && status.Message != "" {
+		return fmt.Errorf("results API returned %s: %s", resp.Status, status.Message)
+	}
+	return fmt.Errorf("results API returned %s", resp.Status)
+}
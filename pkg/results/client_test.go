@@ -0,0 +1,85 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package results
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNewClient_invalidAddr(t *testing.T) {
+	_, err := NewClient(Options{})
+	assert.ErrorContains(t, err, "the address of the Results API is not set")
+
+	_, err = NewClient(Options{Addr: "grpc://results:50051"})
+	assert.ErrorContains(t, err, "only the REST endpoint is supported, over http or https")
+}
+
+func TestClient_ListResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/apis/results.tekton.dev/v1alpha2/parents/ns/results")
+		assert.Equal(t, r.Header.Get("Authorization"), "Bearer secret")
+		assert.Equal(t, r.URL.Query().Get("filter"), "summary.status == FAILURE")
+		if r.URL.Query().Get("page_token") == "" {
+			_, _ = io.WriteString(w, `{"results":[{"name":"ns/results/a"}],"nextPageToken":"next"}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{"results":[{"name":"ns/results/b","summary":{"type":"tekton.dev/v1.PipelineRun"}}]}`)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(Options{Addr: srv.URL, Token: "secret"})
+	assert.NilError(t, err)
+
+	results, err := c.ListResults(context.Background(), "ns", "summary.status == FAILURE")
+	assert.NilError(t, err)
+	assert.Equal(t, len(results), 2)
+	assert.Equal(t, results[0].Name, "ns/results/a")
+	assert.Equal(t, results[1].Summary.Type, "tekton.dev/v1.PipelineRun")
+}
+
+func TestClient_GetRecord(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/apis/results.tekton.dev/v1alpha2/parents/ns/results/a/records/b":
+			// value is base64 encoded as proto bytes are
+			_, _ = io.WriteString(w, `{"name":"ns/results/a/records/b","data":{"type":"tekton.dev/v1.TaskRun","value":"eyJraW5kIjoiVGFza1J1biJ9"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"code":5,"message":"record not found"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(Options{Addr: srv.URL})
+	assert.NilError(t, err)
+
+	record, err := c.GetRecord(context.Background(), "ns/results/a/records/b")
+	assert.NilError(t, err)
+	assert.Equal(t, record.Data.Type, "tekton.dev/v1.TaskRun")
+	assert.Equal(t, string(record.Data.Value), `{"kind":"TaskRun"}`)
+
+	_, err = c.GetRecord(context.Background(), "ns/results/a/records/c")
+	assert.Error(t, err, "results API returned 404 Not Found: record not found")
+}
+
+func TestLogName(t *testing.T) {
+	assert.Equal(t, LogName("ns/results/a/records/b"), "ns/results/a/logs/b")
+}
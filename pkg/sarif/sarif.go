@@ -0,0 +1,137 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sarif writes findings in the SARIF 2.1.0 format understood by
+// GitHub code scanning and other static analysis tools
+package sarif
+
+import (
+	"encoding/json"
+	"io"
+)
+
+const (
+	schema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	version = "2.1.0"
+)
+
+// Levels of a Result
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+	LevelNote    = "note"
+)
+
+// Log is the top level object of a SARIF file
+type Log struct {
+	Schema  string `json:"$schema"`
+	Version string `json:"version"`
+	Runs    []Run  `json:"runs"`
+}
+
+// Run is a single invocation of a tool
+type Run struct {
+	Tool    Tool     `json:"tool"`
+	Results []Result `json:"results"`
+}
+
+// Tool describes the tool which produced the results
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+// Driver describes the tool and the rules it checks
+type Driver struct {
+	Name           string `json:"name"`
+	Version        string `json:"version,omitempty"`
+	InformationURI string `json:"informationUri,omitempty"`
+	Rules          []Rule `json:"rules"`
+}
+
+// Rule describes a check of the tool
+type Rule struct {
+	ID                   string        `json:"id"`
+	Name                 string        `json:"name,omitempty"`
+	ShortDescription     Message       `json:"shortDescription"`
+	DefaultConfiguration Configuration `json:"defaultConfiguration"`
+}
+
+// Configuration holds the default level of a Rule
+type Configuration struct {
+	Level string `json:"level"`
+}
+
+// Message is a plain text message
+type Message struct {
+	Text string `json:"text"`
+}
+
+// Result is a finding of a Rule
+type Result struct {
+	RuleID    string     `json:"ruleId"`
+	Level     string     `json:"level"`
+	Message   Message    `json:"message"`
+	Locations []Location `json:"locations,omitempty"`
+}
+
+// Location is where a Result was found
+type Location struct {
+	PhysicalLocation *PhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []LogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+// PhysicalLocation is a file, and optionally a region of it
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+}
+
+// ArtifactLocation is the URI of a file, relative to the root of the
+// repository when the file is part of it
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// LogicalLocation is a named element, e.g. a Pipeline task
+type LogicalLocation struct {
+	Name               string `json:"name"`
+	FullyQualifiedName string `json:"fullyQualifiedName,omitempty"`
+	Kind               string `json:"kind,omitempty"`
+}
+
+// NewLog returns a Log with a single run of the tool
+func NewLog(driver Driver, results []Result) *Log {
+	if driver.Rules == nil {
+		driver.Rules = []Rule{}
+	}
+	if results == nil {
+		results = []Result{}
+	}
+	return &Log{
+		Schema:  schema,
+		Version: version,
+		Runs: []Run{
+			{
+				Tool:    Tool{Driver: driver},
+				Results: results,
+			},
+		},
+	}
+}
+
+// Write writes the Log as indented JSON
+func (l *Log) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(l)
+}
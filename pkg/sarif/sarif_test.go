@@ -0,0 +1,60 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+)
+
+func TestLog_Write(t *testing.T) {
+	log := NewLog(Driver{
+		Name:           "tkn-pipeline-lint",
+		InformationURI: "https://github.com/tektoncd/cli",
+		Rules: []Rule{
+			{
+				ID:                   "TKN001",
+				Name:                 "unknown-run-after",
+				ShortDescription:     Message{Text: "runAfter references a task which is not part of the Pipeline"},
+				DefaultConfiguration: Configuration{Level: LevelError},
+			},
+		},
+	}, []Result{
+		{
+			RuleID:  "TKN001",
+			Level:   LevelError,
+			Message: Message{Text: "task test runs after build which is not a task of the Pipeline"},
+			Locations: []Location{
+				{
+					PhysicalLocation: &PhysicalLocation{ArtifactLocation: ArtifactLocation{URI: "tekton/pipeline.yaml"}},
+					LogicalLocations: []LogicalLocation{{Name: "build", FullyQualifiedName: "pipelines/build/tasks/test", Kind: "object"}},
+				},
+			},
+		},
+	})
+
+	out := &bytes.Buffer{}
+	assert.NilError(t, log.Write(out))
+	golden.Assert(t, out.String(), "log.golden")
+}
+
+func TestNewLog_empty(t *testing.T) {
+	out := &bytes.Buffer{}
+	assert.NilError(t, NewLog(Driver{Name: "tkn-pipeline-lint"}, nil).Write(out))
+	golden.Assert(t, out.String(), "empty.golden")
+}
@@ -0,0 +1,180 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	lockSuffix = ".lock"
+	tmpPrefix  = ".tmp-"
+)
+
+var (
+	// lockTimeout is how long to wait for the lock of a key
+	lockTimeout = 5 * time.Second
+	// lockRetry is the interval at which a held lock is checked
+	lockRetry = 10 * time.Millisecond
+)
+
+// FileStore is a Store keeping each key in a file of a directory. Keys are
+// locked with the file locks of the system on lock files, released when the
+// process holding them dies, and values are replaced atomically so readers
+// never see a partial value.
+type FileStore struct {
+	dir string
+}
+
+var _ Store = (*FileStore)(nil)
+
+// NewFileStore returns a Store keeping its files in dir, dir is created on
+// the first write
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{dir: dir}
+}
+
+func (s *FileStore) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
+
+// Get returns the value of key or ErrNotFound
+func (s *FileStore) Get(key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	b, err := os.ReadFile(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return b, err
+}
+
+// Put sets the value of key
+func (s *FileStore) Put(key string, value []byte) error {
+	return s.Update(key, func([]byte) ([]byte, error) {
+		return value, nil
+	})
+}
+
+// Delete removes key
+func (s *FileStore) Delete(key string) error {
+	return s.Update(key, func([]byte) ([]byte, error) {
+		return nil, nil
+	})
+}
+
+// Update replaces the value of key with the value returned by fn while
+// holding the lock of key
+func (s *FileStore) Update(key string, fn func([]byte) ([]byte, error)) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	unlock, err := lock(path + lockSuffix)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	old, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	value, err := fn(old)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	return writeAtomic(path, value)
+}
+
+// List returns the sorted keys starting with prefix
+func (s *FileStore) List(prefix string) ([]string, error) {
+	keys := []string{}
+	err := filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasSuffix(d.Name(), lockSuffix) || strings.HasPrefix(d.Name(), tmpPrefix) {
+			return nil
+		}
+		rel, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	sort.Strings(keys)
+	return keys, err
+}
+
+// lock takes the lock of the lock file at path, waiting for lockTimeout
+// while it is held by someone else, and returns the function releasing it
+func lock(path string) (func(), error) {
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		unlock, ok, err := tryLock(path)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return unlock, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for lock %s, another tkn process holds it", path)
+		}
+		time.Sleep(lockRetry)
+	}
+}
+
+func writeAtomic(path string, value []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), tmpPrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(value); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
@@ -0,0 +1,37 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestFileStore_leftLock(t *testing.T) {
+	dir := t.TempDir()
+	s := NewFileStore(dir)
+	// the lock file of a process which died holding the lock
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "counter"+lockSuffix), []byte("1\n"), 0o600))
+
+	assert.NilError(t, s.Put("counter", []byte("1")))
+	v, err := s.Get("counter")
+	assert.NilError(t, err)
+	assert.Equal(t, string(v), "1")
+}
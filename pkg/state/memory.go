@@ -0,0 +1,100 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MemoryStore is a Store kept in memory, used in tests
+type MemoryStore struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{values: map[string][]byte{}}
+}
+
+// Get returns the value of key or ErrNotFound
+func (s *MemoryStore) Get(key string) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return append([]byte{}, v...), nil
+}
+
+// Put sets the value of key
+func (s *MemoryStore) Put(key string, value []byte) error {
+	return s.Update(key, func([]byte) ([]byte, error) {
+		return value, nil
+	})
+}
+
+// Delete removes key
+func (s *MemoryStore) Delete(key string) error {
+	return s.Update(key, func([]byte) ([]byte, error) {
+		return nil, nil
+	})
+}
+
+// Update replaces the value of key with the value returned by fn
+func (s *MemoryStore) Update(key string, fn func([]byte) ([]byte, error)) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var old []byte
+	if v, ok := s.values[key]; ok {
+		old = append([]byte{}, v...)
+	}
+	value, err := fn(old)
+	if err != nil {
+		return err
+	}
+	if value == nil {
+		delete(s.values, key)
+		return nil
+	}
+	s.values[key] = append([]byte{}, value...)
+	return nil
+}
+
+// List returns the sorted keys starting with prefix
+func (s *MemoryStore) List(prefix string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := []string{}
+	for k := range s.values {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
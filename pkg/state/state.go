@@ -0,0 +1,87 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package state stores what tkn keeps between invocations, such as
+// completion caches, as opposed to the configuration written by users
+package state
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	stateDirEnv = "TKN_STATE_DIR"
+	stateDir    = "~/.tkn/state"
+)
+
+// ErrNotFound is returned when a key has no value
+var ErrNotFound = errors.New("state not found")
+
+// Store is a key value store of the state of tkn. Keys are slash separated
+// paths such as completion/pipelineruns. Implementations are safe to use
+// from several goroutines, and from several tkn processes when they are
+// backed by shared storage.
+type Store interface {
+	// Get returns the value of key or ErrNotFound
+	Get(key string) ([]byte, error)
+	// Put sets the value of key
+	Put(key string, value []byte) error
+	// Delete removes key, deleting a missing key is not an error
+	Delete(key string) error
+	// Update replaces the value of key with the value returned by fn while
+	// holding the lock of key, the value passed to fn is nil for a missing
+	// key and key is deleted when fn returns nil
+	Update(key string, fn func(value []byte) ([]byte, error)) error
+	// List returns the sorted keys starting with prefix
+	List(prefix string) ([]string, error)
+}
+
+// Dir returns the directory the state is stored in: $TKN_STATE_DIR when set,
+// $XDG_STATE_HOME/tkn when XDG_STATE_HOME is set, ~/.tkn/state otherwise
+func Dir() (string, error) {
+	if dir := os.Getenv(stateDirEnv); dir != "" {
+		return dir, nil
+	}
+	if xdgState := os.Getenv("XDG_STATE_HOME"); xdgState != "" {
+		return filepath.Join(xdgState, "tkn"), nil
+	}
+	return homedir.Expand(stateDir)
+}
+
+// Open returns the Store backed by the state directory
+func Open() (Store, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	return NewFileStore(dir), nil
+}
+
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.HasSuffix(key, "/") {
+		return fmt.Errorf("invalid state key %q", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." || strings.HasSuffix(part, lockSuffix) || strings.HasPrefix(part, tmpPrefix) {
+			return fmt.Errorf("invalid state key %q", key)
+		}
+	}
+	return nil
+}
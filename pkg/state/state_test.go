@@ -0,0 +1,122 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestStores(t *testing.T) {
+	stores := map[string]func(t *testing.T) Store{
+		"file":   func(t *testing.T) Store { return NewFileStore(t.TempDir()) },
+		"memory": func(*testing.T) Store { return NewMemoryStore() },
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			s := newStore(t)
+
+			_, err := s.Get("completion/pipelineruns")
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			assert.NilError(t, s.Put("completion/pipelineruns", []byte("pr-1 pr-2")))
+			assert.NilError(t, s.Put("completion/taskruns", []byte("tr-1")))
+			assert.NilError(t, s.Put("checkpoints/start", []byte("{}")))
+
+			v, err := s.Get("completion/pipelineruns")
+			assert.NilError(t, err)
+			assert.Equal(t, string(v), "pr-1 pr-2")
+
+			keys, err := s.List("completion/")
+			assert.NilError(t, err)
+			assert.DeepEqual(t, keys, []string{"completion/pipelineruns", "completion/taskruns"})
+
+			assert.NilError(t, s.Update("completion/taskruns", func(old []byte) ([]byte, error) {
+				return append(old, []byte(" tr-2")...), nil
+			}))
+			v, err = s.Get("completion/taskruns")
+			assert.NilError(t, err)
+			assert.Equal(t, string(v), "tr-1 tr-2")
+
+			assert.NilError(t, s.Delete("completion/taskruns"))
+			assert.NilError(t, s.Delete("completion/taskruns"))
+			_, err = s.Get("completion/taskruns")
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			for _, key := range []string{"", "/abs", "dir/", "a/../b", "a//b", "a.lock"} {
+				assert.ErrorContains(t, s.Put(key, []byte("x")), "invalid state key")
+			}
+		})
+	}
+}
+
+func TestFileStore_concurrentUpdates(t *testing.T) {
+	dir := t.TempDir()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// each goroutine uses its own store, as separate processes would
+			s := NewFileStore(dir)
+			err := s.Update("counter", func(old []byte) ([]byte, error) {
+				n, _ := strconv.Atoi(string(old))
+				return []byte(strconv.Itoa(n + 1)), nil
+			})
+			assert.Check(t, err)
+		}()
+	}
+	wg.Wait()
+
+	v, err := NewFileStore(dir).Get("counter")
+	assert.NilError(t, err)
+	assert.Equal(t, string(v), "20")
+}
+
+func TestFileStore_locks(t *testing.T) {
+	oldTimeout := lockTimeout
+	lockTimeout = 50 * time.Millisecond
+	defer func() { lockTimeout = oldTimeout }()
+
+	dir := t.TempDir()
+	s := NewFileStore(dir)
+	unlock, err := lock(filepath.Join(dir, "counter"+lockSuffix))
+	assert.NilError(t, err)
+
+	err = s.Put("counter", []byte("1"))
+	assert.ErrorContains(t, err, "timed out waiting for lock")
+
+	unlock()
+	assert.NilError(t, s.Put("counter", []byte("1")))
+}
+
+func TestDir(t *testing.T) {
+	t.Setenv("TKN_STATE_DIR", "/tmp/tkn-state")
+	t.Setenv("XDG_STATE_HOME", "/tmp/xdg")
+	dir, err := Dir()
+	assert.NilError(t, err)
+	assert.Equal(t, dir, "/tmp/tkn-state")
+
+	t.Setenv("TKN_STATE_DIR", "")
+	dir, err = Dir()
+	assert.NilError(t, err)
+	assert.Equal(t, dir, filepath.Join("/tmp/xdg", "tkn"))
+}
@@ -0,0 +1,114 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScaffoldOptions describe the Task generated by Scaffold
+type ScaffoldOptions struct {
+	Name        string
+	Description string
+	Image       string
+	Script      string
+	// Params are names, optionally followed by =default
+	Params     []string
+	Workspaces []string
+	Results    []string
+}
+
+// Scaffold returns a valid Task with a single step and stubs for the
+// params, workspaces and results, params are passed to the step through
+// environment variables rather than being substituted in the script
+func Scaffold(opts ScaffoldOptions) (*v1.Task, error) {
+	task := &v1.Task{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "tekton.dev/v1",
+			Kind:       "Task",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: opts.Name,
+			Labels: map[string]string{
+				"app.kubernetes.io/version": "0.1",
+			},
+		},
+		Spec: v1.TaskSpec{
+			Description: opts.Description,
+		},
+	}
+	if task.Spec.Description == "" {
+		task.Spec.Description = fmt.Sprintf("TODO: describe what %s does", opts.Name)
+	}
+
+	step := v1.Step{
+		Name:   "run",
+		Image:  opts.Image,
+		Script: opts.Script,
+	}
+	if step.Script == "" {
+		step.Script = fmt.Sprintf("#!/bin/sh\nset -e\necho \"TODO: implement %s\"\n", opts.Name)
+	}
+
+	for _, p := range opts.Params {
+		name, def, hasDefault := strings.Cut(p, "=")
+		spec := v1.ParamSpec{
+			Name:        name,
+			Type:        v1.ParamTypeString,
+			Description: fmt.Sprintf("TODO: describe %s", name),
+		}
+		if hasDefault {
+			spec.Default = v1.NewStructuredValues(def)
+		}
+		task.Spec.Params = append(task.Spec.Params, spec)
+		step.Env = append(step.Env, corev1.EnvVar{
+			Name:  EnvName(name),
+			Value: fmt.Sprintf("$(params.%s)", name),
+		})
+	}
+
+	for _, w := range opts.Workspaces {
+		task.Spec.Workspaces = append(task.Spec.Workspaces, v1.WorkspaceDeclaration{
+			Name:        w,
+			Description: fmt.Sprintf("TODO: describe %s, it is mounted at $(workspaces.%s.path)", w, w),
+		})
+	}
+
+	for _, r := range opts.Results {
+		task.Spec.Results = append(task.Spec.Results, v1.TaskResult{
+			Name:        r,
+			Type:        v1.ResultsTypeString,
+			Description: fmt.Sprintf("TODO: describe %s, write it to $(results.%s.path)", r, r),
+		})
+	}
+
+	task.Spec.Steps = []v1.Step{step}
+	if err := task.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid Task: %w", err)
+	}
+	return task, nil
+}
+
+// EnvName turns a param name into the name of an environment variable, e.g.
+// git-url becomes GIT_URL
+func EnvName(param string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(param))
+}
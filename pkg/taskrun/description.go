@@ -19,18 +19,23 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"text/tabwriter"
 	"text/template"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/conditions"
+	"github.com/tektoncd/cli/pkg/dashboard"
 	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/pods"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
 )
 
 const templ = `{{decorate "bold" "Name"}}:	{{ .TaskRun.Name }}
@@ -89,14 +94,18 @@ STARTED 	DURATION 	STATUS
 {{- end }}
 {{- end }}
 
-{{- if ne (len .TaskRun.Status.Results) 0 }}
+{{- if ne (len .Results) 0 }}
 
 {{decorate "results" ""}}{{decorate "underline bold" "Results"}}
 
  NAME	VALUE
-{{- range $result := .TaskRun.Status.Results }}
+{{- range $result := .Results }}
  {{decorate "bullet" $result.Name }}	{{ formatResult $result.Value }}
 {{- end }}
+{{- if .Captured }}
+
+ Results missing from the status were read from the containers of the steps while following the logs
+{{- end }}
 {{- end }}
 
 {{- if ne (len .TaskRun.Spec.Workspaces) 0 }}
@@ -140,58 +149,49 @@ STARTED 	DURATION 	STATUS
 
 func sortStepStatesByStartTime(steps []v1.StepState) []v1.StepState {
 	sort.Slice(steps, func(i, j int) bool {
-		if steps[j].Waiting != nil && steps[i].Waiting != nil {
+		si := pods.StatusOfState(steps[i].Name, steps[i].ContainerState, steps[i].ImageID)
+		sj := pods.StatusOfState(steps[j].Name, steps[j].ContainerState, steps[j].ImageID)
+		if si.State == pods.ContainerWaiting && sj.State == pods.ContainerWaiting {
 			return false
 		}
-
-		var jStartTime metav1.Time
-		jRunning := false
-		var iStartTime metav1.Time
-		iRunning := false
-		if steps[j].Terminated == nil {
-			if steps[j].Running != nil {
-				jStartTime = steps[j].Running.StartedAt
-				jRunning = true
-			} else {
-				return true
-			}
-		}
-
-		if steps[i].Terminated == nil {
-			if steps[i].Running != nil {
-				iStartTime = steps[i].Running.StartedAt
-				iRunning = true
-			} else {
-				return false
-			}
-		}
-
-		if !jRunning {
-			jStartTime = steps[j].Terminated.StartedAt
+		// the steps which have not started are kept last
+		if !started(sj) {
+			return true
 		}
-
-		if !iRunning {
-			iStartTime = steps[i].Terminated.StartedAt
+		if !started(si) {
+			return false
 		}
-
-		return iStartTime.Before(&jStartTime)
+		return si.StartedAt.Before(sj.StartedAt)
 	})
 
 	return steps
 }
 
+func started(cs pods.ContainerStatus) bool {
+	return cs.State == pods.ContainerRunning || cs.State == pods.ContainerTerminated
+}
+
 func PrintTaskRunDescription(out io.Writer, c *cli.Clients, ns string, trName string, time clockwork.Clock) error {
 	tr, err := GetTaskRun(taskrunGroupResource, c, trName, ns)
 	if err != nil {
-		return fmt.Errorf("failed to get TaskRun %s: %v", trName, err)
+		return fmt.Errorf("failed to get TaskRun %s: %w", trName, err)
 	}
 
+	// the results captured while following the logs are best effort, they
+	// are left out when they cannot be read
+	captured, _ := GetCapturedResults(ns, trName)
+	results, withCaptured := WithCapturedResults(tr, captured)
+
 	var data = struct {
-		TaskRun *v1.TaskRun
-		Time    clockwork.Clock
+		TaskRun  *v1.TaskRun
+		Time     clockwork.Clock
+		Results  []v1.TaskRunResult
+		Captured bool
 	}{
-		TaskRun: tr,
-		Time:    time,
+		TaskRun:  tr,
+		Time:     time,
+		Results:  results,
+		Captured: withCaptured,
 	}
 
 	funcMap := template.FuncMap{
@@ -241,37 +241,123 @@ func getTimeoutValue(tr *v1.TaskRun) string {
 
 // Check if step is in waiting, running, or terminated state by checking StepState of the step.
 func stepReasonExists(state v1.StepState) string {
-	if state.Waiting == nil {
-		if state.Running != nil {
-			return formatted.ColorStatus("Running")
-		}
+	return containerReason(pods.StatusOfState(state.Name, state.ContainerState, state.ImageID))
+}
 
-		if state.Terminated != nil {
-			return formatted.ColorStatus(state.Terminated.Reason)
-		}
+// Check if sidecar is in waiting, running, or terminated state by checking SidecarState of the sidecar.
+func sidecarReasonExists(state v1.SidecarState) string {
+	return containerReason(pods.StatusOfState(state.Name, state.ContainerState, state.ImageID))
+}
 
-		return formatted.ColorStatus("---")
+func containerReason(cs pods.ContainerStatus) string {
+	switch cs.State {
+	case pods.ContainerWaiting, pods.ContainerTerminated:
+		return formatted.ColorStatus(cs.Reason)
+	case pods.ContainerRunning:
+		return formatted.ColorStatus("Running")
 	}
+	return formatted.ColorStatus("---")
+}
 
-	return formatted.ColorStatus(state.Waiting.Reason)
+// PrintTaskRunLink prints the link to the TaskRun in the Tekton Dashboard
+func PrintTaskRunLink(out io.Writer, c *cli.Clients, ns string, trName string, links *dashboard.Links) error {
+	tr, err := GetTaskRun(taskrunGroupResource, c, trName, ns)
+	if err != nil {
+		return cli.WithCause(err, "failed to find taskrun %q", trName)
+	}
+	fmt.Fprintln(out, links.Link(dashboard.KindTaskRun, ns, tr.Name))
+	return nil
 }
 
-// Check if sidecar is in waiting, running, or terminated state by checking SidecarState of the sidecar.
-func sidecarReasonExists(state v1.SidecarState) string {
-	if state.Waiting == nil {
+// PrintTaskRunConditionHistory prints the transitions of the condition of
+// the TaskRun
+func PrintTaskRunConditionHistory(out io.Writer, c *cli.Clients, ns string, trName string, clock clockwork.Clock) error {
+	tr, err := GetTaskRun(taskrunGroupResource, c, trName, ns)
+	if err != nil {
+		return cli.WithCause(err, "failed to find taskrun %q", trName)
+	}
+	history, err := conditions.History(c, ns, "TaskRun", tr.Name, tr.Status.GetCondition(apis.ConditionSucceeded))
+	if err != nil {
+		return fmt.Errorf("failed to get the condition history of TaskRun %s: %w", tr.Name, err)
+	}
+	conditions.PrintHistory(out, history, clock)
+	return nil
+}
 
-		if state.Running != nil {
-			return formatted.ColorStatus("Running")
-		}
+// PrintTaskRunScheduling prints the node the pod of the TaskRun was
+// scheduled on and how it got there
+func PrintTaskRunScheduling(out io.Writer, c *cli.Clients, ns string, trName string) error {
+	tr, err := GetTaskRun(taskrunGroupResource, c, trName, ns)
+	if err != nil {
+		return cli.WithCause(err, "failed to find taskrun %q", trName)
+	}
+	return PrintScheduling(out, c, ns, []*v1.TaskRun{tr})
+}
 
-		if state.Terminated != nil {
-			return formatted.ColorStatus(state.Terminated.Reason)
+// PrintScheduling prints the scheduling of the pods of the TaskRuns, from
+// the pods and from the events of their failed attempts to be scheduled
+func PrintScheduling(out io.Writer, c *cli.Clients, ns string, trs []*v1.TaskRun) error {
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintf(w, "\n%s%s\n\n", formatted.DecorateAttr("scheduling", ""), formatted.DecorateAttr("underline bold", "Scheduling"))
+	if len(trs) == 0 {
+		fmt.Fprintln(w, " No TaskRuns")
+		return w.Flush()
+	}
+	fmt.Fprintln(w, " TASKRUN\tPOD\tNODE\tSCHEDULED AFTER\tDELAYED BY\tNODE SELECTOR\tTOLERATIONS")
+	for _, tr := range trs {
+		if tr.Status.PodName == "" {
+			fmt.Fprintf(w, " %s\t---\t---\t---\t---\t---\t---\n", formatted.DecorateAttr("bullet", tr.Name))
+			continue
+		}
+		s, err := pods.GetScheduling(c.Kube, ns, tr.Status.PodName)
+		if err != nil {
+			return fmt.Errorf("failed to get the scheduling of the pod %s of TaskRun %s: %w", tr.Status.PodName, tr.Name, err)
 		}
+		fmt.Fprintf(w, " %s\t%s\t%s\t%s\t%s\t%s\t%s\n", formatted.DecorateAttr("bullet", tr.Name), s.Pod,
+			orDashes(s.Node), schedulingLatency(s), orDashes(strings.Join(s.Delays, ", ")),
+			orDashes(formatNodeSelector(s.NodeSelector)), orDashes(formatTolerations(s.Tolerations)))
+	}
+	return w.Flush()
+}
 
-		return formatted.ColorStatus("---")
+func schedulingLatency(s *pods.Scheduling) string {
+	if !s.Scheduled {
+		return "not scheduled"
 	}
+	return s.Latency.String()
+}
+
+func formatNodeSelector(selector map[string]string) string {
+	var terms []string
+	for k, v := range selector {
+		terms = append(terms, k+"="+v)
+	}
+	sort.Strings(terms)
+	return strings.Join(terms, ",")
+}
 
-	return formatted.ColorStatus(state.Waiting.Reason)
+func formatTolerations(tolerations []corev1.Toleration) string {
+	var terms []string
+	for _, t := range tolerations {
+		term := t.Key
+		if t.Operator == corev1.TolerationOpExists {
+			term += " exists"
+		} else if t.Value != "" {
+			term += "=" + t.Value
+		}
+		if t.Effect != "" {
+			term += ":" + string(t.Effect)
+		}
+		terms = append(terms, term)
+	}
+	return strings.Join(terms, ",")
+}
+
+func orDashes(s string) string {
+	if s == "" {
+		return "---"
+	}
+	return s
 }
 
 func GetTaskRun(gr schema.GroupVersionResource, c *cli.Clients, trName, ns string) (*v1.TaskRun, error) {
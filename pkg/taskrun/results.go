@@ -0,0 +1,125 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/cli/pkg/state"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+)
+
+// ResultsDir is where the steps write the results of their TaskRun
+const ResultsDir = "/tekton/results"
+
+// openStateStore returns the store the captured results are kept in
+var openStateStore = state.Open
+
+// CapturedResults are the results of a TaskRun read from the termination
+// messages of its steps and from the files the steps wrote them to while its
+// pod was running, in full, as the termination messages the controller reads
+// them from are limited to 4KB
+type CapturedResults struct {
+	// Pod is the pod the results were read from, the results of an earlier
+	// attempt of the TaskRun are not those of the TaskRun
+	Pod     string            `json:"pod"`
+	Results map[string]string `json:"results"`
+}
+
+func capturedResultsKey(ns, name string) string {
+	return "results/" + ns + "/" + name
+}
+
+// SaveCapturedResults keeps the results captured from the pod of a TaskRun
+// in the state of tkn
+func SaveCapturedResults(ns, name string, c *CapturedResults) error {
+	store, err := openStateStore()
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return store.Put(capturedResultsKey(ns, name), b)
+}
+
+// GetCapturedResults returns the results captured from the pod of a TaskRun,
+// nil when none were
+func GetCapturedResults(ns, name string) (*CapturedResults, error) {
+	store, err := openStateStore()
+	if err != nil {
+		return nil, err
+	}
+	b, err := store.Get(capturedResultsKey(ns, name))
+	if errors.Is(err, state.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	c := &CapturedResults{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// WithCapturedResults returns the results of the TaskRun completed by those
+// captured from its current pod which are missing from its status, e.g.
+// when they did not fit in the termination messages of the steps. The
+// second value tells whether any was added.
+func WithCapturedResults(tr *v1.TaskRun, c *CapturedResults) ([]v1.TaskRunResult, bool) {
+	results := tr.Status.Results
+	if c == nil || c.Pod != tr.Status.PodName {
+		return results, false
+	}
+
+	known := map[string]bool{}
+	for _, r := range results {
+		known[r.Name] = true
+	}
+	names := make([]string, 0, len(c.Results))
+	for name := range c.Results {
+		if !known[name] {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return results, false
+	}
+	sort.Strings(names)
+
+	results = append([]v1.TaskRunResult{}, results...)
+	for _, name := range names {
+		results = append(results, v1.TaskRunResult{Name: name, Value: resultValue(c.Results[name])})
+	}
+	return results, true
+}
+
+// resultValue reads the content of the file of a result as the entrypoint
+// does, array and object results are written as JSON
+func resultValue(content string) v1.ResultValue {
+	if trimmed := strings.TrimSpace(content); strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "{") {
+		var v v1.ResultValue
+		if err := json.Unmarshal([]byte(trimmed), &v); err == nil {
+			return v
+		}
+	}
+	return *v1.NewStructuredValues(content)
+}
@@ -0,0 +1,76 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/state"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCapturedResults(t *testing.T) {
+	store := state.NewMemoryStore()
+	openStateStore = func() (state.Store, error) { return store, nil }
+	t.Cleanup(func() { openStateStore = state.Open })
+
+	got, err := GetCapturedResults("ns", "release")
+	assert.NilError(t, err)
+	assert.Assert(t, got == nil)
+
+	captured := &CapturedResults{Pod: "release-pod", Results: map[string]string{"digest": "sha256:0123"}}
+	assert.NilError(t, SaveCapturedResults("ns", "release", captured))
+	got, err = GetCapturedResults("ns", "release")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, captured)
+}
+
+func TestWithCapturedResults(t *testing.T) {
+	tr := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "release", Namespace: "ns"},
+		Status: v1.TaskRunStatus{
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				PodName: "release-pod",
+				Results: []v1.TaskRunResult{{Name: "digest", Value: *v1.NewStructuredValues("sha256:0123")}},
+			},
+		},
+	}
+	captured := &CapturedResults{Pod: "release-pod", Results: map[string]string{
+		"digest": "sha256:4567",
+		"images": `["a", "b"]`,
+		"report": "{not json",
+	}}
+
+	got, added := WithCapturedResults(tr, captured)
+	assert.Assert(t, added)
+	assert.DeepEqual(t, got, []v1.TaskRunResult{
+		{Name: "digest", Value: *v1.NewStructuredValues("sha256:0123")},
+		{Name: "images", Value: *v1.NewStructuredValues("a", "b")},
+		{Name: "report", Value: *v1.NewStructuredValues("{not json")},
+	})
+
+	// the results captured from an earlier attempt are not those of the
+	// TaskRun
+	captured.Pod = "release-pod-retry1"
+	got, added = WithCapturedResults(tr, captured)
+	assert.Assert(t, !added)
+	assert.DeepEqual(t, got, tr.Status.Results)
+
+	got, added = WithCapturedResults(tr, nil)
+	assert.Assert(t, !added)
+	assert.DeepEqual(t, got, tr.Status.Results)
+}
@@ -15,8 +15,12 @@
 package taskrun
 
 import (
+	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
 	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -27,6 +31,10 @@ type Run struct {
 	Retries        int
 	StartTime      *metav1.Time
 	CompletionTime *metav1.Time
+	// Finally is true when the run belongs to a finally task of a Pipeline
+	Finally bool
+	// Matrix holds the names of the params the Pipeline task fans out on
+	Matrix []string
 }
 
 type Runs []Run
@@ -58,6 +66,17 @@ func HasScheduled(trs *v1.PipelineRunTaskRunStatus) bool {
 	return false
 }
 
+// WithoutFinally returns the runs which don't belong to finally tasks
+func WithoutFinally(trs []Run) []Run {
+	filtered := []Run{}
+	for _, tr := range trs {
+		if !tr.Finally {
+			filtered = append(filtered, tr)
+		}
+	}
+	return filtered
+}
+
 func Filter(trs []Run, ts []string) []Run {
 	if len(ts) == 0 {
 		return trs
@@ -79,15 +98,18 @@ func Filter(trs []Run, ts []string) []Run {
 }
 
 func SortTasksBySpecOrder(pipelineTasks []v1.PipelineTask, pipelinesTaskRuns map[string]*v1.PipelineRunTaskRunStatus) []Run {
-	trNames := map[string]string{}
+	// a Pipeline task using a matrix fans out to several TaskRuns
+	trNames := map[string][]string{}
 
 	for name, t := range pipelinesTaskRuns {
-		trNames[t.PipelineTaskName] = name
+		trNames[t.PipelineTaskName] = append(trNames[t.PipelineTaskName], name)
 	}
 	trs := Runs{}
 
 	for _, ts := range pipelineTasks {
-		if n, ok := trNames[ts.Name]; ok {
+		names := trNames[ts.Name]
+		sortMatrixNames(names)
+		for _, n := range names {
 			trStatusFields := pipelinesTaskRuns[n].Status.TaskRunStatusFields
 			trs = append(trs, Run{
 				Task:           ts.Name,
@@ -95,9 +117,113 @@ func SortTasksBySpecOrder(pipelineTasks []v1.PipelineTask, pipelinesTaskRuns map
 				Retries:        ts.Retries,
 				StartTime:      trStatusFields.StartTime,
 				CompletionTime: trStatusFields.CompletionTime,
+				Matrix:         MatrixParamNames(ts),
 			})
 		}
 	}
-	sort.Sort(trs)
+	sort.Stable(trs)
 	return trs
 }
+
+// sortMatrixNames sorts the names of the TaskRuns of a Pipeline task in the
+// order of the combinations of its matrix, the index of the combination is
+// the numeric suffix of the name so that -10 comes after -2
+func sortMatrixNames(names []string) {
+	sort.SliceStable(names, func(i, j int) bool {
+		pi, ii := matrixIndex(names[i])
+		pj, ij := matrixIndex(names[j])
+		if pi != pj || ii < 0 || ij < 0 {
+			return names[i] < names[j]
+		}
+		return ii < ij
+	})
+}
+
+// matrixIndex splits the name of a TaskRun in its prefix and the index of
+// its combination, the index is -1 when the name has no numeric suffix
+func matrixIndex(name string) (string, int) {
+	i := strings.LastIndex(name, "-")
+	if i < 0 {
+		return name, -1
+	}
+	n, err := strconv.Atoi(name[i+1:])
+	if err != nil || n < 0 {
+		return name, -1
+	}
+	return name[:i], n
+}
+
+// MatrixParamNames returns the names of the params a Pipeline task fans out
+// on, both from the matrix params and from the explicit combinations
+func MatrixParamNames(pt v1.PipelineTask) []string {
+	if pt.Matrix == nil {
+		return nil
+	}
+
+	names := []string{}
+	seen := map[string]bool{}
+	add := func(params v1.Params) {
+		for _, p := range params {
+			if !seen[p.Name] {
+				seen[p.Name] = true
+				names = append(names, p.Name)
+			}
+		}
+	}
+	add(pt.Matrix.Params)
+	for _, include := range pt.Matrix.Include {
+		add(include.Params)
+	}
+	return names
+}
+
+// MatrixAnnotationPrefix is the prefix of the annotations, or labels, with
+// the value of each matrix param a TaskRun has been created with, e.g.
+// tekton.dev/matrix-arch: arm64
+const MatrixAnnotationPrefix = pipeline.GroupName + "/matrix-"
+
+// MatrixLabel decorates the name of a Pipeline task with the values of the
+// matrix params a TaskRun has been created with, e.g. build[arch=arm64]. The
+// values are read from the matrix annotations and labels of the TaskRun, and
+// from its params when the controller does not set them
+func MatrixLabel(task string, matrix []string, tr *v1.TaskRun) string {
+	if len(matrix) == 0 {
+		return task
+	}
+
+	values := map[string]string{}
+	for _, p := range tr.Spec.Params {
+		if p.Value.Type == v1.ParamTypeString || p.Value.Type == "" {
+			values[p.Name] = p.Value.StringVal
+		}
+	}
+	for _, meta := range []map[string]string{tr.Labels, tr.Annotations} {
+		for k, v := range meta {
+			if name, ok := strings.CutPrefix(k, MatrixAnnotationPrefix); ok {
+				values[name] = v
+			}
+		}
+	}
+
+	labels := []string{}
+	for _, name := range matrix {
+		if v, ok := values[name]; ok {
+			labels = append(labels, fmt.Sprintf("%s=%s", name, v))
+		}
+	}
+	if len(labels) == 0 {
+		return task
+	}
+	return fmt.Sprintf("%s[%s]", task, strings.Join(labels, ","))
+}
+
+// OwnerPipelineRun returns the name of the PipelineRun the TaskRun is part of,
+// from its owner references or else its labels, empty for a standalone run
+func OwnerPipelineRun(tr *v1.TaskRun) string {
+	for _, ref := range tr.GetOwnerReferences() {
+		if ref.Kind == pipeline.PipelineRunControllerName {
+			return ref.Name
+		}
+	}
+	return tr.Labels[pipeline.PipelineRunLabelKey]
+}
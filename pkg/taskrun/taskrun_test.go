@@ -0,0 +1,149 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMatrixParamNames(t *testing.T) {
+	pt := v1.PipelineTask{
+		Name: "build",
+		Matrix: &v1.Matrix{
+			Params: v1.Params{
+				{Name: "arch", Value: *v1.NewStructuredValues("amd64", "arm64")},
+			},
+			Include: v1.IncludeParamsList{
+				{
+					Name: "with-os",
+					Params: v1.Params{
+						{Name: "arch", Value: *v1.NewStructuredValues("s390x")},
+						{Name: "os", Value: *v1.NewStructuredValues("linux")},
+					},
+				},
+			},
+		},
+	}
+
+	test.AssertOutput(t, []string{"arch", "os"}, MatrixParamNames(pt))
+	test.AssertOutput(t, []string(nil), MatrixParamNames(v1.PipelineTask{Name: "test"}))
+}
+
+func TestMatrixLabel(t *testing.T) {
+	params := v1.Params{
+		{Name: "arch", Value: *v1.NewStructuredValues("arm64")},
+		{Name: "os", Value: *v1.NewStructuredValues("linux")},
+		{Name: "flags", Value: *v1.NewStructuredValues("-v", "-race")},
+	}
+
+	testParams := []struct {
+		name     string
+		matrix   []string
+		tr       *v1.TaskRun
+		expected string
+	}{
+		{
+			name:     "no matrix",
+			tr:       &v1.TaskRun{Spec: v1.TaskRunSpec{Params: params}},
+			expected: "build",
+		},
+		{
+			name:     "single param",
+			matrix:   []string{"arch"},
+			tr:       &v1.TaskRun{Spec: v1.TaskRunSpec{Params: params}},
+			expected: "build[arch=arm64]",
+		},
+		{
+			name:     "params in matrix order",
+			matrix:   []string{"os", "arch"},
+			tr:       &v1.TaskRun{Spec: v1.TaskRunSpec{Params: params}},
+			expected: "build[os=linux,arch=arm64]",
+		},
+		{
+			name:   "matrix annotations and labels",
+			matrix: []string{"os", "arch"},
+			tr: &v1.TaskRun{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      map[string]string{"tekton.dev/matrix-os": "darwin"},
+					Annotations: map[string]string{"tekton.dev/matrix-arch": "amd64"},
+				},
+				Spec: v1.TaskRunSpec{Params: params},
+			},
+			expected: "build[os=darwin,arch=amd64]",
+		},
+		{
+			name:     "matrix params missing from the taskrun",
+			matrix:   []string{"arch"},
+			tr:       &v1.TaskRun{},
+			expected: "build",
+		},
+	}
+
+	for _, tp := range testParams {
+		t.Run(tp.name, func(t *testing.T) {
+			test.AssertOutput(t, tp.expected, MatrixLabel("build", tp.matrix, tp.tr))
+		})
+	}
+}
+
+func TestSortTasksBySpecOrder_matrix(t *testing.T) {
+	pipelineTasks := []v1.PipelineTask{
+		{
+			Name: "build",
+			Matrix: &v1.Matrix{
+				Params: v1.Params{
+					{Name: "arch", Value: *v1.NewStructuredValues("amd64", "arm64")},
+				},
+			},
+		},
+		{Name: "test"},
+	}
+	taskRuns := map[string]*v1.PipelineRunTaskRunStatus{
+		"pr-build-10": {PipelineTaskName: "build", Status: &v1.TaskRunStatus{}},
+		"pr-build-1":  {PipelineTaskName: "build", Status: &v1.TaskRunStatus{}},
+		"pr-build-2":  {PipelineTaskName: "build", Status: &v1.TaskRunStatus{}},
+		"pr-build-0":  {PipelineTaskName: "build", Status: &v1.TaskRunStatus{}},
+		"pr-test":     {PipelineTaskName: "test", Status: &v1.TaskRunStatus{}},
+	}
+
+	runs := SortTasksBySpecOrder(pipelineTasks, taskRuns)
+	expected := []Run{
+		{Name: "pr-build-0", Task: "build", Matrix: []string{"arch"}},
+		{Name: "pr-build-1", Task: "build", Matrix: []string{"arch"}},
+		{Name: "pr-build-2", Task: "build", Matrix: []string{"arch"}},
+		{Name: "pr-build-10", Task: "build", Matrix: []string{"arch"}},
+		{Name: "pr-test", Task: "test"},
+	}
+	test.AssertOutput(t, expected, runs)
+}
+
+func TestOwnerPipelineRun(t *testing.T) {
+	owned := &v1.TaskRun{ObjectMeta: metav1.ObjectMeta{
+		OwnerReferences: []metav1.OwnerReference{{Kind: "PipelineRun", Name: "pr-1"}},
+		Labels:          map[string]string{"tekton.dev/pipelineRun": "pr-2"},
+	}}
+	test.AssertOutput(t, "pr-1", OwnerPipelineRun(owned))
+
+	labelled := &v1.TaskRun{ObjectMeta: metav1.ObjectMeta{
+		Labels: map[string]string{"tekton.dev/pipelineRun": "pr-2"},
+	}}
+	test.AssertOutput(t, "pr-2", OwnerPipelineRun(labelled))
+
+	test.AssertOutput(t, "", OwnerPipelineRun(&v1.TaskRun{}))
+}
@@ -0,0 +1,62 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/actions"
+	"github.com/tektoncd/cli/pkg/cli"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// WaitForCompletion watches the TaskRun until it reaches a terminal state
+// and returns it. It gives up after timeout.
+func WaitForCompletion(c *cli.Clients, trname, ns string, timeout time.Duration) (*v1.TaskRun, error) {
+	opts := metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("metadata.name", trname).String(),
+	}
+	w, err := actions.Watch(taskrunGroupResource, c, ns, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer w.Stop()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		// the run may have finished before the watch started, its state
+		// is read again on every event
+		tr, err := GetTaskRun(taskrunGroupResource, c, trname, ns)
+		if err != nil {
+			return nil, err
+		}
+		if tr.IsDone() {
+			return tr, nil
+		}
+
+		select {
+		case _, ok := <-w.ResultChan():
+			if !ok {
+				return nil, fmt.Errorf("watch of TaskRun %s closed before it finished", trname)
+			}
+		case <-timer.C:
+			return nil, fmt.Errorf("timed out after %s waiting for TaskRun %s to finish", timeout, trname)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/formatted"
+	"github.com/tektoncd/cli/pkg/pods"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// WorkspaceBinding is what backs a workspace of a TaskRun and where its
+// steps see it
+type WorkspaceBinding struct {
+	Name string
+	// Kind is the kind of volume bound, e.g. PersistentVolumeClaim
+	Kind string
+	// Resource is the name of the resource backing the volume, e.g. the
+	// claim, the secret or the config map
+	Resource string
+	// Details describe the resource, e.g. the class and size of a claim
+	Details   string
+	MountPath string
+	ReadOnly  bool
+	// Steps are the steps the workspace is mounted in
+	Steps []string
+}
+
+// WorkspaceBindings returns the bindings of the workspaces of the TaskRun.
+// The volumes are read from its pod when it exists, e.g. for the claim
+// created from a template, and the claims from the cluster. The steps of
+// the pod are named with pods.StepNames from the status of the steps of the
+// TaskRun.
+func WorkspaceBindings(kube kubernetes.Interface, tr *v1.TaskRun, stepPrefixes []string) ([]WorkspaceBinding, error) {
+	var pod *corev1.Pod
+	if tr.Status.PodName != "" {
+		p, err := kube.CoreV1().Pods(tr.Namespace).Get(context.Background(), tr.Status.PodName, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get the pod %s of TaskRun %s: %w", tr.Status.PodName, tr.Name, err)
+		}
+		if err == nil {
+			pod = p
+		}
+	}
+
+	bindings := []WorkspaceBinding{}
+	for _, ws := range tr.Spec.Workspaces {
+		b := WorkspaceBinding{Name: ws.Name, MountPath: filepath.Join(pipeline.WorkspaceDir, ws.Name)}
+		var decl *v1.WorkspaceDeclaration
+		if tr.Status.TaskSpec != nil {
+			for i := range tr.Status.TaskSpec.Workspaces {
+				if tr.Status.TaskSpec.Workspaces[i].Name == ws.Name {
+					decl = &tr.Status.TaskSpec.Workspaces[i]
+				}
+			}
+		}
+		if decl != nil {
+			if decl.MountPath != "" {
+				b.MountPath = decl.MountPath
+			}
+			b.ReadOnly = decl.ReadOnly
+		}
+
+		volume := podVolume(pod, b.MountPath)
+		if err := describeVolume(kube, tr.Namespace, ws, volume, &b); err != nil {
+			return nil, err
+		}
+		if pod != nil {
+			b.Steps = podSteps(pod, b.MountPath, pods.NewStepNames(pod, tr.Status.Steps, stepPrefixes))
+		} else {
+			b.Steps = specSteps(tr.Status.TaskSpec, ws.Name)
+		}
+		bindings = append(bindings, b)
+	}
+	return bindings, nil
+}
+
+// podVolume returns the volume of the pod mounted at mountPath by a step
+func podVolume(pod *corev1.Pod, mountPath string) *corev1.Volume {
+	if pod == nil {
+		return nil
+	}
+	for _, c := range pod.Spec.Containers {
+		for _, m := range c.VolumeMounts {
+			if m.MountPath != mountPath {
+				continue
+			}
+			for i := range pod.Spec.Volumes {
+				if pod.Spec.Volumes[i].Name == m.Name {
+					return &pod.Spec.Volumes[i]
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func describeVolume(kube kubernetes.Interface, ns string, ws v1.WorkspaceBinding, volume *corev1.Volume, b *WorkspaceBinding) error {
+	switch {
+	case ws.PersistentVolumeClaim != nil:
+		b.Kind = "PersistentVolumeClaim"
+		b.Resource = ws.PersistentVolumeClaim.ClaimName
+		details, err := describeClaim(kube, ns, b.Resource)
+		if err != nil {
+			return err
+		}
+		b.Details = details
+	case ws.VolumeClaimTemplate != nil:
+		b.Kind = "VolumeClaimTemplate"
+		// the claim created from the template is only known from the pod
+		if volume != nil && volume.PersistentVolumeClaim != nil {
+			b.Resource = volume.PersistentVolumeClaim.ClaimName
+			details, err := describeClaim(kube, ns, b.Resource)
+			if err != nil {
+				return err
+			}
+			b.Details = details
+			return nil
+		}
+		spec := ws.VolumeClaimTemplate.Spec
+		b.Details = claimDetails(spec.StorageClassName, spec.Resources.Requests.Storage().String(), spec.AccessModes)
+	case ws.Secret != nil:
+		b.Kind = "Secret"
+		b.Resource = ws.Secret.SecretName
+		b.Details = keysDetails(ws.Secret.Items)
+	case ws.ConfigMap != nil:
+		b.Kind = "ConfigMap"
+		b.Resource = ws.ConfigMap.Name
+		b.Details = keysDetails(ws.ConfigMap.Items)
+	case ws.EmptyDir != nil:
+		b.Kind = "EmptyDir"
+		var details []string
+		if ws.EmptyDir.Medium != corev1.StorageMediumDefault {
+			details = append(details, "medium "+string(ws.EmptyDir.Medium))
+		}
+		if ws.EmptyDir.SizeLimit != nil {
+			details = append(details, "size limit "+ws.EmptyDir.SizeLimit.String())
+		}
+		b.Details = strings.Join(details, ", ")
+	case ws.CSI != nil:
+		b.Kind = "CSI"
+		b.Resource = ws.CSI.Driver
+	case ws.Projected != nil:
+		b.Kind = "Projected"
+		var sources []string
+		for _, s := range ws.Projected.Sources {
+			switch {
+			case s.Secret != nil:
+				sources = append(sources, "secret "+s.Secret.Name)
+			case s.ConfigMap != nil:
+				sources = append(sources, "configMap "+s.ConfigMap.Name)
+			case s.ServiceAccountToken != nil:
+				sources = append(sources, "serviceAccountToken")
+			case s.DownwardAPI != nil:
+				sources = append(sources, "downwardAPI")
+			}
+		}
+		b.Resource = strings.Join(sources, ", ")
+	}
+	return nil
+}
+
+// describeClaim returns the class, the size and the access modes of a claim,
+// its capacity once bound
+func describeClaim(kube kubernetes.Interface, ns, name string) (string, error) {
+	pvc, err := kube.CoreV1().PersistentVolumeClaims(ns).Get(context.Background(), name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return "not found", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get the PersistentVolumeClaim %s: %w", name, err)
+	}
+	size := pvc.Spec.Resources.Requests.Storage().String()
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		size = capacity.String()
+	}
+	details := claimDetails(pvc.Spec.StorageClassName, size, pvc.Spec.AccessModes)
+	if pvc.Status.Phase != "" && pvc.Status.Phase != corev1.ClaimBound {
+		details += ", " + strings.ToLower(string(pvc.Status.Phase))
+	}
+	return details, nil
+}
+
+func claimDetails(class *string, size string, modes []corev1.PersistentVolumeAccessMode) string {
+	details := []string{}
+	if class != nil {
+		details = append(details, "class "+*class)
+	}
+	if size != "0" {
+		details = append(details, size)
+	}
+	for _, m := range modes {
+		details = append(details, string(m))
+	}
+	return strings.Join(details, ", ")
+}
+
+func keysDetails(items []corev1.KeyToPath) string {
+	if len(items) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(items))
+	for _, i := range items {
+		keys = append(keys, i.Key)
+	}
+	return "keys " + strings.Join(keys, ", ")
+}
+
+// podSteps returns the steps of the pod which mount mountPath
+func podSteps(pod *corev1.Pod, mountPath string, names *pods.StepNames) []string {
+	var steps []string
+	for _, c := range pod.Spec.Containers {
+		name, ok := names.Name(c.Name)
+		if !ok {
+			continue
+		}
+		for _, m := range c.VolumeMounts {
+			if m.MountPath == mountPath {
+				steps = append(steps, name)
+				break
+			}
+		}
+	}
+	return steps
+}
+
+// specSteps returns the steps of the task which the workspace is mounted in,
+// all of them unless the workspace is isolated to some steps or sidecars
+func specSteps(spec *v1.TaskSpec, workspace string) []string {
+	if spec == nil {
+		return nil
+	}
+	isolated := false
+	for _, s := range spec.Sidecars {
+		isolated = isolated || usesWorkspace(s.Workspaces, workspace)
+	}
+	for _, s := range spec.Steps {
+		isolated = isolated || usesWorkspace(s.Workspaces, workspace)
+	}
+	var steps []string
+	for _, s := range spec.Steps {
+		if !isolated || usesWorkspace(s.Workspaces, workspace) {
+			steps = append(steps, s.Name)
+		}
+	}
+	return steps
+}
+
+func usesWorkspace(usages []v1.WorkspaceUsage, workspace string) bool {
+	for _, u := range usages {
+		if u.Name == workspace {
+			return true
+		}
+	}
+	return false
+}
+
+// PrintTaskRunWorkspaceBindings prints what backs each workspace of the
+// TaskRun and the steps it is mounted in, the containers of the steps are
+// found with stepPrefixes as well as the prefix of the pod
+func PrintTaskRunWorkspaceBindings(out io.Writer, c *cli.Clients, ns string, trName string, stepPrefixes []string) error {
+	tr, err := GetTaskRun(taskrunGroupResource, c, trName, ns)
+	if err != nil {
+		return cli.WithCause(err, "failed to find taskrun %q", trName)
+	}
+	bindings, err := WorkspaceBindings(c.Kube, tr, stepPrefixes)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 5, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintf(w, "\n%s%s\n\n", formatted.DecorateAttr("workspaces", ""), formatted.DecorateAttr("underline bold", "Workspace Bindings"))
+	if len(bindings) == 0 {
+		fmt.Fprintln(w, " No workspaces")
+		return w.Flush()
+	}
+	fmt.Fprintln(w, " NAME\tKIND\tRESOURCE\tDETAILS\tMOUNT PATH\tSTEPS")
+	for _, b := range bindings {
+		mountPath := b.MountPath
+		if b.ReadOnly {
+			mountPath += " (read only)"
+		}
+		fmt.Fprintf(w, " %s\t%s\t%s\t%s\t%s\t%s\n", formatted.DecorateAttr("bullet", b.Name), orDashes(b.Kind),
+			orDashes(b.Resource), orDashes(b.Details), mountPath, orDashes(strings.Join(b.Steps, ", ")))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,58 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package taskrun
+
+import (
+	"testing"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestWorkspaceBindings_withoutPod(t *testing.T) {
+	tr := &v1.TaskRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "ns"},
+		Spec: v1.TaskRunSpec{
+			Workspaces: []v1.WorkspaceBinding{
+				{Name: "source", PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "missing"}},
+				{Name: "config", ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "settings"},
+					Items:                []corev1.KeyToPath{{Key: "a.yaml", Path: "a.yaml"}},
+				}},
+			},
+		},
+		Status: v1.TaskRunStatus{
+			TaskRunStatusFields: v1.TaskRunStatusFields{
+				PodName: "tr-pod",
+				TaskSpec: &v1.TaskSpec{
+					Steps: []v1.Step{
+						{Name: "clone", Workspaces: []v1.WorkspaceUsage{{Name: "source"}}},
+						{Name: "build"},
+					},
+				},
+			},
+		},
+	}
+
+	bindings, err := WorkspaceBindings(k8sfake.NewSimpleClientset(), tr, nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, bindings, []WorkspaceBinding{
+		{Name: "source", Kind: "PersistentVolumeClaim", Resource: "missing", Details: "not found", MountPath: "/workspace/source", Steps: []string{"clone"}},
+		{Name: "config", Kind: "ConfigMap", Resource: "settings", Details: "keys a.yaml", MountPath: "/workspace/config", Steps: []string{"clone", "build"}},
+	})
+}
@@ -0,0 +1,282 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tasktest reads the test cases of a Task from a directory of
+// fixtures and evaluates the assertions of a case on the TaskRun it ran.
+package tasktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/yaml"
+)
+
+// Statuses a test case can expect the TaskRun to finish with
+const (
+	StatusSucceeded = "Succeeded"
+	StatusFailed    = "Failed"
+)
+
+// Case is a test case of a Task: the params and workspaces its TaskRun is
+// started with and what is expected of the TaskRun once it finished
+type Case struct {
+	// Name of the case, the name of its file without extension by default
+	Name       string                        `json:"name,omitempty"`
+	Params     map[string]v1beta1.ParamValue `json:"params,omitempty"`
+	Workspaces []v1beta1.WorkspaceBinding    `json:"workspaces,omitempty"`
+	// Timeout of the TaskRun, the one of the cluster by default
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	Expect  Expect           `json:"expect,omitempty"`
+}
+
+// Expect holds the assertions of a test case
+type Expect struct {
+	// Status the TaskRun finishes with, Succeeded by default
+	Status string `json:"status,omitempty"`
+	// Results asserts the values of the results by name
+	Results map[string]*ValueAssertion `json:"results,omitempty"`
+	// Logs asserts the logs of a step, or of all the steps when no step
+	// is given
+	Logs []*LogAssertion `json:"logs,omitempty"`
+	// Steps asserts the exit codes of the steps by name
+	Steps map[string]int32 `json:"steps,omitempty"`
+}
+
+// ValueAssertion asserts a value is equal to a string or matches a regular
+// expression
+type ValueAssertion struct {
+	Equals  *string `json:"equals,omitempty"`
+	Matches string  `json:"matches,omitempty"`
+
+	re *regexp.Regexp
+}
+
+// LogAssertion asserts the logs match, or do not match, a regular
+// expression
+type LogAssertion struct {
+	Step       string `json:"step,omitempty"`
+	Matches    string `json:"matches,omitempty"`
+	NotMatches string `json:"notMatches,omitempty"`
+
+	re, notRe *regexp.Regexp
+}
+
+// LoadCases reads the test cases from the .yaml and .yml files of dir,
+// ordered by file name
+func LoadCases(dir string) ([]*Case, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the fixtures: %w", err)
+	}
+
+	var cases []*Case
+	names := map[string]string{}
+	for _, e := range entries {
+		ext := filepath.Ext(e.Name())
+		if e.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		c := &Case{}
+		if err := yaml.UnmarshalStrict(b, c); err != nil {
+			return nil, fmt.Errorf("invalid test case %s: %w", path, err)
+		}
+		if c.Name == "" {
+			c.Name = strings.TrimSuffix(e.Name(), ext)
+		}
+		if other, ok := names[c.Name]; ok {
+			return nil, fmt.Errorf("test case %s of %s is also defined in %s", c.Name, path, other)
+		}
+		names[c.Name] = path
+		if err := c.compile(); err != nil {
+			return nil, fmt.Errorf("invalid test case %s: %w", path, err)
+		}
+		cases = append(cases, c)
+	}
+	if len(cases) == 0 {
+		return nil, fmt.Errorf("no test case found in %s", dir)
+	}
+	return cases, nil
+}
+
+func (c *Case) compile() error {
+	switch c.Expect.Status {
+	case "":
+		c.Expect.Status = StatusSucceeded
+	case StatusSucceeded, StatusFailed:
+	default:
+		return fmt.Errorf("expected status must be %s or %s, not %q", StatusSucceeded, StatusFailed, c.Expect.Status)
+	}
+
+	var err error
+	for name, r := range c.Expect.Results {
+		if r == nil || (r.Equals == nil && r.Matches == "") {
+			return fmt.Errorf("result %s must have equals or matches", name)
+		}
+		if r.Matches != "" {
+			if r.re, err = regexp.Compile(r.Matches); err != nil {
+				return fmt.Errorf("result %s: %w", name, err)
+			}
+		}
+	}
+	for _, l := range c.Expect.Logs {
+		if l == nil || (l.Matches == "" && l.NotMatches == "") {
+			return fmt.Errorf("log assertions must have matches or notMatches")
+		}
+		if l.Matches != "" {
+			if l.re, err = regexp.Compile(l.Matches); err != nil {
+				return fmt.Errorf("logs: %w", err)
+			}
+		}
+		if l.NotMatches != "" {
+			if l.notRe, err = regexp.Compile(l.NotMatches); err != nil {
+				return fmt.Errorf("logs: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// TaskRunParams returns the params of the case as the params of a TaskRun,
+// ordered by name
+func (c *Case) TaskRunParams() v1beta1.Params {
+	var params v1beta1.Params
+	for name, value := range c.Params {
+		params = append(params, v1beta1.Param{Name: name, Value: value})
+	}
+	sort.Slice(params, func(i, j int) bool { return params[i].Name < params[j].Name })
+	return params
+}
+
+// Evaluate evaluates the assertions of the case on the finished TaskRun
+// and the logs of its steps by step name. It returns the assertions which
+// failed, none when the case passed.
+func (c *Case) Evaluate(tr *v1.TaskRun, logs map[string]string) []string {
+	var failures []string
+
+	if status := runStatus(tr); status != c.Expect.Status {
+		failure := fmt.Sprintf("expected the TaskRun to be %s, it is %s", c.Expect.Status, status)
+		if cond := tr.Status.GetCondition(apis.ConditionSucceeded); cond != nil && cond.Message != "" {
+			failure += ": " + cond.Message
+		}
+		failures = append(failures, failure)
+	}
+
+	results := map[string]string{}
+	for _, r := range tr.Status.Results {
+		results[r.Name] = resultValue(r.Value)
+	}
+	for _, name := range sortedKeys(c.Expect.Results) {
+		r := c.Expect.Results[name]
+		value, ok := results[name]
+		switch {
+		case !ok:
+			failures = append(failures, fmt.Sprintf("result %s was not emitted", name))
+		case r.Equals != nil && value != *r.Equals:
+			failures = append(failures, fmt.Sprintf("result %s is %q, expected %q", name, value, *r.Equals))
+		case r.re != nil && !r.re.MatchString(value):
+			failures = append(failures, fmt.Sprintf("result %s is %q, expected to match %q", name, value, r.Matches))
+		}
+	}
+
+	for _, l := range c.Expect.Logs {
+		text, where := "", "the logs"
+		if l.Step != "" {
+			step, ok := logs[l.Step]
+			if !ok {
+				failures = append(failures, fmt.Sprintf("no logs of step %s", l.Step))
+				continue
+			}
+			text, where = step, "the logs of step "+l.Step
+		} else {
+			var all []string
+			for _, name := range sortedKeys(logs) {
+				all = append(all, logs[name])
+			}
+			text = strings.Join(all, "\n")
+		}
+		if l.re != nil && !l.re.MatchString(text) {
+			failures = append(failures, fmt.Sprintf("%s do not match %q", where, l.Matches))
+		}
+		if l.notRe != nil && l.notRe.MatchString(text) {
+			failures = append(failures, fmt.Sprintf("%s match %q", where, l.NotMatches))
+		}
+	}
+
+	steps := map[string]*corev1.ContainerStateTerminated{}
+	for _, s := range tr.Status.Steps {
+		steps[s.Name] = s.Terminated
+	}
+	for _, name := range sortedKeys(c.Expect.Steps) {
+		expected := c.Expect.Steps[name]
+		terminated, ok := steps[name]
+		switch {
+		case !ok:
+			failures = append(failures, fmt.Sprintf("step %s did not run", name))
+		case terminated == nil:
+			failures = append(failures, fmt.Sprintf("step %s did not terminate", name))
+		case terminated.ExitCode != expected:
+			failures = append(failures, fmt.Sprintf("step %s exited with %d, expected %d", name, terminated.ExitCode, expected))
+		}
+	}
+
+	return failures
+}
+
+func runStatus(tr *v1.TaskRun) string {
+	cond := tr.Status.GetCondition(apis.ConditionSucceeded)
+	switch {
+	case cond == nil:
+		return "Unknown"
+	case cond.IsTrue():
+		return StatusSucceeded
+	case cond.IsFalse():
+		return StatusFailed
+	}
+	return "Running"
+}
+
+// resultValue returns the string of a string result and the JSON of an
+// array or object result
+func resultValue(v v1.ResultValue) string {
+	if v.Type == v1.ParamTypeString || v.Type == "" {
+		return v.StringVal
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
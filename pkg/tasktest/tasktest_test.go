@@ -0,0 +1,244 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasktest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func writeFixtures(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLoadCases(t *testing.T) {
+	dir := writeFixtures(t, map[string]string{
+		"b-failure.yml": `
+name: fails-on-bad-url
+params:
+  url: nowhere
+expect:
+  status: Failed
+`,
+		"a-default.yaml": `
+params:
+  url: https://github.com/tektoncd/cli
+  flags: [--depth, "1"]
+workspaces:
+- name: output
+  emptyDir: {}
+timeout: 5m
+expect:
+  results:
+    commit:
+      matches: "^[0-9a-f]+$"
+  logs:
+  - step: clone
+    matches: cloned
+  steps:
+    clone: 0
+`,
+		"README.md": "not a test case",
+	})
+
+	cases, err := LoadCases(dir)
+	assert.NilError(t, err)
+	assert.Equal(t, len(cases), 2)
+
+	assert.Equal(t, cases[0].Name, "a-default")
+	assert.Equal(t, cases[0].Expect.Status, StatusSucceeded)
+	assert.Equal(t, cases[0].Timeout.Duration.String(), "5m0s")
+	assert.DeepEqual(t, cases[0].TaskRunParams(), v1beta1.Params{
+		{Name: "flags", Value: *v1beta1.NewStructuredValues("--depth", "1")},
+		{Name: "url", Value: *v1beta1.NewStructuredValues("https://github.com/tektoncd/cli")},
+	})
+	assert.Equal(t, cases[0].Workspaces[0].Name, "output")
+
+	assert.Equal(t, cases[1].Name, "fails-on-bad-url")
+	assert.Equal(t, cases[1].Expect.Status, StatusFailed)
+}
+
+func TestLoadCases_invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		err   string
+	}{
+		{
+			name:  "no case",
+			files: map[string]string{"README.md": "nothing"},
+			err:   "no test case found in ",
+		},
+		{
+			name:  "unknown field",
+			files: map[string]string{"a.yaml": "param:\n  url: x\n"},
+			err:   `invalid test case `,
+		},
+		{
+			name:  "unknown status",
+			files: map[string]string{"a.yaml": "expect:\n  status: Done\n"},
+			err:   `expected status must be Succeeded or Failed, not "Done"`,
+		},
+		{
+			name:  "invalid regexp",
+			files: map[string]string{"a.yaml": "expect:\n  logs:\n  - matches: \"(\"\n"},
+			err:   "logs: error parsing regexp",
+		},
+		{
+			name:  "result without assertion",
+			files: map[string]string{"a.yaml": "expect:\n  results:\n    commit: {}\n"},
+			err:   "result commit must have equals or matches",
+		},
+		{
+			name: "duplicated name",
+			files: map[string]string{
+				"a.yaml": "name: same\n",
+				"b.yaml": "name: same\n",
+			},
+			err: "test case same of ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadCases(writeFixtures(t, tt.files))
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func taskRun(status corev1.ConditionStatus, message string) *v1.TaskRun {
+	tr := &v1.TaskRun{}
+	tr.Status = v1.TaskRunStatus{
+		Status: duckv1.Status{
+			Conditions: duckv1.Conditions{
+				{Type: apis.ConditionSucceeded, Status: status, Message: message},
+			},
+		},
+		TaskRunStatusFields: v1.TaskRunStatusFields{
+			Results: []v1.TaskRunResult{
+				{Name: "commit", Type: v1.ResultsTypeString, Value: *v1.NewStructuredValues("1a2b3c")},
+				{Name: "tags", Type: v1.ResultsTypeArray, Value: *v1.NewStructuredValues("v1", "latest")},
+			},
+			Steps: []v1.StepState{
+				{Name: "clone", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}},
+				{Name: "check", ContainerState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 3}}},
+				{Name: "report", ContainerState: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		},
+	}
+	return tr
+}
+
+func TestEvaluate(t *testing.T) {
+	logs := map[string]string{
+		"clone": "Successfully cloned 1a2b3c\n",
+		"check": "3 problems found\n",
+	}
+
+	tests := []struct {
+		name     string
+		fixture  string
+		tr       *v1.TaskRun
+		failures []string
+	}{
+		{
+			name: "passes",
+			fixture: `
+expect:
+  results:
+    commit:
+      equals: 1a2b3c
+    tags:
+      matches: '^\["v1","latest"\]$'
+  logs:
+  - step: clone
+    matches: cloned [0-9a-f]+
+  - notMatches: panic
+  steps:
+    clone: 0
+    check: 3
+`,
+			tr: taskRun(corev1.ConditionTrue, ""),
+		},
+		{
+			name:    "expected failure",
+			fixture: "expect:\n  status: Failed\n",
+			tr:      taskRun(corev1.ConditionFalse, "step check failed"),
+		},
+		{
+			name:     "unexpected failure",
+			fixture:  "expect: {}\n",
+			tr:       taskRun(corev1.ConditionFalse, "step check failed"),
+			failures: []string{"expected the TaskRun to be Succeeded, it is Failed: step check failed"},
+		},
+		{
+			name: "failing assertions",
+			fixture: `
+expect:
+  results:
+    commit:
+      equals: ffffff
+    digest:
+      matches: sha256
+    tags:
+      matches: ^v2
+  logs:
+  - step: clone
+    matches: fatal
+  - step: push
+    matches: pushed
+  - notMatches: problems
+  steps:
+    check: 0
+    report: 0
+    push: 0
+`,
+			tr: taskRun(corev1.ConditionTrue, ""),
+			failures: []string{
+				`result commit is "1a2b3c", expected "ffffff"`,
+				"result digest was not emitted",
+				`result tags is "[\"v1\",\"latest\"]", expected to match "^v2"`,
+				`the logs of step clone do not match "fatal"`,
+				"no logs of step push",
+				`the logs match "problems"`,
+				"step check exited with 3, expected 0",
+				"step push did not run",
+				"step report did not terminate",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cases, err := LoadCases(writeFixtures(t, map[string]string{"case.yaml": tt.fixture}))
+			assert.NilError(t, err)
+			assert.DeepEqual(t, cases[0].Evaluate(tt.tr, logs), tt.failures)
+		})
+	}
+}
@@ -27,7 +27,7 @@ var allowedTektonTypes = map[string][]string{
 }
 
 var allowedTriggerTektonTypes = map[string][]string{
-	"v1alpha1": {"triggertemplates", "triggerbindings", "clustertriggerbindings", "eventlisteners"},
+	"v1alpha1": {"triggertemplates", "triggerbindings", "clustertriggerbindings", "eventlisteners", "clusterinterceptors", "interceptors"},
 	"v1beta1":  {"triggertemplates", "triggerbindings", "clustertriggerbindings", "eventlisteners"},
 }
 
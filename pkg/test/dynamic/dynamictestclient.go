@@ -58,6 +58,8 @@ func (opt *Options) Client(objects ...runtime.Object) (dynamic.Interface, error)
 			{Group: "triggers.tekton.dev", Version: "v1beta1", Resource: "triggerbindings"}:        "TriggerBindingList",
 			{Group: "triggers.tekton.dev", Version: "v1beta1", Resource: "clustertriggerbindings"}: "ClusterTriggerBindingList",
 			{Group: "triggers.tekton.dev", Version: "v1beta1", Resource: "eventlisteners"}:         "EventListenerList",
+			{Group: "triggers.tekton.dev", Version: "v1alpha1", Resource: "clusterinterceptors"}:   "ClusterInterceptorList",
+			{Group: "triggers.tekton.dev", Version: "v1alpha1", Resource: "interceptors"}:          "InterceptorList",
 		},
 		objects...,
 	)
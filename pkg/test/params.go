@@ -15,9 +15,12 @@
 package test
 
 import (
+	"fmt"
+
 	"github.com/jonboulle/clockwork"
 	"github.com/tektoncd/cli/pkg/actions"
 	"github.com/tektoncd/cli/pkg/cli"
+	"github.com/tektoncd/cli/pkg/config"
 	"github.com/tektoncd/pipeline/pkg/client/clientset/versioned"
 	versionedTriggers "github.com/tektoncd/triggers/pkg/client/clientset/versioned"
 	"k8s.io/client-go/dynamic"
@@ -27,12 +30,20 @@ import (
 
 type Params struct {
 	ns, kubeCfg, kubeCtx string
+	impersonate          rest.ImpersonationConfig
 	Tekton               versioned.Interface
 	Triggers             versionedTriggers.Interface
 	Kube                 k8s.Interface
 	Clock                clockwork.Clock
 	Cls                  *cli.Clients
 	Dynamic              dynamic.Interface
+	// Contexts are the Params returned by ForContext for each context
+	Contexts map[string]*Params
+	// ClientsErr is returned by Clients when set
+	ClientsErr error
+	// TknProfile is the profile of the tkn config returned by Profile, the
+	// configuration of the user is never read
+	TknProfile config.Profile
 }
 
 func (p *Params) SetNamespace(ns string) {
@@ -53,10 +64,22 @@ func (p *Params) SetKubeContext(context string) {
 	p.kubeCtx = context
 }
 
+func (p *Params) SetImpersonation(impersonate rest.ImpersonationConfig) {
+	p.impersonate = impersonate
+}
+
+func (p *Params) Impersonation() rest.ImpersonationConfig {
+	return p.impersonate
+}
+
 func (p *Params) KubeConfigPath() string {
 	return p.kubeCfg
 }
 
+func (p *Params) KubeContext() string {
+	return p.kubeCtx
+}
+
 func (p *Params) tektonClient() (versioned.Interface, error) {
 	return p.Tekton, nil
 }
@@ -74,6 +97,9 @@ func (p *Params) KubeClient() (k8s.Interface, error) {
 }
 
 func (p *Params) Clients(_ ...*rest.Config) (*cli.Clients, error) {
+	if p.ClientsErr != nil {
+		return nil, p.ClientsErr
+	}
 	if p.Cls != nil {
 		return p.Cls, nil
 	}
@@ -120,9 +146,32 @@ func (p *Params) Clients(_ ...*rest.Config) (*cli.Clients, error) {
 	return p.Cls, nil
 }
 
+// ForContext returns the Params of the context from Contexts, or Params
+// failing to create clients when there are none
+func (p *Params) ForContext(kubeContext, namespace string) cli.Params {
+	c, ok := p.Contexts[kubeContext]
+	if !ok {
+		return &Params{ns: namespace, ClientsErr: fmt.Errorf("context %q does not exist", kubeContext)}
+	}
+	c.kubeCtx = kubeContext
+	c.ns = namespace
+	if namespace == "" {
+		c.ns = p.ns
+	}
+	if c.Clock == nil {
+		c.Clock = p.Clock
+	}
+	return c
+}
+
 func (p *Params) Time() clockwork.Clock {
 	if p.Clock == nil {
 		p.Clock = FakeClock()
 	}
 	return p.Clock
 }
+
+// Profile returns TknProfile
+func (p *Params) Profile() (config.Profile, error) {
+	return p.TknProfile, nil
+}
@@ -0,0 +1,106 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trustedresources
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+)
+
+var (
+	// the OIDC issuer of a certificate issued by Fulcio for keyless
+	// signing, a raw string in its first version and an UTF-8 string since
+	oidIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// Identity identifies the key which signed an artifact, for the verifiers
+// of the artifact to audit who produced it
+type Identity struct {
+	// KMSKey is the reference of the key in its KMS, e.g.
+	// awskms:///arn:aws:kms:us-east-1:111122223333:key/1234abcd
+	KMSKey string `json:"kmsKey,omitempty"`
+	// PublicKey is the SHA-256 fingerprint of the DER encoded public key
+	PublicKey string `json:"publicKey"`
+	// CertIdentity is the subject alternative name of the certificate of
+	// the key, e.g. the email or the workload identity it was issued to
+	CertIdentity string `json:"certIdentity,omitempty"`
+	// CertIssuer is the OIDC issuer of a Fulcio certificate, the issuer of
+	// the certificate otherwise
+	CertIssuer string `json:"certIssuer,omitempty"`
+}
+
+// SignerIdentity returns the identity of signer, loaded from the KMS key
+// kmsKey when it is set, with the identity of its certificate in certFile
+// when it is set
+func SignerIdentity(signer signature.Signer, kmsKey, certFile string) (*Identity, error) {
+	pub, err := signer.PublicKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting the public key of the signer: %w", err)
+	}
+	der, err := cryptoutils.MarshalPublicKeyToDER(pub)
+	if err != nil {
+		return nil, err
+	}
+	identity := &Identity{
+		KMSKey:    kmsKey,
+		PublicKey: fmt.Sprintf("sha256:%x", sha256.Sum256(der)),
+	}
+	if certFile == "" {
+		return identity, nil
+	}
+
+	b, err := os.ReadFile(certFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading certificate: %w", err)
+	}
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM(b)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate %s: %w", certFile, err)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate found in %s", certFile)
+	}
+	// the first certificate is the one of the key, the others its chain
+	cert := certs[0]
+	if err := cryptoutils.EqualKeys(cert.PublicKey, pub); err != nil {
+		return nil, fmt.Errorf("certificate %s is not the certificate of the signing key", certFile)
+	}
+	identity.CertIdentity = strings.Join(cryptoutils.GetSubjectAlternateNames(cert), ",")
+	identity.CertIssuer = certIssuer(cert)
+	return identity, nil
+}
+
+func certIssuer(cert *x509.Certificate) string {
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var issuer string
+			if _, err := asn1.Unmarshal(ext.Value, &issuer); err == nil {
+				return issuer
+			}
+		case ext.Id.Equal(oidIssuerV1):
+			return string(ext.Value)
+		}
+	}
+	return cert.Issuer.String()
+}
@@ -0,0 +1,112 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trustedresources
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"gotest.tools/v3/assert"
+)
+
+func TestSignerIdentity(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := signature.LoadSigner(key, crypto.SHA256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := cryptoutils.MarshalPublicKeyToDER(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fingerprint := fmt.Sprintf("sha256:%x", sha256.Sum256(der))
+
+	issuer, err := asn1.Marshal("https://token.actions.githubusercontent.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	writeCert := func(name string, pub crypto.PublicKey, extensions []pkix.Extension) string {
+		template := &x509.Certificate{
+			SerialNumber:    big.NewInt(1),
+			Subject:         pkix.Name{CommonName: "release"},
+			NotBefore:       time.Now(),
+			NotAfter:        time.Now().Add(time.Hour),
+			EmailAddresses:  []string{"release@example.com"},
+			ExtraExtensions: extensions,
+		}
+		b, err := x509.CreateCertificate(rand.Reader, template, template, pub, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert, err := x509.ParseCertificate(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := cryptoutils.MarshalCertificateToPEM(cert)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, p, 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	fulcio := writeCert("fulcio.pem", key.Public(), []pkix.Extension{{Id: oidIssuerV2, Value: issuer}})
+	selfSigned := writeCert("self-signed.pem", key.Public(), nil)
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey := writeCert("other.pem", other.Public(), nil)
+
+	identity, err := SignerIdentity(signer, "", "")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, identity, &Identity{PublicKey: fingerprint})
+
+	identity, err = SignerIdentity(signer, "gcpkms://projects/p/locations/l/keyRings/r/cryptoKeys/k", fulcio)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, identity, &Identity{
+		KMSKey:       "gcpkms://projects/p/locations/l/keyRings/r/cryptoKeys/k",
+		PublicKey:    fingerprint,
+		CertIdentity: "release@example.com",
+		CertIssuer:   "https://token.actions.githubusercontent.com",
+	})
+
+	identity, err = SignerIdentity(signer, "", selfSigned)
+	assert.NilError(t, err)
+	assert.Equal(t, identity.CertIssuer, "CN=release")
+
+	_, err = SignerIdentity(signer, "", otherKey)
+	assert.Error(t, err, fmt.Sprintf("certificate %s is not the certificate of the signing key", otherKey))
+}
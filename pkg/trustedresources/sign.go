@@ -47,21 +47,9 @@ var (
 
 // Sign the crd and output signed bytes to writer
 func Sign(o metav1.Object, keyfile, kmsKey, targetFile string) error {
-	// Load signer
-	var signer signature.Signer
-	var err error
-	if keyfile != "" {
-		signer, err = signature.LoadSignerFromPEMFile(keyfile, crypto.SHA256, getPass)
-		if err != nil {
-			return fmt.Errorf("error getting signer from key file: %v", err)
-		}
-	}
-	if kmsKey != "" {
-		ctx := context.Background()
-		signer, err = kms.Get(ctx, kmsKey, crypto.SHA256)
-		if err != nil {
-			return fmt.Errorf("error getting kms signer: %v", err)
-		}
+	signer, err := LoadSigner(keyfile, kmsKey)
+	if err != nil {
+		return err
 	}
 
 	// Get annotation
@@ -85,7 +73,7 @@ func Sign(o metav1.Object, keyfile, kmsKey, targetFile string) error {
 	// save signed file
 	f, err := os.OpenFile(targetFile, os.O_WRONLY|os.O_CREATE, 0600)
 	if err != nil {
-		return fmt.Errorf("error opening output file: %v", err)
+		return fmt.Errorf("error opening output file: %w", err)
 	}
 	defer f.Close()
 	_, err = f.Write(signedBuf)
@@ -93,6 +81,27 @@ func Sign(o metav1.Object, keyfile, kmsKey, targetFile string) error {
 	return err
 }
 
+// LoadSigner returns the signer of the private key of keyfile, prompting
+// for its password, or of the key of a KMS, the KMS key taking precedence
+func LoadSigner(keyfile, kmsKey string) (signature.Signer, error) {
+	var signer signature.Signer
+	var err error
+	if keyfile != "" {
+		signer, err = signature.LoadSignerFromPEMFile(keyfile, crypto.SHA256, getPass)
+		if err != nil {
+			return nil, fmt.Errorf("error getting signer from key file: %w", err)
+		}
+	}
+	if kmsKey != "" {
+		ctx := context.Background()
+		signer, err = kms.Get(ctx, kmsKey, crypto.SHA256)
+		if err != nil {
+			return nil, fmt.Errorf("error getting kms signer: %w", err)
+		}
+	}
+	return signer, nil
+}
+
 // signInterface returns the encoded signature for the given object.
 func signInterface(signer signature.Signer, i interface{}) ([]byte, error) {
 	if signer == nil {
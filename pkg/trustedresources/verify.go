@@ -38,7 +38,7 @@ func Verify(o metav1.Object, keyfile, kmsKey string) error {
 		ctx := context.Background()
 		verifier, err = cosignsignature.LoadPublicKey(ctx, keyfile)
 		if err != nil {
-			return fmt.Errorf("error getting verifier from key file: %v", err)
+			return fmt.Errorf("error getting verifier from key file: %w", err)
 		}
 
 	}
@@ -46,7 +46,7 @@ func Verify(o metav1.Object, keyfile, kmsKey string) error {
 		ctx := context.Background()
 		verifier, err = kms.Get(ctx, kmsKey, crypto.SHA256)
 		if err != nil {
-			return fmt.Errorf("error getting kms verifier: %v", err)
+			return fmt.Errorf("error getting kms verifier: %w", err)
 		}
 	}
 
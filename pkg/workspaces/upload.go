@@ -0,0 +1,311 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspaces
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tektoncd/cli/pkg/pods"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	localDirParam = "localDir"
+
+	// DefaultUploadImage is the image of the pod extracting a local
+	// directory into a PersistentVolumeClaim, it only needs sh and tar
+	DefaultUploadImage = "busybox"
+
+	uploadMountPath    = "/workspace"
+	uploadPollInterval = time.Second
+	// minUploadClaimSize is the smallest size of the PersistentVolumeClaims
+	// local directories are uploaded to, they are twice as large as the
+	// directory otherwise
+	minUploadClaimSize = 1 << 30
+	// uploadUser is the user the pod extracting a local directory runs as,
+	// the user nobody of the distroless images
+	uploadUser = 65532
+)
+
+// LocalDir is a workspace bound to a local directory, which is uploaded to a
+// new PersistentVolumeClaim when the run is started
+type LocalDir struct {
+	Workspace string
+	Dir       string
+	SubPath   string
+}
+
+// SplitLocalDirs separates the workspaces bound to a local directory, passed
+// like name=source,localDir=., from the other workspaces
+func SplitLocalDirs(ws []string) ([]LocalDir, []string, error) {
+	var dirs []LocalDir
+	var others []string
+	for _, v := range ws {
+		r := strings.Split(v, ",")
+		dir, err := getPar(r, localDirParam)
+		if err == errNotFoundParam {
+			others = append(others, v)
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		name, err := getPar(r, nameParam)
+		if err != nil {
+			return nil, nil, errors.New("Name not found for workspace")
+		}
+		subPath, err := getPar(r, subPathParam)
+		if err != nil && err != errNotFoundParam {
+			return nil, nil, err
+		}
+		if len(r) != 2 && (len(r) != 3 || subPath == "") {
+			return nil, nil, errors.New(invalidWorkspace + v)
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid local directory for workspace %s: %w", name, err)
+		}
+		if !info.IsDir() {
+			return nil, nil, fmt.Errorf("invalid local directory for workspace %s: %s is not a directory", name, dir)
+		}
+		dirs = append(dirs, LocalDir{Workspace: name, Dir: dir, SubPath: subPath})
+	}
+	return dirs, others, nil
+}
+
+// Binding returns the binding of the workspace to the claim the local
+// directory was uploaded to
+func (l LocalDir) Binding(claim string) v1beta1.WorkspaceBinding {
+	return v1beta1.WorkspaceBinding{
+		Name:    l.Workspace,
+		SubPath: l.SubPath,
+		PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: claim,
+		},
+	}
+}
+
+// Upload copies the local directory to a new PersistentVolumeClaim and
+// returns its name. The directory is written as a tar archive to a pod
+// mounting the claim, which extracts it
+func (l LocalDir) Upload(kube k8s.Interface, ns, image string, exec pods.ExecInputFunc, timeout time.Duration) (string, error) {
+	size, err := dirSize(l.Dir)
+	if err != nil {
+		return "", err
+	}
+	claimSize := max(2*size, minUploadClaimSize)
+
+	ctx := context.Background()
+	claim, err := kube.CoreV1().PersistentVolumeClaims(ns).Create(ctx, &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "tkn-upload-",
+			Labels:       map[string]string{"app.kubernetes.io/managed-by": "tkn"},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: *resource.NewQuantity(claimSize, resource.BinarySI),
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to create the PersistentVolumeClaim of workspace %s: %w", l.Workspace, err)
+	}
+
+	if err := l.extract(kube, ns, claim.Name, image, exec, timeout); err != nil {
+		_ = kube.CoreV1().PersistentVolumeClaims(ns).Delete(ctx, claim.Name, metav1.DeleteOptions{})
+		return "", err
+	}
+	return claim.Name, nil
+}
+
+// OwnClaim makes the run own the claim a local directory was uploaded to,
+// so that the claim is deleted with the run
+func OwnClaim(kube k8s.Interface, ns, claim string, owner metav1.OwnerReference) error {
+	ctx := context.Background()
+	pvc, err := kube.CoreV1().PersistentVolumeClaims(ns).Get(ctx, claim, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	pvc.OwnerReferences = append(pvc.OwnerReferences, owner)
+	_, err = kube.CoreV1().PersistentVolumeClaims(ns).Update(ctx, pvc, metav1.UpdateOptions{})
+	return err
+}
+
+func (l LocalDir) extract(kube k8s.Interface, ns, claim, image string, exec pods.ExecInputFunc, timeout time.Duration) error {
+	podsClient := kube.CoreV1().Pods(ns)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "tkn-upload-",
+			Labels:       map[string]string{"app.kubernetes.io/managed-by": "tkn"},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			// the pod only writes the files to the claim, it is allowed
+			// nothing else so that it passes the restricted pod security
+			// standard
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot:   ptr.To(true),
+				RunAsUser:      ptr.To[int64](uploadUser),
+				RunAsGroup:     ptr.To[int64](uploadUser),
+				FSGroup:        ptr.To[int64](uploadUser),
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			},
+			Containers: []corev1.Container{{
+				Name:  "upload",
+				Image: image,
+				// the pod is deleted once the directory is extracted
+				Command:      []string{"sh", "-c", "sleep 3600"},
+				VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: uploadMountPath}},
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: ptr.To(false),
+					Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+				},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+				},
+			}},
+		},
+	}
+
+	ctx := context.Background()
+	created, err := podsClient.Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create the pod uploading workspace %s: %w", l.Workspace, err)
+	}
+	defer func() {
+		_ = podsClient.Delete(ctx, created.Name, metav1.DeleteOptions{})
+	}()
+
+	err = wait.PollUntilContextTimeout(ctx, uploadPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		p, err := podsClient.Get(ctx, created.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch p.Status.Phase {
+		case corev1.PodRunning:
+			return true, nil
+		case corev1.PodSucceeded, corev1.PodFailed:
+			return false, fmt.Errorf("pod %s stopped: %s", created.Name, p.Status.Message)
+		}
+		return false, nil
+	})
+	if wait.Interrupted(err) {
+		return fmt.Errorf("timed out after %s waiting for the pod uploading workspace %s", timeout, l.Workspace)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to upload workspace %s: %w", l.Workspace, err)
+	}
+
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(Tar(l.Dir, w))
+	}()
+	if _, err := exec(ns, created.Name, "upload", "tar -xf - -C "+uploadMountPath, r); err != nil {
+		_ = r.CloseWithError(err)
+		return fmt.Errorf("failed to upload %s to workspace %s: %w", l.Dir, l.Workspace, err)
+	}
+	return nil
+}
+
+// Tar writes the regular files, directories and symbolic links of dir as a
+// tar archive
+func Tar(dir string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		var link string
+		switch {
+		case info.Mode().IsRegular(), info.IsDir():
+		case info.Mode()&fs.ModeSymlink != 0:
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,82 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workspaces
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tektoncd/cli/pkg/test"
+)
+
+func TestSplitLocalDirs(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file")
+	if err := os.WriteFile(file, []byte("content"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	dirs, others, err := SplitLocalDirs([]string{"name=pvc,claimName=pvc1", "name=source,localDir=" + dir, "name=sub,localDir=" + dir + ",subPath=app"})
+	if err != nil {
+		t.Fatalf("Not expected error: %s", err.Error())
+	}
+	test.AssertOutput(t, []LocalDir{{Workspace: "source", Dir: dir}, {Workspace: "sub", Dir: dir, SubPath: "app"}}, dirs)
+	test.AssertOutput(t, []string{"name=pvc,claimName=pvc1"}, others)
+
+	_, _, err = SplitLocalDirs([]string{"localDir=" + dir})
+	test.AssertOutput(t, "Name not found for workspace", err.Error())
+
+	_, _, err = SplitLocalDirs([]string{"name=source,localDir=" + dir + ",emptyDir="})
+	test.AssertOutput(t, invalidWorkspace+"name=source,localDir="+dir+",emptyDir=", err.Error())
+
+	_, _, err = SplitLocalDirs([]string{"name=source,localDir=" + file})
+	test.AssertOutput(t, "invalid local directory for workspace source: "+file+" is not a directory", err.Error())
+}
+
+func TestTar(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "src"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("package main\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("src/main.go", filepath.Join(dir, "main.go")); err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	if err := Tar(dir, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	var entries []string
+	tr := tar.NewReader(&b)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, hdr.Name+" "+hdr.Linkname)
+	}
+	test.AssertOutput(t, []string{"main.go src/main.go", "src ", "src/main.go "}, entries)
+}